@@ -19,6 +19,7 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/httprate"
 	kitlog "github.com/go-kit/kit/log"
+	"github.com/google/uuid"
 	"github.com/keighl/postmark"
 	_ "github.com/lib/pq" // init pg driver
 	"github.com/oklog/run"
@@ -30,6 +31,7 @@ import (
 	internal_rsa "github.com/SatorNetwork/sator-api/lib/encryption/rsa"
 	"github.com/SatorNetwork/sator-api/lib/ethereum"
 	"github.com/SatorNetwork/sator-api/lib/firebase"
+	"github.com/SatorNetwork/sator-api/lib/idtoken"
 	"github.com/SatorNetwork/sator-api/lib/jwt"
 	lib_postmark "github.com/SatorNetwork/sator-api/lib/mail/postmark"
 	nft_marketplace_client "github.com/SatorNetwork/sator-api/lib/nft_marketplace/client"
@@ -40,15 +42,27 @@ import (
 	"github.com/SatorNetwork/sator-api/lib/solana_multiprovider"
 	storage "github.com/SatorNetwork/sator-api/lib/storage"
 	"github.com/SatorNetwork/sator-api/lib/sumsub"
+	"github.com/SatorNetwork/sator-api/svc/account_deletion"
+	accountDeletionRepo "github.com/SatorNetwork/sator-api/svc/account_deletion/repository"
 	announcement_svc "github.com/SatorNetwork/sator-api/svc/announcement"
 	announcement_repository "github.com/SatorNetwork/sator-api/svc/announcement/repository"
+	"github.com/SatorNetwork/sator-api/svc/audit_log"
+	auditLogRepo "github.com/SatorNetwork/sator-api/svc/audit_log/repository"
 	"github.com/SatorNetwork/sator-api/svc/auth"
 	authc "github.com/SatorNetwork/sator-api/svc/auth/client"
 	authRepo "github.com/SatorNetwork/sator-api/svc/auth/repository"
 	"github.com/SatorNetwork/sator-api/svc/balance"
+	"github.com/SatorNetwork/sator-api/svc/budgets"
+	budgetsClient "github.com/SatorNetwork/sator-api/svc/budgets/client"
+	budgetsRepo "github.com/SatorNetwork/sator-api/svc/budgets/repository"
+	"github.com/SatorNetwork/sator-api/svc/campaigns"
+	campaignsClient "github.com/SatorNetwork/sator-api/svc/campaigns/client"
+	campaignsRepo "github.com/SatorNetwork/sator-api/svc/campaigns/repository"
 	"github.com/SatorNetwork/sator-api/svc/challenge"
 	challengeClient "github.com/SatorNetwork/sator-api/svc/challenge/client"
 	challengeRepo "github.com/SatorNetwork/sator-api/svc/challenge/repository"
+	"github.com/SatorNetwork/sator-api/svc/data_export"
+	dataExportRepo "github.com/SatorNetwork/sator-api/svc/data_export/repository"
 	"github.com/SatorNetwork/sator-api/svc/exchange_rates"
 	exchange_rates_client "github.com/SatorNetwork/sator-api/svc/exchange_rates/client"
 	exchange_rates_repository "github.com/SatorNetwork/sator-api/svc/exchange_rates/repository"
@@ -63,12 +77,26 @@ import (
 	"github.com/SatorNetwork/sator-api/svc/invitations"
 	invitationsClient "github.com/SatorNetwork/sator-api/svc/invitations/client"
 	invitationsRepo "github.com/SatorNetwork/sator-api/svc/invitations/repository"
+	"github.com/SatorNetwork/sator-api/svc/leaderboard"
+	leaderboardClient "github.com/SatorNetwork/sator-api/svc/leaderboard/client"
+	leaderboardRepo "github.com/SatorNetwork/sator-api/svc/leaderboard/repository"
 	metrics_repository "github.com/SatorNetwork/sator-api/svc/metrics/repository"
+	"github.com/SatorNetwork/sator-api/svc/multipliers"
+	multipliersClient "github.com/SatorNetwork/sator-api/svc/multipliers/client"
+	multipliersRepo "github.com/SatorNetwork/sator-api/svc/multipliers/repository"
 	"github.com/SatorNetwork/sator-api/svc/nft"
 	nftC "github.com/SatorNetwork/sator-api/svc/nft/client"
 	nftRepo "github.com/SatorNetwork/sator-api/svc/nft/repository"
+	"github.com/SatorNetwork/sator-api/svc/permissions"
+	permissionsRepo "github.com/SatorNetwork/sator-api/svc/permissions/repository"
+	"github.com/SatorNetwork/sator-api/svc/pricing"
+	pricingClient "github.com/SatorNetwork/sator-api/svc/pricing/client"
+	pricingRepo "github.com/SatorNetwork/sator-api/svc/pricing/repository"
 	"github.com/SatorNetwork/sator-api/svc/profile"
 	profileRepo "github.com/SatorNetwork/sator-api/svc/profile/repository"
+	"github.com/SatorNetwork/sator-api/svc/promocodes"
+	promocodesClient "github.com/SatorNetwork/sator-api/svc/promocodes/client"
+	promocodesRepo "github.com/SatorNetwork/sator-api/svc/promocodes/repository"
 	"github.com/SatorNetwork/sator-api/svc/puzzle_game"
 	puzzleGameRepo "github.com/SatorNetwork/sator-api/svc/puzzle_game/repository"
 	"github.com/SatorNetwork/sator-api/svc/qrcodes"
@@ -76,6 +104,7 @@ import (
 	"github.com/SatorNetwork/sator-api/svc/quiz_v2"
 	quizV2Repo "github.com/SatorNetwork/sator-api/svc/quiz_v2/repository"
 	"github.com/SatorNetwork/sator-api/svc/referrals"
+	referralsClient "github.com/SatorNetwork/sator-api/svc/referrals/client"
 	referralsRepo "github.com/SatorNetwork/sator-api/svc/referrals/repository"
 	"github.com/SatorNetwork/sator-api/svc/rewards"
 	rewardsClient "github.com/SatorNetwork/sator-api/svc/rewards/client"
@@ -85,8 +114,6 @@ import (
 	showsRepo "github.com/SatorNetwork/sator-api/svc/shows/repository"
 	"github.com/SatorNetwork/sator-api/svc/trading_platforms"
 	tradingPlatformsRepo "github.com/SatorNetwork/sator-api/svc/trading_platforms/repository"
-	tx_watcher_svc "github.com/SatorNetwork/sator-api/svc/tx_watcher"
-	tx_watcher_repository "github.com/SatorNetwork/sator-api/svc/tx_watcher/repository"
 	"github.com/SatorNetwork/sator-api/svc/wallet"
 	walletClient "github.com/SatorNetwork/sator-api/svc/wallet/client"
 	walletRepo "github.com/SatorNetwork/sator-api/svc/wallet/repository"
@@ -101,7 +128,39 @@ type Config struct {
 	DBMaxOpenConns                 int
 	DBMaxIdleConns                 int
 	JwtSigningKey                  string
+	JwtLegacyKeyCutoff             int64
 	JwtTTL                         time.Duration
+	JwtKeysEncryptionKey           string
+	JwtKeyRotationPeriod           time.Duration
+	JwtKeyGracePeriod              time.Duration
+	JwtKeySyncInterval             time.Duration
+	SessionCacheSyncInterval       time.Duration
+	SessionMaxLifetime             time.Duration
+	TwoFactorEncryptionKey         string
+	TwoFactorIssuer                string
+	AppleClientIDs                 string
+	GoogleClientIDs                string
+	MagicLinkSecret                string
+	MagicLinkURL                   string
+	MagicLinkTTL                   time.Duration
+	MagicLinkEmailLimit            int
+	MagicLinkIPLimit               int
+	SolanaSignInDomain             string
+	SolanaSignInURI                string
+	LockoutMaxAccountFailures      int
+	LockoutMaxIPFailures           int
+	LockoutBaseDuration            time.Duration
+	LockoutMaxDuration             time.Duration
+	DataExportSecret               string
+	DataExportURL                  string
+	DataExportLinkTTL              time.Duration
+	DataExportRequestInterval      time.Duration
+	DataExportWorkerInterval       time.Duration
+	AccountDeletionGracePeriod     time.Duration
+	AccountDeletionMaxAttempts     int
+	AccountDeletionRetryDelay      time.Duration
+	AccountDeletionWorkerInterval  time.Duration
+	AuditLogChainKey               string
 	OtpLength                      int
 	MasterOTPHash                  string
 	QuizWsConnURL                  string
@@ -112,6 +171,7 @@ type Config struct {
 	SolanaApiBaseUrl               string
 	SolanaProviderConfigs          string
 	SolanaAssetAddr                string
+	SolanaUSDCAssetAddr            string
 	SolanaFeePayerAddr             string
 	SolanaFeePayerPrivateKey       string
 	SolanaTokenHolderAddr          string
@@ -133,8 +193,19 @@ type Config struct {
 	CompanyName                    string
 	CompanyAddress                 string
 	HoldRewardsPeriod              time.Duration
+	HoldRewardsPeriods             string
+	RewardsVestingThreshold        float64
+	RewardsVestingPeriod           time.Duration
+	RewardClaimConfirmationTimeout time.Duration
+	MultiplierStackingMode         string
+	MultiplierMaxPercent           float64
+	PromoCodeMaxFailedAttempts     int
+	PromoCodeAttemptsWindow        time.Duration
 	InvitationReward               float64
 	InvitationURL                  string
+	InvitationTokenSecret          string
+	InvitationTTL                  time.Duration
+	InvitationMaxSends             int
 	FileStorageKey                 string
 	FileStorageSecret              string
 	FileStorageEndpoint            string
@@ -166,6 +237,11 @@ type Config struct {
 	TipsPercent                    float64
 	TokenTransferPercent           float64
 	ClaimRewardsPercent            float64
+	FeeSponsorshipTiers            string
+	FeeSponsorshipFallbackFee      float64
+	WatchedWalletsLimit            int
+	WatchedWalletRefreshInterval   time.Duration
+	WatchedWalletWorkerInterval    time.Duration
 	FeeAccumulatorAddress          string
 	SatorAPIKey                    string
 	SkipAPIKeyCheck                bool
@@ -183,6 +259,8 @@ type Config struct {
 	DisableRewardsForQuiz          bool
 	RewardsWalletEnabled           bool
 	DisableVerificationForRealm    bool
+	PriceQuoteTTL                  time.Duration
+	PriceMaxSlippage               float64
 }
 
 var buildTag string
@@ -210,8 +288,55 @@ func ConfigFromEnv() *Config {
 		BlacklistMode: env.GetBool("BLACKLIST_MODE", true),
 
 		// JWT
-		JwtSigningKey: env.MustString("JWT_SIGNING_KEY"),
-		JwtTTL:        env.GetDuration("JWT_TTL", 24*time.Hour),
+		JwtSigningKey:      env.GetString("JWT_SIGNING_KEY", ""),          // legacy HS256 tokens are accepted during the key grace period since the cutoff if set
+		JwtLegacyKeyCutoff: int64(env.GetInt("JWT_LEGACY_KEY_CUTOFF", 0)), // unix time of the switch to RS256, required with JWT_SIGNING_KEY
+		JwtTTL:             env.GetDuration("JWT_TTL", 24*time.Hour),
+
+		JwtKeysEncryptionKey: env.MustString("JWT_KEYS_ENCRYPTION_KEY"),
+		JwtKeyRotationPeriod: env.GetDuration("JWT_KEY_ROTATION_PERIOD", 30*24*time.Hour),
+		JwtKeyGracePeriod:    env.GetDuration("JWT_KEY_GRACE_PERIOD", 31*24*time.Hour),
+		JwtKeySyncInterval:   env.GetDuration("JWT_KEY_SYNC_INTERVAL", 5*time.Minute),
+
+		SessionCacheSyncInterval: env.GetDuration("SESSION_CACHE_SYNC_INTERVAL", 30*time.Second),
+		SessionMaxLifetime:       env.GetDuration("SESSION_MAX_LIFETIME", 90*24*time.Hour), // absolute refresh token lifetime
+
+		TwoFactorEncryptionKey: env.MustString("TWO_FACTOR_ENCRYPTION_KEY"),
+		TwoFactorIssuer:        env.GetString("TWO_FACTOR_ISSUER", "Sator"),
+
+		// Sign in with Apple and Google, comma-separated client ids of the apps
+		AppleClientIDs:  env.GetString("APPLE_CLIENT_IDS", ""),
+		GoogleClientIDs: env.GetString("GOOGLE_CLIENT_IDS", ""),
+
+		// Passwordless login by email link, limits are per hour
+		MagicLinkSecret:     env.MustString("MAGIC_LINK_SECRET"),
+		MagicLinkURL:        env.GetString("MAGIC_LINK_URL", "https://sator.io/magic-link"),
+		MagicLinkTTL:        env.GetDuration("MAGIC_LINK_TTL", 15*time.Minute),
+		MagicLinkEmailLimit: env.GetInt("MAGIC_LINK_EMAIL_LIMIT", 5),
+		MagicLinkIPLimit:    env.GetInt("MAGIC_LINK_IP_LIMIT", 20),
+
+		// Sign in with Solana wallet, signed messages are bound to the domain
+		SolanaSignInDomain: env.GetString("SOLANA_SIGN_IN_DOMAIN", "sator.io"),
+		SolanaSignInURI:    env.GetString("SOLANA_SIGN_IN_URI", "https://sator.io"),
+
+		// Brute-force protection of login and OTP verification, failures are counted within an hour
+		LockoutMaxAccountFailures: env.GetInt("LOCKOUT_MAX_ACCOUNT_FAILURES", 5),
+		LockoutMaxIPFailures:      env.GetInt("LOCKOUT_MAX_IP_FAILURES", 20),
+		LockoutBaseDuration:       env.GetDuration("LOCKOUT_BASE_DURATION", time.Minute),
+		LockoutMaxDuration:        env.GetDuration("LOCKOUT_MAX_DURATION", 24*time.Hour),
+
+		// Self-service export of the user's data, archives are removed when download links expire
+		DataExportSecret:              env.MustString("DATA_EXPORT_SECRET"),
+		DataExportURL:                 env.GetString("DATA_EXPORT_URL", "https://sator.io/data-export"),
+		DataExportLinkTTL:             env.GetDuration("DATA_EXPORT_LINK_TTL", 7*24*time.Hour),
+		DataExportRequestInterval:     env.GetDuration("DATA_EXPORT_REQUEST_INTERVAL", 24*time.Hour),
+		DataExportWorkerInterval:      env.GetDuration("DATA_EXPORT_WORKER_INTERVAL", time.Minute),
+		AccountDeletionGracePeriod:    env.GetDuration("ACCOUNT_DELETION_GRACE_PERIOD", 14*24*time.Hour),
+		AccountDeletionMaxAttempts:    env.GetInt("ACCOUNT_DELETION_MAX_ATTEMPTS", 5),
+		AccountDeletionRetryDelay:     env.GetDuration("ACCOUNT_DELETION_RETRY_DELAY", time.Hour),
+		AccountDeletionWorkerInterval: env.GetDuration("ACCOUNT_DELETION_WORKER_INTERVAL", time.Minute),
+
+		// Audit log of privileged actions, entries are hash chained if the key is set
+		AuditLogChainKey: env.GetString("AUDIT_LOG_CHAIN_KEY", ""),
 
 		// Quiz
 		QuizWsConnURL:    env.MustString("QUIZ_WS_CONN_URL"),
@@ -224,6 +349,7 @@ func ConfigFromEnv() *Config {
 		SolanaApiBaseUrl:            env.MustString("SOLANA_API_BASE_URL"),
 		SolanaProviderConfigs:       env.GetString("SOLANA_PROVIDER_CONFIGS", ""),
 		SolanaAssetAddr:             env.MustString("SOLANA_ASSET_ADDR"),
+		SolanaUSDCAssetAddr:         env.GetString("SOLANA_USDC_ASSET_ADDR", "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"),
 		SolanaFeePayerAddr:          env.MustString("SOLANA_FEE_PAYER_ADDR"),
 		SolanaFeePayerPrivateKey:    env.MustString("SOLANA_FEE_PAYER_PRIVATE_KEY"),
 		SolanaTokenHolderAddr:       env.MustString("SOLANA_TOKEN_HOLDER_ADDR"),
@@ -254,10 +380,26 @@ func ConfigFromEnv() *Config {
 		// Rewards
 		HoldRewardsPeriod:    env.GetDuration("HOLD_REWARDS_PERIOD", 0),
 		RewardsWalletEnabled: env.GetBool("REWARDS_WALLET_ENABLED", true),
+		// Hold period per relation type, e.g. "quizzes:24h,invitation:720h"
+		HoldRewardsPeriods:      env.GetString("HOLD_REWARDS_PERIODS", ""),
+		RewardsVestingThreshold: env.GetFloat("REWARDS_VESTING_THRESHOLD", 0),
+		RewardsVestingPeriod:    env.GetDuration("REWARDS_VESTING_PERIOD", 0),
+		// Not confirmed reward claims are reverted after this timeout
+		RewardClaimConfirmationTimeout: env.GetDuration("REWARD_CLAIM_CONFIRMATION_TIMEOUT", 30*time.Minute),
+		// Reward multiplier factors are stacked "additive" or "multiplicative", total bonus percent is capped (0 means unlimited)
+		MultiplierStackingMode: env.GetString("MULTIPLIER_STACKING_MODE", multipliers.StackingAdditive),
+		MultiplierMaxPercent:   env.GetFloat("MULTIPLIER_MAX_PERCENT", 0),
+		// Users are not able to redeem promo codes after too many failed attempts within the window
+		PromoCodeMaxFailedAttempts: env.GetInt("PROMO_CODE_MAX_FAILED_ATTEMPTS", 5),
+		PromoCodeAttemptsWindow:    env.GetDuration("PROMO_CODE_ATTEMPTS_WINDOW", time.Hour),
 
 		// Invitation
 		InvitationReward: env.GetFloat("INVITATION_REWARD", 0),
 		InvitationURL:    env.GetString("INVITATION_URL", "https://sator.io"),
+		// Invitation links are signed with the secret and expire after TTL since the last sending
+		InvitationTokenSecret: env.MustString("INVITATION_TOKEN_SECRET"),
+		InvitationTTL:         env.GetDuration("INVITATION_TTL", 7*24*time.Hour),
+		InvitationMaxSends:    env.GetInt("INVITATION_MAX_SENDS", 3),
 
 		// File Storage
 		FileStorageKey:            env.MustString("STORAGE_KEY"),
@@ -305,6 +447,15 @@ func ConfigFromEnv() *Config {
 		ClaimRewardsPercent:   env.GetFloat("CLAIM_REWARDS_PERCENT", 0.75),
 		FeeAccumulatorAddress: env.GetString("FEE_ACCUMULATOR_ADDRESS", "96P3ugPEP6osg2R5RGRApikWLPzsgm1FRU3hiuc8WnMh"),
 
+		// JSON list of wallet.FeeSponsorshipTier, default tiers are used if empty
+		FeeSponsorshipTiers:       env.GetString("FEE_SPONSORSHIP_TIERS", ""),
+		FeeSponsorshipFallbackFee: env.GetFloat("FEE_SPONSORSHIP_FALLBACK_FEE", 1),
+
+		// Watch-only wallets
+		WatchedWalletsLimit:          env.GetInt("WATCHED_WALLETS_LIMIT", 10),
+		WatchedWalletRefreshInterval: env.GetDuration("WATCHED_WALLET_REFRESH_INTERVAL", 10*time.Minute),
+		WatchedWalletWorkerInterval:  env.GetDuration("WATCHED_WALLET_WORKER_INTERVAL", time.Minute),
+
 		SatorAPIKey:       env.MustString("SATOR_API_KEY"),
 		SkipAPIKeyCheck:   env.GetBool("SKIP_API_KEY_CHECK", false),
 		SkipDeviceIDCheck: env.GetBool("SKIP_DEVICE_ID_CHECK", false),
@@ -324,6 +475,10 @@ func ConfigFromEnv() *Config {
 
 		DisableRewardsForQuiz:       env.GetBool("DISABLE_REWARDS_FOR_QUIZ", false),
 		DisableVerificationForRealm: env.GetBool("DISABLE_VERIFICATION_FOR_REALM", false),
+
+		// Pricing
+		PriceQuoteTTL:    env.GetDuration("PRICE_QUOTE_TTL", time.Minute),
+		PriceMaxSlippage: env.GetFloat("PRICE_MAX_SLIPPAGE", 0.02),
 	}
 }
 
@@ -400,6 +555,7 @@ func (a *app) Run() {
 			middleware.NoCache,
 			middleware.RealIP,
 			middleware.RequestID,
+			audit_log.ClientIPToContext,
 			httprate.LimitByIP(100, 1*time.Minute),
 			middleware.Timeout(a.cfg.HttpRequestTimeout),
 			cors.AllowAll().Handler,
@@ -428,8 +584,76 @@ func (a *app) Run() {
 
 	// Init JWT parser middleware
 	// not depends on transport
-	jwtMdw := jwt.NewParser(a.cfg.JwtSigningKey, jwt.CheckUser(authRepository.IsUserDisabled), authRepository)
-	jwtInteractor := jwt.NewInteractor(a.cfg.JwtSigningKey, a.cfg.JwtTTL)
+	// JWT signing keys, rotated on schedule and published on /.well-known/jwks.json
+	jwtKeys := jwt.NewKeySet(
+		auth.NewKeyStore(authRepository, a.cfg.JwtKeysEncryptionKey),
+		jwt.WithRotation(a.cfg.JwtKeyRotationPeriod, a.cfg.JwtKeyGracePeriod),
+		jwt.WithLegacySigningKey(a.cfg.JwtSigningKey, time.Unix(a.cfg.JwtLegacyKeyCutoff, 0)),
+	)
+	if err := jwtKeys.Sync(ctx); err != nil {
+		log.Fatalf("can't load jwt signing keys: %v", err)
+	}
+	g.Add(func() error {
+		return jwtKeys.Run(ctx, a.cfg.JwtKeySyncInterval)
+	}, func(err error) {
+		cancel()
+	})
+	r.Get("/.well-known/jwks.json", jwt.JWKSHandler(jwtKeys))
+
+	// Revoked sessions are kept in memory and synced with db periodically
+	sessionCache := jwt.NewSessionCache(
+		func(ctx context.Context) ([]jwt.RevokedSession, error) {
+			rows, err := authRepository.GetRevokedUserSessions(ctx)
+			if err != nil {
+				return nil, err
+			}
+			sessions := make([]jwt.RevokedSession, 0, len(rows))
+			for _, r := range rows {
+				sessions = append(sessions, jwt.RevokedSession{ID: r.ID, ExpiresAt: r.ExpiresAt})
+			}
+			return sessions, nil
+		},
+		func(ctx context.Context, sessionID uuid.UUID, lastSeenAt time.Time) error {
+			return authRepository.UpdateUserSessionLastSeen(ctx, authRepo.UpdateUserSessionLastSeenParams{
+				ID:         sessionID,
+				LastSeenAt: lastSeenAt,
+			})
+		},
+	)
+	g.Add(func() error {
+		return sessionCache.Run(ctx, a.cfg.SessionCacheSyncInterval)
+	}, func(err error) {
+		cancel()
+	})
+
+	// TOTP second factor, sensitive actions of other services require step-up code
+	twoFactor := auth.NewTwoFactor(authRepository, a.cfg.TwoFactorEncryptionKey, a.cfg.TwoFactorIssuer)
+
+	jwtMdw := jwt.NewParser(
+		jwtKeys,
+		jwt.ChainChecks(jwt.CheckUser(authRepository.IsUserDisabled), jwt.CheckSession(sessionCache)),
+		authRepository,
+	)
+	refreshJwtMdw := jwt.NewRefreshParser(
+		jwtKeys,
+		jwt.ChainChecks(jwt.CheckUser(authRepository.IsUserDisabled), jwt.CheckSession(sessionCache)),
+		authRepository,
+	)
+	jwtInteractor := jwt.NewInteractor(jwtKeys, a.cfg.JwtTTL)
+
+	// Audit log service
+	// Privileged mutations of other services are recorded with auditLogSvc.Record
+	auditLogRepository, err := auditLogRepo.Prepare(ctx, db)
+	if err != nil {
+		log.Fatalf("auditLogRepo error: %v", err)
+	}
+	auditLogSvc := audit_log.NewService(db, auditLogRepository, audit_log.Config{
+		ChainKey: a.cfg.AuditLogChainKey,
+	})
+	r.Mount("/audit-log", audit_log.MakeHTTPHandler(
+		audit_log.MakeEndpoints(auditLogSvc, jwtMdw),
+		logger,
+	))
 
 	ethereumClient, err := ethereum.NewClient()
 	if err != nil {
@@ -538,21 +762,6 @@ func (a *app) Run() {
 		}
 	}
 
-	var txWatcherSvc *tx_watcher_svc.Service
-	{
-		txWatcherRepository, err := tx_watcher_repository.Prepare(ctx, db)
-		if err != nil {
-			log.Fatalf("can't prepare tx watcher repository: %v", err)
-		}
-
-		txWatcherSvc = tx_watcher_svc.NewService(
-			txWatcherRepository,
-			solanaClient,
-			feePayer,
-			tokenHolder,
-		)
-	}
-
 	var unityGameTokenHolder types.Account
 	{
 		unityGameTokenHolder, err = types.AccountFromBase58(a.cfg.UnityGameTokenPoolPrivateKey)
@@ -561,15 +770,35 @@ func (a *app) Run() {
 		}
 	}
 
+	// Campaigns service client is set up after the rewards service,
+	// so the wallet service reports stakes to it through the closure.
+	var campaignsSvcClient *campaignsClient.Client
+	// Account deletion service is set up after all the services,
+	// so the auth service schedules account deletion through the closure.
+	var accountDeletionSvc *account_deletion.Service
+
 	var walletSvcClient *walletClient.Client
 	// Wallet service
+	// Services add the user's data to the data export archive
+	var dataExportCollectors []data_export.ServiceOption
+	// Services erase the user's personal data on account deletion
+	var accountDeletionErasers []account_deletion.ServiceOption
+
 	{
+		var feeSponsorshipTiers []wallet.FeeSponsorshipTier
+		if a.cfg.FeeSponsorshipTiers != "" {
+			if err := json.Unmarshal([]byte(a.cfg.FeeSponsorshipTiers), &feeSponsorshipTiers); err != nil {
+				log.Fatalf("can't unmarshal fee sponsorship tiers: %v\n", err)
+			}
+		}
+
 		walletService := wallet.NewService(
+			db,
 			walletRepository,
 			solanaClient,
 			ethereumClient,
-			txWatcherSvc,
 			wallet.WithAssetSolanaAddress(a.cfg.SolanaAssetAddr),
+			wallet.WithUSDCAssetSolanaAddress(a.cfg.SolanaUSDCAssetAddr),
 			wallet.WithSolanaFeePayer(a.cfg.SolanaFeePayerAddr, feePayer.PrivateKey),
 			wallet.WithSolanaTokenHolder(a.cfg.SolanaTokenHolderAddr, tokenHolder.PrivateKey),
 			wallet.WithMinAmountToTransfer(a.cfg.MinAmountToTransfer),
@@ -579,12 +808,74 @@ func (a *app) Run() {
 			wallet.WithClaimRewardsPercent(a.cfg.ClaimRewardsPercent),
 			wallet.WithResourceIntensiveQueries(a.cfg.EnableResourceIntensiveQueries),
 			wallet.WithRewardsWalletEnabled(a.cfg.RewardsWalletEnabled),
+			wallet.WithFeeSponsorshipTiers(feeSponsorshipTiers...),
+			wallet.WithFeeSponsorshipFallbackFee(a.cfg.FeeSponsorshipFallbackFee),
+			wallet.WithExchangeRatesClient(exchangeRatesClient),
+			wallet.WithWatchedWalletsLimit(int32(a.cfg.WatchedWalletsLimit)),
+			wallet.WithWatchedWalletRefreshInterval(a.cfg.WatchedWalletRefreshInterval),
+			wallet.WithCampaignEventFunc(func(ctx context.Context, userID uuid.UUID, event string) {
+				if campaignsSvcClient != nil {
+					campaignsSvcClient.TrackEvent(ctx, userID, event)
+				}
+			}),
+			wallet.WithTwoFactorCheckFunc(twoFactor.Verify),
 		)
 		walletSvcClient = walletClient.New(walletService)
+		dataExportCollectors = append(dataExportCollectors, data_export.WithCollector("wallets", walletService.ExportUserData))
+		accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("wallets", walletService.EraseUserData))
 		r.Mount("/wallets", wallet.MakeHTTPHandler(
 			wallet.MakeEndpoints(walletService, kycMdw, jwtMdw),
 			logger,
 		))
+		g.Add(func() error {
+			return walletService.RunWatchedWalletsRefresh(ctx, a.cfg.WatchedWalletWorkerInterval)
+		}, func(err error) {
+			cancel()
+		})
+	}
+
+	// Promo codes service is set up after the services it grants rewards through,
+	// so pricing gets the user's discount through the closures.
+	var promocodesSvcClient *promocodesClient.Client
+	getDiscountFn := func(ctx context.Context, userID uuid.UUID) (uuid.UUID, float64, error) {
+		if promocodesSvcClient == nil {
+			return uuid.Nil, 0, nil
+		}
+		return promocodesSvcClient.GetDiscount(ctx, userID)
+	}
+	useDiscountFn := func(ctx context.Context, userID, discountID uuid.UUID) error {
+		if promocodesSvcClient == nil {
+			return fmt.Errorf("promo codes service is not set")
+		}
+		return promocodesSvcClient.UseDiscount(ctx, userID, discountID)
+	}
+	releaseDiscountFn := func(ctx context.Context, discountID uuid.UUID) error {
+		if promocodesSvcClient == nil {
+			return nil
+		}
+		return promocodesSvcClient.ReleaseDiscount(ctx, discountID)
+	}
+
+	var pricingSvcClient *pricingClient.Client
+	// Pricing service
+	{
+		pricingRepository, err := pricingRepo.Prepare(ctx, db)
+		if err != nil {
+			log.Fatalf("pricingRepo error: %v", err)
+		}
+		pricingService := pricing.NewService(
+			pricingRepository,
+			walletSvcClient.PayForServiceWithAsset,
+			exchangeRatesClient,
+			pricing.WithQuoteTTL(a.cfg.PriceQuoteTTL),
+			pricing.WithMaxSlippage(a.cfg.PriceMaxSlippage),
+			pricing.WithDiscountFunctions(getDiscountFn, useDiscountFn, releaseDiscountFn),
+		)
+		pricingSvcClient = pricingClient.New(pricingService)
+		r.Mount("/pricing", pricing.MakeHTTPHandler(
+			pricing.MakeEndpoints(pricingService, jwtMdw),
+			logger,
+		))
 	}
 
 	// Rewards service
@@ -594,20 +885,86 @@ func (a *app) Run() {
 	if err != nil {
 		log.Fatalf("rewardsRepo error: %v", err)
 	}
+	holdRewardsPeriods, err := rewards.ParseHoldPeriods(a.cfg.HoldRewardsPeriods)
+	if err != nil {
+		log.Fatalf("can't parse hold rewards periods: %v", err)
+	}
 	rewardService := rewards.NewService(
+		db,
 		rewardsRepository,
 		walletSvcClient,
 		db_internal.NewAdvisoryLocks(db),
 		rewards.WithExplorerURLTmpl("https://explorer.solana.com/tx/%s?cluster="+a.cfg.SolanaEnv),
 		rewards.WithHoldRewardsPeriod(a.cfg.HoldRewardsPeriod),
 		rewards.WithMinAmountToClaim(a.cfg.MinAmountToClaim),
+		rewards.WithHoldPeriods(holdRewardsPeriods),
+		rewards.WithVesting(a.cfg.RewardsVestingThreshold, a.cfg.RewardsVestingPeriod),
+		rewards.WithTransactionStatusChecker(solanaClient),
+		rewards.WithClaimConfirmationTimeout(a.cfg.RewardClaimConfirmationTimeout),
+		rewards.WithCampaignEventFunc(func(ctx context.Context, userID uuid.UUID, event string) {
+			if campaignsSvcClient != nil {
+				campaignsSvcClient.TrackEvent(ctx, userID, event)
+			}
+		}),
+		rewards.WithTwoFactorCheckFunc(twoFactor.Verify),
 	)
 	rewardsSvcClient = rewardsClient.New(rewardService)
+	dataExportCollectors = append(dataExportCollectors, data_export.WithCollector("rewards", rewardService.ExportUserData))
 	r.Mount("/rewards", rewards.MakeHTTPHandler(
 		rewards.MakeEndpoints(rewardService, kycMdw, jwtMdw),
 		logger,
 	))
 
+	// Reward budgets service
+	budgetsRepository, err := budgetsRepo.Prepare(ctx, db)
+	if err != nil {
+		log.Fatalf("budgetsRepo error: %v", err)
+	}
+	budgetsService := budgets.NewService(db, budgetsRepository, mailer)
+	budgetsSvcClient := budgetsClient.New(budgetsService)
+	r.Mount("/budgets", budgets.MakeHTTPHandler(
+		budgets.MakeEndpoints(budgetsService, jwtMdw),
+		logger,
+	))
+
+	// Referrals service client is set up after the auth service,
+	// so campaigns forward user's activity to it through the closure.
+	var referralsSvcClient *referralsClient.Client
+
+	// Multipliers service is set up after the services it collects factors from,
+	// so reward-issuing services created earlier get the user's multiplier through the closure.
+	var multipliersSvcClient *multipliersClient.Client
+	rewardMultiplierFn := func(ctx context.Context, userID uuid.UUID) (int32, error) {
+		if multipliersSvcClient == nil {
+			return 0, nil
+		}
+		return multipliersSvcClient.GetMultiplier(ctx, userID)
+	}
+
+	// Campaigns service
+	{
+		campaignsRepository, err := campaignsRepo.Prepare(ctx, db)
+		if err != nil {
+			log.Fatalf("campaignsRepo error: %v", err)
+		}
+		campaignsService := campaigns.NewService(
+			db,
+			campaignsRepository,
+			rewardsSvcClient,
+			campaigns.WithEventSubscriber(func(ctx context.Context, userID uuid.UUID, event string) {
+				if referralsSvcClient != nil {
+					referralsSvcClient.TrackEvent(ctx, userID, event)
+				}
+			}),
+			campaigns.WithRewardMultiplierFunc(rewardMultiplierFn),
+		)
+		campaignsSvcClient = campaignsClient.New(campaignsService)
+		r.Mount("/campaigns", campaigns.MakeHTTPHandler(
+			campaigns.MakeEndpoints(campaignsService, jwtMdw),
+			logger,
+		))
+	}
+
 	// Invitation service
 	invitationsRepository, err := invitationsRepo.Prepare(ctx, db)
 	if err != nil {
@@ -616,8 +973,13 @@ func (a *app) Run() {
 	invitationsService := invitations.NewService(invitationsRepository, mailer, rewardsSvcClient, invitations.Config{
 		InvitationReward: a.cfg.InvitationReward,
 		InvitationURL:    a.cfg.InvitationURL,
+		TokenSecret:      a.cfg.InvitationTokenSecret,
+		TTL:              a.cfg.InvitationTTL,
+		MaxSends:         int32(a.cfg.InvitationMaxSends),
 	})
 	invitationsClient := invitationsClient.New(invitationsService)
+	dataExportCollectors = append(dataExportCollectors, data_export.WithCollector("invitations", invitationsService.ExportUserData))
+	accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("invitations", invitationsService.EraseUserData))
 	r.Mount("/invitations", invitations.MakeHTTPHandler(
 		invitations.MakeEndpoints(invitationsService, jwtMdw),
 		logger,
@@ -642,6 +1004,7 @@ func (a *app) Run() {
 		if err != nil {
 			log.Fatalf("can't create firebase service: %v\n", err)
 		}
+		accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("notifications", firebaseSvc.EraseUserData))
 		r.Mount("/firebase", firebase_svc.MakeHTTPHandler(
 			firebase_svc.MakeEndpoints(firebaseSvc, jwtMdw),
 			logger,
@@ -650,12 +1013,15 @@ func (a *app) Run() {
 
 	var authClient *authc.Client
 	var nftClient *nftC.Client
+	// Account row is deleted after the data of all other services is erased
+	var eraseAccountData account_deletion.EraseFunc
 	{
 		// KYC
 		kycService := sumsub.New(a.cfg.KycAppToken, a.cfg.KycAppSecret, a.cfg.KycAppBaseURL, a.cfg.KycAppTTL)
 		kycClient := sumsub.NewClient(kycService)
 
 		authService := auth.NewService(
+			db,
 			jwtInteractor,
 			authRepository,
 			walletSvcClient,
@@ -668,25 +1034,92 @@ func (a *app) Run() {
 			auth.WithBlacklistMode(a.cfg.BlacklistMode),
 			auth.WithWhitelistMode(a.cfg.WhitelistMode),
 			auth.WithSkipDeviceIDCheck(a.cfg.SkipDeviceIDCheck),
+			auth.WithCampaignEventFunc(campaignsSvcClient.TrackEvent),
+			auth.WithSessionCache(sessionCache),
+			auth.WithAuditFunc(auditLogSvc.Record),
+			auth.WithSessionMaxLifetime(a.cfg.SessionMaxLifetime),
+			auth.WithTwoFactor(twoFactor),
+			auth.WithIdentityProvider(idtoken.Apple, strings.Split(a.cfg.AppleClientIDs, ",")...),
+			auth.WithIdentityProvider(idtoken.Google, strings.Split(a.cfg.GoogleClientIDs, ",")...),
+			auth.WithMagicLinks(a.cfg.MagicLinkSecret, a.cfg.MagicLinkURL, a.cfg.MagicLinkTTL),
+			auth.WithMagicLinkRateLimits(a.cfg.MagicLinkEmailLimit, a.cfg.MagicLinkIPLimit),
+			auth.WithSolanaSignIn(a.cfg.SolanaSignInDomain, a.cfg.SolanaSignInURI, a.cfg.SolanaEnv),
+			auth.WithLockoutPolicy(
+				a.cfg.LockoutMaxAccountFailures,
+				a.cfg.LockoutMaxIPFailures,
+				a.cfg.LockoutBaseDuration,
+				a.cfg.LockoutMaxDuration,
+			),
+			auth.WithAccountDeletionFunc(func(ctx context.Context, userID uuid.UUID) error {
+				if accountDeletionSvc == nil {
+					return fmt.Errorf("account deletion service is not set")
+				}
+				_, err := accountDeletionSvc.Schedule(ctx, userID)
+				return err
+			}),
 		)
 
 		// Auth service
 		{
 			r.Mount("/auth", auth.MakeHTTPHandler(
-				auth.MakeEndpoints(authService, jwtMdw),
+				auth.MakeEndpoints(authService, jwtMdw, refreshJwtMdw),
 				logger,
 			))
 		}
 
 		authClient = authc.New(authService)
+		dataExportCollectors = append(dataExportCollectors, data_export.WithCollector("account", authService.ExportUserData))
+		eraseAccountData = authService.EraseUserData
+	}
+
+	// Leaderboard service
+	leaderboardRepository, err := leaderboardRepo.Prepare(ctx, db)
+	if err != nil {
+		log.Fatalf("leaderboardRepo error: %v", err)
 	}
+	leaderboardService := leaderboard.NewService(
+		db,
+		leaderboardRepository,
+		authClient,
+		rewardsSvcClient,
+		db_internal.NewAdvisoryLocks(db),
+		leaderboard.WithRewardMultiplierFunc(rewardMultiplierFn),
+	)
+	leaderboardSvcClient := leaderboardClient.New(leaderboardService)
+	r.Mount("/leaderboard", leaderboard.MakeHTTPHandler(
+		leaderboard.MakeEndpoints(leaderboardService, jwtMdw),
+		logger,
+	))
+
+	// Permissions service
+	permissionsRepository, err := permissionsRepo.Prepare(ctx, db)
+	if err != nil {
+		log.Fatalf("permissionsRepo error: %v", err)
+	}
+	permissionsSvc := permissions.NewService(db, permissionsRepository, permissions.WithAuditFunc(auditLogSvc.Record))
+	accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("permissions", permissionsSvc.EraseUserData))
+	r.Mount("/permissions", permissions.MakeHTTPHandler(
+		permissions.MakeEndpoints(permissionsSvc, jwtMdw),
+		logger,
+	))
 
 	// Profile service
 	profileRepository, err := profileRepo.Prepare(ctx, db)
 	if err != nil {
 		log.Fatalf("profileRepo error: %v", err)
 	}
-	profileSvc := profile.NewService(profileRepository)
+	// Files service is set up later, so profile erases the avatar through the closure.
+	var fileSvc *files.Service
+	profileSvc := profile.NewService(profileRepository, profile.WithFileEraser(
+		func(ctx context.Context, userID uuid.UUID, fileURL string) error {
+			if fileSvc == nil {
+				return fmt.Errorf("files service is not set")
+			}
+			return fileSvc.EraseUploadedFile(ctx, userID, fileURL)
+		},
+	))
+	dataExportCollectors = append(dataExportCollectors, data_export.WithCollector("profile", profileSvc.ExportUserData))
+	accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("profile", profileSvc.EraseUserData))
 	r.Mount("/profile", profile.MakeHTTPHandler(
 		profile.MakeEndpoints(profileSvc, jwtMdw),
 		logger,
@@ -710,15 +1143,18 @@ func (a *app) Run() {
 		if err != nil {
 			log.Fatalf("referralRepo error: %v", err)
 		}
+		referralsService := referrals.NewService(db, referralRepository, fb, firebase.Config{
+			BaseFirebaseURL:    a.cfg.BaseFirebaseURL,
+			WebAPIKey:          a.cfg.FBWebAPIKey,
+			MainSiteLink:       a.cfg.MainSiteLink,
+			AndroidPackageName: a.cfg.AndroidPackageName,
+			IosBundleId:        a.cfg.IOSBundleId,
+			SuffixOption:       a.cfg.SuffixOption,
+		}, rewardsSvcClient, authClient, referrals.WithRewardMultiplierFunc(rewardMultiplierFn))
+		referralsSvcClient = referralsClient.New(referralsService)
+		dataExportCollectors = append(dataExportCollectors, data_export.WithCollector("referrals", referralsService.ExportUserData))
 		r.Mount("/ref", referrals.MakeHTTPHandler(
-			referrals.MakeEndpoints(referrals.NewService(referralRepository, fb, firebase.Config{
-				BaseFirebaseURL:    a.cfg.BaseFirebaseURL,
-				WebAPIKey:          a.cfg.FBWebAPIKey,
-				MainSiteLink:       a.cfg.MainSiteLink,
-				AndroidPackageName: a.cfg.AndroidPackageName,
-				IosBundleId:        a.cfg.IOSBundleId,
-				SuffixOption:       a.cfg.SuffixOption,
-			}), jwtMdw),
+			referrals.MakeEndpoints(referralsService, jwtMdw),
 			logger,
 		))
 	}
@@ -770,6 +1206,7 @@ func (a *app) Run() {
 			solanaClient,
 			walletSvcClient.PayForNFT,
 			a.cfg.EnableResourceIntensiveQueries,
+			nft.WithPricingFunctions(pricingSvcClient.CreateQuote, pricingSvcClient.Pay),
 		)
 		r.Mount("/nft", nft.MakeHTTPHandler(
 			nft.MakeEndpoints(nftService, jwtMdw),
@@ -778,6 +1215,28 @@ func (a *app) Run() {
 		nftClient = nftC.New(nftService)
 	}
 
+	// Multipliers service
+	{
+		multipliersRepository, err := multipliersRepo.Prepare(ctx, db)
+		if err != nil {
+			log.Fatalf("multipliersRepo error: %v", err)
+		}
+		multipliersService := multipliers.NewService(
+			multipliersRepository,
+			walletSvcClient,
+			nftClient,
+			campaignsSvcClient,
+			authClient,
+			multipliers.WithStackingMode(a.cfg.MultiplierStackingMode),
+			multipliers.WithMaxPercent(a.cfg.MultiplierMaxPercent),
+		)
+		multipliersSvcClient = multipliersClient.New(multipliersService)
+		r.Mount("/multipliers", multipliers.MakeHTTPHandler(
+			multipliers.MakeEndpoints(multipliersService, jwtMdw),
+			logger,
+		))
+	}
+
 	// Shows service
 	{
 		// Show repo
@@ -799,9 +1258,13 @@ func (a *app) Run() {
 				fmt.Sprintf("%s/challenges", strings.TrimSuffix(a.cfg.AppBaseURL, "/")),
 			),
 			challenge.WithChargeForUnlockFunc(walletSvcClient.PayForService),
+			challenge.WithPricingFunctions(pricingSvcClient.CreateQuote, pricingSvcClient.Pay),
 			challenge.WithDisabledVerification(a.cfg.DisableVerificationForRealm),
+			challenge.WithCampaignEventFunc(campaignsSvcClient.TrackEvent),
 		)
 		challengeSvcClient = challengeClient.New(challengeSvc)
+		dataExportCollectors = append(dataExportCollectors, data_export.WithCollector("challenges", challengeSvc.ExportUserData))
+		accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("challenges", challengeSvc.EraseUserData))
 		r.Mount("/challenges", challenge.MakeHTTPHandlerChallenges(
 			challenge.MakeEndpoints(challengeSvc, jwtMdw),
 			logger,
@@ -821,9 +1284,13 @@ func (a *app) Run() {
 			nftClient,
 			firebaseSvc,
 			a.cfg.TipsPercent,
+			shows.WithCampaignEventFunc(campaignsSvcClient.TrackEvent),
+			shows.WithAuditFunc(auditLogSvc.Record),
 		)
+		dataExportCollectors = append(dataExportCollectors, data_export.WithCollector("reviews", showsService.ExportUserData))
+		accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("reviews", showsService.EraseUserData))
 		r.Mount("/shows", shows.MakeHTTPHandler(
-			shows.MakeEndpoints(showsService, jwtMdw),
+			shows.MakeEndpoints(showsService, permissionsSvc, jwtMdw),
 			logger,
 		))
 		r.Mount("/nft-marketplace/shows", private.MakeHTTPHandler(
@@ -833,7 +1300,7 @@ func (a *app) Run() {
 	}
 
 	// files service
-	var fileSvc *files.Service
+	var fileStorage *storage.Interactor
 	{
 		opt := storage.Options{
 			Key:            a.cfg.FileStorageKey,
@@ -845,14 +1312,14 @@ func (a *app) Run() {
 			DisableSSL:     a.cfg.FileStorageDisableSsl,
 			ForcePathStyle: a.cfg.FileStorageForcePathStyle,
 		}
-		stor := storage.New(storage.NewS3Client(opt), opt)
+		fileStorage = storage.New(storage.NewS3Client(opt), opt)
 
 		mediaServiceRepo, err := filesRepo.Prepare(ctx, db)
 		if err != nil {
 			log.Fatalf("mediaServiceRepo error: %v", err)
 		}
 
-		fileSvc = files.NewService(mediaServiceRepo, stor, resizer.Resize)
+		fileSvc = files.NewService(mediaServiceRepo, fileStorage, resizer.Resize)
 
 		r.Mount("/files", files.MakeHTTPHandler(
 			files.MakeEndpoints(fileSvc, jwtMdw),
@@ -875,7 +1342,13 @@ func (a *app) Run() {
 			log.Fatalf("qrcodesRepo error: %v", err)
 		}
 		r.Mount("/qrcodes", qrcodes.MakeHTTPHandler(
-			qrcodes.MakeEndpoints(qrcodes.NewService(qrcodesRepository, rewardsSvcClient), jwtMdw),
+			qrcodes.MakeEndpoints(qrcodes.NewService(
+				qrcodesRepository,
+				rewardsSvcClient,
+				qrcodes.WithBudgetFunc(budgetsSvcClient.SpendQRCodeBudget, budgetsSvcClient.RefundQRCodeBudget),
+				qrcodes.WithRewardMultiplierFunc(multipliersSvcClient.GetMultiplier),
+				qrcodes.WithAuditFunc(auditLogSvc.Record),
+			), jwtMdw),
 			logger,
 		))
 	}
@@ -890,8 +1363,11 @@ func (a *app) Run() {
 			a.cfg.NatsURL,
 			a.cfg.NatsWSURL,
 			challengeSvcClient,
-			walletSvcClient,
+			multipliersSvcClient,
 			rewardsSvcClient,
+			campaignsSvcClient,
+			budgetsSvcClient,
+			leaderboardSvcClient,
 			authClient,
 			profileSvc,
 			db,
@@ -902,6 +1378,8 @@ func (a *app) Run() {
 			a.cfg.QuizLobbyLatency,
 			a.cfg.DisableVerificationForRealm,
 		)
+		dataExportCollectors = append(dataExportCollectors, data_export.WithCollector("quizzes", quizV2Svc.ExportUserData))
+		accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("quizzes", quizV2Svc.EraseUserData))
 		r.Mount("/quiz_v2", quiz_v2.MakeHTTPHandler(
 			quiz_v2.MakeEndpoints(quizV2Svc, jwtMdw),
 			logger,
@@ -926,19 +1404,23 @@ func (a *app) Run() {
 		))
 	}
 
+	var puzzleGameSvc *puzzle_game.Service
 	{
 		puzzleGameRepository, err := puzzleGameRepo.Prepare(ctx, db)
 		if err != nil {
 			log.Fatalf("can't prepare puzzle game repository: %v", err)
 		}
 
-		puzzleGameSvc := puzzle_game.NewService(
+		puzzleGameSvc = puzzle_game.NewService(
 			puzzleGameRepository,
 			a.cfg.PuzzleGameShuffle,
 			puzzle_game.WithChargeFunction(walletSvcClient.PayForService),
+			puzzle_game.WithPricingFunctions(pricingSvcClient.CreateQuote, pricingSvcClient.Pay),
 			puzzle_game.WithRewardsFunction(rewardsSvcClient.AddDepositTransaction),
 			puzzle_game.WithFileServiceClient(fileSvc),
-			puzzle_game.WithUserMultiplierFunction(walletSvcClient.GetMultiplier),
+			puzzle_game.WithUserMultiplierFunction(multipliersSvcClient.GetMultiplier),
+			puzzle_game.WithBudgetFunction(budgetsSvcClient.SpendPuzzleGameBudget, budgetsSvcClient.RefundPuzzleGameBudget),
+			puzzle_game.WithLeaderboardFunction(leaderboardSvcClient.RecordScore),
 			puzzle_game.IsPaidStepsEnabled(a.cfg.PuzzleGamePaidStepsEnabled),
 			puzzle_game.IsRewardsEnabled(a.cfg.PuzzleGameRewardsEnabled),
 		)
@@ -949,6 +1431,7 @@ func (a *app) Run() {
 		))
 	}
 
+	var gapiSvc *gapi.Service
 	// Unity game API
 	{
 		unityGameRepository, err := unityGameRepo.Prepare(ctx, db)
@@ -956,28 +1439,31 @@ func (a *app) Run() {
 			log.Fatalf("can't prepare unity game repository: %v", err)
 		}
 
-		settingsService := gapi.NewSettingsService(unityGameRepository)
+		settingsService := gapi.NewSettingsService(unityGameRepository, gapi.WithSettingsAuditFunc(auditLogSvc.Record))
+		gapiSvc = gapi.NewService(
+			unityGameRepository,
+			settingsService,
+			gapi.NewSolanaClient(
+				solanaClient,
+				walletSvcClient,
+				a.cfg.SolanaAssetAddr,
+				a.cfg.UnityGameFeeCollectorAddress,
+				feePayer,
+				unityGameTokenHolder,
+			),
+			gapi.WithDB(db),
+			gapi.WithLeaderboardFunc(leaderboardSvcClient.RecordScore),
+		)
+		dataExportCollectors = append(dataExportCollectors, data_export.WithCollector("game", gapiSvc.ExportUserData))
 
 		r.Mount("/gapi", gapi.MakeHTTPHandler(
 			gapi.MakeEndpoints(
-				gapi.NewService(
-					unityGameRepository,
-					settingsService,
-					gapi.NewSolanaClient(
-						solanaClient,
-						walletSvcClient,
-						a.cfg.SolanaAssetAddr,
-						a.cfg.UnityGameFeeCollectorAddress,
-						feePayer,
-						unityGameTokenHolder,
-					),
-					gapi.WithDB(db),
-				),
+				gapiSvc,
 				settingsService,
 				jwtMdw,
 			),
 			gapi.MakeNFTPacksEndpoints(
-				gapi.NewNFTPackService(unityGameRepository),
+				gapi.NewNFTPackService(unityGameRepository, gapi.WithNFTPackAuditFunc(auditLogSvc.Record)),
 				jwtMdw,
 			),
 			logger,
@@ -985,6 +1471,31 @@ func (a *app) Run() {
 		))
 	}
 
+	// Promo codes service
+	{
+		promocodesRepository, err := promocodesRepo.Prepare(ctx, db)
+		if err != nil {
+			log.Fatalf("promocodesRepo error: %v", err)
+		}
+		promocodesService := promocodes.NewService(
+			db,
+			promocodesRepository,
+			rewardsSvcClient,
+			db_internal.NewAdvisoryLocks(db),
+			promocodes.WithEpisodeUnlockFunc(challengeSvcClient.GrantEpisodeAccess),
+			promocodes.WithPuzzleUnlockFunc(puzzleGameSvc.GrantPuzzleGameUnlock),
+			promocodes.WithNFTPackFunc(func(ctx context.Context, userID, packID uuid.UUID) (interface{}, error) {
+				return gapiSvc.GrantNFTPack(ctx, userID, packID)
+			}),
+			promocodes.WithThrottling(int64(a.cfg.PromoCodeMaxFailedAttempts), a.cfg.PromoCodeAttemptsWindow),
+		)
+		promocodesSvcClient = promocodesClient.New(promocodesService)
+		r.Mount("/promocodes", promocodes.MakeHTTPHandler(
+			promocodes.MakeEndpoints(promocodesService, jwtMdw),
+			logger,
+		))
+	}
+
 	// In-app purchases service
 	{
 		announcementRepository, err := announcement_repository.Prepare(ctx, db)
@@ -995,12 +1506,72 @@ func (a *app) Run() {
 		announcementSvc := announcement_svc.NewService(
 			announcementRepository,
 		)
+		accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("announcements", announcementSvc.EraseUserData))
 		r.Mount("/announcement", announcement_svc.MakeHTTPHandler(
 			announcement_svc.MakeEndpoints(announcementSvc, jwtMdw),
 			logger,
 		))
 	}
 
+	// Data export service
+	{
+		dataExportRepository, err := dataExportRepo.Prepare(ctx, db)
+		if err != nil {
+			log.Fatalf("dataExportRepo error: %v", err)
+		}
+		dataExportService := data_export.NewService(
+			dataExportRepository,
+			fileStorage,
+			mailer,
+			authClient,
+			fileSvc,
+			data_export.Config{
+				DownloadURL:     a.cfg.DataExportURL,
+				TokenSecret:     a.cfg.DataExportSecret,
+				LinkTTL:         a.cfg.DataExportLinkTTL,
+				RequestInterval: a.cfg.DataExportRequestInterval,
+			},
+			dataExportCollectors...,
+		)
+		accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("data_export", dataExportService.EraseUserData))
+		r.Mount("/data-export", data_export.MakeHTTPHandler(
+			data_export.MakeEndpoints(dataExportService, jwtMdw),
+			logger,
+		))
+		g.Add(func() error {
+			return dataExportService.Run(ctx, a.cfg.DataExportWorkerInterval)
+		}, func(err error) {
+			cancel()
+		})
+	}
+
+	// Account deletion service
+	{
+		accountDeletionRepository, err := accountDeletionRepo.Prepare(ctx, db)
+		if err != nil {
+			log.Fatalf("accountDeletionRepo error: %v", err)
+		}
+		accountDeletionErasers = append(accountDeletionErasers, account_deletion.WithEraser("account", eraseAccountData))
+		accountDeletionSvc = account_deletion.NewService(
+			accountDeletionRepository,
+			account_deletion.Config{
+				GracePeriod: a.cfg.AccountDeletionGracePeriod,
+				MaxAttempts: a.cfg.AccountDeletionMaxAttempts,
+				RetryDelay:  a.cfg.AccountDeletionRetryDelay,
+			},
+			accountDeletionErasers...,
+		)
+		r.Mount("/account-deletion", account_deletion.MakeHTTPHandler(
+			account_deletion.MakeEndpoints(accountDeletionSvc, jwtMdw),
+			logger,
+		))
+		g.Add(func() error {
+			return accountDeletionSvc.Run(ctx, a.cfg.AccountDeletionWorkerInterval)
+		}, func(err error) {
+			cancel()
+		})
+	}
+
 	{
 		// Init and run http server
 		httpServer := &http.Server{
@@ -11,6 +11,7 @@ import (
 	jwti "github.com/SatorNetwork/sator-api/lib/jwt"
 	"github.com/SatorNetwork/sator-api/lib/rbac"
 	"github.com/SatorNetwork/sator-api/lib/sumsub"
+	"github.com/SatorNetwork/sator-api/lib/totp"
 	"github.com/SatorNetwork/sator-api/lib/validator"
 	"github.com/go-kit/kit/auth/jwt"
 	httptransport "github.com/go-kit/kit/transport/http"
@@ -59,7 +60,10 @@ func CodeAndMessageFrom(err error) (int, interface{}) {
 		errors.Is(err, jwt.ErrTokenInvalid) ||
 		errors.Is(err, jwt.ErrTokenMalformed) ||
 		errors.Is(err, jwt.ErrTokenNotActive) ||
-		errors.Is(err, jwt.ErrUnexpectedSigningMethod) {
+		errors.Is(err, jwt.ErrUnexpectedSigningMethod) ||
+		errors.Is(err, jwti.ErrSessionRevoked) ||
+		errors.Is(err, jwti.ErrUnexpectedTokenType) ||
+		errors.Is(err, jwti.ErrUnknownSigningKey) {
 		return http.StatusUnauthorized, err.Error()
 	}
 
@@ -72,6 +76,19 @@ func CodeAndMessageFrom(err error) (int, interface{}) {
 		return http.StatusProxyAuthRequired, err.Error()
 	}
 
+	// apps ask the user for a step-up code and repeat the request with it
+	if errors.Is(err, totp.ErrTwoFactorRequired) {
+		return http.StatusPreconditionRequired, err.Error()
+	}
+
+	if errors.Is(err, totp.ErrInvalidCode) {
+		return http.StatusForbidden, err.Error()
+	}
+
+	if errors.Is(err, totp.ErrTooManyAttempts) {
+		return http.StatusTooManyRequests, err.Error()
+	}
+
 	if errors.Is(err, sql.ErrNoRows) {
 		return http.StatusNotFound, err.Error()
 	}
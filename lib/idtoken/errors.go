@@ -0,0 +1,12 @@
+package idtoken
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrInvalidToken    = errors.New("invalid id token")
+	ErrInvalidIssuer   = errors.New("id token issuer mismatch")
+	ErrInvalidAudience = errors.New("id token audience mismatch")
+	ErrInvalidNonce    = errors.New("id token nonce mismatch")
+	ErrUnknownKey      = errors.New("id token is signed with unknown key")
+)
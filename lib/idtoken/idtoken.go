@@ -0,0 +1,186 @@
+// Package idtoken verifies OpenID Connect ID tokens issued by Apple and Google,
+// used to sign in with the provider's account.
+package idtoken
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// Supported identity providers
+const (
+	ProviderApple  = "apple"
+	ProviderGoogle = "google"
+)
+
+// Predefined providers settings
+var (
+	Apple = Provider{
+		Name:        ProviderApple,
+		Issuers:     []string{"https://appleid.apple.com"},
+		JWKSURL:     "https://appleid.apple.com/auth/keys",
+		HashedNonce: true,
+	}
+	Google = Provider{
+		Name:    ProviderGoogle,
+		Issuers: []string{"https://accounts.google.com", "accounts.google.com"},
+		JWKSURL: "https://www.googleapis.com/oauth2/v3/certs",
+	}
+)
+
+type (
+	// Provider describes identity provider
+	Provider struct {
+		Name    string
+		Issuers []string
+		JWKSURL string
+		// HashedNonce is set if the app passes sha256 of the nonce to the provider,
+		// so the token contains the hash and the app sends the raw nonce to the server.
+		HashedNonce bool
+	}
+
+	// Claims of the verified ID token
+	Claims struct {
+		Subject       string
+		Email         string
+		EmailVerified bool
+	}
+
+	// Verifier verifies ID tokens of the provider,
+	// provider's signing keys are cached in memory.
+	Verifier struct {
+		provider  Provider
+		audiences []string
+		keys      *remoteKeySet
+	}
+
+	// VerifierOption function
+	// interface to extend verifier via options
+	VerifierOption func(*Verifier)
+
+	tokenClaims struct {
+		jwt.StandardClaims
+		Email         string    `json:"email"`
+		EmailVerified boolClaim `json:"email_verified"`
+		Nonce         string    `json:"nonce"`
+	}
+
+	// boolClaim is decoded from both boolean and string values,
+	// Apple sends "true" string in email_verified claim.
+	boolClaim bool
+)
+
+// NewVerifier is a factory function,
+// returns a new instance of the Verifier struct.
+// Audiences are client ids of the apps registered in the provider.
+func NewVerifier(p Provider, audiences []string, opt ...VerifierOption) *Verifier {
+	v := &Verifier{
+		provider:  p,
+		audiences: audiences,
+		keys:      newRemoteKeySet(p.JWKSURL, http.DefaultClient),
+	}
+
+	// Set up options.
+	for _, o := range opt {
+		o(v)
+	}
+
+	return v
+}
+
+// WithHTTPClient option
+// Sets up http client to fetch provider's signing keys
+func WithHTTPClient(c *http.Client) VerifierOption {
+	return func(v *Verifier) {
+		if c != nil {
+			v.keys.client = c
+		}
+	}
+}
+
+// WithKeysCacheTTL option
+// Sets up how long provider's signing keys are cached
+func WithKeysCacheTTL(ttl time.Duration) VerifierOption {
+	return func(v *Verifier) {
+		if ttl > 0 {
+			v.keys.ttl = ttl
+		}
+	}
+}
+
+// Provider returns name of the provider
+func (v *Verifier) Provider() string {
+	return v.provider.Name
+}
+
+// Verify checks signature, expiration time, issuer, audience and nonce of the token.
+// The nonce must be issued by the server and used once, which is up to the caller.
+func (v *Verifier) Verify(ctx context.Context, rawToken, nonce string) (Claims, error) {
+	claims := &tokenClaims{}
+	if _, err := jwt.ParseWithClaims(rawToken, claims, func(t *jwt.Token) (interface{}, error) {
+		if t.Method != jwt.SigningMethodRS256 {
+			return nil, fmt.Errorf("%w: unexpected signing method %v", ErrInvalidToken, t.Header["alg"])
+		}
+		kid, _ := t.Header["kid"].(string)
+		return v.keys.publicKey(ctx, kid)
+	}); err != nil {
+		if e, ok := err.(*jwt.ValidationError); ok && e.Inner != nil {
+			err = e.Inner
+		}
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if !contains(v.provider.Issuers, claims.Issuer) {
+		return Claims{}, ErrInvalidIssuer
+	}
+	if !contains(v.audiences, claims.Audience) {
+		return Claims{}, ErrInvalidAudience
+	}
+	if !v.checkNonce(claims.Nonce, nonce) {
+		return Claims{}, ErrInvalidNonce
+	}
+	if claims.Subject == "" {
+		return Claims{}, fmt.Errorf("%w: missed subject", ErrInvalidToken)
+	}
+
+	return Claims{
+		Subject:       claims.Subject,
+		Email:         strings.ToLower(strings.TrimSpace(claims.Email)),
+		EmailVerified: bool(claims.EmailVerified),
+	}, nil
+}
+
+// checkNonce compares nonce claim with the nonce sent by the app, both must be set.
+func (v *Verifier) checkNonce(claim, nonce string) bool {
+	if claim == "" || nonce == "" {
+		return false
+	}
+	if v.provider.HashedNonce {
+		sum := sha256.Sum256([]byte(nonce))
+		nonce = hex.EncodeToString(sum[:])
+	}
+	return subtle.ConstantTimeCompare([]byte(claim), []byte(nonce)) == 1
+}
+
+// UnmarshalJSON decodes boolean claim from bool or string value
+func (b *boolClaim) UnmarshalJSON(data []byte) error {
+	*b = boolClaim(strings.Trim(string(data), `"`) == "true")
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package idtoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	libjwt "github.com/SatorNetwork/sator-api/lib/jwt"
+)
+
+type testProvider struct {
+	key    *rsa.PrivateKey
+	kid    string
+	server *httptest.Server
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+
+	p := &testProvider{key: key, kid: "test-key"}
+	p.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(libjwt.JWKS{Keys: []libjwt.JWK{{
+			Kty: "RSA",
+			Alg: "RS256",
+			Use: "sig",
+			Kid: p.kid,
+			N:   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+		}}})
+	}))
+	t.Cleanup(p.server.Close)
+
+	return p
+}
+
+func (p *testProvider) sign(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = p.kid
+	s, err := token.SignedString(p.key)
+	if err != nil {
+		t.Fatalf("could not sign token: %v", err)
+	}
+	return s
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            "https://accounts.google.com",
+		"aud":            "client-id",
+		"sub":            "provider-user-id",
+		"email":          "User@Example.com",
+		"email_verified": true,
+		"nonce":          "nonce",
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestVerify(t *testing.T) {
+	p := newTestProvider(t)
+	provider := Google
+	provider.JWKSURL = p.server.URL
+	v := NewVerifier(provider, []string{"client-id"})
+
+	claims, err := v.Verify(context.Background(), p.sign(t, validClaims()), "nonce")
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if claims.Subject != "provider-user-id" || claims.Email != "user@example.com" || !claims.EmailVerified {
+		t.Errorf("Verify() = %+v", claims)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(c jwt.MapClaims)
+		nonce  string
+		want   error
+	}{
+		{"wrong issuer", func(c jwt.MapClaims) { c["iss"] = "https://evil.com" }, "nonce", ErrInvalidIssuer},
+		{"wrong audience", func(c jwt.MapClaims) { c["aud"] = "other-client-id" }, "nonce", ErrInvalidAudience},
+		{"wrong nonce", func(c jwt.MapClaims) {}, "other-nonce", ErrInvalidNonce},
+		{"missed nonce", func(c jwt.MapClaims) { delete(c, "nonce") }, "", ErrInvalidNonce},
+		{"expired", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }, "nonce", ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validClaims()
+			tt.modify(c)
+			if _, err := v.Verify(context.Background(), p.sign(t, c), tt.nonce); !errors.Is(err, tt.want) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// token signed with unknown key
+	other := newTestProvider(t)
+	if _, err := v.Verify(context.Background(), other.sign(t, validClaims()), "nonce"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Verify() with foreign key error = %v, want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestVerifyHashedNonce(t *testing.T) {
+	p := newTestProvider(t)
+	provider := Apple
+	provider.JWKSURL = p.server.URL
+	v := NewVerifier(provider, []string{"com.sator.app"})
+
+	sum := sha256.Sum256([]byte("raw-nonce"))
+	c := validClaims()
+	c["iss"] = "https://appleid.apple.com"
+	c["aud"] = "com.sator.app"
+	c["nonce"] = hex.EncodeToString(sum[:])
+	c["email_verified"] = "true"
+
+	claims, err := v.Verify(context.Background(), p.sign(t, c), "raw-nonce")
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !claims.EmailVerified {
+		t.Errorf("email_verified string claim is not decoded")
+	}
+
+	if _, err := v.Verify(context.Background(), p.sign(t, c), hex.EncodeToString(sum[:])); !errors.Is(err, ErrInvalidNonce) {
+		t.Errorf("Verify() with hashed nonce error = %v, want %v", err, ErrInvalidNonce)
+	}
+}
@@ -0,0 +1,139 @@
+package idtoken
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+)
+
+// Signing keys cache settings
+const (
+	defaultKeysCacheTTL = time.Hour
+	minReloadInterval   = time.Minute // limits reloads on tokens signed with unknown key
+)
+
+// remoteKeySet caches provider's public signing keys published in JWKS format.
+// Keys are reloaded when the cache expires or the token is signed with unknown key,
+// since providers rotate their keys.
+type remoteKeySet struct {
+	mu         sync.RWMutex
+	keys       map[string]*rsa.PublicKey
+	loadedAt   time.Time
+	lastLoadAt time.Time
+
+	url    string
+	client *http.Client
+	ttl    time.Duration
+}
+
+func newRemoteKeySet(url string, client *http.Client) *remoteKeySet {
+	return &remoteKeySet{
+		url:    url,
+		client: client,
+		ttl:    defaultKeysCacheTTL,
+	}
+}
+
+// publicKey returns public key by key id
+func (ks *remoteKeySet) publicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	ks.mu.RLock()
+	key, ok := ks.keys[kid]
+	expired := time.Since(ks.loadedAt) >= ks.ttl
+	ks.mu.RUnlock()
+	if ok && !expired {
+		return key, nil
+	}
+
+	ks.mu.Lock()
+	canReload := expired || time.Since(ks.lastLoadAt) >= minReloadInterval
+	if canReload {
+		ks.lastLoadAt = time.Now()
+	}
+	ks.mu.Unlock()
+
+	if canReload {
+		if err := ks.load(ctx); err != nil {
+			// stale keys are still used if the provider is not available
+			if ok {
+				return key, nil
+			}
+			return nil, err
+		}
+		ks.mu.RLock()
+		key, ok = ks.keys[kid]
+		ks.mu.RUnlock()
+	}
+
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return key, nil
+}
+
+// load fetches keys from the provider
+func (ks *remoteKeySet) load(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.url, nil)
+	if err != nil {
+		return fmt.Errorf("could not create jwks request: %w", err)
+	}
+
+	resp, err := ks.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not fetch jwks: unexpected status %d", resp.StatusCode)
+	}
+
+	var set jwt.JWKS
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("could not decode jwks: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		pub, err := rsaPublicKey(k)
+		if err != nil {
+			return fmt.Errorf("could not decode key %s: %w", k.Kid, err)
+		}
+		keys[k.Kid] = pub
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	ks.keys = keys
+	ks.loadedAt = time.Now()
+
+	return nil
+}
+
+func rsaPublicKey(k jwt.JWK) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
@@ -2,6 +2,7 @@ package jwt
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 )
@@ -20,3 +21,38 @@ func CheckUser(isUserDisabled func(context.Context, uuid.UUID) (bool, error)) fu
 		return nil
 	}
 }
+
+// CheckSession returns error if the token session was revoked.
+// Tokens issued before sessions were introduced have no session id, they are valid until expiration
+// and are not refreshed.
+func CheckSession(sc *SessionCache) func(context.Context) error {
+	return func(ctx context.Context) error {
+		sid, err := SessionIDFromContext(ctx)
+		if err != nil {
+			if errors.Is(err, ErrSessionIDEmpty) {
+				return nil
+			}
+			return err
+		}
+
+		if sc.IsRevoked(sid) {
+			return ErrSessionRevoked
+		}
+		sc.Touch(sid)
+
+		return nil
+	}
+}
+
+// ChainChecks runs the given checks one by one, returns the first error
+func ChainChecks(checks ...func(context.Context) error) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for _, check := range checks {
+			if err := check(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -15,6 +15,9 @@ type Claims struct {
 	Username string `json:"username,omitempty"`
 	Role     string `json:"role,omitempty"`
 	DeviceID string `json:"-"`
+	// SessionID is shared by access and refresh tokens issued on login,
+	// used to revoke the tokens before they expire.
+	SessionID string `json:"sid,omitempty"`
 	jwt.StandardClaims
 }
 
@@ -73,6 +76,22 @@ func DeviceIDFromContext(ctx context.Context) (string, error) {
 	return "", ErrInvalidJWTClaims
 }
 
+// SessionIDFromContext returns user session id from request context
+func SessionIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims := ctx.Value(kitjwt.JWTClaimsContextKey)
+	if cl, ok := claims.(*Claims); ok {
+		if cl.SessionID == "" {
+			return uuid.Nil, ErrSessionIDEmpty
+		}
+		id, err := uuid.Parse(cl.SessionID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("could not parse session uuid: %w", err)
+		}
+		return id, nil
+	}
+	return uuid.Nil, ErrInvalidJWTClaims
+}
+
 // TokenIDFromContext returns jwt id from request context
 func TokenIDFromContext(ctx context.Context) (uuid.UUID, error) {
 	claims := ctx.Value(kitjwt.JWTClaimsContextKey)
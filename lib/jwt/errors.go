@@ -6,10 +6,16 @@ import "errors"
 var (
 	ErrUserIDEmpty       = errors.New("user id is empty")
 	ErrJWTIDEmpty        = errors.New("jwt id is empty")
+	ErrSessionIDEmpty    = errors.New("session id is empty")
 	ErrJWTSubjectEmpty   = errors.New("jwt subject is empty")
 	ErrInvalidJWTClaims  = errors.New("invalid jwt claims")
 	ErrInvalidJWTSubject = errors.New("invalid jwt subject")
 
-	ErrUserIsDisabled = errors.New("your profile was disabled. Please contact support for details")
-	ErrMissedUserID   = errors.New("missed user id")
+	ErrUserIsDisabled      = errors.New("your profile was disabled. Please contact support for details")
+	ErrMissedUserID        = errors.New("missed user id")
+	ErrSessionRevoked      = errors.New("session was revoked, please log in again")
+	ErrUnexpectedTokenType = errors.New("unexpected token type")
+
+	ErrNoSigningKey      = errors.New("no signing key")
+	ErrUnknownSigningKey = errors.New("token is signed with unknown key")
 )
@@ -14,24 +14,22 @@ const (
 	RefreshToken = "refresh_token"
 )
 
-var defaultSigningMethod jwt.SigningMethod = jwt.SigningMethodHS256
-
 type (
 	// JWT struct
 	JWT struct {
-		signingKey []byte
-		expIn      time.Duration
-		expInRt    time.Duration
+		keys    *KeySet
+		expIn   time.Duration
+		expInRt time.Duration
 	}
 )
 
 // NewInteractor is a factory function,
 // returns a new instance of the JWT struct
-func NewInteractor(signingKey string, expiresIn time.Duration) *JWT {
+func NewInteractor(keys *KeySet, expiresIn time.Duration) *JWT {
 	return &JWT{
-		signingKey: []byte(signingKey),
-		expIn:      expiresIn,
-		expInRt:    expiresIn + (time.Hour * 24 * 30), // expiresIn + 30 days
+		keys:    keys,
+		expIn:   expiresIn,
+		expInRt: expiresIn + (time.Hour * 24 * 30), // expiresIn + 30 days
 	}
 }
 
@@ -39,33 +37,35 @@ func NewInteractor(signingKey string, expiresIn time.Duration) *JWT {
 func (i *JWT) NewWithUserData(userID uuid.UUID, username, role, deviceID string) (uuid.UUID, string, error) {
 	tokenID := uuid.New()
 	claims := &Claims{
-		userID.String(),
-		username,
-		role,
-		deviceID,
-		jwt.StandardClaims{
+		UserID:   userID.String(),
+		Username: username,
+		Role:     role,
+		DeviceID: deviceID,
+		StandardClaims: jwt.StandardClaims{
 			Id:        tokenID.String(),
 			ExpiresAt: time.Now().Add(i.expIn).Unix(),
 			NotBefore: time.Now().Unix(),
 			IssuedAt:  time.Now().Unix(),
 		},
 	}
-	token := jwt.NewWithClaims(defaultSigningMethod, claims)
-	ss, err := token.SignedString(i.signingKey)
+	ss, err := i.keys.sign(claims)
 	if err != nil {
 		return uuid.Nil, "", fmt.Errorf("could not sign token: %w", err)
 	}
 	return tokenID, ss, nil
 }
 
-// NewWithUserData returns signed JWT string with user id and username in claims
-func (i *JWT) NewWithRefreshToken(userID uuid.UUID, username, role, deviceID string) (access, refresh string, err error) {
-	accessToken := jwt.NewWithClaims(defaultSigningMethod, &Claims{
-		userID.String(),
-		username,
-		role,
-		deviceID,
-		jwt.StandardClaims{
+// NewWithRefreshToken returns signed access and refresh tokens of the user session.
+// Refresh token id is used to detect reuse of rotated refresh tokens,
+// its expiration time is limited by the session lifetime.
+func (i *JWT) NewWithRefreshToken(sessionID, refreshTokenID, userID uuid.UUID, username, role, deviceID string, refreshExpiresAt time.Time) (access, refresh string, err error) {
+	accessTokenStr, err := i.keys.sign(&Claims{
+		UserID:    userID.String(),
+		Username:  username,
+		Role:      role,
+		DeviceID:  deviceID,
+		SessionID: sessionID.String(),
+		StandardClaims: jwt.StandardClaims{
 			Id:        uuid.New().String(),
 			Subject:   AccessToken,
 			ExpiresAt: time.Now().Add(i.expIn).Unix(),
@@ -73,28 +73,33 @@ func (i *JWT) NewWithRefreshToken(userID uuid.UUID, username, role, deviceID str
 			IssuedAt:  time.Now().Unix(),
 		},
 	})
-	accessTokenStr, err := accessToken.SignedString(i.signingKey)
 	if err != nil {
 		return "", "", fmt.Errorf("could not sign access token: %w", err)
 	}
 
-	refreshToken := jwt.NewWithClaims(defaultSigningMethod, &Claims{
-		userID.String(),
-		username,
-		role,
-		deviceID,
-		jwt.StandardClaims{
-			Id:        uuid.New().String(),
+	refreshTokenStr, err := i.keys.sign(&Claims{
+		UserID:    userID.String(),
+		Username:  username,
+		Role:      role,
+		DeviceID:  deviceID,
+		SessionID: sessionID.String(),
+		StandardClaims: jwt.StandardClaims{
+			Id:        refreshTokenID.String(),
 			Subject:   RefreshToken,
-			ExpiresAt: time.Now().Add(i.expInRt).Unix(), // access token exp time + 30 days
+			ExpiresAt: refreshExpiresAt.Unix(),
 			NotBefore: time.Now().Unix(),
 			IssuedAt:  time.Now().Unix(),
 		},
 	})
-	refreshTokenStr, err := refreshToken.SignedString(i.signingKey)
 	if err != nil {
 		return "", "", fmt.Errorf("could not sign refresh token: %w", err)
 	}
 
 	return accessTokenStr, refreshTokenStr, nil
 }
+
+// RefreshTokenTTL returns sliding lifetime of refresh token,
+// the session is valid until the last issued refresh token expires.
+func (i *JWT) RefreshTokenTTL() time.Duration {
+	return i.expInRt
+}
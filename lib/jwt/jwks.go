@@ -0,0 +1,57 @@
+package jwt
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+)
+
+type (
+	// JWK is a public signing key in JSON Web Key format
+	JWK struct {
+		Kty string `json:"kty"`
+		Use string `json:"use"`
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	}
+
+	// JWKS is a set of public signing keys,
+	// partner services use it to verify user tokens offline.
+	JWKS struct {
+		Keys []JWK `json:"keys"`
+	}
+)
+
+// JWKS returns public keys of the active signing keys, including retired keys within the grace period
+func (ks *KeySet) JWKS() JWKS {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	result := JWKS{Keys: make([]JWK, 0, len(ks.keys))}
+	for _, key := range ks.keys {
+		pub := key.PrivateKey.PublicKey
+		result.Keys = append(result.Keys, JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: signingMethod.Alg(),
+			Kid: key.ID,
+			N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		})
+	}
+
+	return result
+}
+
+// JWKSHandler publishes public signing keys, e.g. on /.well-known/jwks.json
+func JWKSHandler(ks *KeySet) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ks.JWKS())
+	}
+}
@@ -0,0 +1,277 @@
+package jwt
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	kitjwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+// Default key rotation settings
+const (
+	defaultRotationPeriod = 30 * 24 * time.Hour
+	defaultGracePeriod    = 31 * 24 * time.Hour // refresh token lifetime with default access token ttl
+	signingKeyBits        = 2048
+	minReloadInterval     = 5 * time.Second // limits reloads on tokens signed with unknown key
+)
+
+// Supported signing methods
+var (
+	signingMethod       jwt.SigningMethod = jwt.SigningMethodRS256
+	legacySigningMethod jwt.SigningMethod = jwt.SigningMethodHS256
+)
+
+type (
+	// SigningKey is an RSA key pair used to sign tokens,
+	// the key id is set into the kid header of the token.
+	SigningKey struct {
+		ID         string
+		PrivateKey *rsa.PrivateKey
+		CreatedAt  time.Time
+	}
+
+	// KeySet keeps signing keys in memory and rotates them on schedule.
+	// Tokens are signed with the newest key, retired keys are used to verify tokens
+	// during the grace period, so tokens issued before rotation stay valid until expiration.
+	KeySet struct {
+		mu         sync.RWMutex
+		keys       []SigningKey // the newest first
+		lastLoadAt time.Time
+
+		store          keyStore
+		rotationPeriod time.Duration
+		gracePeriod    time.Duration
+		legacySecret   []byte
+		legacyCutoff   time.Time // switch to RS256, legacy tokens issued later are forged
+	}
+
+	// KeySetOption function
+	// interface to extend key set via options
+	KeySetOption func(*KeySet)
+
+	keyStore interface {
+		GetSigningKeys(ctx context.Context, createdAfter time.Time) ([]SigningKey, error)
+		AddSigningKey(ctx context.Context, key SigningKey) error
+	}
+)
+
+// NewKeySet is a factory function,
+// returns a new instance of the KeySet struct.
+// Keys are loaded on the first sync.
+func NewKeySet(store keyStore, opt ...KeySetOption) *KeySet {
+	if store == nil {
+		log.Fatalln("signing keys store is not set")
+	}
+
+	ks := &KeySet{
+		store:          store,
+		rotationPeriod: defaultRotationPeriod,
+		gracePeriod:    defaultGracePeriod,
+	}
+
+	// Set up options.
+	for _, o := range opt {
+		o(ks)
+	}
+
+	return ks
+}
+
+// WithRotation option
+// Sets how often a new signing key is added and how long the retired keys are used to verify tokens
+func WithRotation(period, grace time.Duration) KeySetOption {
+	return func(ks *KeySet) {
+		if period > 0 {
+			ks.rotationPeriod = period
+		}
+		if grace > 0 {
+			ks.gracePeriod = grace
+		}
+	}
+}
+
+// WithLegacySigningKey option
+// Tokens signed with HS256 shared secret before the cutoff are accepted
+// during the grace period since the cutoff, the shared secret is not trusted afterwards.
+func WithLegacySigningKey(secret string, cutoff time.Time) KeySetOption {
+	return func(ks *KeySet) {
+		if secret == "" {
+			return
+		}
+		if cutoff.Unix() <= 0 {
+			log.Fatalln("legacy signing key cutoff is not set")
+		}
+		ks.legacySecret = []byte(secret)
+		ks.legacyCutoff = cutoff
+	}
+}
+
+// Sync loads active keys from the store and adds a new key,
+// if the newest one is older than rotation period.
+func (ks *KeySet) Sync(ctx context.Context) error {
+	keys, err := ks.store.GetSigningKeys(ctx, time.Now().Add(-ks.rotationPeriod-ks.gracePeriod))
+	if err != nil {
+		return fmt.Errorf("could not load signing keys: %w", err)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+
+	if len(keys) == 0 || time.Since(keys[0].CreatedAt) >= ks.rotationPeriod {
+		key, err := newSigningKey()
+		if err != nil {
+			return err
+		}
+		if err := ks.store.AddSigningKey(ctx, key); err != nil {
+			return fmt.Errorf("could not store signing key: %w", err)
+		}
+		keys = append([]SigningKey{key}, keys...)
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	ks.keys = keys
+	ks.lastLoadAt = time.Now()
+
+	return nil
+}
+
+// Run syncs keys with the given interval until the context is canceled
+func (ks *KeySet) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := ks.Sync(ctx); err != nil {
+				log.Printf("signing keys: %v", err)
+			}
+		}
+	}
+}
+
+// signingKey returns the newest key
+func (ks *KeySet) signingKey() (SigningKey, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if len(ks.keys) == 0 {
+		return SigningKey{}, ErrNoSigningKey
+	}
+
+	return ks.keys[0], nil
+}
+
+// publicKey returns public key by key id.
+// Keys are reloaded if the key is unknown, since it could be added by another API instance.
+func (ks *KeySet) publicKey(kid string) (*rsa.PublicKey, error) {
+	if key, ok := ks.findKey(kid); ok {
+		return &key.PrivateKey.PublicKey, nil
+	}
+
+	ks.mu.Lock()
+	canReload := time.Since(ks.lastLoadAt) >= minReloadInterval
+	if canReload {
+		ks.lastLoadAt = time.Now()
+	}
+	ks.mu.Unlock()
+
+	if canReload {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := ks.Sync(ctx); err != nil {
+			log.Printf("signing keys: %v", err)
+		}
+		if key, ok := ks.findKey(kid); ok {
+			return &key.PrivateKey.PublicKey, nil
+		}
+	}
+
+	return nil, ErrUnknownSigningKey
+}
+
+func (ks *KeySet) findKey(kid string) (SigningKey, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	for _, key := range ks.keys {
+		if key.ID == kid {
+			return key, true
+		}
+	}
+
+	return SigningKey{}, false
+}
+
+// verificationKey is a jwt.Keyfunc, returns the key to verify the token signature
+func (ks *KeySet) verificationKey(token *jwt.Token) (interface{}, error) {
+	switch token.Method {
+	case signingMethod:
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, ErrUnknownSigningKey
+		}
+		return ks.publicKey(kid)
+	case legacySigningMethod:
+		if ks.isLegacyTokenAccepted(token) {
+			return ks.legacySecret, nil
+		}
+	}
+
+	return nil, kitjwt.ErrUnexpectedSigningMethod
+}
+
+// isLegacyTokenAccepted reports whether the HS256 token was issued before the switch to RS256
+// and the legacy tokens are still accepted. Anyone knowing the shared secret can forge the issue time,
+// so no legacy tokens are accepted once the grace period since the cutoff is over.
+func (ks *KeySet) isLegacyTokenAccepted(token *jwt.Token) bool {
+	if len(ks.legacySecret) == 0 || !time.Now().Before(ks.legacyCutoff.Add(ks.gracePeriod)) {
+		return false
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if !ok || claims.IssuedAt == 0 {
+		return false
+	}
+
+	return time.Unix(claims.IssuedAt, 0).Before(ks.legacyCutoff)
+}
+
+// sign returns token signed with the newest key
+func (ks *KeySet) sign(claims jwt.Claims) (string, error) {
+	key, err := ks.signingKey()
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(signingMethod, claims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.PrivateKey)
+}
+
+func newSigningKey() (SigningKey, error) {
+	pk, err := rsa.GenerateKey(rand.Reader, signingKeyBits)
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("could not generate signing key: %w", err)
+	}
+
+	return SigningKey{
+		ID:         uuid.New().String(),
+		PrivateKey: pk,
+		CreatedAt:  time.Now(),
+	}, nil
+}
@@ -0,0 +1,133 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	kitjwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/golang-jwt/jwt"
+)
+
+type memoryKeyStore struct {
+	keys []SigningKey
+}
+
+func (s *memoryKeyStore) GetSigningKeys(_ context.Context, createdAfter time.Time) ([]SigningKey, error) {
+	var result []SigningKey
+	for _, key := range s.keys {
+		if key.CreatedAt.After(createdAfter) {
+			result = append(result, key)
+		}
+	}
+	return result, nil
+}
+
+func (s *memoryKeyStore) AddSigningKey(_ context.Context, key SigningKey) error {
+	s.keys = append(s.keys, key)
+	return nil
+}
+
+func parseWithKeySet(ks *KeySet, token string) error {
+	_, err := jwt.ParseWithClaims(token, ClaimsFactory(), ks.verificationKey)
+	if e, ok := err.(*jwt.ValidationError); ok && e.Inner != nil {
+		return e.Inner
+	}
+	return err
+}
+
+func TestKeySetRotation(t *testing.T) {
+	store := &memoryKeyStore{}
+	ks := NewKeySet(store, WithRotation(time.Hour, time.Hour))
+
+	if _, err := ks.sign(&Claims{UserID: "user"}); !errors.Is(err, ErrNoSigningKey) {
+		t.Fatalf("sign() before sync error = %v, want %v", err, ErrNoSigningKey)
+	}
+
+	if err := ks.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	oldToken, err := ks.sign(&Claims{UserID: "user"})
+	if err != nil {
+		t.Fatalf("sign() error = %v", err)
+	}
+
+	// the key is not rotated within rotation period
+	if err := ks.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if len(store.keys) != 1 {
+		t.Fatalf("keys count = %d, want 1", len(store.keys))
+	}
+
+	// the key is retired, but still verifies tokens during the grace period
+	store.keys[0].CreatedAt = time.Now().Add(-90 * time.Minute)
+	if err := ks.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if len(store.keys) != 2 || len(ks.JWKS().Keys) != 2 {
+		t.Fatalf("keys count = %d, published = %d, want 2", len(store.keys), len(ks.JWKS().Keys))
+	}
+	if err := parseWithKeySet(ks, oldToken); err != nil {
+		t.Errorf("token signed with retired key is not valid: %v", err)
+	}
+
+	newToken, err := ks.sign(&Claims{UserID: "user"})
+	if err != nil {
+		t.Fatalf("sign() error = %v", err)
+	}
+	parsed, _ := jwt.Parse(newToken, ks.verificationKey)
+	if kid := parsed.Header["kid"]; kid != store.keys[1].ID {
+		t.Errorf("token kid = %v, want the newest key %s", kid, store.keys[1].ID)
+	}
+
+	// the key is dropped after the grace period
+	store.keys[0].CreatedAt = time.Now().Add(-3 * time.Hour)
+	if err := ks.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if err := parseWithKeySet(ks, oldToken); err == nil {
+		t.Errorf("token signed with expired key is valid")
+	}
+}
+
+func TestKeySetLegacySigningKey(t *testing.T) {
+	cutoff := time.Now().Add(-time.Hour)
+	signLegacy := func(issuedAt time.Time) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{
+			UserID:         "user",
+			StandardClaims: jwt.StandardClaims{IssuedAt: issuedAt.Unix()},
+		}).SignedString([]byte("secret"))
+		if err != nil {
+			t.Fatalf("could not sign legacy token: %v", err)
+		}
+		return token
+	}
+	legacyToken := signLegacy(cutoff.Add(-time.Minute))
+
+	ks := NewKeySet(&memoryKeyStore{})
+	if err := parseWithKeySet(ks, legacyToken); !errors.Is(err, kitjwt.ErrUnexpectedSigningMethod) {
+		t.Errorf("legacy token error = %v, want %v", err, kitjwt.ErrUnexpectedSigningMethod)
+	}
+
+	ks = NewKeySet(&memoryKeyStore{}, WithLegacySigningKey("secret", cutoff))
+	if err := parseWithKeySet(ks, legacyToken); err != nil {
+		t.Errorf("legacy token is not valid: %v", err)
+	}
+
+	// the shared secret is not trusted for tokens issued after the switch to RS256
+	if err := parseWithKeySet(ks, signLegacy(time.Now())); !errors.Is(err, kitjwt.ErrUnexpectedSigningMethod) {
+		t.Errorf("freshly minted legacy token error = %v, want %v", err, kitjwt.ErrUnexpectedSigningMethod)
+	}
+	noIssuedAt, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{UserID: "user"}).SignedString([]byte("secret"))
+	if err := parseWithKeySet(ks, noIssuedAt); !errors.Is(err, kitjwt.ErrUnexpectedSigningMethod) {
+		t.Errorf("legacy token without iat error = %v, want %v", err, kitjwt.ErrUnexpectedSigningMethod)
+	}
+
+	// legacy tokens are not accepted at all once the grace period is over
+	ks = NewKeySet(&memoryKeyStore{}, WithLegacySigningKey("secret", cutoff), WithRotation(time.Hour, time.Minute))
+	if err := parseWithKeySet(ks, legacyToken); !errors.Is(err, kitjwt.ErrUnexpectedSigningMethod) {
+		t.Errorf("legacy token after grace period error = %v, want %v", err, kitjwt.ErrUnexpectedSigningMethod)
+	}
+}
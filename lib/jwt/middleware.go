@@ -23,15 +23,17 @@ type userRepository interface {
 }
 
 // NewParser creates a new JWT token parsing middleware, specifying a
-// jwt.Keyfunc interface, the signing method and the claims type to be used. NewParser
-// adds the resulting claims to endpoint context or returns error on invalid token.
+// jwt.Keyfunc interface and the claims type to be used. The key function
+// validates the signing method. NewParser adds the resulting claims
+// to endpoint context or returns error on invalid token.
 // Particularly useful for servers.
+// Refresh tokens are accepted only if refresh is true, other tokens are rejected then.
 func newParser(
 	keyFunc jwt.Keyfunc,
-	method jwt.SigningMethod,
 	newClaims claimsFactory,
 	checkUser checkUserFunc,
 	ur userRepository,
+	refresh bool,
 ) endpoint.Middleware {
 	return func(next endpoint.Endpoint) endpoint.Endpoint {
 		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
@@ -47,14 +49,7 @@ func newParser(
 			// of the token to identify which key to use, but the parsed token
 			// (head and claims) is provided to the callback, providing
 			// flexibility.
-			token, err := jwt.ParseWithClaims(tokenString, newClaims(), func(token *jwt.Token) (interface{}, error) {
-				// Don't forget to validate the alg is what you expect:
-				if token.Method != method {
-					return nil, kitjwt.ErrUnexpectedSigningMethod
-				}
-
-				return keyFunc(token)
-			})
+			token, err := jwt.ParseWithClaims(tokenString, newClaims(), keyFunc)
 			if err != nil {
 				if e, ok := err.(*jwt.ValidationError); ok {
 					switch {
@@ -86,6 +81,11 @@ func newParser(
 
 			ctx = context.WithValue(ctx, kitjwt.JWTClaimsContextKey, token.Claims)
 
+			// refresh tokens live much longer than access tokens, they can't be used as bearer tokens
+			if sub, _ := TokenSubjectFromContext(ctx); (sub == RefreshToken) != refresh {
+				return nil, ErrUnexpectedTokenType
+			}
+
 			if checkUser != nil {
 				if err := checkUser(ctx); err != nil {
 					return nil, err
@@ -107,11 +107,16 @@ func newParser(
 	}
 }
 
-// NewParser returns go-kit parser middleware
-func NewParser(signingKey string, checkUser checkUserFunc, ur userRepository) endpoint.Middleware {
-	return newParser(func(token *jwt.Token) (interface{}, error) {
-		return []byte(signingKey), nil
-	}, defaultSigningMethod, ClaimsFactory, checkUser, ur)
+// NewParser returns go-kit parser middleware of access tokens,
+// tokens are verified with public keys of the key set.
+func NewParser(keys *KeySet, checkUser checkUserFunc, ur userRepository) endpoint.Middleware {
+	return newParser(keys.verificationKey, ClaimsFactory, checkUser, ur, false)
+}
+
+// NewRefreshParser returns go-kit parser middleware of refresh tokens,
+// used by the endpoint issuing new tokens of the session only.
+func NewRefreshParser(keys *KeySet, checkUser checkUserFunc, ur userRepository) endpoint.Middleware {
+	return newParser(keys.verificationKey, ClaimsFactory, checkUser, ur, true)
 }
 
 func NewAPIKeyMdw(apiKey string, skip bool) endpoint.Middleware {
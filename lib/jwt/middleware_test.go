@@ -0,0 +1,87 @@
+package jwt_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	kitjwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+)
+
+type keyStore struct {
+	keys []jwt.SigningKey
+}
+
+func (s *keyStore) GetSigningKeys(_ context.Context, _ time.Time) ([]jwt.SigningKey, error) {
+	return s.keys, nil
+}
+
+func (s *keyStore) AddSigningKey(_ context.Context, key jwt.SigningKey) error {
+	s.keys = append(s.keys, key)
+	return nil
+}
+
+type userRepository struct{}
+
+func (userRepository) GetPublicKey(_ context.Context, _ uuid.UUID) (sql.NullString, error) {
+	return sql.NullString{}, nil
+}
+
+func TestParserTokenTypes(t *testing.T) {
+	keys := jwt.NewKeySet(&keyStore{})
+	if err := keys.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+
+	access, refresh, err := jwt.NewInteractor(keys, time.Minute).
+		NewWithRefreshToken(uuid.New(), uuid.New(), uuid.New(), "user", "user", "device", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("NewWithRefreshToken() error = %v", err)
+	}
+
+	ok := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	endpoint := jwt.NewParser(keys, nil, userRepository{})(ok)
+	refreshEndpoint := jwt.NewRefreshParser(keys, nil, userRepository{})(ok)
+
+	tests := []struct {
+		name     string
+		refresh  bool
+		token    string
+		wantCode int
+	}{
+		{name: "access token", token: access},
+		{name: "refresh token as access token", token: refresh, wantCode: http.StatusUnauthorized},
+		{name: "refresh token", refresh: true, token: refresh},
+		{name: "access token as refresh token", refresh: true, token: access, wantCode: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := endpoint
+			if tt.refresh {
+				e = refreshEndpoint
+			}
+
+			ctx := context.WithValue(context.Background(), kitjwt.JWTTokenContextKey, tt.token)
+			_, err := e(ctx, nil)
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("token is accepted")
+			}
+			if code, _ := httpencoder.CodeAndMessageFrom(err); code != tt.wantCode {
+				t.Errorf("status code = %d, want %d", code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// SessionCache keeps revoked sessions in memory,
+	// so the parser middleware checks revocation without database queries.
+	// Revoked sessions are reloaded periodically to catch up revocations made by other API instances.
+	SessionCache struct {
+		mu       sync.RWMutex
+		revoked  map[uuid.UUID]time.Time // session id => session expiration time
+		lastSeen map[uuid.UUID]time.Time // sessions used since the last sync
+
+		loadRevoked  loadRevokedSessionsFunc
+		saveLastSeen saveLastSeenFunc
+	}
+
+	// RevokedSession struct
+	RevokedSession struct {
+		ID        uuid.UUID
+		ExpiresAt time.Time
+	}
+
+	loadRevokedSessionsFunc func(ctx context.Context) ([]RevokedSession, error)
+	saveLastSeenFunc        func(ctx context.Context, sessionID uuid.UUID, lastSeenAt time.Time) error
+)
+
+// NewSessionCache is a factory function,
+// returns a new instance of the SessionCache struct
+func NewSessionCache(loadRevoked loadRevokedSessionsFunc, saveLastSeen saveLastSeenFunc) *SessionCache {
+	return &SessionCache{
+		revoked:      make(map[uuid.UUID]time.Time),
+		lastSeen:     make(map[uuid.UUID]time.Time),
+		loadRevoked:  loadRevoked,
+		saveLastSeen: saveLastSeen,
+	}
+}
+
+// Revoke adds sessions into the revoked list right away, without waiting for the next sync
+func (c *SessionCache) Revoke(sessions ...RevokedSession) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, s := range sessions {
+		c.revoked[s.ID] = s.ExpiresAt
+		delete(c.lastSeen, s.ID)
+	}
+}
+
+// IsRevoked returns true if the session was revoked
+func (c *SessionCache) IsRevoked(sessionID uuid.UUID) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, ok := c.revoked[sessionID]
+	return ok
+}
+
+// Touch marks the session as used,
+// last seen time is stored on the next sync to avoid a database query per request.
+func (c *SessionCache) Touch(sessionID uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastSeen[sessionID] = time.Now()
+}
+
+// Sync stores last seen time of used sessions and reloads revoked sessions
+func (c *SessionCache) Sync(ctx context.Context) error {
+	c.mu.Lock()
+	lastSeen := c.lastSeen
+	c.lastSeen = make(map[uuid.UUID]time.Time)
+	c.mu.Unlock()
+
+	for id, t := range lastSeen {
+		if err := c.saveLastSeen(ctx, id, t); err != nil {
+			log.Printf("could not store last seen time of session %s: %v", id, err)
+		}
+	}
+
+	sessions, err := c.loadRevoked(ctx)
+	if err != nil {
+		return fmt.Errorf("could not load revoked sessions: %w", err)
+	}
+
+	revoked := make(map[uuid.UUID]time.Time, len(sessions))
+	for _, s := range sessions {
+		revoked[s.ID] = s.ExpiresAt
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// keep sessions revoked locally during the sync, they could be missed by the query
+	now := time.Now()
+	for id, exp := range c.revoked {
+		if _, ok := revoked[id]; !ok && exp.After(now) {
+			revoked[id] = exp
+		}
+	}
+	c.revoked = revoked
+
+	return nil
+}
+
+// Run syncs the cache with the given interval until the context is canceled
+func (c *SessionCache) Run(ctx context.Context, interval time.Duration) error {
+	if err := c.Sync(ctx); err != nil {
+		log.Printf("session cache: %v", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := c.Sync(ctx); err != nil {
+				log.Printf("session cache: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package jwt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSessionCache(t *testing.T) {
+	var (
+		loaded   []RevokedSession
+		lastSeen = make(map[uuid.UUID]time.Time)
+	)
+	c := NewSessionCache(
+		func(ctx context.Context) ([]RevokedSession, error) {
+			return loaded, nil
+		},
+		func(ctx context.Context, sessionID uuid.UUID, lastSeenAt time.Time) error {
+			lastSeen[sessionID] = lastSeenAt
+			return nil
+		},
+	)
+
+	active, local, remote := uuid.New(), uuid.New(), uuid.New()
+	c.Touch(active)
+	c.Revoke(RevokedSession{ID: local, ExpiresAt: time.Now().Add(time.Hour)})
+	if !c.IsRevoked(local) {
+		t.Errorf("IsRevoked() = false for locally revoked session")
+	}
+
+	loaded = []RevokedSession{{ID: remote, ExpiresAt: time.Now().Add(time.Hour)}}
+	if err := c.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if _, ok := lastSeen[active]; !ok {
+		t.Errorf("Sync() did not store last seen time of the used session")
+	}
+	if c.IsRevoked(active) {
+		t.Errorf("IsRevoked() = true for active session")
+	}
+	if !c.IsRevoked(local) || !c.IsRevoked(remote) {
+		t.Errorf("IsRevoked() = false for revoked session after sync")
+	}
+
+	c.Revoke(RevokedSession{ID: active, ExpiresAt: time.Now().Add(-time.Minute)})
+	if err := c.Sync(context.Background()); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if c.IsRevoked(active) {
+		t.Errorf("IsRevoked() = true for expired session")
+	}
+}
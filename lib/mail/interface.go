@@ -2,6 +2,7 @@ package mail
 
 import (
 	"context"
+	"time"
 )
 
 //go:generate mockgen -destination=mock_client.go -package=mail github.com/SatorNetwork/sator-api/lib/mail Interface
@@ -10,6 +11,11 @@ type (
 		SendVerificationCode(_ context.Context, email, otp string) error
 		SendResetPasswordCode(_ context.Context, email, otp string) error
 		SendDestroyAccountCode(_ context.Context, email, otp string) error
-		SendInvitation(_ context.Context, email, invitedBy string) error
+		SendInvitation(_ context.Context, email, invitedBy, invitationLink string) error
+		SendRewardBudgetAlert(_ context.Context, email, scopeType, scopeID string, threshold int32, consumed, total float64) error
+		SendSessionCompromisedAlert(_ context.Context, email, deviceID, ip string) error
+		SendMagicLink(_ context.Context, email, link string) error
+		SendAccountLockedAlert(_ context.Context, email, ip string, lockedUntil time.Time) error
+		SendDataExportLink(_ context.Context, email, link string, expiresAt time.Time) error
 	}
 )
@@ -7,6 +7,7 @@ package mail
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -34,6 +35,34 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// SendAccountLockedAlert mocks base method.
+func (m *MockInterface) SendAccountLockedAlert(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAccountLockedAlert", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAccountLockedAlert indicates an expected call of SendAccountLockedAlert.
+func (mr *MockInterfaceMockRecorder) SendAccountLockedAlert(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAccountLockedAlert", reflect.TypeOf((*MockInterface)(nil).SendAccountLockedAlert), arg0, arg1, arg2, arg3)
+}
+
+// SendDataExportLink mocks base method.
+func (m *MockInterface) SendDataExportLink(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDataExportLink", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendDataExportLink indicates an expected call of SendDataExportLink.
+func (mr *MockInterfaceMockRecorder) SendDataExportLink(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDataExportLink", reflect.TypeOf((*MockInterface)(nil).SendDataExportLink), arg0, arg1, arg2, arg3)
+}
+
 // SendDestroyAccountCode mocks base method.
 func (m *MockInterface) SendDestroyAccountCode(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -49,17 +78,31 @@ func (mr *MockInterfaceMockRecorder) SendDestroyAccountCode(arg0, arg1, arg2 int
 }
 
 // SendInvitation mocks base method.
-func (m *MockInterface) SendInvitation(arg0 context.Context, arg1, arg2 string) error {
+func (m *MockInterface) SendInvitation(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendInvitation", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SendInvitation", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendInvitation indicates an expected call of SendInvitation.
-func (mr *MockInterfaceMockRecorder) SendInvitation(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockInterfaceMockRecorder) SendInvitation(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvitation", reflect.TypeOf((*MockInterface)(nil).SendInvitation), arg0, arg1, arg2, arg3)
+}
+
+// SendMagicLink mocks base method.
+func (m *MockInterface) SendMagicLink(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMagicLink", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMagicLink indicates an expected call of SendMagicLink.
+func (mr *MockInterfaceMockRecorder) SendMagicLink(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvitation", reflect.TypeOf((*MockInterface)(nil).SendInvitation), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMagicLink", reflect.TypeOf((*MockInterface)(nil).SendMagicLink), arg0, arg1, arg2)
 }
 
 // SendResetPasswordCode mocks base method.
@@ -76,6 +119,34 @@ func (mr *MockInterfaceMockRecorder) SendResetPasswordCode(arg0, arg1, arg2 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendResetPasswordCode", reflect.TypeOf((*MockInterface)(nil).SendResetPasswordCode), arg0, arg1, arg2)
 }
 
+// SendRewardBudgetAlert mocks base method.
+func (m *MockInterface) SendRewardBudgetAlert(arg0 context.Context, arg1, arg2, arg3 string, arg4 int32, arg5, arg6 float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRewardBudgetAlert", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendRewardBudgetAlert indicates an expected call of SendRewardBudgetAlert.
+func (mr *MockInterfaceMockRecorder) SendRewardBudgetAlert(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRewardBudgetAlert", reflect.TypeOf((*MockInterface)(nil).SendRewardBudgetAlert), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// SendSessionCompromisedAlert mocks base method.
+func (m *MockInterface) SendSessionCompromisedAlert(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendSessionCompromisedAlert", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendSessionCompromisedAlert indicates an expected call of SendSessionCompromisedAlert.
+func (mr *MockInterfaceMockRecorder) SendSessionCompromisedAlert(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSessionCompromisedAlert", reflect.TypeOf((*MockInterface)(nil).SendSessionCompromisedAlert), arg0, arg1, arg2, arg3)
+}
+
 // SendVerificationCode mocks base method.
 func (m *MockInterface) SendVerificationCode(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -25,7 +25,42 @@ func (m *MockInterface) ExpectSendDestroyAccountCodeAny() *gomock.Call {
 
 func (m *MockInterface) ExpectSendInvitationAny() *gomock.Call {
 	return m.EXPECT().
-		SendInvitation(gomock.Any(), gomock.Any(), gomock.Any()).
+		SendInvitation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+}
+
+func (m *MockInterface) ExpectSendRewardBudgetAlertAny() *gomock.Call {
+	return m.EXPECT().
+		SendRewardBudgetAlert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+}
+
+func (m *MockInterface) ExpectSendSessionCompromisedAlertAny() *gomock.Call {
+	return m.EXPECT().
+		SendSessionCompromisedAlert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+}
+
+func (m *MockInterface) ExpectSendMagicLinkAny() *gomock.Call {
+	return m.EXPECT().
+		SendMagicLink(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+}
+
+func (m *MockInterface) ExpectSendAccountLockedAlertAny() *gomock.Call {
+	return m.EXPECT().
+		SendAccountLockedAlert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+}
+
+func (m *MockInterface) ExpectSendDataExportLinkAny() *gomock.Call {
+	return m.EXPECT().
+		SendDataExportLink(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).
 		AnyTimes()
 }
@@ -29,6 +29,11 @@ func New(pc postmarkClient, cnf Config) lib_mail.Interface {
 		m.(*lib_mail.MockInterface).ExpectSendResetPasswordCodeAny()
 		m.(*lib_mail.MockInterface).ExpectSendDestroyAccountCodeAny()
 		m.(*lib_mail.MockInterface).ExpectSendInvitationAny()
+		m.(*lib_mail.MockInterface).ExpectSendRewardBudgetAlertAny()
+		m.(*lib_mail.MockInterface).ExpectSendSessionCompromisedAlertAny()
+		m.(*lib_mail.MockInterface).ExpectSendMagicLinkAny()
+		m.(*lib_mail.MockInterface).ExpectSendAccountLockedAlertAny()
+		m.(*lib_mail.MockInterface).ExpectSendDataExportLinkAny()
 	}
 	return m.(lib_mail.Interface)
 }
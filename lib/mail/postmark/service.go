@@ -5,6 +5,7 @@ package postmark
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/keighl/postmark"
 )
@@ -15,6 +16,11 @@ var (
 	PasswordResetTmpl      = "password_reset"
 	DestroyAccountCodeTmpl = "destroy_account"
 	InvitationCodeTmpl     = "invitation"
+	RewardBudgetAlertTmpl  = "reward_budget_alert"
+	SessionCompromisedTmpl = "session_compromised"
+	MagicLinkTmpl          = "magic_link"
+	AccountLockedTmpl      = "account_locked"
+	DataExportTmpl         = "data_export"
 )
 
 type (
@@ -61,15 +67,75 @@ func (s *Service) SendDestroyAccountCode(_ context.Context, email, otp string) e
 }
 
 // SendInvitation ...
-func (s *Service) SendInvitation(_ context.Context, email, invitedBy string) error {
+func (s *Service) SendInvitation(_ context.Context, email, invitedBy, invitationLink string) error {
 	if err := s.send(InvitationCodeTmpl, "invitation", email, map[string]interface{}{
-		"invited_by": invitedBy,
+		"invited_by":      invitedBy,
+		"invitation_link": invitationLink,
 	}); err != nil {
 		return fmt.Errorf("could not send invitation to email %s: %w", email, err)
 	}
 	return nil
 }
 
+// SendRewardBudgetAlert notifies the budget owner that the given percent of the budget is consumed
+func (s *Service) SendRewardBudgetAlert(_ context.Context, email, scopeType, scopeID string, threshold int32, consumed, total float64) error {
+	if err := s.send(RewardBudgetAlertTmpl, "reward_budget_alert", email, map[string]interface{}{
+		"scope_type":      scopeType,
+		"scope_id":        scopeID,
+		"threshold":       threshold,
+		"consumed_amount": consumed,
+		"total_amount":    total,
+	}); err != nil {
+		return fmt.Errorf("could not send reward budget alert to email %s: %w", email, err)
+	}
+	return nil
+}
+
+// SendSessionCompromisedAlert notifies the user that a used refresh token was presented again,
+// so the session was revoked
+func (s *Service) SendSessionCompromisedAlert(_ context.Context, email, deviceID, ip string) error {
+	if err := s.send(SessionCompromisedTmpl, "session_compromised", email, map[string]interface{}{
+		"device_id": deviceID,
+		"ip":        ip,
+	}); err != nil {
+		return fmt.Errorf("could not send session compromised alert to email %s: %w", email, err)
+	}
+	return nil
+}
+
+// SendMagicLink sends a single-use link to log in without password
+func (s *Service) SendMagicLink(_ context.Context, email, link string) error {
+	if err := s.send(MagicLinkTmpl, "magic_link", email, map[string]interface{}{
+		"magic_link": link,
+	}); err != nil {
+		return fmt.Errorf("could not send magic link to email %s: %w", email, err)
+	}
+	return nil
+}
+
+// SendAccountLockedAlert notifies the user that the account is temporarily locked
+// after too many failed login or verification attempts.
+func (s *Service) SendAccountLockedAlert(_ context.Context, email, ip string, lockedUntil time.Time) error {
+	if err := s.send(AccountLockedTmpl, "account_locked", email, map[string]interface{}{
+		"ip":           ip,
+		"locked_until": lockedUntil.UTC().Format(time.RFC1123),
+	}); err != nil {
+		return fmt.Errorf("could not send account locked alert to email %s: %w", email, err)
+	}
+	return nil
+}
+
+// SendDataExportLink sends a time-limited link to download the archive with the user's data
+func (s *Service) SendDataExportLink(_ context.Context, email, link string, expiresAt time.Time) error {
+	if err := s.send(DataExportTmpl, "data_export", email, map[string]interface{}{
+		"download_link": link,
+		"expires_at":    expiresAt.UTC().Format(time.RFC1123),
+	}); err != nil {
+		return fmt.Errorf("could not send data export link to email %s: %w", email, err)
+	}
+	return nil
+}
+
 // send email
 func (s *Service) send(tpl, tag, email string, data map[string]interface{}) error {
 	// Default model data
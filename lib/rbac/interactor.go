@@ -35,9 +35,7 @@ func GetRoleFromContext(ctx context.Context) Role {
 		}
 	}
 
-	switch r := Role(role); r {
-	case RoleAdmin, RoleContentManager, RoleModerator,
-		RoleGuest, RoleShowRunner, RoleUser, RoleTestUser:
+	if r := Role(role); r.IsPredefined() {
 		return r
 	}
 
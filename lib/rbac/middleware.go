@@ -0,0 +1,61 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+)
+
+type (
+	// PermissionChecker checks whether the user with the role has the permission on the resource
+	PermissionChecker interface {
+		HasPermission(ctx context.Context, userID uuid.UUID, role Role, permission Permission, resource Resource) (bool, error)
+	}
+
+	// ResourceFunc returns the resource from the endpoint request
+	ResourceFunc func(ctx context.Context, request interface{}) (Resource, error)
+)
+
+// CheckPermissionMdw returns endpoint middleware which allows the request
+// only if the current user has the permission on the resource of the request.
+// The middleware must be applied after the JWT middleware, admins are always allowed.
+// If rf is nil, the permission is checked globally.
+func CheckPermissionMdw(pc PermissionChecker, permission Permission, rf ResourceFunc) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			role := GetRoleFromContext(ctx)
+			if role == RoleGuest {
+				return nil, ErrAccessDenied
+			}
+			if role == RoleAdmin {
+				return next(ctx, request)
+			}
+
+			uid, err := jwt.UserIDFromContext(ctx)
+			if err != nil {
+				return nil, ErrAccessDenied
+			}
+
+			var resource Resource
+			if rf != nil {
+				if resource, err = rf(ctx, request); err != nil {
+					return nil, err
+				}
+			}
+
+			ok, err := pc.HasPermission(ctx, uid, role, permission, resource)
+			if err != nil {
+				return nil, fmt.Errorf("could not check permission %s: %w", permission, err)
+			}
+			if !ok {
+				return nil, ErrAccessDenied
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	kitjwt "github.com/go-kit/kit/auth/jwt"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+)
+
+type permissionCheckerMock struct {
+	allowed  bool
+	err      error
+	called   bool
+	resource Resource
+}
+
+func (m *permissionCheckerMock) HasPermission(_ context.Context, _ uuid.UUID, _ Role, _ Permission, resource Resource) (bool, error) {
+	m.called = true
+	m.resource = resource
+	return m.allowed, m.err
+}
+
+func TestCheckPermissionMdw(t *testing.T) {
+	showID := uuid.New()
+	rf := func(_ context.Context, request interface{}) (Resource, error) {
+		return ShowResource(request.(uuid.UUID)), nil
+	}
+	next := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return true, nil
+	}
+	withClaims := func(role string) context.Context {
+		return context.WithValue(context.Background(), kitjwt.JWTClaimsContextKey, &jwt.Claims{
+			UserID: uuid.New().String(),
+			Role:   role,
+		})
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		checker    *permissionCheckerMock
+		wantErr    error
+		wantCalled bool
+	}{
+		{
+			name:    "guest is denied",
+			ctx:     context.Background(),
+			checker: &permissionCheckerMock{allowed: true},
+			wantErr: ErrAccessDenied,
+		},
+		{
+			name:    "admin is always allowed",
+			ctx:     withClaims(RoleAdmin.String()),
+			checker: &permissionCheckerMock{},
+		},
+		{
+			name:       "granted permission",
+			ctx:        withClaims(RoleShowRunner.String()),
+			checker:    &permissionCheckerMock{allowed: true},
+			wantCalled: true,
+		},
+		{
+			name:       "missed permission",
+			ctx:        withClaims(RoleUser.String()),
+			checker:    &permissionCheckerMock{},
+			wantErr:    ErrAccessDenied,
+			wantCalled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := CheckPermissionMdw(tt.checker, PermissionShowsEdit, rf)(next)
+			_, err := e(tt.ctx, showID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckPermissionMdw() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.checker.called != tt.wantCalled {
+				t.Errorf("CheckPermissionMdw() checker called = %v, want %v", tt.checker.called, tt.wantCalled)
+			}
+			if tt.wantCalled && tt.checker.resource != ShowResource(showID) {
+				t.Errorf("CheckPermissionMdw() resource = %v, want %v", tt.checker.resource, ShowResource(showID))
+			}
+		})
+	}
+}
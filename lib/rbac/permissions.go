@@ -0,0 +1,71 @@
+package rbac
+
+import "github.com/google/uuid"
+
+// Predefined permissions.
+// Permission is granted to roles, roles are granted to users globally or on a resource.
+const (
+	PermissionShowsCreate  Permission = "shows.create"
+	PermissionShowsEdit    Permission = "shows.edit"
+	PermissionShowsDelete  Permission = "shows.delete"
+	PermissionEpisodesEdit Permission = "episodes.edit"
+	PermissionRolesManage  Permission = "roles.manage"
+)
+
+// Resource types permissions can be scoped to.
+const (
+	ResourceShow = "show"
+)
+
+type (
+	Permission string
+
+	// Resource the permission is checked against.
+	// Zero value means the permission isn't related to a specific resource, e.g. shows.create.
+	Resource struct {
+		Type string
+		ID   uuid.UUID
+	}
+)
+
+func (p Permission) String() string {
+	return string(p)
+}
+
+// Permissions returns list of all predefined permissions
+func Permissions() []Permission {
+	return []Permission{
+		PermissionShowsCreate,
+		PermissionShowsEdit,
+		PermissionShowsDelete,
+		PermissionEpisodesEdit,
+		PermissionRolesManage,
+	}
+}
+
+// IsValid reports whether the permission is predefined
+func (p Permission) IsValid() bool {
+	for _, v := range Permissions() {
+		if v == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsValidResourceType reports whether permissions can be scoped to the resource type.
+// Empty type means the global scope.
+func IsValidResourceType(t string) bool {
+	switch t {
+	case "", ResourceShow:
+		return true
+	}
+
+	return false
+}
+
+// ShowResource returns the show resource with given id
+func ShowResource(id uuid.UUID) Resource {
+	return Resource{Type: ResourceShow, ID: id}
+}
@@ -19,3 +19,14 @@ type Role string
 func (r Role) String() string {
 	return string(r)
 }
+
+// IsPredefined reports whether the role is one of predefined user roles
+func (r Role) IsPredefined() bool {
+	switch r {
+	case RoleAdmin, RoleContentManager, RoleModerator,
+		RoleGuest, RoleShowRunner, RoleUser, RoleTestUser:
+		return true
+	}
+
+	return false
+}
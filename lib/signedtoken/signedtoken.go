@@ -0,0 +1,65 @@
+// Package signedtoken implements tokens in format "<payload>.<signature>",
+// where the payload is signed with HMAC-SHA256 of the secret key.
+// A valid signature proves the token was issued by the server, so forged tokens
+// are rejected before the payload is looked up. The purpose is signed along with the payload,
+// a token issued for one purpose is not accepted for another one with the same secret.
+package signedtoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// New returns the payload signed with the secret key for the purpose
+func New(secret, purpose string, payload []byte) string {
+	p := base64.RawURLEncoding.EncodeToString(payload)
+	return p + "." + sign(secret, purpose, p)
+}
+
+// Verify returns the payload if the token was issued with the secret key for the purpose
+func Verify(secret, purpose, token string) ([]byte, bool) {
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, false
+	}
+	if !hmac.Equal([]byte(parts[1]), []byte(sign(secret, purpose, parts[0]))) {
+		return nil, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, false
+	}
+
+	return payload, true
+}
+
+// NewID returns the id signed with the secret key for the purpose
+func NewID(secret, purpose string, id uuid.UUID) string {
+	return New(secret, purpose, id[:])
+}
+
+// VerifyID returns the id if the token was issued with NewID with the secret key for the purpose
+func VerifyID(secret, purpose, token string) (uuid.UUID, bool) {
+	payload, ok := Verify(secret, purpose, token)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.FromBytes(payload)
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+func sign(secret, purpose, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose + ":" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,56 @@
+package signedtoken
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestVerifyID(t *testing.T) {
+	const (
+		secret  = "secret"
+		purpose = "test"
+	)
+
+	id := uuid.New()
+	token := NewID(secret, purpose, id)
+	payload := strings.SplitN(token, ".", 2)[0]
+	another := NewID(secret, purpose, uuid.New())
+
+	tests := []struct {
+		name    string
+		secret  string
+		purpose string
+		token   string
+		want    bool
+	}{
+		{name: "valid", secret: secret, purpose: purpose, token: token, want: true},
+		{name: "another secret", secret: "another", purpose: purpose, token: token, want: false},
+		{name: "another purpose", secret: secret, purpose: "another", token: token, want: false},
+		{name: "tampered payload", secret: secret, purpose: purpose, token: "x" + token, want: false},
+		{name: "signature of another id", secret: secret, purpose: purpose, token: payload + "." + strings.SplitN(another, ".", 2)[1], want: false},
+		{name: "missed signature", secret: secret, purpose: purpose, token: payload, want: false},
+		{name: "empty signature", secret: secret, purpose: purpose, token: payload + ".", want: false},
+		{name: "empty", secret: secret, purpose: purpose, token: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := VerifyID(tt.secret, tt.purpose, tt.token)
+			if ok != tt.want {
+				t.Fatalf("VerifyID() ok = %v, want %v", ok, tt.want)
+			}
+			if ok && got != id {
+				t.Errorf("VerifyID() = %s, want %s", got, id)
+			}
+		})
+	}
+}
+
+func TestVerifyIDOfAnotherPayload(t *testing.T) {
+	token := New("secret", "test", []byte("not an id"))
+	if _, ok := VerifyID("secret", "test", token); ok {
+		t.Error("VerifyID() accepted the token with payload of wrong size")
+	}
+}
@@ -0,0 +1,9 @@
+package siws
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrInvalidAddress   = errors.New("invalid solana address")
+	ErrInvalidSignature = errors.New("invalid message signature")
+)
@@ -0,0 +1,94 @@
+// Package siws implements sign in with Solana account in the style of Sign-In With Ethereum (EIP-4361):
+// the server issues a message bound to its domain with a random nonce,
+// the wallet signs the message with ed25519 key of the account.
+package siws
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mr-tron/base58"
+)
+
+// Version of the message format
+const Version = "1"
+
+// nonceSize is a number of random bytes in the nonce
+const nonceSize = 16
+
+// Message to sign in with Solana account
+type Message struct {
+	Domain         string // domain requesting the signing, e.g. sator.io
+	Address        string // base58 encoded public key of the account
+	Statement      string // human-readable assertion the user signs
+	URI            string // resource the user signs in to
+	ChainID        string // solana cluster, e.g. mainnet
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime time.Time
+}
+
+// String returns text of the message to be signed by the wallet
+func (m Message) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s wants you to sign in with your Solana account:\n%s\n\n", m.Domain, m.Address)
+	if m.Statement != "" {
+		fmt.Fprintf(&b, "%s\n\n", m.Statement)
+	}
+	fmt.Fprintf(&b, "URI: %s\n", m.URI)
+	fmt.Fprintf(&b, "Version: %s\n", Version)
+	fmt.Fprintf(&b, "Chain ID: %s\n", m.ChainID)
+	fmt.Fprintf(&b, "Nonce: %s\n", m.Nonce)
+	fmt.Fprintf(&b, "Issued At: %s\n", m.IssuedAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "Expiration Time: %s", m.ExpirationTime.UTC().Format(time.RFC3339))
+
+	return b.String()
+}
+
+// NewNonce returns a random base58 encoded nonce
+func NewNonce() (string, error) {
+	b := make([]byte, nonceSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("could not generate nonce: %w", err)
+	}
+	return base58.Encode(b), nil
+}
+
+// ValidateAddress returns ErrInvalidAddress if the address is not a base58 encoded ed25519 public key
+func ValidateAddress(address string) error {
+	if _, err := publicKey(address); err != nil {
+		return err
+	}
+	return nil
+}
+
+// VerifySignature verifies base58 encoded signature of the message made by the account with the given address
+func VerifySignature(address, message, signature string) error {
+	pk, err := publicKey(address)
+	if err != nil {
+		return err
+	}
+
+	sig, err := base58.Decode(signature)
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return ErrInvalidSignature
+	}
+
+	if !ed25519.Verify(pk, []byte(message), sig) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func publicKey(address string) (ed25519.PublicKey, error) {
+	b, err := base58.Decode(address)
+	if err != nil || len(b) != ed25519.PublicKeySize {
+		return nil, ErrInvalidAddress
+	}
+	return ed25519.PublicKey(b), nil
+}
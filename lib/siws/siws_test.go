@@ -0,0 +1,77 @@
+package siws
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mr-tron/base58"
+)
+
+func TestMessageString(t *testing.T) {
+	m := Message{
+		Domain:         "sator.io",
+		Address:        "GsbwXfJraMomNxBcjYLcG3mxkBUiyWXAB32fGbSMQRdW",
+		Statement:      "Sign in to Sator",
+		URI:            "https://sator.io",
+		ChainID:        "mainnet",
+		Nonce:          "32891757",
+		IssuedAt:       time.Date(2022, 11, 8, 10, 0, 0, 0, time.UTC),
+		ExpirationTime: time.Date(2022, 11, 8, 10, 5, 0, 0, time.UTC),
+	}
+
+	want := "sator.io wants you to sign in with your Solana account:\n" +
+		"GsbwXfJraMomNxBcjYLcG3mxkBUiyWXAB32fGbSMQRdW\n\n" +
+		"Sign in to Sator\n\n" +
+		"URI: https://sator.io\n" +
+		"Version: 1\n" +
+		"Chain ID: mainnet\n" +
+		"Nonce: 32891757\n" +
+		"Issued At: 2022-11-08T10:00:00Z\n" +
+		"Expiration Time: 2022-11-08T10:05:00Z"
+
+	if got := m.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+	anotherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+
+	const message = "sator.io wants you to sign in with your Solana account"
+	address := base58.Encode(pub)
+	signature := base58.Encode(ed25519.Sign(priv, []byte(message)))
+
+	tests := []struct {
+		name      string
+		address   string
+		message   string
+		signature string
+		want      error
+	}{
+		{name: "valid", address: address, message: message, signature: signature},
+		{name: "another message", address: address, message: message + ".", signature: signature, want: ErrInvalidSignature},
+		{name: "another account", address: base58.Encode(anotherPub), message: message, signature: signature, want: ErrInvalidSignature},
+		{name: "malformed signature", address: address, message: message, signature: "0OIl", want: ErrInvalidSignature},
+		{name: "short signature", address: address, message: message, signature: base58.Encode([]byte("sig")), want: ErrInvalidSignature},
+		{name: "malformed address", address: "0OIl", message: message, signature: signature, want: ErrInvalidAddress},
+		{name: "short address", address: base58.Encode([]byte("address")), message: message, signature: signature, want: ErrInvalidAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := VerifySignature(tt.address, tt.message, tt.signature); !errors.Is(err, tt.want) {
+				t.Errorf("VerifySignature() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -190,6 +190,23 @@ func (c *Client) GetTokenAccountBalance(ctx context.Context, accPubKey string) (
 	return float64(accBalance) / float64(c.mltpl), nil
 }
 
+// GetTokenAccountAmount returns raw token amount of the token account and decimals of its mint,
+// unlike GetTokenAccountBalance it works with any token and reports missed account
+// as lib_solana.ErrTokenAccountNotFound instead of zero balance.
+func (c *Client) GetTokenAccountAmount(ctx context.Context, accPubKey string) (uint64, uint8, error) {
+	amount, decimals, err := c.solana.GetTokenAccountBalanceWithConfig(ctx, accPubKey, rpc.GetTokenAccountBalanceConfig{
+		Commitment: rpc.CommitmentFinalized,
+	})
+	if err != nil && strings.Contains(err.Error(), `{"code":-32602,"message":"Invalid param: could not find account"}`) {
+		return 0, 0, lib_solana.ErrTokenAccountNotFound
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not get token account balance: %w", err)
+	}
+
+	return amount, decimals, nil
+}
+
 func (c *Client) GetTokenAccountBalanceWithAutoDerive(ctx context.Context, assetAddr, accountAddr string) (float64, error) {
 	accountPublicKey := common.PublicKeyFromString(accountAddr)
 	assetPublicKey := common.PublicKeyFromString(assetAddr)
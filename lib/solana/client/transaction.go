@@ -136,6 +136,37 @@ func (c *Client) IsTransactionSuccessful(ctx context.Context, txhash string) (bo
 	return ok1 || ok2, nil
 }
 
+// GetTransactionStatus looks up transaction by its signature
+func (c *Client) GetTransactionStatus(ctx context.Context, txhash string) (lib_solana.TransactionStatus, error) {
+	ss, err := c.solana.GetSignatureStatusWithConfig(ctx, txhash, rpc.GetSignatureStatusesConfig{
+		SearchTransactionHistory: true,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "can't get signature status")
+	}
+
+	switch {
+	case ss == nil:
+		return lib_solana.TransactionStatusNotFound, nil
+	case ss.Err != nil:
+		return lib_solana.TransactionStatusFailed, nil
+	case ss.ConfirmationStatus != nil && *ss.ConfirmationStatus == rpc.CommitmentFinalized:
+		return lib_solana.TransactionStatusSucceeded, nil
+	}
+
+	return lib_solana.TransactionStatusPending, nil
+}
+
+// IsBlockhashValid reports whether transactions with the blockhash can still be processed
+func (c *Client) IsBlockhashValid(ctx context.Context, blockhash string) (bool, error) {
+	ok, err := c.solana.IsBlockhashValid(ctx, blockhash)
+	if err != nil {
+		return false, errors.Wrap(err, "can't check blockhash")
+	}
+
+	return ok, nil
+}
+
 func (s *Client) NeedToRetry(ctx context.Context, latestValidBlockHeight int64) (bool, error) {
 	cbh, err := s.GetBlockHeight(ctx)
 	if err != nil {
@@ -0,0 +1,8 @@
+package solana
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrTokenAccountNotFound = errors.New("token account not found")
+)
@@ -18,6 +18,8 @@ type Interface interface {
 	GetConfirmedTransaction(ctx context.Context, txhash string) (GetConfirmedTransactionResponse, error)
 	GetConfirmedTransactionForAccount(ctx context.Context, assetAddr string, rootPubKey string, txhash string) (ConfirmedTransactionResponse, error)
 	IsTransactionSuccessful(ctx context.Context, txhash string) (bool, error)
+	GetTransactionStatus(ctx context.Context, txhash string) (TransactionStatus, error)
+	IsBlockhashValid(ctx context.Context, blockhash string) (bool, error)
 	NeedToRetry(ctx context.Context, latestValidBlockHeight int64) (bool, error)
 	GetBlockHeight(ctx context.Context) (uint64, error)
 	NewAccount() types.Account
@@ -31,6 +33,7 @@ type Interface interface {
 	GetAccountBalanceSOL(ctx context.Context, accPubKey string) (float64, error)
 	GetTokenAccountBalance(ctx context.Context, accPubKey string) (float64, error)
 	GetTokenAccountBalanceWithAutoDerive(ctx context.Context, assetAddr, accountAddr string) (float64, error)
+	GetTokenAccountAmount(ctx context.Context, accPubKey string) (amount uint64, decimals uint8, err error)
 	GetTransactions(ctx context.Context, assetAddr, rootPubKey, ataPubKey string) (txList []ConfirmedTransactionResponse, err error)
 	GetTransactionsWithAutoDerive(ctx context.Context, assetAddr, accountAddr string) (txList []ConfirmedTransactionResponse, err error)
 	CreateAsset(ctx context.Context, feePayer, issuer, asset types.Account) (string, error)
@@ -67,6 +70,17 @@ type Interface interface {
 	Unstake(ctx context.Context, feePayer, userWallet types.Account, stakePool, asset common.PublicKey) (string, error)
 }
 
+// TransactionStatus is a state of transaction found by its signature
+type TransactionStatus string
+
+// Predefined transaction statuses
+const (
+	TransactionStatusNotFound  TransactionStatus = "not_found" // the cluster doesn't know the signature
+	TransactionStatusPending   TransactionStatus = "pending"   // transaction is processed, but not finalized yet
+	TransactionStatusSucceeded TransactionStatus = "succeeded" // transaction is finalized without error
+	TransactionStatusFailed    TransactionStatus = "failed"    // transaction is processed with error
+)
+
 type (
 	// GetConfirmedTransactionResponse ...
 	GetConfirmedTransactionResponse struct {
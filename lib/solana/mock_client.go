@@ -289,6 +289,22 @@ func (mr *MockInterfaceMockRecorder) GetTokenAccountBalance(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenAccountBalance", reflect.TypeOf((*MockInterface)(nil).GetTokenAccountBalance), arg0, arg1)
 }
 
+// GetTokenAccountAmount mocks base method.
+func (m *MockInterface) GetTokenAccountAmount(arg0 context.Context, arg1 string) (uint64, uint8, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenAccountAmount", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(uint8)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTokenAccountAmount indicates an expected call of GetTokenAccountAmount.
+func (mr *MockInterfaceMockRecorder) GetTokenAccountAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenAccountAmount", reflect.TypeOf((*MockInterface)(nil).GetTokenAccountAmount), arg0, arg1)
+}
+
 // GetTokenAccountBalanceWithAutoDerive mocks base method.
 func (m *MockInterface) GetTokenAccountBalanceWithAutoDerive(arg0 context.Context, arg1, arg2 string) (float64, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +320,21 @@ func (mr *MockInterfaceMockRecorder) GetTokenAccountBalanceWithAutoDerive(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenAccountBalanceWithAutoDerive", reflect.TypeOf((*MockInterface)(nil).GetTokenAccountBalanceWithAutoDerive), arg0, arg1, arg2)
 }
 
+// GetTransactionStatus mocks base method.
+func (m *MockInterface) GetTransactionStatus(arg0 context.Context, arg1 string) (TransactionStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionStatus", arg0, arg1)
+	ret0, _ := ret[0].(TransactionStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionStatus indicates an expected call of GetTransactionStatus.
+func (mr *MockInterfaceMockRecorder) GetTransactionStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionStatus", reflect.TypeOf((*MockInterface)(nil).GetTransactionStatus), arg0, arg1)
+}
+
 // GetTransactions mocks base method.
 func (m *MockInterface) GetTransactions(arg0 context.Context, arg1, arg2, arg3 string) ([]ConfirmedTransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -380,6 +411,21 @@ func (mr *MockInterfaceMockRecorder) InitializeStakePool(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeStakePool", reflect.TypeOf((*MockInterface)(nil).InitializeStakePool), arg0, arg1, arg2, arg3)
 }
 
+// IsBlockhashValid mocks base method.
+func (m *MockInterface) IsBlockhashValid(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlockhashValid", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlockhashValid indicates an expected call of IsBlockhashValid.
+func (mr *MockInterfaceMockRecorder) IsBlockhashValid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlockhashValid", reflect.TypeOf((*MockInterface)(nil).IsBlockhashValid), arg0, arg1)
+}
+
 // IsTransactionSuccessful mocks base method.
 func (m *MockInterface) IsTransactionSuccessful(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +172,50 @@ func (s *solanaMultiProvider) IsTransactionSuccessful(ctx context.Context, txhas
 	return false, ErrSolanaProvidersDontRespond
 }
 
+func (s *solanaMultiProvider) GetTransactionStatus(ctx context.Context, txhash string) (lib_solana.TransactionStatus, error) {
+	for _, p := range s.providers {
+		resp, err := p.GetTransactionStatus(ctx, txhash)
+		if err != nil {
+			s.m.registerError(ctx, p.Endpoint(), err.Error())
+		}
+		if err != nil && tryNextProvider(err) {
+			s.m.registerNotAvailableError(ctx, p.Endpoint())
+			continue
+		}
+
+		if err != nil {
+			s.m.registerOtherError(ctx, p.Endpoint())
+		} else {
+			s.m.registerSuccessCall(ctx, p.Endpoint())
+		}
+
+		return resp, err
+	}
+	return "", ErrSolanaProvidersDontRespond
+}
+
+func (s *solanaMultiProvider) IsBlockhashValid(ctx context.Context, blockhash string) (bool, error) {
+	for _, p := range s.providers {
+		resp, err := p.IsBlockhashValid(ctx, blockhash)
+		if err != nil {
+			s.m.registerError(ctx, p.Endpoint(), err.Error())
+		}
+		if err != nil && tryNextProvider(err) {
+			s.m.registerNotAvailableError(ctx, p.Endpoint())
+			continue
+		}
+
+		if err != nil {
+			s.m.registerOtherError(ctx, p.Endpoint())
+		} else {
+			s.m.registerSuccessCall(ctx, p.Endpoint())
+		}
+
+		return resp, err
+	}
+	return false, ErrSolanaProvidersDontRespond
+}
+
 func (s *solanaMultiProvider) NeedToRetry(ctx context.Context, latestValidBlockHeight int64) (bool, error) {
 	for _, p := range s.providers {
 		resp, err := p.NeedToRetry(ctx, latestValidBlockHeight)
@@ -360,6 +404,28 @@ func (s *solanaMultiProvider) GetTokenAccountBalance(ctx context.Context, accPub
 	return 0, ErrSolanaProvidersDontRespond
 }
 
+func (s *solanaMultiProvider) GetTokenAccountAmount(ctx context.Context, accPubKey string) (uint64, uint8, error) {
+	for _, p := range s.providers {
+		amount, decimals, err := p.GetTokenAccountAmount(ctx, accPubKey)
+		if err != nil && !errors.Is(err, lib_solana.ErrTokenAccountNotFound) {
+			s.m.registerError(ctx, p.Endpoint(), err.Error())
+		}
+		if err != nil && tryNextProvider(err) {
+			s.m.registerNotAvailableError(ctx, p.Endpoint())
+			continue
+		}
+
+		if err != nil && !errors.Is(err, lib_solana.ErrTokenAccountNotFound) {
+			s.m.registerOtherError(ctx, p.Endpoint())
+		} else {
+			s.m.registerSuccessCall(ctx, p.Endpoint())
+		}
+
+		return amount, decimals, err
+	}
+	return 0, 0, ErrSolanaProvidersDontRespond
+}
+
 func (s *solanaMultiProvider) GetTokenAccountBalanceWithAutoDerive(ctx context.Context, assetAddr, accountAddr string) (float64, error) {
 	for _, p := range s.providers {
 		resp, err := p.GetTokenAccountBalanceWithAutoDerive(ctx, assetAddr, accountAddr)
@@ -1,13 +1,17 @@
 package storage
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"path"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/pkg/errors"
 )
 
@@ -70,6 +74,32 @@ func (i *Interactor) Upload(file io.ReadSeeker, filepath string, acl ACL, conten
 	return nil
 }
 
+// UploadStream uploads file of unknown size from the reader to the cloud storage in parts,
+// so the whole file is never kept in memory.
+func (i *Interactor) UploadStream(r io.Reader, filepath string, acl ACL, contentType string) error {
+	api, ok := i.client.(s3iface.S3API)
+	if !ok {
+		// multipart upload is not supported by the client, e.g. mock
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return errors.Wrap(err, "storage.upload")
+		}
+		return i.Upload(bytes.NewReader(b), filepath, acl, contentType)
+	}
+
+	if _, err := s3manager.NewUploaderWithClient(api).Upload(&s3manager.UploadInput{
+		Bucket:      aws.String(i.bucket),
+		Key:         aws.String(filepath),
+		Body:        r,
+		ACL:         aws.String(acl.String()),
+		ContentType: aws.String(contentType),
+	}); err != nil {
+		return errors.Wrap(err, "storage.upload")
+	}
+
+	return nil
+}
+
 // Download file from the cloud storage
 func (i *Interactor) Download(filepath string) (io.ReadCloser, *string, error) {
 	input := &s3.GetObjectInput{
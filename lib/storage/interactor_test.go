@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -95,6 +96,25 @@ func TestInteractor_Upload(t *testing.T) {
 	}
 }
 
+func TestInteractor_UploadStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  Client
+		wantErr bool
+	}{
+		{"success", &S3Mock{}, false},
+		{"error", &S3Mock{Error: errors.New("upload")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &Interactor{client: tt.client, bucket: "test"}
+			if err := i.UploadStream(strings.NewReader("data"), "test.zip", Private, "application/zip"); (err != nil) != tt.wantErr {
+				t.Errorf("Interactor.UploadStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestInteractor_Download(t *testing.T) {
 	s3mock := &S3Mock{}
 	s3mockErr := &S3Mock{Error: errors.New("upload")}
@@ -0,0 +1,29 @@
+package totp
+
+import (
+	"context"
+	"net/http"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+type contextKey string
+
+var (
+	codeHeaderKey  string     = "X-2FA-Code"
+	codeContextKey contextKey = "TwoFactorCode"
+)
+
+// ToContext moves a step-up code from request header to context.
+// The code is either TOTP code or one of the recovery codes.
+func ToContext() httptransport.RequestFunc {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		return context.WithValue(ctx, codeContextKey, r.Header.Get(codeHeaderKey))
+	}
+}
+
+// CodeFromContext gets step-up code from context
+func CodeFromContext(ctx context.Context) string {
+	code, _ := ctx.Value(codeContextKey).(string)
+	return code
+}
@@ -0,0 +1,10 @@
+package totp
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrTwoFactorRequired = errors.New("two-factor authentication code is required")
+	ErrInvalidCode       = errors.New("invalid two-factor authentication code")
+	ErrTooManyAttempts   = errors.New("two-factor authentication is locked")
+)
@@ -0,0 +1,142 @@
+// Package totp implements time-based one-time passwords (RFC 6238),
+// used as the second authentication factor.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Default TOTP parameters, supported by all authenticator apps
+const (
+	Digits     = 6
+	Period     = 30 // seconds
+	Skew       = 1  // number of periods before and after the current one, which codes are accepted
+	secretSize = 20
+)
+
+// Actions which could require a step-up code
+const (
+	ActionLogin                = "login"
+	ActionConfirmTransfer      = "confirm_transfer"
+	ActionClaimRewards         = "claim_rewards"
+	ActionExportRecoveryPhrase = "export_recovery_phrase" // verified by the app before revealing the phrase
+	ActionChangeEmail          = "change_email"
+)
+
+// Actions is the list of actions which could require a step-up code
+var Actions = []string{
+	ActionLogin,
+	ActionConfirmTransfer,
+	ActionClaimRewards,
+	ActionExportRecoveryPhrase,
+	ActionChangeEmail,
+}
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// IsValidAction reports whether the action could require a step-up code
+func IsValidAction(action string) bool {
+	for _, a := range Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateSecret returns a new random secret encoded in base32
+func GenerateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("could not generate totp secret: %w", err)
+	}
+	return b32.EncodeToString(secret), nil
+}
+
+// ProvisioningURI returns otpauth uri of the secret,
+// the uri is encoded into QR code to enroll the secret in authenticator app.
+func ProvisioningURI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(Period))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// Step returns time step number of the given time
+func Step(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+// Code returns the code of the secret for the given time step
+func Code(secret string, step int64) (string, error) {
+	key, err := b32.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+	if err != nil {
+		return "", fmt.Errorf("could not decode totp secret: %w", err)
+	}
+	return hotp(key, uint64(step), Digits), nil
+}
+
+// Validate checks the code within the allowed skew around the given time,
+// returns the time step which the code belongs to.
+// Steps not after lastUsedStep are rejected to prevent the code replay.
+func Validate(secret, code string, t time.Time, lastUsedStep int64) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	current := Step(t)
+	for step := current - Skew; step <= current+Skew; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+		expected, err := Code(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// hotp returns HMAC-based one-time password (RFC 4226)
+func hotp(key []byte, counter uint64, digits int) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
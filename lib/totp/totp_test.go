@@ -0,0 +1,71 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test vectors of RFC 6238, SHA1 mode
+func TestHOTPRFC6238(t *testing.T) {
+	key := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+	for _, tt := range tests {
+		if got := hotp(key, uint64(tt.unix/Period), 8); got != tt.want {
+			t.Errorf("hotp(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	now := time.Unix(1234567890, 0)
+
+	code, err := Code(secret, Step(now))
+	if err != nil {
+		t.Fatalf("Code() error = %v", err)
+	}
+	if code != "005924" {
+		t.Fatalf("Code() = %s, want 005924", code)
+	}
+
+	step, ok := Validate(secret, code, now, 0)
+	if !ok || step != Step(now) {
+		t.Fatalf("Validate() = %d, %v, want %d, true", step, ok, Step(now))
+	}
+
+	// the code of the previous period is accepted within the skew
+	if _, ok := Validate(secret, code, now.Add(Period*time.Second), 0); !ok {
+		t.Errorf("code of the previous period is rejected")
+	}
+	if _, ok := Validate(secret, code, now.Add(2*Period*time.Second), 0); ok {
+		t.Errorf("expired code is accepted")
+	}
+
+	// the used code can't be replayed
+	if _, ok := Validate(secret, code, now, step); ok {
+		t.Errorf("used code is accepted")
+	}
+
+	if _, ok := Validate(secret, "000000", now, 0); ok {
+		t.Errorf("invalid code is accepted")
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("Sator", "user@sator.io", "SECRET")
+	if !strings.HasPrefix(uri, "otpauth://totp/Sator:user@sator.io?") || !strings.Contains(uri, "secret=SECRET") {
+		t.Errorf("ProvisioningURI() = %s", uri)
+	}
+}
@@ -0,0 +1,99 @@
+package account_deletion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+)
+
+type (
+	// Endpoints collection of account deletion service
+	Endpoints struct {
+		GetStatus  endpoint.Endpoint
+		Cancel     endpoint.Endpoint
+		GetRequest endpoint.Endpoint
+	}
+
+	service interface {
+		GetStatus(ctx context.Context, userID uuid.UUID) (Request, error)
+		Cancel(ctx context.Context, userID uuid.UUID) error
+		GetRequest(ctx context.Context, id uuid.UUID) (Request, error)
+	}
+)
+
+// MakeEndpoints ...
+func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
+	e := Endpoints{
+		GetStatus:  MakeGetStatusEndpoint(s),
+		Cancel:     MakeCancelEndpoint(s),
+		GetRequest: MakeGetRequestEndpoint(s),
+	}
+
+	// setup middlewares for each endpoints
+	if len(m) > 0 {
+		for _, mdw := range m {
+			e.GetStatus = mdw(e.GetStatus)
+			e.Cancel = mdw(e.Cancel)
+			e.GetRequest = mdw(e.GetRequest)
+		}
+	}
+
+	return e
+}
+
+// MakeGetStatusEndpoint ...
+func MakeGetStatusEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		return s.GetStatus(ctx, uid)
+	}
+}
+
+// MakeCancelEndpoint ...
+func MakeCancelEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		if err := s.Cancel(ctx, uid); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeGetRequestEndpoint ...
+func MakeGetRequestEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w request id: %v", ErrInvalidParameter, err)
+		}
+
+		return s.GetRequest(ctx, id)
+	}
+}
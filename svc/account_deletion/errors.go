@@ -0,0 +1,10 @@
+package account_deletion
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrNotFound         = errors.New("not found")
+	ErrCannotCancel     = errors.New("account deletion is not scheduled or already started")
+)
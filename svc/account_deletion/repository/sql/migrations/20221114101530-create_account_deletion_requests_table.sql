@@ -0,0 +1,28 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS account_deletion_requests (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'scheduled',
+    scheduled_for TIMESTAMP NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    completed_at TIMESTAMP DEFAULT NULL,
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX account_deletion_requests_active_user_id ON account_deletion_requests USING BTREE (user_id)
+    WHERE status IN ('scheduled', 'processing', 'retrying');
+CREATE INDEX account_deletion_requests_user_id_created_at ON account_deletion_requests USING BTREE (user_id, created_at);
+CREATE INDEX account_deletion_requests_status_scheduled_for ON account_deletion_requests USING BTREE (status, scheduled_for);
+CREATE TABLE IF NOT EXISTS account_deletion_reports (
+    request_id uuid NOT NULL,
+    service VARCHAR NOT NULL,
+    status VARCHAR NOT NULL,
+    error VARCHAR NOT NULL DEFAULT '',
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY(request_id, service),
+    FOREIGN KEY(request_id) REFERENCES account_deletion_requests(id) ON DELETE CASCADE
+);
+-- +migrate Down
+DROP TABLE IF EXISTS account_deletion_reports;
+DROP TABLE IF EXISTS account_deletion_requests;
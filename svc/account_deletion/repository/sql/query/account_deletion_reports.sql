@@ -0,0 +1,12 @@
+-- name: GetAccountDeletionReports :many
+SELECT *
+FROM account_deletion_reports
+WHERE request_id = @request_id
+ORDER BY created_at, service;
+-- name: UpsertAccountDeletionReport :exec
+INSERT INTO account_deletion_reports (request_id, service, status, error)
+VALUES (@request_id, @service, @status, @error)
+ON CONFLICT (request_id, service) DO UPDATE
+SET status = EXCLUDED.status,
+    error = EXCLUDED.error,
+    updated_at = now();
@@ -0,0 +1,51 @@
+-- name: ScheduleAccountDeletion :one
+INSERT INTO account_deletion_requests (user_id, scheduled_for)
+VALUES (@user_id, @scheduled_for) RETURNING *;
+-- name: GetAccountDeletionRequestByID :one
+SELECT *
+FROM account_deletion_requests
+WHERE id = @id;
+-- name: GetLastAccountDeletionRequestByUserID :one
+SELECT *
+FROM account_deletion_requests
+WHERE user_id = @user_id
+ORDER BY created_at DESC
+LIMIT 1;
+-- name: CancelAccountDeletion :execrows
+UPDATE account_deletion_requests
+SET status = 'cancelled',
+    updated_at = now()
+WHERE user_id = @user_id
+    AND status = 'scheduled'
+    AND scheduled_for > now();
+-- name: ClaimAccountDeletionRequest :one
+UPDATE account_deletion_requests
+SET status = 'processing',
+    attempts = attempts + 1,
+    updated_at = now()
+WHERE id = (
+    SELECT id
+    FROM account_deletion_requests
+    WHERE (status IN ('scheduled', 'retrying') AND scheduled_for <= now())
+        OR (status = 'processing' AND updated_at < @stale_before)
+    ORDER BY scheduled_for
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+) RETURNING *;
+-- name: CompleteAccountDeletionRequest :exec
+UPDATE account_deletion_requests
+SET status = 'completed',
+    completed_at = now(),
+    updated_at = now()
+WHERE id = @id;
+-- name: RetryAccountDeletionRequest :exec
+UPDATE account_deletion_requests
+SET status = 'retrying',
+    scheduled_for = @scheduled_for,
+    updated_at = now()
+WHERE id = @id;
+-- name: FailAccountDeletionRequest :exec
+UPDATE account_deletion_requests
+SET status = 'failed',
+    updated_at = now()
+WHERE id = @id;
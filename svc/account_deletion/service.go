@@ -0,0 +1,240 @@
+package account_deletion
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/account_deletion/repository"
+)
+
+// Account deletion request statuses
+const (
+	StatusScheduled  = "scheduled"
+	StatusCancelled  = "cancelled"
+	StatusProcessing = "processing"
+	StatusRetrying   = "retrying"
+	StatusCompleted  = "completed"
+	StatusFailed     = "failed"
+)
+
+// Default account deletion settings
+const (
+	defaultGracePeriod       = 14 * 24 * time.Hour
+	defaultMaxAttempts       = 5
+	defaultRetryDelay        = time.Hour
+	defaultProcessingTimeout = time.Hour
+)
+
+type (
+	// Service struct
+	Service struct {
+		repo    accountDeletionRepository
+		erasers []eraser
+		config  Config
+	}
+
+	// Config struct
+	Config struct {
+		GracePeriod       time.Duration // period during which the user can cancel the deletion
+		MaxAttempts       int           // deletion is marked as failed after this number of attempts
+		RetryDelay        time.Duration // delay before the first retry, it's doubled for each next one
+		ProcessingTimeout time.Duration // deletion is restarted if it is processed longer, e.g. the worker crashed
+	}
+
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
+	// EraseFunc deletes or anonymizes the user's personal data in a service.
+	// It must be idempotent, since it's called again if any service fails.
+	EraseFunc func(ctx context.Context, userID uuid.UUID) error
+
+	eraser struct {
+		service string
+		erase   EraseFunc
+	}
+
+	// Request struct
+	Request struct {
+		ID           uuid.UUID  `json:"id"`
+		UserID       uuid.UUID  `json:"user_id"`
+		Status       string     `json:"status"`
+		ScheduledFor time.Time  `json:"scheduled_for"`
+		Attempts     int32      `json:"attempts"`
+		CompletedAt  *time.Time `json:"completed_at,omitempty"`
+		RequestedAt  time.Time  `json:"requested_at"`
+		Reports      []Report   `json:"reports"`
+	}
+
+	// Report is the result of the user's data erasure in a service
+	Report struct {
+		Service   string    `json:"service"`
+		Status    string    `json:"status"`
+		Error     string    `json:"error,omitempty"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	accountDeletionRepository interface {
+		ScheduleAccountDeletion(ctx context.Context, arg repository.ScheduleAccountDeletionParams) (repository.AccountDeletionRequest, error)
+		GetAccountDeletionRequestByID(ctx context.Context, id uuid.UUID) (repository.AccountDeletionRequest, error)
+		GetLastAccountDeletionRequestByUserID(ctx context.Context, userID uuid.UUID) (repository.AccountDeletionRequest, error)
+		CancelAccountDeletion(ctx context.Context, userID uuid.UUID) (int64, error)
+		ClaimAccountDeletionRequest(ctx context.Context, staleBefore sql.NullTime) (repository.AccountDeletionRequest, error)
+		CompleteAccountDeletionRequest(ctx context.Context, id uuid.UUID) error
+		RetryAccountDeletionRequest(ctx context.Context, arg repository.RetryAccountDeletionRequestParams) error
+		FailAccountDeletionRequest(ctx context.Context, id uuid.UUID) error
+
+		GetAccountDeletionReports(ctx context.Context, requestID uuid.UUID) ([]repository.AccountDeletionReport, error)
+		UpsertAccountDeletionReport(ctx context.Context, arg repository.UpsertAccountDeletionReportParams) error
+	}
+)
+
+// NewService is a factory function,
+// returns a new instance of the Service interface implementation.
+func NewService(repo accountDeletionRepository, config Config, opt ...ServiceOption) *Service {
+	if repo == nil {
+		log.Fatalln("account deletion repository is not set")
+	}
+	if config.GracePeriod <= 0 {
+		config.GracePeriod = defaultGracePeriod
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.RetryDelay <= 0 {
+		config.RetryDelay = defaultRetryDelay
+	}
+	if config.ProcessingTimeout <= 0 {
+		config.ProcessingTimeout = defaultProcessingTimeout
+	}
+
+	s := &Service{
+		repo:   repo,
+		config: config,
+	}
+
+	for _, fn := range opt {
+		fn(s)
+	}
+
+	return s
+}
+
+// WithEraser adds the service which personal data must be erased on account deletion.
+// Erasers are called in the order they are added.
+func WithEraser(service string, fn EraseFunc) ServiceOption {
+	return func(s *Service) {
+		s.erasers = append(s.erasers, eraser{service: service, erase: fn})
+	}
+}
+
+// Schedule schedules deletion of the user's account after the grace period.
+// Returns the active request if the deletion is already scheduled or in progress.
+func (s *Service) Schedule(ctx context.Context, userID uuid.UUID) (Request, error) {
+	last, err := s.repo.GetLastAccountDeletionRequestByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return Request{}, fmt.Errorf("could not get last account deletion request: %w", err)
+	}
+	if err == nil && isActive(last.Status) {
+		return castToRequest(last, nil), nil
+	}
+
+	req, err := s.repo.ScheduleAccountDeletion(ctx, repository.ScheduleAccountDeletionParams{
+		UserID:       userID,
+		ScheduledFor: time.Now().Add(s.config.GracePeriod),
+	})
+	if err != nil {
+		return Request{}, fmt.Errorf("could not schedule account deletion: %w", err)
+	}
+
+	return castToRequest(req, nil), nil
+}
+
+// Cancel cancels the account deletion scheduled by the user.
+// It's possible only until the grace period is over.
+func (s *Service) Cancel(ctx context.Context, userID uuid.UUID) error {
+	n, err := s.repo.CancelAccountDeletion(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("could not cancel account deletion: %w", err)
+	}
+	if n == 0 {
+		return ErrCannotCancel
+	}
+
+	return nil
+}
+
+// GetStatus returns the last account deletion request of the user with per-service reports
+func (s *Service) GetStatus(ctx context.Context, userID uuid.UUID) (Request, error) {
+	req, err := s.repo.GetLastAccountDeletionRequestByUserID(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Request{}, ErrNotFound
+		}
+		return Request{}, fmt.Errorf("could not get account deletion request: %w", err)
+	}
+
+	return s.withReports(ctx, req)
+}
+
+// GetRequest returns account deletion request by id with per-service reports
+func (s *Service) GetRequest(ctx context.Context, id uuid.UUID) (Request, error) {
+	req, err := s.repo.GetAccountDeletionRequestByID(ctx, id)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Request{}, ErrNotFound
+		}
+		return Request{}, fmt.Errorf("could not get account deletion request: %w", err)
+	}
+
+	return s.withReports(ctx, req)
+}
+
+func (s *Service) withReports(ctx context.Context, req repository.AccountDeletionRequest) (Request, error) {
+	reports, err := s.repo.GetAccountDeletionReports(ctx, req.ID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return Request{}, fmt.Errorf("could not get account deletion reports: %w", err)
+	}
+
+	return castToRequest(req, reports), nil
+}
+
+func isActive(status string) bool {
+	return status == StatusScheduled || status == StatusProcessing || status == StatusRetrying
+}
+
+func castToRequest(source repository.AccountDeletionRequest, reports []repository.AccountDeletionReport) Request {
+	r := Request{
+		ID:           source.ID,
+		UserID:       source.UserID,
+		Status:       source.Status,
+		ScheduledFor: source.ScheduledFor,
+		Attempts:     source.Attempts,
+		RequestedAt:  source.CreatedAt,
+		Reports:      make([]Report, 0, len(reports)),
+	}
+	if source.CompletedAt.Valid {
+		r.CompletedAt = &source.CompletedAt.Time
+	}
+
+	for _, rep := range reports {
+		updatedAt := rep.CreatedAt
+		if rep.UpdatedAt.Valid {
+			updatedAt = rep.UpdatedAt.Time
+		}
+		r.Reports = append(r.Reports, Report{
+			Service:   rep.Service,
+			Status:    rep.Status,
+			Error:     rep.Error,
+			UpdatedAt: updatedAt,
+		})
+	}
+
+	return r
+}
@@ -0,0 +1,248 @@
+package account_deletion
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/svc/account_deletion/repository"
+)
+
+// fakeRepo keeps requests and reports in memory, the queries are emulated as in SQL
+type fakeRepo struct {
+	requests []repository.AccountDeletionRequest
+	reports  map[string]repository.AccountDeletionReport
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{reports: make(map[string]repository.AccountDeletionReport)}
+}
+
+func (r *fakeRepo) ScheduleAccountDeletion(_ context.Context, arg repository.ScheduleAccountDeletionParams) (repository.AccountDeletionRequest, error) {
+	req := repository.AccountDeletionRequest{
+		ID:           uuid.New(),
+		UserID:       arg.UserID,
+		Status:       StatusScheduled,
+		ScheduledFor: arg.ScheduledFor,
+		CreatedAt:    time.Now(),
+	}
+	r.requests = append(r.requests, req)
+	return req, nil
+}
+
+func (r *fakeRepo) GetAccountDeletionRequestByID(_ context.Context, id uuid.UUID) (repository.AccountDeletionRequest, error) {
+	for _, req := range r.requests {
+		if req.ID == id {
+			return req, nil
+		}
+	}
+	return repository.AccountDeletionRequest{}, sql.ErrNoRows
+}
+
+func (r *fakeRepo) GetLastAccountDeletionRequestByUserID(_ context.Context, userID uuid.UUID) (repository.AccountDeletionRequest, error) {
+	for i := len(r.requests) - 1; i >= 0; i-- {
+		if r.requests[i].UserID == userID {
+			return r.requests[i], nil
+		}
+	}
+	return repository.AccountDeletionRequest{}, sql.ErrNoRows
+}
+
+func (r *fakeRepo) CancelAccountDeletion(_ context.Context, userID uuid.UUID) (int64, error) {
+	var n int64
+	for i, req := range r.requests {
+		if req.UserID == userID && req.Status == StatusScheduled && req.ScheduledFor.After(time.Now()) {
+			r.requests[i].Status = StatusCancelled
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *fakeRepo) ClaimAccountDeletionRequest(context.Context, sql.NullTime) (repository.AccountDeletionRequest, error) {
+	return repository.AccountDeletionRequest{}, sql.ErrNoRows
+}
+
+func (r *fakeRepo) CompleteAccountDeletionRequest(_ context.Context, id uuid.UUID) error {
+	return r.setStatus(id, StatusCompleted)
+}
+
+func (r *fakeRepo) RetryAccountDeletionRequest(_ context.Context, arg repository.RetryAccountDeletionRequestParams) error {
+	return r.setStatus(arg.ID, StatusRetrying)
+}
+
+func (r *fakeRepo) FailAccountDeletionRequest(_ context.Context, id uuid.UUID) error {
+	return r.setStatus(id, StatusFailed)
+}
+
+func (r *fakeRepo) setStatus(id uuid.UUID, status string) error {
+	for i, req := range r.requests {
+		if req.ID == id {
+			r.requests[i].Status = status
+			return nil
+		}
+	}
+	return sql.ErrNoRows
+}
+
+func (r *fakeRepo) GetAccountDeletionReports(_ context.Context, requestID uuid.UUID) ([]repository.AccountDeletionReport, error) {
+	var list []repository.AccountDeletionReport
+	for _, rep := range r.reports {
+		if rep.RequestID == requestID {
+			list = append(list, rep)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeRepo) UpsertAccountDeletionReport(_ context.Context, arg repository.UpsertAccountDeletionReportParams) error {
+	r.reports[arg.Service] = repository.AccountDeletionReport{
+		RequestID: arg.RequestID,
+		Service:   arg.Service,
+		Status:    arg.Status,
+		Error:     arg.Error,
+		CreatedAt: time.Now(),
+	}
+	return nil
+}
+
+func TestSchedule(t *testing.T) {
+	ctx := context.Background()
+	uid := uuid.New()
+	repo := newFakeRepo()
+	s := NewService(repo, Config{})
+
+	first, err := s.Schedule(ctx, uid)
+	if err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+	second, err := s.Schedule(ctx, uid)
+	if err != nil {
+		t.Fatalf("second Schedule() error = %v", err)
+	}
+	if first.ID != second.ID || len(repo.requests) != 1 {
+		t.Errorf("requests = %d, want the active request to be returned", len(repo.requests))
+	}
+
+	// a new request is scheduled once the previous one is finished
+	repo.requests[0].Status = StatusCancelled
+	third, err := s.Schedule(ctx, uid)
+	if err != nil {
+		t.Fatalf("third Schedule() error = %v", err)
+	}
+	if third.ID == first.ID || len(repo.requests) != 2 {
+		t.Errorf("requests = %d, want a new request after cancellation", len(repo.requests))
+	}
+}
+
+func TestCancel(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		gracePassed bool
+		status      string
+		wantErr     error
+	}{
+		{name: "scheduled", status: StatusScheduled},
+		{name: "grace period is over", status: StatusScheduled, gracePassed: true, wantErr: ErrCannotCancel},
+		{name: "in progress", status: StatusProcessing, wantErr: ErrCannotCancel},
+		{name: "already cancelled", status: StatusCancelled, wantErr: ErrCannotCancel},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uid := uuid.New()
+			repo := newFakeRepo()
+			s := NewService(repo, Config{})
+
+			if _, err := s.Schedule(ctx, uid); err != nil {
+				t.Fatalf("Schedule() error = %v", err)
+			}
+			repo.requests[0].Status = tt.status
+			if tt.gracePassed {
+				repo.requests[0].ScheduledFor = time.Now().Add(-time.Minute)
+			}
+
+			if err := s.Cancel(ctx, uid); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Cancel() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProcessSkipsCompletedServices(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeRepo()
+
+	var called []string
+	eraser := func(service string) ServiceOption {
+		return WithEraser(service, func(context.Context, uuid.UUID) error {
+			called = append(called, service)
+			return nil
+		})
+	}
+	s := NewService(repo, Config{}, eraser("profile"), eraser("wallet"))
+
+	req, err := repo.ScheduleAccountDeletion(ctx, repository.ScheduleAccountDeletionParams{UserID: uuid.New()})
+	if err != nil {
+		t.Fatalf("ScheduleAccountDeletion() error = %v", err)
+	}
+	repo.reports["profile"] = repository.AccountDeletionReport{RequestID: req.ID, Service: "profile", Status: StatusCompleted}
+
+	if err := s.process(ctx, req); err != nil {
+		t.Fatalf("process() error = %v", err)
+	}
+	if len(called) != 1 || called[0] != "wallet" {
+		t.Errorf("called erasers = %v, want [wallet]", called)
+	}
+	if repo.requests[0].Status != StatusCompleted {
+		t.Errorf("status = %s, want %s", repo.requests[0].Status, StatusCompleted)
+	}
+}
+
+func TestProcessFailedService(t *testing.T) {
+	ctx := context.Background()
+	errErase := errors.New("erase failed")
+
+	tests := []struct {
+		name       string
+		attempts   int32
+		wantStatus string
+		wantErr    bool
+	}{
+		{name: "retried", attempts: 1, wantStatus: StatusRetrying},
+		{name: "failed after max attempts", attempts: 3, wantStatus: StatusFailed, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo()
+			s := NewService(repo, Config{MaxAttempts: 3},
+				WithEraser("profile", func(context.Context, uuid.UUID) error { return nil }),
+				WithEraser("wallet", func(context.Context, uuid.UUID) error { return errErase }),
+			)
+
+			req, err := repo.ScheduleAccountDeletion(ctx, repository.ScheduleAccountDeletionParams{UserID: uuid.New()})
+			if err != nil {
+				t.Fatalf("ScheduleAccountDeletion() error = %v", err)
+			}
+			req.Attempts = tt.attempts
+
+			if err := s.process(ctx, req); (err != nil) != tt.wantErr {
+				t.Fatalf("process() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if repo.requests[0].Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", repo.requests[0].Status, tt.wantStatus)
+			}
+			if rep := repo.reports["wallet"]; rep.Status != StatusFailed || rep.Error != errErase.Error() {
+				t.Errorf("wallet report = %+v, want failed with error", rep)
+			}
+			if rep := repo.reports["profile"]; rep.Status != StatusCompleted {
+				t.Errorf("profile report status = %s, want %s", rep.Status, StatusCompleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package account_deletion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	jwtkit "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+)
+
+type (
+	logger interface {
+		Log(keyvals ...interface{}) error
+	}
+)
+
+// MakeHTTPHandler ...
+func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
+	r := chi.NewRouter()
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
+		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
+		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+	}
+
+	r.Get("/", httptransport.NewServer(
+		e.GetStatus,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/cancel", httptransport.NewServer(
+		e.Cancel,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/{id}", httptransport.NewServer(
+		e.GetRequest,
+		decodeGetRequestRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	return r
+}
+
+func decodeEmptyRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodeGetRequestRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return chi.URLParam(r, "id"), nil
+}
+
+// returns http error code by error type
+func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrInvalidParameter) {
+		return http.StatusBadRequest, err.Error()
+	}
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+	if errors.Is(err, ErrCannotCancel) {
+		return http.StatusConflict, err.Error()
+	}
+	return httpencoder.CodeAndMessageFrom(err)
+}
@@ -0,0 +1,129 @@
+package account_deletion
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/account_deletion/repository"
+)
+
+// maxRetryDelay limits the exponential backoff between attempts
+const maxRetryDelay = 24 * time.Hour
+
+// Run processes account deletion requests which grace period is over
+// every interval until the context is canceled.
+func (s *Service) Run(ctx context.Context, interval time.Duration) error {
+	s.work(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.work(ctx)
+		}
+	}
+}
+
+func (s *Service) work(ctx context.Context) {
+	for ctx.Err() == nil {
+		req, err := s.repo.ClaimAccountDeletionRequest(ctx, sql.NullTime{
+			Time:  time.Now().Add(-s.config.ProcessingTimeout),
+			Valid: true,
+		})
+		if err != nil {
+			if !db.IsNotFoundError(err) {
+				log.Printf("account deletion: could not claim request: %v", err)
+			}
+			return
+		}
+
+		if err := s.process(ctx, req); err != nil {
+			log.Printf("account deletion: request %s: %v", req.ID, err)
+		}
+	}
+}
+
+// process erases the user's data in every service which is not completed yet
+// and stores per-service reports. If any service fails, the request is retried
+// with backoff until the max attempts number is reached.
+func (s *Service) process(ctx context.Context, req repository.AccountDeletionRequest) error {
+	reports, err := s.repo.GetAccountDeletionReports(ctx, req.ID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get account deletion reports: %w", err)
+	}
+	completed := make(map[string]bool, len(reports))
+	for _, r := range reports {
+		completed[r.Service] = r.Status == StatusCompleted
+	}
+
+	var failed []string
+	for _, e := range s.erasers {
+		if completed[e.service] {
+			continue
+		}
+
+		report := repository.UpsertAccountDeletionReportParams{
+			RequestID: req.ID,
+			Service:   e.service,
+			Status:    StatusCompleted,
+		}
+		if err := e.erase(ctx, req.UserID); err != nil {
+			log.Printf("account deletion: request %s: could not erase %s data: %v", req.ID, e.service, err)
+			report.Status = StatusFailed
+			report.Error = err.Error()
+			failed = append(failed, e.service)
+		}
+
+		if err := s.repo.UpsertAccountDeletionReport(ctx, report); err != nil {
+			return fmt.Errorf("could not store %s report: %w", e.service, err)
+		}
+	}
+
+	if len(failed) == 0 {
+		if err := s.repo.CompleteAccountDeletionRequest(ctx, req.ID); err != nil {
+			return fmt.Errorf("could not complete account deletion request: %w", err)
+		}
+		return nil
+	}
+
+	if int(req.Attempts) >= s.config.MaxAttempts {
+		if err := s.repo.FailAccountDeletionRequest(ctx, req.ID); err != nil {
+			return fmt.Errorf("could not mark account deletion request as failed: %w", err)
+		}
+		return fmt.Errorf("failed after %d attempts, services: %v", req.Attempts, failed)
+	}
+
+	if err := s.repo.RetryAccountDeletionRequest(ctx, repository.RetryAccountDeletionRequestParams{
+		ScheduledFor: time.Now().Add(retryDelay(s.config.RetryDelay, req.Attempts)),
+		ID:           req.ID,
+	}); err != nil {
+		return fmt.Errorf("could not schedule account deletion retry: %w", err)
+	}
+
+	return nil
+}
+
+// retryDelay returns delay before the next attempt,
+// it's doubled after each failed attempt up to maxRetryDelay.
+func retryDelay(base time.Duration, attempts int32) time.Duration {
+	delay := base
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,29 @@
+package account_deletion
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     time.Duration
+		attempts int32
+		want     time.Duration
+	}{
+		{name: "first attempt", base: time.Hour, attempts: 1, want: time.Hour},
+		{name: "second attempt", base: time.Hour, attempts: 2, want: 2 * time.Hour},
+		{name: "fourth attempt", base: time.Hour, attempts: 4, want: 8 * time.Hour},
+		{name: "capped", base: time.Hour, attempts: 10, want: maxRetryDelay},
+		{name: "base above cap", base: 48 * time.Hour, attempts: 1, want: maxRetryDelay},
+		{name: "no attempts", base: time.Minute, attempts: 0, want: time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryDelay(tt.base, tt.attempts); got != tt.want {
+				t.Errorf("retryDelay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -36,6 +36,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteAnnouncementByIDStmt, err = db.PrepareContext(ctx, deleteAnnouncementByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAnnouncementByID: %w", err)
 	}
+	if q.deleteReadAnnouncementsByUserIDStmt, err = db.PrepareContext(ctx, deleteReadAnnouncementsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteReadAnnouncementsByUserID: %w", err)
+	}
 	if q.getAnnouncementByIDStmt, err = db.PrepareContext(ctx, getAnnouncementByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAnnouncementByID: %w", err)
 	}
@@ -85,6 +88,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteAnnouncementByIDStmt: %w", cerr)
 		}
 	}
+	if q.deleteReadAnnouncementsByUserIDStmt != nil {
+		if cerr := q.deleteReadAnnouncementsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteReadAnnouncementsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getAnnouncementByIDStmt != nil {
 		if cerr := q.getAnnouncementByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAnnouncementByIDStmt: %w", cerr)
@@ -162,37 +170,39 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                           DBTX
-	tx                           *sql.Tx
-	cleanUpAnnouncementsStmt     *sql.Stmt
-	cleanUpReadAnnouncementsStmt *sql.Stmt
-	createAnnouncementStmt       *sql.Stmt
-	deleteAnnouncementByIDStmt   *sql.Stmt
-	getAnnouncementByIDStmt      *sql.Stmt
-	isNotReadStmt                *sql.Stmt
-	isReadStmt                   *sql.Stmt
-	listActiveAnnouncementsStmt  *sql.Stmt
-	listAnnouncementsStmt        *sql.Stmt
-	listUnreadAnnouncementsStmt  *sql.Stmt
-	markAsReadStmt               *sql.Stmt
-	updateAnnouncementByIDStmt   *sql.Stmt
+	db                                  DBTX
+	tx                                  *sql.Tx
+	cleanUpAnnouncementsStmt            *sql.Stmt
+	cleanUpReadAnnouncementsStmt        *sql.Stmt
+	createAnnouncementStmt              *sql.Stmt
+	deleteAnnouncementByIDStmt          *sql.Stmt
+	deleteReadAnnouncementsByUserIDStmt *sql.Stmt
+	getAnnouncementByIDStmt             *sql.Stmt
+	isNotReadStmt                       *sql.Stmt
+	isReadStmt                          *sql.Stmt
+	listActiveAnnouncementsStmt         *sql.Stmt
+	listAnnouncementsStmt               *sql.Stmt
+	listUnreadAnnouncementsStmt         *sql.Stmt
+	markAsReadStmt                      *sql.Stmt
+	updateAnnouncementByIDStmt          *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                           tx,
-		tx:                           tx,
-		cleanUpAnnouncementsStmt:     q.cleanUpAnnouncementsStmt,
-		cleanUpReadAnnouncementsStmt: q.cleanUpReadAnnouncementsStmt,
-		createAnnouncementStmt:       q.createAnnouncementStmt,
-		deleteAnnouncementByIDStmt:   q.deleteAnnouncementByIDStmt,
-		getAnnouncementByIDStmt:      q.getAnnouncementByIDStmt,
-		isNotReadStmt:                q.isNotReadStmt,
-		isReadStmt:                   q.isReadStmt,
-		listActiveAnnouncementsStmt:  q.listActiveAnnouncementsStmt,
-		listAnnouncementsStmt:        q.listAnnouncementsStmt,
-		listUnreadAnnouncementsStmt:  q.listUnreadAnnouncementsStmt,
-		markAsReadStmt:               q.markAsReadStmt,
-		updateAnnouncementByIDStmt:   q.updateAnnouncementByIDStmt,
+		db:                                  tx,
+		tx:                                  tx,
+		cleanUpAnnouncementsStmt:            q.cleanUpAnnouncementsStmt,
+		cleanUpReadAnnouncementsStmt:        q.cleanUpReadAnnouncementsStmt,
+		createAnnouncementStmt:              q.createAnnouncementStmt,
+		deleteAnnouncementByIDStmt:          q.deleteAnnouncementByIDStmt,
+		deleteReadAnnouncementsByUserIDStmt: q.deleteReadAnnouncementsByUserIDStmt,
+		getAnnouncementByIDStmt:             q.getAnnouncementByIDStmt,
+		isNotReadStmt:                       q.isNotReadStmt,
+		isReadStmt:                          q.isReadStmt,
+		listActiveAnnouncementsStmt:         q.listActiveAnnouncementsStmt,
+		listAnnouncementsStmt:               q.listAnnouncementsStmt,
+		listUnreadAnnouncementsStmt:         q.listUnreadAnnouncementsStmt,
+		markAsReadStmt:                      q.markAsReadStmt,
+		updateAnnouncementByIDStmt:          q.updateAnnouncementByIDStmt,
 	}
 }
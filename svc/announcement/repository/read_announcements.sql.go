@@ -11,6 +11,16 @@ import (
 	"github.com/google/uuid"
 )
 
+const deleteReadAnnouncementsByUserID = `-- name: DeleteReadAnnouncementsByUserID :exec
+DELETE FROM read_announcements
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteReadAnnouncementsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteReadAnnouncementsByUserIDStmt, deleteReadAnnouncementsByUserID, userID)
+	return err
+}
+
 const isNotRead = `-- name: IsNotRead :one
 SELECT (NOT EXISTS(
    SELECT announcement_id, user_id, updated_at, created_at FROM read_announcements
@@ -21,3 +21,8 @@ SELECT (NOT EXISTS(
    WHERE announcement_id = @announcement_id AND
          user_id = @user_id
 ))::BOOL;
+
+
+-- name: DeleteReadAnnouncementsByUserID :exec
+DELETE FROM read_announcements
+WHERE user_id = @user_id;
@@ -32,6 +32,7 @@ type (
 		MarkAsRead(ctx context.Context, arg announcement_repository.MarkAsReadParams) error
 		IsRead(ctx context.Context, arg announcement_repository.IsReadParams) (bool, error)
 		IsNotRead(ctx context.Context, arg announcement_repository.IsNotReadParams) (bool, error)
+		DeleteReadAnnouncementsByUserID(ctx context.Context, userID uuid.UUID) error
 	}
 
 	Empty struct{}
@@ -293,6 +294,15 @@ func (s *Service) MarkAllAsRead(ctx context.Context, userID uuid.UUID) error {
 	return nil
 }
 
+// EraseUserData forgets which announcements the user has read on account deletion
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	if err := s.ar.DeleteReadAnnouncementsByUserID(ctx, userID); err != nil {
+		return errors.Wrap(err, "can't delete read announcements")
+	}
+
+	return nil
+}
+
 func (s *Service) GetAnnouncementTypes(ctx context.Context) (*GetAnnouncementTypesResponse, error) {
 	return &GetAnnouncementTypesResponse{
 		Types: []string{"show", "episode", "link"},
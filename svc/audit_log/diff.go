@@ -0,0 +1,70 @@
+package audit_log
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+type (
+	// Change of the entity field value
+	Change struct {
+		Before interface{} `json:"before"`
+		After  interface{} `json:"after"`
+	}
+)
+
+// diff returns changed fields of the entity by its state before and after the action.
+// States are compared by their JSON representation, nil state means the entity doesn't exist,
+// e.g. it's created or deleted.
+func diff(before, after interface{}) (map[string]Change, error) {
+	b, err := toFields(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := toFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]Change)
+	for k, v := range b {
+		if av, ok := a[k]; !ok || !reflect.DeepEqual(v, av) {
+			result[k] = Change{Before: v, After: av}
+		}
+	}
+	for k, v := range a {
+		if _, ok := b[k]; !ok {
+			result[k] = Change{Before: nil, After: v}
+		}
+	}
+
+	return result, nil
+}
+
+// toFields returns fields of the JSON object.
+// Non-object values are represented as a single "value" field.
+func toFields(v interface{}) (map[string]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode entity state: %w", err)
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("could not decode entity state: %w", err)
+	}
+
+	switch d := decoded.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		return d, nil
+	default:
+		return map[string]interface{}{"value": d}, nil
+	}
+}
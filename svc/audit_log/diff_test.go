@@ -0,0 +1,86 @@
+package audit_log
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	type entity struct {
+		Name  string  `json:"name"`
+		Price float64 `json:"price"`
+	}
+
+	tests := []struct {
+		name   string
+		before interface{}
+		after  interface{}
+		want   map[string]Change
+	}{
+		{
+			name:  "created",
+			after: entity{Name: "pack", Price: 10},
+			want: map[string]Change{
+				"name":  {Before: nil, After: "pack"},
+				"price": {Before: nil, After: float64(10)},
+			},
+		},
+		{
+			name:   "updated",
+			before: entity{Name: "pack", Price: 10},
+			after:  entity{Name: "pack", Price: 15},
+			want: map[string]Change{
+				"price": {Before: float64(10), After: float64(15)},
+			},
+		},
+		{
+			name:   "deleted",
+			before: &entity{Name: "pack"},
+			want: map[string]Change{
+				"name":  {Before: "pack", After: nil},
+				"price": {Before: float64(0), After: nil},
+			},
+		},
+		{
+			name:   "nested values",
+			before: map[string]interface{}{"chances": map[string]float64{"common": 90, "rare": 10}},
+			after:  map[string]interface{}{"chances": map[string]float64{"common": 80, "rare": 20}},
+			want: map[string]Change{
+				"chances": {
+					Before: map[string]interface{}{"common": float64(90), "rare": float64(10)},
+					After:  map[string]interface{}{"common": float64(80), "rare": float64(20)},
+				},
+			},
+		},
+		{
+			name:   "scalar values",
+			before: "email@example.com",
+			want: map[string]Change{
+				"value": {Before: "email@example.com", After: nil},
+			},
+		},
+		{
+			name:   "nil pointer",
+			before: (*entity)(nil),
+			after:  (*entity)(nil),
+			want:   map[string]Change{},
+		},
+		{
+			name:   "not changed",
+			before: entity{Name: "pack", Price: 10},
+			after:  entity{Name: "pack", Price: 10},
+			want:   map[string]Change{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diff(tt.before, tt.after)
+			if err != nil {
+				t.Fatalf("diff() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
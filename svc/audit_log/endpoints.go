@@ -0,0 +1,113 @@
+package audit_log
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+	"github.com/SatorNetwork/sator-api/lib/validator"
+)
+
+type (
+	// Endpoints collection of audit log service
+	Endpoints struct {
+		GetAuditLog    endpoint.Endpoint
+		VerifyAuditLog endpoint.Endpoint
+	}
+
+	service interface {
+		GetEntries(ctx context.Context, f Filter, limit, offset int32) ([]Entry, error)
+		Verify(ctx context.Context) (VerifyResult, error)
+	}
+
+	// GetAuditLogRequest struct
+	GetAuditLogRequest struct {
+		ActorID    string `json:"actor_id,omitempty" validate:"omitempty,uuid"`
+		Action     string `json:"action,omitempty"`
+		EntityType string `json:"entity_type,omitempty"`
+		EntityID   string `json:"entity_id,omitempty"`
+		From       string `json:"from,omitempty"` // RFC3339
+		To         string `json:"to,omitempty"`   // RFC3339
+
+		utils.PaginationRequest
+	}
+)
+
+// MakeEndpoints ...
+func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
+	validateFunc := validator.ValidateStruct()
+
+	e := Endpoints{
+		GetAuditLog:    MakeGetAuditLogEndpoint(s, validateFunc),
+		VerifyAuditLog: MakeVerifyAuditLogEndpoint(s),
+	}
+
+	// setup middlewares for each endpoints
+	if len(m) > 0 {
+		for _, mdw := range m {
+			e.GetAuditLog = mdw(e.GetAuditLog)
+			e.VerifyAuditLog = mdw(e.VerifyAuditLog)
+		}
+	}
+
+	return e
+}
+
+// MakeGetAuditLogEndpoint ...
+func MakeGetAuditLogEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(GetAuditLogRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		f := Filter{
+			Action:     req.Action,
+			EntityType: req.EntityType,
+			EntityID:   req.EntityID,
+		}
+		if req.ActorID != "" {
+			actorID, err := uuid.Parse(req.ActorID)
+			if err != nil {
+				return nil, fmt.Errorf("%w actor id: %v", ErrInvalidParameter, err)
+			}
+			f.ActorID = actorID
+		}
+		if req.From != "" {
+			from, err := time.Parse(time.RFC3339, req.From)
+			if err != nil {
+				return nil, fmt.Errorf("%w from: %v", ErrInvalidParameter, err)
+			}
+			f.From = from
+		}
+		if req.To != "" {
+			to, err := time.Parse(time.RFC3339, req.To)
+			if err != nil {
+				return nil, fmt.Errorf("%w to: %v", ErrInvalidParameter, err)
+			}
+			f.To = to
+		}
+
+		return s.GetEntries(ctx, f, req.Limit(), req.Offset())
+	}
+}
+
+// MakeVerifyAuditLogEndpoint ...
+func MakeVerifyAuditLogEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		return s.Verify(ctx)
+	}
+}
@@ -0,0 +1,10 @@
+package audit_log
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrChainingDisabled = errors.New("audit log hash chaining is disabled")
+	ErrChainKeyRequired = errors.New("audit log is already chained, chain key is required")
+)
@@ -0,0 +1,39 @@
+package audit_log
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/audit_log/repository"
+)
+
+// entryHash returns HMAC-SHA256 of the entry fields and the hash of the previous entry.
+// Fields are length-prefixed, so values can't be shifted between fields.
+func entryHash(key []byte, e repository.AddAuditLogEntryParams) string {
+	actorID := ""
+	if e.ActorID.Valid {
+		actorID = e.ActorID.UUID.String()
+	}
+
+	mac := hmac.New(sha256.New, key)
+	for _, field := range []string{
+		e.PrevHash,
+		e.ID.String(),
+		actorID,
+		e.ActorRole,
+		e.Action,
+		e.EntityType,
+		e.EntityID,
+		e.Diff,
+		e.Ip,
+		e.RequestID,
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+	} {
+		fmt.Fprintf(mac, "%d:%s", len(field), field)
+	}
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,66 @@
+package audit_log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/svc/audit_log/repository"
+)
+
+func TestEntryHash(t *testing.T) {
+	key := []byte("secret")
+	entry := repository.AddAuditLogEntryParams{
+		ID:         uuid.MustParse("8b5b0b7e-2d3c-4a36-9f5e-1c2b8e0b6a01"),
+		ActorID:    uuid.NullUUID{UUID: uuid.MustParse("0f4e9c55-7d1a-4f0b-8f43-6a2d5b9c3e12"), Valid: true},
+		ActorRole:  "admin",
+		Action:     "nft_pack.update",
+		EntityType: "nft_pack",
+		EntityID:   "42",
+		Diff:       `{"price":{"before":10,"after":15}}`,
+		Ip:         "127.0.0.1",
+		RequestID:  "host/abc-000001",
+		PrevHash:   "prev",
+		CreatedAt:  time.Date(2022, 11, 17, 10, 0, 0, 123000, time.UTC),
+	}
+	hash := entryHash(key, entry)
+
+	if len(hash) != 64 {
+		t.Fatalf("entryHash() length = %d, want 64", len(hash))
+	}
+
+	sameTime := entry
+	sameTime.CreatedAt = entry.CreatedAt.In(time.FixedZone("", 3600))
+	if got := entryHash(key, sameTime); got != hash {
+		t.Errorf("entryHash() depends on time zone: %s != %s", got, hash)
+	}
+
+	withHash := entry
+	withHash.Hash = "ignored"
+	if got := entryHash(key, withHash); got != hash {
+		t.Errorf("entryHash() depends on the entry hash: %s != %s", got, hash)
+	}
+
+	tampered := []func(e *repository.AddAuditLogEntryParams){
+		func(e *repository.AddAuditLogEntryParams) { e.PrevHash = "" },
+		func(e *repository.AddAuditLogEntryParams) { e.ActorID = uuid.NullUUID{} },
+		func(e *repository.AddAuditLogEntryParams) { e.Action = "nft_pack.delete" },
+		func(e *repository.AddAuditLogEntryParams) { e.Diff = `{"price":{"before":10,"after":1}}` },
+		func(e *repository.AddAuditLogEntryParams) { e.Ip = "10.0.0.1" },
+		func(e *repository.AddAuditLogEntryParams) { e.CreatedAt = e.CreatedAt.Add(time.Microsecond) },
+		// shifting value between fields must change the hash
+		func(e *repository.AddAuditLogEntryParams) { e.EntityType, e.EntityID = "nft_pack4", "2" },
+	}
+	for i, fn := range tampered {
+		e := entry
+		fn(&e)
+		if got := entryHash(key, e); got == hash {
+			t.Errorf("tampered entry #%d has the same hash", i)
+		}
+	}
+
+	if got := entryHash([]byte("another"), entry); got == hash {
+		t.Errorf("entryHash() doesn't depend on the key")
+	}
+}
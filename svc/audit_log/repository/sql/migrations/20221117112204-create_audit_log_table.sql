@@ -0,0 +1,37 @@
+-- +migrate Up
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION audit_log_prevent_changes() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit log is append-only';
+END;
+$$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+CREATE TABLE IF NOT EXISTS audit_log (
+    id uuid PRIMARY KEY,
+    seq BIGSERIAL NOT NULL,
+    actor_id uuid DEFAULT NULL,
+    actor_role VARCHAR NOT NULL DEFAULT '',
+    action VARCHAR NOT NULL,
+    entity_type VARCHAR NOT NULL,
+    entity_id VARCHAR NOT NULL DEFAULT '',
+    diff TEXT NOT NULL DEFAULT '{}',
+    ip VARCHAR NOT NULL DEFAULT '',
+    request_id VARCHAR NOT NULL DEFAULT '',
+    prev_hash VARCHAR NOT NULL DEFAULT '',
+    hash VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL
+);
+CREATE UNIQUE INDEX audit_log_seq ON audit_log USING BTREE (seq);
+CREATE INDEX audit_log_actor_id ON audit_log USING BTREE (actor_id, seq);
+CREATE INDEX audit_log_entity ON audit_log USING BTREE (entity_type, entity_id, seq);
+CREATE INDEX audit_log_action ON audit_log USING BTREE (action, seq);
+CREATE INDEX audit_log_created_at ON audit_log USING BTREE (created_at);
+CREATE TRIGGER audit_log_prevent_update_delete BEFORE
+UPDATE OR DELETE ON audit_log FOR EACH ROW EXECUTE PROCEDURE audit_log_prevent_changes();
+CREATE TRIGGER audit_log_prevent_truncate BEFORE
+TRUNCATE ON audit_log FOR EACH STATEMENT EXECUTE PROCEDURE audit_log_prevent_changes();
+-- +migrate Down
+DROP TRIGGER IF EXISTS audit_log_prevent_truncate ON audit_log;
+DROP TRIGGER IF EXISTS audit_log_prevent_update_delete ON audit_log;
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_prevent_changes();
@@ -0,0 +1,53 @@
+-- name: AddAuditLogEntry :exec
+INSERT INTO audit_log (
+    id,
+    actor_id,
+    actor_role,
+    action,
+    entity_type,
+    entity_id,
+    diff,
+    ip,
+    request_id,
+    prev_hash,
+    hash,
+    created_at
+) VALUES (
+    @id,
+    @actor_id,
+    @actor_role,
+    @action,
+    @entity_type,
+    @entity_id,
+    @diff,
+    @ip,
+    @request_id,
+    @prev_hash,
+    @hash,
+    @created_at
+);
+-- name: LockAuditLog :exec
+LOCK TABLE audit_log IN SHARE ROW EXCLUSIVE MODE;
+-- name: GetLastAuditLogHash :one
+SELECT hash
+FROM audit_log
+WHERE hash <> ''
+ORDER BY seq DESC
+LIMIT 1;
+-- name: GetAuditLog :many
+SELECT *
+FROM audit_log
+WHERE (sqlc.narg('actor_id')::uuid IS NULL OR actor_id = sqlc.narg('actor_id'))
+    AND (@action::VARCHAR = '' OR action = @action)
+    AND (@entity_type::VARCHAR = '' OR entity_type = @entity_type)
+    AND (@entity_id::VARCHAR = '' OR entity_id = @entity_id)
+    AND (sqlc.narg('created_from')::TIMESTAMP IS NULL OR created_at >= sqlc.narg('created_from'))
+    AND (sqlc.narg('created_to')::TIMESTAMP IS NULL OR created_at < sqlc.narg('created_to'))
+ORDER BY seq DESC
+LIMIT @limit_val OFFSET @offset_val;
+-- name: GetAuditLogAfterSeq :many
+SELECT *
+FROM audit_log
+WHERE seq > @seq
+ORDER BY seq
+LIMIT @limit_val;
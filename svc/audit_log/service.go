@@ -0,0 +1,292 @@
+package audit_log
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/svc/audit_log/repository"
+)
+
+// Number of entries loaded at once during the chain verification
+const verifyBatchSize = 500
+
+type (
+	// Service struct
+	Service struct {
+		db       *sql.DB
+		repo     auditLogRepository
+		chainKey []byte
+	}
+
+	// Config struct
+	Config struct {
+		ChainKey string // entries are chained with HMAC-SHA256 hashes if set
+	}
+
+	// Entry struct
+	Entry struct {
+		ID         uuid.UUID         `json:"id"`
+		Seq        int64             `json:"seq"`
+		ActorID    *uuid.UUID        `json:"actor_id,omitempty"`
+		ActorRole  string            `json:"actor_role"`
+		Action     string            `json:"action"`
+		EntityType string            `json:"entity_type"`
+		EntityID   string            `json:"entity_id,omitempty"`
+		Diff       map[string]Change `json:"diff"`
+		IP         string            `json:"ip,omitempty"`
+		RequestID  string            `json:"request_id,omitempty"`
+		PrevHash   string            `json:"prev_hash,omitempty"`
+		Hash       string            `json:"hash,omitempty"`
+		CreatedAt  time.Time         `json:"created_at"`
+	}
+
+	// Filter of the audit log entries, empty fields are ignored
+	Filter struct {
+		ActorID    uuid.UUID
+		Action     string
+		EntityType string
+		EntityID   string
+		From       time.Time
+		To         time.Time
+	}
+
+	// VerifyResult is the result of the hash chain verification
+	VerifyResult struct {
+		Valid       bool   `json:"valid"`
+		Checked     int    `json:"checked"`   // number of chained entries
+		Unchained   int    `json:"unchained"` // entries stored before chaining was enabled
+		LastSeq     int64  `json:"last_seq"`  // the last valid chained entry, to compare with an external checkpoint
+		LastHash    string `json:"last_hash,omitempty"`
+		BrokenEntry *Entry `json:"broken_entry,omitempty"`
+	}
+
+	auditLogRepository interface {
+		AddAuditLogEntry(ctx context.Context, arg repository.AddAuditLogEntryParams) error
+		LockAuditLog(ctx context.Context) error
+		GetLastAuditLogHash(ctx context.Context) (string, error)
+		GetAuditLog(ctx context.Context, arg repository.GetAuditLogParams) ([]repository.AuditLog, error)
+		GetAuditLogAfterSeq(ctx context.Context, arg repository.GetAuditLogAfterSeqParams) ([]repository.AuditLog, error)
+
+		WithTx(tx *sql.Tx) *repository.Queries
+	}
+)
+
+// NewService is a factory function,
+// returns a new instance of the Service interface implementation.
+func NewService(dbConn *sql.DB, repo auditLogRepository, config Config) *Service {
+	if dbConn == nil {
+		log.Fatalln("db connection is not set")
+	}
+	if repo == nil {
+		log.Fatalln("audit log repository is not set")
+	}
+
+	s := &Service{db: dbConn, repo: repo}
+	if config.ChainKey != "" {
+		s.chainKey = []byte(config.ChainKey)
+	}
+
+	return s
+}
+
+// Record stores the action of the current user with the entity in the audit log.
+// The actor, client ip and request id are taken from the context.
+// The error is returned to the privileged endpoint, so an unaudited action isn't reported as done.
+func (s *Service) Record(ctx context.Context, action, entityType, entityID string, before, after interface{}) error {
+	if err := s.record(ctx, action, entityType, entityID, before, after); err != nil {
+		log.Printf("audit log: could not record %s of %s %s: %v", action, entityType, entityID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) record(ctx context.Context, action, entityType, entityID string, before, after interface{}) error {
+	changes, err := diff(before, after)
+	if err != nil {
+		return err
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("could not encode diff: %w", err)
+	}
+
+	entry := repository.AddAuditLogEntryParams{
+		ID:         uuid.New(),
+		ActorRole:  rbac.GetRoleFromContext(ctx).String(),
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Diff:       string(changesJSON),
+		Ip:         clientIPFromContext(ctx),
+		RequestID:  middleware.GetReqID(ctx),
+		CreatedAt:  time.Now().UTC().Truncate(time.Microsecond),
+	}
+	if uid, err := jwt.UserIDFromContext(ctx); err == nil {
+		entry.ActorID = uuid.NullUUID{UUID: uid, Valid: true}
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	// Lock prevents concurrent entries from referencing the same previous hash
+	if err := repo.LockAuditLog(ctx); err != nil {
+		return fmt.Errorf("could not lock audit log: %w", err)
+	}
+	prevHash, err := repo.GetLastAuditLogHash(ctx)
+	if err != nil && !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get last audit log hash: %w", err)
+	}
+
+	// unchained entries are allowed only before the first chained one,
+	// otherwise a missed chain key would break the chain forever
+	if s.chainKey == nil {
+		if prevHash != "" {
+			return ErrChainKeyRequired
+		}
+	} else {
+		entry.PrevHash = prevHash
+		entry.Hash = entryHash(s.chainKey, entry)
+	}
+
+	if err := repo.AddAuditLogEntry(ctx, entry); err != nil {
+		return fmt.Errorf("could not add audit log entry: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// GetEntries returns the audit log entries matching the filter, latest first
+func (s *Service) GetEntries(ctx context.Context, f Filter, limit, offset int32) ([]Entry, error) {
+	arg := repository.GetAuditLogParams{
+		Action:     f.Action,
+		EntityType: f.EntityType,
+		EntityID:   f.EntityID,
+		LimitVal:   limit,
+		OffsetVal:  offset,
+	}
+	if f.ActorID != uuid.Nil {
+		arg.ActorID = uuid.NullUUID{UUID: f.ActorID, Valid: true}
+	}
+	if !f.From.IsZero() {
+		arg.CreatedFrom = sql.NullTime{Time: f.From.UTC(), Valid: true}
+	}
+	if !f.To.IsZero() {
+		arg.CreatedTo = sql.NullTime{Time: f.To.UTC(), Valid: true}
+	}
+
+	list, err := s.repo.GetAuditLog(ctx, arg)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get audit log: %w", err)
+	}
+
+	result := make([]Entry, 0, len(list))
+	for _, e := range list {
+		result = append(result, castToEntry(e))
+	}
+
+	return result, nil
+}
+
+// Verify walks through the whole audit log and checks the hash chain.
+// Modified, deleted or injected entries break the chain at the first affected entry.
+func (s *Service) Verify(ctx context.Context) (VerifyResult, error) {
+	if s.chainKey == nil {
+		return VerifyResult{}, ErrChainingDisabled
+	}
+
+	result := VerifyResult{Valid: true}
+	var lastSeq int64
+	var prevHash string
+
+	for {
+		list, err := s.repo.GetAuditLogAfterSeq(ctx, repository.GetAuditLogAfterSeqParams{
+			Seq:      lastSeq,
+			LimitVal: verifyBatchSize,
+		})
+		if err != nil && !db.IsNotFoundError(err) {
+			return VerifyResult{}, fmt.Errorf("could not get audit log: %w", err)
+		}
+		if len(list) == 0 {
+			return result, nil
+		}
+
+		for _, e := range list {
+			lastSeq = e.Seq
+			// entries without hashes are expected only before the first chained one,
+			// later they break the chain, otherwise chained entries could be replaced with unchained ones
+			if e.Hash == "" && e.PrevHash == "" && prevHash == "" {
+				result.Unchained++
+				continue
+			}
+
+			if e.PrevHash != prevHash || e.Hash != entryHash(s.chainKey, castToEntryParams(e)) {
+				broken := castToEntry(e)
+				result.Valid = false
+				result.BrokenEntry = &broken
+				return result, nil
+			}
+
+			prevHash = e.Hash
+			result.Checked++
+			result.LastSeq = e.Seq
+			result.LastHash = e.Hash
+		}
+	}
+}
+
+func castToEntry(source repository.AuditLog) Entry {
+	e := Entry{
+		ID:         source.ID,
+		Seq:        source.Seq,
+		ActorRole:  source.ActorRole,
+		Action:     source.Action,
+		EntityType: source.EntityType,
+		EntityID:   source.EntityID,
+		IP:         source.Ip,
+		RequestID:  source.RequestID,
+		PrevHash:   source.PrevHash,
+		Hash:       source.Hash,
+		CreatedAt:  source.CreatedAt,
+	}
+	if source.ActorID.Valid {
+		e.ActorID = &source.ActorID.UUID
+	}
+	if err := json.Unmarshal([]byte(source.Diff), &e.Diff); err != nil {
+		log.Printf("audit log: could not decode diff of entry %s: %v", source.ID, err)
+	}
+
+	return e
+}
+
+func castToEntryParams(source repository.AuditLog) repository.AddAuditLogEntryParams {
+	return repository.AddAuditLogEntryParams{
+		ID:         source.ID,
+		ActorID:    source.ActorID,
+		ActorRole:  source.ActorRole,
+		Action:     source.Action,
+		EntityType: source.EntityType,
+		EntityID:   source.EntityID,
+		Diff:       source.Diff,
+		Ip:         source.Ip,
+		RequestID:  source.RequestID,
+		PrevHash:   source.PrevHash,
+		Hash:       source.Hash,
+		CreatedAt:  source.CreatedAt,
+	}
+}
@@ -0,0 +1,93 @@
+package audit_log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/svc/audit_log/repository"
+)
+
+type auditLogRepoMock struct {
+	auditLogRepository
+	entries []repository.AuditLog
+}
+
+func (r *auditLogRepoMock) GetAuditLogAfterSeq(ctx context.Context, arg repository.GetAuditLogAfterSeqParams) ([]repository.AuditLog, error) {
+	var result []repository.AuditLog
+	for _, e := range r.entries {
+		if e.Seq > arg.Seq && int32(len(result)) < arg.LimitVal {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func TestVerify(t *testing.T) {
+	key := []byte("secret")
+
+	unchained := func(seq int64) repository.AuditLog {
+		return repository.AuditLog{ID: uuid.New(), Seq: seq, Diff: "{}"}
+	}
+	chained := func(seq int64, prevHash string) repository.AuditLog {
+		e := unchained(seq)
+		e.PrevHash = prevHash
+		e.Hash = entryHash(key, castToEntryParams(e))
+		return e
+	}
+
+	first := chained(3, "")
+	second := chained(4, first.Hash)
+	replaced := unchained(4)
+
+	tests := []struct {
+		name      string
+		entries   []repository.AuditLog
+		valid     bool
+		checked   int
+		unchained int
+		lastSeq   int64
+		lastHash  string
+		brokenSeq int64
+	}{
+		{
+			name:      "entries before chaining",
+			entries:   []repository.AuditLog{unchained(1), unchained(2), first, second},
+			valid:     true,
+			checked:   2,
+			unchained: 2,
+			lastSeq:   second.Seq,
+			lastHash:  second.Hash,
+		},
+		{
+			name:      "unchained entry after chaining started",
+			entries:   []repository.AuditLog{unchained(1), unchained(2), first, replaced},
+			valid:     false,
+			checked:   1,
+			unchained: 2,
+			lastSeq:   first.Seq,
+			lastHash:  first.Hash,
+			brokenSeq: replaced.Seq,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{repo: &auditLogRepoMock{entries: tt.entries}, chainKey: key}
+			got, err := s.Verify(context.Background())
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if got.Valid != tt.valid || got.Checked != tt.checked || got.Unchained != tt.unchained {
+				t.Errorf("Verify() = %+v, want valid=%v checked=%d unchained=%d", got, tt.valid, tt.checked, tt.unchained)
+			}
+			if got.LastSeq != tt.lastSeq || got.LastHash != tt.lastHash {
+				t.Errorf("Verify() last = %d %s, want %d %s", got.LastSeq, got.LastHash, tt.lastSeq, tt.lastHash)
+			}
+			if tt.brokenSeq != 0 && (got.BrokenEntry == nil || got.BrokenEntry.Seq != tt.brokenSeq) {
+				t.Errorf("Verify() broken entry = %+v, want seq %d", got.BrokenEntry, tt.brokenSeq)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package audit_log
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	jwtkit "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+)
+
+type (
+	logger interface {
+		Log(keyvals ...interface{}) error
+	}
+
+	contextKey string
+)
+
+const clientIPContextKey contextKey = "AuditLogClientIP"
+
+// MakeHTTPHandler ...
+func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
+	r := chi.NewRouter()
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
+		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
+		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+	}
+
+	r.Get("/", httptransport.NewServer(
+		e.GetAuditLog,
+		decodeGetAuditLogRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/verify", httptransport.NewServer(
+		e.VerifyAuditLog,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	return r
+}
+
+// ClientIPToContext is a router middleware, which moves client ip from request to context,
+// so privileged actions can be recorded with it by any service.
+// Remote address must be set to the real client ip before, e.g. by middleware.RealIP.
+func ClientIPToContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := r.RemoteAddr
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			ip = host
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPContextKey, ip)))
+	})
+}
+
+func clientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPContextKey).(string)
+	return ip
+}
+
+func decodeEmptyRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodeGetAuditLogRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	return GetAuditLogRequest{
+		ActorID:    q.Get("actor_id"),
+		Action:     q.Get("action"),
+		EntityType: q.Get("entity_type"),
+		EntityID:   q.Get("entity_id"),
+		From:       q.Get("from"),
+		To:         q.Get("to"),
+		PaginationRequest: utils.PaginationRequest{
+			Page:         utils.StrToInt32(q.Get(utils.PageParam)),
+			ItemsPerPage: utils.StrToInt32(q.Get(utils.ItemsPerPageParam)),
+		},
+	}, nil
+}
+
+// returns http error code by error type
+func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrInvalidParameter) {
+		return http.StatusBadRequest, err.Error()
+	}
+	if errors.Is(err, ErrChainingDisabled) {
+		return http.StatusPreconditionFailed, err.Error()
+	}
+
+	return httpencoder.CodeAndMessageFrom(err)
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// EraseUserData anonymizes the account of the user when the account deletion is processed:
+// email and username are replaced with the email hash, sessions are revoked and
+// identity providers, magic links, verification codes, device links and TOTP are removed.
+// It's safe to call it several times for the same user.
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	if err := s.ur.DestroyUser(ctx, userID); err != nil {
+		return fmt.Errorf("could not anonymize user: %w", err)
+	}
+
+	if err := s.revokeSessions(ctx, userID, uuid.Nil); err != nil {
+		return fmt.Errorf("could not revoke sessions: %w", err)
+	}
+	if err := s.ur.AnonymizeUserSessions(ctx, userID); err != nil {
+		return fmt.Errorf("could not anonymize sessions: %w", err)
+	}
+
+	if err := s.ur.DeleteUserIdentitiesByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not unlink identity providers: %w", err)
+	}
+	if err := s.ur.DeleteUserMagicLinksByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete magic links: %w", err)
+	}
+	if err := s.ur.DeleteAllUserVerificationsByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete verification codes: %w", err)
+	}
+	if err := s.ur.DeleteUserDevicesByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete device links: %w", err)
+	}
+
+	if s.tf != nil {
+		if err := s.tf.Remove(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
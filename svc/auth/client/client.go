@@ -15,7 +15,11 @@ type (
 
 	service interface {
 		GetUsernameByID(ctx context.Context, uid uuid.UUID) (string, error)
+		GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error)
 		GetPublicKey(ctx context.Context, userID uuid.UUID) (*rsa.PublicKey, error)
+		AreUsersLinked(ctx context.Context, userID, otherUserID uuid.UUID) (bool, error)
+		HasMultipleAccounts(ctx context.Context, userID uuid.UUID) (bool, error)
+		IsKYCVerified(ctx context.Context, userID uuid.UUID) (bool, error)
 	}
 )
 
@@ -29,6 +33,26 @@ func (c *Client) GetUsernameByID(ctx context.Context, id uuid.UUID) (string, err
 	return c.s.GetUsernameByID(ctx, id)
 }
 
+// GetUserEmail returns email of the user, empty for accounts signed up with wallet
+func (c *Client) GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error) {
+	return c.s.GetUserEmail(ctx, userID)
+}
+
 func (c *Client) GetPublicKey(ctx context.Context, userID uuid.UUID) (*rsa.PublicKey, error) {
 	return c.s.GetPublicKey(ctx, userID)
 }
+
+// AreUsersLinked reports whether both accounts seem to belong to the same person
+func (c *Client) AreUsersLinked(ctx context.Context, userID, otherUserID uuid.UUID) (bool, error) {
+	return c.s.AreUsersLinked(ctx, userID, otherUserID)
+}
+
+// HasMultipleAccounts reports whether the user shares a device with another account
+func (c *Client) HasMultipleAccounts(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return c.s.HasMultipleAccounts(ctx, userID)
+}
+
+// IsKYCVerified reports whether the user has passed identity verification
+func (c *Client) IsKYCVerified(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return c.s.IsKYCVerified(ctx, userID)
+}
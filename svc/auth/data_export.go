@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+)
+
+// AccountData is the account data exported on the user's request.
+// Secrets like password hash, public key and two-factor secrets are never exported.
+type AccountData struct {
+	ID         uuid.UUID         `json:"id"`
+	Username   string            `json:"username"`
+	Email      string            `json:"email,omitempty"`
+	Role       string            `json:"role"`
+	KYCStatus  string            `json:"kyc_status,omitempty"`
+	VerifiedAt *time.Time        `json:"verified_at,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	Identities []Identity        `json:"identities"`
+	Sessions   []Session         `json:"sessions"`
+	TwoFactor  TwoFactorSettings `json:"two_factor"`
+}
+
+// ExportUserData returns the account data of the user to be included into the data export archive
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	u, err := s.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+
+	data := AccountData{
+		ID:        u.ID,
+		Username:  u.Username,
+		Email:     accountEmail(u.Email),
+		Role:      u.Role,
+		KYCStatus: u.KycStatus.String,
+		CreatedAt: u.CreatedAt,
+	}
+	if u.VerifiedAt.Valid {
+		data.VerifiedAt = &u.VerifiedAt.Time
+	}
+
+	if data.Identities, err = s.GetIdentities(ctx, userID); err != nil {
+		return nil, err
+	}
+	if data.Sessions, err = s.GetSessions(ctx, userID, uuid.Nil); err != nil {
+		return nil, err
+	}
+	if data.TwoFactor, err = s.GetTwoFactorSettings(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// GetUserEmail returns email of the user,
+// the email is empty if the account was signed up with wallet and has no email.
+func (s *Service) GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error) {
+	u, err := s.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return "", fmt.Errorf("user %w", ErrNotFound)
+		}
+		return "", fmt.Errorf("could not get user by id: %v: %w", userID, err)
+	}
+
+	return accountEmail(u.Email), nil
+}
+
+// accountEmail returns the email unless it's a placeholder of the account signed up with wallet
+func accountEmail(email string) string {
+	if strings.HasSuffix(email, "@"+walletAccountEmailDomain) {
+		return ""
+	}
+	return email
+}
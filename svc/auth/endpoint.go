@@ -57,27 +57,54 @@ type (
 		GetAccessTokenByUserID endpoint.Endpoint
 
 		GetUserStatus        endpoint.Endpoint
+		UnlockAccount        endpoint.Endpoint
 		VerificationCallback endpoint.Endpoint
 
 		RegisterPublicKey endpoint.Endpoint
+
+		GetSessions       endpoint.Endpoint
+		RevokeSession     endpoint.Endpoint
+		RevokeAllSessions endpoint.Endpoint
+
+		GetTwoFactorSettings    endpoint.Endpoint
+		EnrollTOTP              endpoint.Endpoint
+		EnableTOTP              endpoint.Endpoint
+		DisableTOTP             endpoint.Endpoint
+		UpdateTwoFactorActions  endpoint.Endpoint
+		RegenerateRecoveryCodes endpoint.Endpoint
+		VerifyTwoFactorAction   endpoint.Endpoint
+
+		RequestIDTokenNonce endpoint.Endpoint
+		SignInWithProvider  endpoint.Endpoint
+		GetIdentities       endpoint.Endpoint
+		LinkIdentity        endpoint.Endpoint
+		UnlinkIdentity      endpoint.Endpoint
+
+		RequestMagicLink   endpoint.Endpoint
+		ApproveMagicLink   endpoint.Endpoint
+		LoginWithMagicLink endpoint.Endpoint
+
+		RequestSolanaSignInMessage endpoint.Endpoint
+		SignInWithSolana           endpoint.Endpoint
+		LinkSolanaWallet           endpoint.Endpoint
 	}
 
 	authService interface {
 		Login(ctx context.Context, email, password, deviceID string) (Token, error)
-		Logout(ctx context.Context, tid string, userID uuid.UUID, deviceID string) error
-		SignUp(ctx context.Context, email, password, username, deviceID string) (Token, error)
-		RefreshToken(ctx context.Context, uid uuid.UUID, username, role, deviceID string) (Token, error)
+		Logout(ctx context.Context, sessionID, userID uuid.UUID, deviceID string) error
+		SignUp(ctx context.Context, email, password, username, deviceID, invitationToken string) (Token, error)
+		RefreshToken(ctx context.Context, uid, sessionID, refreshTokenID uuid.UUID, username, role, deviceID string) (Token, error)
 
 		ForgotPassword(ctx context.Context, email string) error
 		ValidateResetPasswordCode(ctx context.Context, email, otp string) (uuid.UUID, error)
 		ResetPassword(ctx context.Context, email, password, otp string) error
-		ChangePassword(ctx context.Context, userID uuid.UUID, oldPassword, newPassword string) error
+		ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, oldPassword, newPassword string) error
 
 		VerifyAccount(ctx context.Context, userID uuid.UUID, otp string) error
 
 		RequestChangeEmail(ctx context.Context, userID uuid.UUID, email string) error
 		ValidateChangeEmailCode(ctx context.Context, userID uuid.UUID, email, otp string) error
-		UpdateEmail(ctx context.Context, userID uuid.UUID, email, otp string) error
+		UpdateEmail(ctx context.Context, userID, sessionID uuid.UUID, email, otp string) error
 		UpdateUsername(ctx context.Context, userID uuid.UUID, username string) error
 
 		RequestDestroyAccount(ctx context.Context, uid uuid.UUID) error
@@ -99,9 +126,36 @@ type (
 		GetAccessTokenByUserID(ctx context.Context, userID uuid.UUID) (string, error)
 
 		GetUserStatus(ctx context.Context, email string) (UserStatus, error)
+		UnlockAccount(ctx context.Context, email string) error
 		VerificationCallback(ctx context.Context, userID uuid.UUID) error
 
 		RegisterPublicKey(ctx context.Context, userID uuid.UUID, publicKey *rsa.PublicKey) error
+
+		GetSessions(ctx context.Context, userID, currentSessionID uuid.UUID) ([]Session, error)
+		RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+		RevokeAllSessions(ctx context.Context, userID uuid.UUID) error
+
+		GetTwoFactorSettings(ctx context.Context, userID uuid.UUID) (TwoFactorSettings, error)
+		EnrollTOTP(ctx context.Context, userID uuid.UUID) (TOTPEnrollment, error)
+		EnableTOTP(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+		DisableTOTP(ctx context.Context, userID uuid.UUID) error
+		UpdateTwoFactorActions(ctx context.Context, userID uuid.UUID, actions []string) error
+		RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error)
+		VerifyTwoFactorAction(ctx context.Context, userID uuid.UUID, action string) error
+
+		RequestIDTokenNonce(ctx context.Context, provider string) (IDTokenNonce, error)
+		SignInWithProvider(ctx context.Context, provider, idToken, nonce, deviceID, invitationToken string) (Token, error)
+		GetIdentities(ctx context.Context, userID uuid.UUID) ([]Identity, error)
+		LinkIdentity(ctx context.Context, userID uuid.UUID, provider, idToken, nonce string) error
+		UnlinkIdentity(ctx context.Context, userID uuid.UUID, provider string) error
+
+		RequestMagicLink(ctx context.Context, email, deviceID string) error
+		ApproveMagicLink(ctx context.Context, email, deviceID string) error
+		LoginWithMagicLink(ctx context.Context, token, deviceID string) (Token, error)
+
+		RequestSolanaSignInMessage(ctx context.Context, address string) (SolanaSignInMessage, error)
+		SignInWithSolana(ctx context.Context, nonce, signature, deviceID, invitationToken string) (Token, error)
+		LinkSolanaWallet(ctx context.Context, userID uuid.UUID, nonce, signature string) error
 	}
 
 	Empty struct{}
@@ -129,6 +183,9 @@ type (
 		Email    string `json:"email" validate:"required,email,lte=150"`
 		Password string `json:"password" validate:"required,gte=8,lte=50"`
 		Username string `json:"username" validate:"required,alphanum,gte=5,lte=50"`
+
+		// InvitationToken is passed if user signs up from the invitation link
+		InvitationToken string `json:"invitation_token,omitempty"`
 	}
 
 	// ForgotPasswordRequest struct
@@ -212,13 +269,73 @@ type (
 		Email string `json:"email" validate:"required,email"`
 	}
 
+	// UnlockAccountRequest struct
+	UnlockAccountRequest struct {
+		Email string `json:"email" validate:"required,email"`
+	}
+
 	RegisterPublicKeyRequest struct {
 		PublicKey string `json:"public_key"`
 	}
+
+	// EnableTOTPRequest struct
+	EnableTOTPRequest struct {
+		Code string `json:"code" validate:"required,numeric,len=6"`
+	}
+
+	// UpdateTwoFactorActionsRequest struct
+	UpdateTwoFactorActionsRequest struct {
+		Actions []string `json:"actions"`
+	}
+
+	// VerifyTwoFactorActionRequest struct
+	VerifyTwoFactorActionRequest struct {
+		Action string `json:"action" validate:"required"`
+	}
+
+	// IDTokenRequest struct
+	IDTokenRequest struct {
+		Provider string `json:"-" validate:"required"`
+		IDToken  string `json:"id_token" validate:"required"`
+		Nonce    string `json:"nonce" validate:"required"`
+
+		// InvitationToken is passed if user signs up from the invitation link
+		InvitationToken string `json:"invitation_token,omitempty"`
+	}
+
+	// RequestMagicLinkRequest struct
+	RequestMagicLinkRequest struct {
+		Email string `json:"email" validate:"required,email"`
+	}
+
+	// LoginWithMagicLinkRequest struct
+	LoginWithMagicLinkRequest struct {
+		Token string `json:"token" validate:"required"`
+	}
+
+	// RequestSolanaSignInMessageRequest struct
+	RequestSolanaSignInMessageRequest struct {
+		Address string `json:"address" validate:"required"`
+	}
+
+	// SolanaSignatureRequest struct
+	SolanaSignatureRequest struct {
+		Nonce     string `json:"nonce" validate:"required"`
+		Signature string `json:"signature" validate:"required"` // base58 encoded signature of the message
+
+		// InvitationToken is passed if user signs up from the invitation link
+		InvitationToken string `json:"invitation_token,omitempty"`
+	}
+
+	// RecoveryCodesResponse struct
+	RecoveryCodesResponse struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	}
 )
 
 // MakeEndpoints ...
-func MakeEndpoints(as authService, jwtMdw endpoint.Middleware, m ...endpoint.Middleware) Endpoints {
+// Refresh token endpoint is protected with refreshMdw, it accepts refresh tokens only.
+func MakeEndpoints(as authService, jwtMdw, refreshMdw endpoint.Middleware, m ...endpoint.Middleware) Endpoints {
 	validateFunc := validator.ValidateStruct()
 
 	e := Endpoints{
@@ -227,7 +344,7 @@ func MakeEndpoints(as authService, jwtMdw endpoint.Middleware, m ...endpoint.Mid
 		Login:        MakeLoginEndpoint(as, validateFunc),
 		Logout:       jwtMdw(MakeLogoutEndpoint(as)),
 		SignUp:       MakeSignUpEndpoint(as, validateFunc),
-		RefreshToken: jwtMdw(MakeRefreshTokenEndpoint(as, validateFunc)),
+		RefreshToken: refreshMdw(MakeRefreshTokenEndpoint(as, validateFunc)),
 
 		ForgotPassword:            MakeForgotPasswordEndpoint(as, validateFunc),
 		ValidateResetPasswordCode: MakeValidateResetPasswordCodeEndpoint(as, validateFunc),
@@ -256,9 +373,36 @@ func MakeEndpoints(as authService, jwtMdw endpoint.Middleware, m ...endpoint.Mid
 		GetAccessTokenByUserID: jwtMdw(MakeGetAccessTokenByUserIDEndpoint(as)),
 
 		GetUserStatus:        jwtMdw(MakeGetUserStatusEndpoint(as, validateFunc)),
+		UnlockAccount:        jwtMdw(MakeUnlockAccountEndpoint(as, validateFunc)),
 		VerificationCallback: MakeVerificationCallbackEndpoint(as),
 
 		RegisterPublicKey: jwtMdw(MakeRegisterPublicKeyEndpoint(as)),
+
+		GetSessions:       jwtMdw(MakeGetSessionsEndpoint(as)),
+		RevokeSession:     jwtMdw(MakeRevokeSessionEndpoint(as)),
+		RevokeAllSessions: jwtMdw(MakeRevokeAllSessionsEndpoint(as)),
+
+		GetTwoFactorSettings:    jwtMdw(MakeGetTwoFactorSettingsEndpoint(as)),
+		EnrollTOTP:              jwtMdw(MakeEnrollTOTPEndpoint(as)),
+		EnableTOTP:              jwtMdw(MakeEnableTOTPEndpoint(as, validateFunc)),
+		DisableTOTP:             jwtMdw(MakeDisableTOTPEndpoint(as)),
+		UpdateTwoFactorActions:  jwtMdw(MakeUpdateTwoFactorActionsEndpoint(as)),
+		RegenerateRecoveryCodes: jwtMdw(MakeRegenerateRecoveryCodesEndpoint(as)),
+		VerifyTwoFactorAction:   jwtMdw(MakeVerifyTwoFactorActionEndpoint(as, validateFunc)),
+
+		RequestIDTokenNonce: MakeRequestIDTokenNonceEndpoint(as),
+		SignInWithProvider:  MakeSignInWithProviderEndpoint(as, validateFunc),
+		GetIdentities:       jwtMdw(MakeGetIdentitiesEndpoint(as)),
+		LinkIdentity:        jwtMdw(MakeLinkIdentityEndpoint(as, validateFunc)),
+		UnlinkIdentity:      jwtMdw(MakeUnlinkIdentityEndpoint(as)),
+
+		RequestMagicLink:   MakeRequestMagicLinkEndpoint(as, validateFunc),
+		ApproveMagicLink:   MakeApproveMagicLinkEndpoint(as, validateFunc),
+		LoginWithMagicLink: MakeLoginWithMagicLinkEndpoint(as, validateFunc),
+
+		RequestSolanaSignInMessage: MakeRequestSolanaSignInMessageEndpoint(as, validateFunc),
+		SignInWithSolana:           MakeSignInWithSolanaEndpoint(as, validateFunc),
+		LinkSolanaWallet:           jwtMdw(MakeLinkSolanaWalletEndpoint(as, validateFunc)),
 	}
 
 	if len(m) > 0 {
@@ -296,8 +440,35 @@ func MakeEndpoints(as authService, jwtMdw endpoint.Middleware, m ...endpoint.Mid
 
 			e.GetAccessTokenByUserID = mdw(e.GetAccessTokenByUserID)
 			e.VerificationCallback = mdw(e.VerificationCallback)
+			e.UnlockAccount = mdw(e.UnlockAccount)
 
 			e.RegisterPublicKey = mdw(e.RegisterPublicKey)
+
+			e.GetSessions = mdw(e.GetSessions)
+			e.RevokeSession = mdw(e.RevokeSession)
+			e.RevokeAllSessions = mdw(e.RevokeAllSessions)
+
+			e.GetTwoFactorSettings = mdw(e.GetTwoFactorSettings)
+			e.EnrollTOTP = mdw(e.EnrollTOTP)
+			e.EnableTOTP = mdw(e.EnableTOTP)
+			e.DisableTOTP = mdw(e.DisableTOTP)
+			e.UpdateTwoFactorActions = mdw(e.UpdateTwoFactorActions)
+			e.RegenerateRecoveryCodes = mdw(e.RegenerateRecoveryCodes)
+			e.VerifyTwoFactorAction = mdw(e.VerifyTwoFactorAction)
+
+			e.RequestIDTokenNonce = mdw(e.RequestIDTokenNonce)
+			e.SignInWithProvider = mdw(e.SignInWithProvider)
+			e.GetIdentities = mdw(e.GetIdentities)
+			e.LinkIdentity = mdw(e.LinkIdentity)
+			e.UnlinkIdentity = mdw(e.UnlinkIdentity)
+
+			e.RequestMagicLink = mdw(e.RequestMagicLink)
+			e.ApproveMagicLink = mdw(e.ApproveMagicLink)
+			e.LoginWithMagicLink = mdw(e.LoginWithMagicLink)
+
+			e.RequestSolanaSignInMessage = mdw(e.RequestSolanaSignInMessage)
+			e.SignInWithSolana = mdw(e.SignInWithSolana)
+			e.LinkSolanaWallet = mdw(e.LinkSolanaWallet)
 		}
 	}
 
@@ -342,15 +513,15 @@ func MakeLoginEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoin
 // MakeLogoutEndpoint ...
 func MakeLogoutEndpoint(s authService) endpoint.Endpoint {
 	return func(ctx context.Context, _ interface{}) (interface{}, error) {
-		tid, err := jwt.TokenIDFromContext(ctx)
+		sid, err := sessionIDFromContext(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("could not get user id: %w", err)
+			return nil, err
 		}
 		uid, err := jwt.UserIDFromContext(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("could not get user id: %w", err)
 		}
-		if err := s.Logout(ctx, tid.String(), uid, deviceid.FromContext(ctx)); err != nil {
+		if err := s.Logout(ctx, sid, uid, deviceid.FromContext(ctx)); err != nil {
 			return nil, err
 		}
 		return true, nil
@@ -371,6 +542,7 @@ func MakeSignUpEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoi
 			strings.TrimSpace(req.Password),
 			strings.TrimSpace(req.Username),
 			deviceid.FromContext(ctx),
+			strings.TrimSpace(req.InvitationToken),
 		)
 		if err != nil {
 			return nil, err
@@ -398,7 +570,21 @@ func MakeRefreshTokenEndpoint(s authService, v validator.ValidateFunc) endpoint.
 			return nil, fmt.Errorf("could not get role: %w", err)
 		}
 
-		token, err := s.RefreshToken(ctx, uid, username, role, deviceid.FromContext(ctx))
+		sid, err := sessionIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// refresh token id is used to detect reuse of the rotated tokens,
+		// tokens without session are rejected by the service
+		rtid := uuid.Nil
+		if sid != uuid.Nil {
+			if rtid, err = jwt.TokenIDFromContext(ctx); err != nil {
+				return nil, fmt.Errorf("could not get refresh token id: %w", err)
+			}
+		}
+
+		token, err := s.RefreshToken(ctx, uid, sid, rtid, username, role, deviceid.FromContext(ctx))
 		if err != nil {
 			return nil, err
 		}
@@ -477,7 +663,12 @@ func MakeChangePasswordEndpoint(s authService, v validator.ValidateFunc) endpoin
 			return nil, err
 		}
 
-		if err := s.ChangePassword(ctx, uid, req.OldPassword, req.NewPassword); err != nil {
+		sid, err := sessionIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.ChangePassword(ctx, uid, sid, req.OldPassword, req.NewPassword); err != nil {
 			return nil, err
 		}
 
@@ -570,7 +761,12 @@ func MakeUpdateEmailEndpoint(s authService, v validator.ValidateFunc) endpoint.E
 			return nil, fmt.Errorf("could not get user id: %w", err)
 		}
 
-		if err := s.UpdateEmail(ctx, uid, req.Email, req.OTP); err != nil {
+		sid, err := sessionIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.UpdateEmail(ctx, uid, sid, req.Email, req.OTP); err != nil {
 			return nil, err
 		}
 
@@ -886,6 +1082,26 @@ func MakeGetUserStatusEndpoint(s authService, v validator.ValidateFunc) endpoint
 	}
 }
 
+// MakeUnlockAccountEndpoint ...
+func MakeUnlockAccountEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(UnlockAccountRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := s.UnlockAccount(ctx, strings.ToLower(strings.TrimSpace(req.Email))); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
 // MakeVerificationCallbackEndpoint ...
 func MakeVerificationCallbackEndpoint(s authService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
@@ -925,3 +1141,404 @@ func MakeRegisterPublicKeyEndpoint(s authService) endpoint.Endpoint {
 		return new(Empty), nil
 	}
 }
+
+// MakeGetSessionsEndpoint ...
+func MakeGetSessionsEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		sid, err := sessionIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions, err := s.GetSessions(ctx, uid, sid)
+		if err != nil {
+			return nil, err
+		}
+
+		return sessions, nil
+	}
+}
+
+// MakeRevokeSessionEndpoint ...
+func MakeRevokeSessionEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		sid, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w session id: %v", ErrInvalidParameter, err)
+		}
+
+		if err := s.RevokeSession(ctx, uid, sid); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeRevokeAllSessionsEndpoint ...
+func MakeRevokeAllSessionsEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		if err := s.RevokeAllSessions(ctx, uid); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeGetTwoFactorSettingsEndpoint ...
+func MakeGetTwoFactorSettingsEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		settings, err := s.GetTwoFactorSettings(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		return settings, nil
+	}
+}
+
+// MakeEnrollTOTPEndpoint ...
+func MakeEnrollTOTPEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		enrollment, err := s.EnrollTOTP(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		return enrollment, nil
+	}
+}
+
+// MakeEnableTOTPEndpoint ...
+func MakeEnableTOTPEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(EnableTOTPRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		codes, err := s.EnableTOTP(ctx, uid, req.Code)
+		if err != nil {
+			return nil, err
+		}
+
+		return RecoveryCodesResponse{RecoveryCodes: codes}, nil
+	}
+}
+
+// MakeDisableTOTPEndpoint ...
+func MakeDisableTOTPEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		if err := s.DisableTOTP(ctx, uid); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeUpdateTwoFactorActionsEndpoint ...
+func MakeUpdateTwoFactorActionsEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateTwoFactorActionsRequest)
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		if err := s.UpdateTwoFactorActions(ctx, uid, req.Actions); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeVerifyTwoFactorActionEndpoint ...
+func MakeVerifyTwoFactorActionEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(VerifyTwoFactorActionRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		if err := s.VerifyTwoFactorAction(ctx, uid, req.Action); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeRegenerateRecoveryCodesEndpoint ...
+func MakeRegenerateRecoveryCodesEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		codes, err := s.RegenerateRecoveryCodes(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		return RecoveryCodesResponse{RecoveryCodes: codes}, nil
+	}
+}
+
+// MakeRequestIDTokenNonceEndpoint ...
+func MakeRequestIDTokenNonceEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		nonce, err := s.RequestIDTokenNonce(ctx, request.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return nonce, nil
+	}
+}
+
+// MakeSignInWithProviderEndpoint ...
+func MakeSignInWithProviderEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(IDTokenRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		token, err := s.SignInWithProvider(
+			ctx,
+			req.Provider,
+			strings.TrimSpace(req.IDToken),
+			req.Nonce,
+			deviceid.FromContext(ctx),
+			strings.TrimSpace(req.InvitationToken),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return AccessToken(token), nil
+	}
+}
+
+// MakeGetIdentitiesEndpoint ...
+func MakeGetIdentitiesEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		identities, err := s.GetIdentities(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		return identities, nil
+	}
+}
+
+// MakeLinkIdentityEndpoint ...
+func MakeLinkIdentityEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(IDTokenRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		if err := s.LinkIdentity(ctx, uid, req.Provider, strings.TrimSpace(req.IDToken), req.Nonce); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeUnlinkIdentityEndpoint ...
+func MakeUnlinkIdentityEndpoint(s authService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		if err := s.UnlinkIdentity(ctx, uid, request.(string)); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// sessionIDFromContext returns session id of the token,
+// uuid.Nil for tokens issued before sessions were introduced.
+func sessionIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	sid, err := jwt.SessionIDFromContext(ctx)
+	if err != nil {
+		if errors.Is(err, jwt.ErrSessionIDEmpty) {
+			return uuid.Nil, nil
+		}
+		return uuid.Nil, fmt.Errorf("could not get session id: %w", err)
+	}
+
+	return sid, nil
+}
+
+// MakeRequestMagicLinkEndpoint ...
+func MakeRequestMagicLinkEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RequestMagicLinkRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := s.RequestMagicLink(ctx, req.Email, deviceid.FromContext(ctx)); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeApproveMagicLinkEndpoint ...
+func MakeApproveMagicLinkEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RequestMagicLinkRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := s.ApproveMagicLink(ctx, req.Email, deviceid.FromContext(ctx)); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeLoginWithMagicLinkEndpoint ...
+func MakeLoginWithMagicLinkEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(LoginWithMagicLinkRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		token, err := s.LoginWithMagicLink(ctx, strings.TrimSpace(req.Token), deviceid.FromContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		return AccessToken(token), nil
+	}
+}
+
+// MakeRequestSolanaSignInMessageEndpoint ...
+func MakeRequestSolanaSignInMessageEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RequestSolanaSignInMessageRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		msg, err := s.RequestSolanaSignInMessage(ctx, strings.TrimSpace(req.Address))
+		if err != nil {
+			return nil, err
+		}
+
+		return msg, nil
+	}
+}
+
+// MakeSignInWithSolanaEndpoint ...
+func MakeSignInWithSolanaEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(SolanaSignatureRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		token, err := s.SignInWithSolana(
+			ctx,
+			req.Nonce,
+			strings.TrimSpace(req.Signature),
+			deviceid.FromContext(ctx),
+			strings.TrimSpace(req.InvitationToken),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return AccessToken(token), nil
+	}
+}
+
+// MakeLinkSolanaWalletEndpoint ...
+func MakeLinkSolanaWalletEndpoint(s authService, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(SolanaSignatureRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		if err := s.LinkSolanaWallet(ctx, uid, req.Nonce, strings.TrimSpace(req.Signature)); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
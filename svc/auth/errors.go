@@ -16,6 +16,32 @@ var (
 	ErrEmptyDeviceID            = errors.New("the current version of the application is outdated please update to the latest version")
 	ErrInvalidParameter         = errors.New("invalid parameter")
 	ErrPublicKeyIsNotRegistered = errors.New("public key is not registered")
+	ErrSessionExpired           = errors.New("your session has expired, please log in again")
+	ErrRefreshTokenReused       = errors.New("refresh token has already been used, please log in again")
+	ErrTwoFactorUnavailable     = errors.New("two-factor authentication is not available")
+	ErrTwoFactorAlreadyEnabled  = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnabled      = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorNotEnrolled     = errors.New("authenticator app is not set up, please start over")
+
+	// Sign in with identity providers
+	ErrIdentityEmailNotVerified   = errors.New("email address of the account is not verified by the provider")
+	ErrIdentityAccountNotVerified = errors.New("account with this email already exists, please log in with password and link the provider in profile")
+	ErrIdentityAlreadyLinked      = errors.New("this account is already linked")
+	ErrLastSignInMethod           = errors.New("set up a password or link another account before unlinking the last one")
+
+	// Magic links
+	ErrMagicLinkUnavailable      = errors.New("login by email link is not available")
+	ErrInvalidMagicLink          = errors.New("login link is invalid or has already been used")
+	ErrMagicLinkExpired          = errors.New("login link has expired, please request a new one")
+	ErrMagicLinkApprovalRequired = errors.New("login link was requested on another device, approve logging in on that device and try again")
+	ErrTooManyRequests           = errors.New("too many requests, please try again later")
+
+	// Sign in with Solana
+	ErrSolanaSignInUnavailable = errors.New("sign in with solana wallet is not available")
+	ErrSolanaSignInExpired     = errors.New("sign in message has expired, please request a new one")
+
+	// Brute-force protection
+	ErrTooManyAttempts = errors.New("too many failed attempts")
 
 	// ErrBadRouting is returned when an expected path variable is missing.
 	// It always indicates programmer error.
@@ -0,0 +1,470 @@
+package auth
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/dmitrymomot/random"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/idtoken"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/lib/totp"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+	"github.com/SatorNetwork/sator-api/lib/validator"
+	"github.com/SatorNetwork/sator-api/svc/auth/repository"
+)
+
+// ID token nonce settings
+const (
+	idTokenNonceTTL             = 10 * time.Minute
+	idTokenNonceSize            = 32
+	idTokenNonceIPLimit         = 30 // nonces per client ip within the rate limit window
+	idTokenNonceRateLimitWindow = time.Hour
+)
+
+// Generated username settings
+const (
+	usernameBaseMaxLen  = 20
+	usernameMinLen      = 5
+	usernameSuffixLen   = 4
+	usernameMaxAttempts = 5
+)
+
+type (
+	// Identity is an account of external identity provider linked to the user, e.g. Apple ID
+	Identity struct {
+		Provider  string    `json:"provider"`
+		Email     string    `json:"email,omitempty"`
+		Address   string    `json:"address,omitempty"` // wallet address of Solana account
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	// IDTokenNonce is a single-use nonce to be passed to the identity provider,
+	// the ID token issued with another nonce is rejected.
+	IDTokenNonce struct {
+		Nonce     string    `json:"nonce"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+
+	idTokenVerifier interface {
+		Verify(ctx context.Context, rawToken, nonce string) (idtoken.Claims, error)
+	}
+)
+
+// RequestIDTokenNonce issues a nonce for signing in with the identity provider or linking it
+func (s *Service) RequestIDTokenNonce(ctx context.Context, provider string) (IDTokenNonce, error) {
+	if _, ok := s.idProviders[provider]; !ok {
+		return IDTokenNonce{}, fmt.Errorf("%w: unsupported identity provider %s", ErrInvalidParameter, provider)
+	}
+
+	ip, _ := clientFromContext(ctx)
+	if ip != "" {
+		n, err := s.ur.CountIDTokenNoncesByIP(ctx, repository.CountIDTokenNoncesByIPParams{
+			Ip:           ip,
+			CreatedAfter: time.Now().Add(-idTokenNonceRateLimitWindow),
+		})
+		if err != nil {
+			return IDTokenNonce{}, fmt.Errorf("could not count id token nonces: %w", err)
+		}
+		if n >= idTokenNonceIPLimit {
+			return IDTokenNonce{}, ErrTooManyRequests
+		}
+	}
+
+	b := make([]byte, idTokenNonceSize)
+	if _, err := rand.Read(b); err != nil {
+		return IDTokenNonce{}, fmt.Errorf("could not generate nonce: %w", err)
+	}
+
+	n := IDTokenNonce{
+		Nonce:     base64.RawURLEncoding.EncodeToString(b),
+		ExpiresAt: time.Now().Add(idTokenNonceTTL),
+	}
+	if err := s.ur.AddIDTokenNonce(ctx, repository.AddIDTokenNonceParams{
+		Nonce:     n.Nonce,
+		Provider:  provider,
+		Ip:        ip,
+		ExpiresAt: n.ExpiresAt,
+	}); err != nil {
+		return IDTokenNonce{}, fmt.Errorf("could not store id token nonce: %w", err)
+	}
+
+	return n, nil
+}
+
+// SignInWithProvider signs in the user by ID token of identity provider.
+// The identity is linked to existing account by verified email,
+// otherwise a new verified account is created.
+func (s *Service) SignInWithProvider(ctx context.Context, provider, idToken, nonce, deviceID, invitationToken string) (Token, error) {
+	if deviceID == "" && !s.skipDeviceIDCheck {
+		return Token{}, ErrEmptyDeviceID
+	}
+
+	claims, err := s.verifyIDToken(ctx, provider, idToken, nonce)
+	if err != nil {
+		return Token{}, err
+	}
+
+	identity, err := s.ur.GetUserIdentity(ctx, repository.GetUserIdentityParams{
+		Provider: provider,
+		Subject:  claims.Subject,
+	})
+	if err == nil {
+		u, err := s.ur.GetUserByID(ctx, identity.UserID)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return Token{}, ErrInvalidCredentials
+			}
+			return Token{}, fmt.Errorf("could not get user: %w", err)
+		}
+		return s.signInWithIdentity(ctx, u, deviceID)
+	} else if !db.IsNotFoundError(err) {
+		return Token{}, fmt.Errorf("could not get user identity: %w", err)
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return Token{}, ErrIdentityEmailNotVerified
+	}
+
+	u, err := s.ur.GetUserByEmail(ctx, claims.Email)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return s.signUpWithIdentity(ctx, provider, claims, deviceID, invitationToken)
+		}
+		return Token{}, fmt.Errorf("could not get user: %w", err)
+	}
+
+	// the email of unverified account could be registered by someone else,
+	// so the owner has to log in with password and link the provider from profile
+	if !u.VerifiedAt.Valid {
+		return Token{}, ErrIdentityAccountNotVerified
+	}
+
+	if err := s.ur.AddUserIdentity(ctx, repository.AddUserIdentityParams{
+		Provider: provider,
+		Subject:  claims.Subject,
+		UserID:   u.ID,
+		Email:    claims.Email,
+	}); err != nil {
+		return Token{}, fmt.Errorf("could not link %s account: %w", provider, err)
+	}
+
+	return s.signInWithIdentity(ctx, u, deviceID)
+}
+
+// GetIdentities returns identity providers linked to the user
+func (s *Service) GetIdentities(ctx context.Context, userID uuid.UUID) ([]Identity, error) {
+	identities, err := s.ur.GetUserIdentitiesByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get user identities: %w", err)
+	}
+
+	result := make([]Identity, 0, len(identities))
+	for _, i := range identities {
+		identity := Identity{
+			Provider:  i.Provider,
+			Email:     i.Email,
+			CreatedAt: i.CreatedAt,
+		}
+		if i.Provider == ProviderSolana {
+			identity.Address = i.Subject
+		}
+		result = append(result, identity)
+	}
+
+	return result, nil
+}
+
+// LinkIdentity links identity provider account to the user
+func (s *Service) LinkIdentity(ctx context.Context, userID uuid.UUID, provider, idToken, nonce string) error {
+	claims, err := s.verifyIDToken(ctx, provider, idToken, nonce)
+	if err != nil {
+		return err
+	}
+
+	identity, err := s.ur.GetUserIdentity(ctx, repository.GetUserIdentityParams{
+		Provider: provider,
+		Subject:  claims.Subject,
+	})
+	if err == nil {
+		if identity.UserID == userID {
+			return nil
+		}
+		return ErrIdentityAlreadyLinked
+	} else if !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get user identity: %w", err)
+	}
+
+	identities, err := s.GetIdentities(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, i := range identities {
+		if i.Provider == provider {
+			return ErrIdentityAlreadyLinked
+		}
+	}
+
+	if err := s.ur.AddUserIdentity(ctx, repository.AddUserIdentityParams{
+		Provider: provider,
+		Subject:  claims.Subject,
+		UserID:   userID,
+		Email:    claims.Email,
+	}); err != nil {
+		return fmt.Errorf("could not link %s account: %w", provider, err)
+	}
+
+	return nil
+}
+
+// UnlinkIdentity unlinks identity provider from the user,
+// the last sign in method can't be removed from account without password.
+func (s *Service) UnlinkIdentity(ctx context.Context, userID uuid.UUID, provider string) error {
+	u, err := s.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("could not get user: %w", err)
+	}
+
+	identities, err := s.GetIdentities(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(u.Password) == 0 && len(identities) <= 1 {
+		return ErrLastSignInMethod
+	}
+
+	deleted, err := s.ur.DeleteUserIdentity(ctx, repository.DeleteUserIdentityParams{
+		UserID:   userID,
+		Provider: provider,
+	})
+	if err != nil {
+		return fmt.Errorf("could not unlink %s account: %w", provider, err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%s account %w", provider, ErrNotFound)
+	}
+
+	return nil
+}
+
+// verifyIDToken returns claims of the ID token verified by the provider's verifier.
+// The nonce must be issued by RequestIDTokenNonce, it's marked as used,
+// so the token can't be replayed.
+func (s *Service) verifyIDToken(ctx context.Context, provider, idToken, nonce string) (idtoken.Claims, error) {
+	v, ok := s.idProviders[provider]
+	if !ok {
+		return idtoken.Claims{}, fmt.Errorf("%w: unsupported identity provider %s", ErrInvalidParameter, provider)
+	}
+
+	claims, err := v.Verify(ctx, idToken, nonce)
+	if err != nil {
+		return idtoken.Claims{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	used, err := s.ur.UseIDTokenNonce(ctx, repository.UseIDTokenNonceParams{
+		Nonce:    nonce,
+		Provider: provider,
+	})
+	if err != nil {
+		return idtoken.Claims{}, fmt.Errorf("could not use id token nonce: %w", err)
+	}
+	if used == 0 {
+		return idtoken.Claims{}, fmt.Errorf("%w: nonce is unknown, expired or already used", ErrInvalidCredentials)
+	}
+
+	return claims, nil
+}
+
+// signInWithIdentity starts a new session of the user signed in with identity provider
+func (s *Service) signInWithIdentity(ctx context.Context, u repository.User, deviceID string) (Token, error) {
+	if u.Disabled || s.isEmailRestricted(ctx, u.Email, u.SanitizedEmail.String) {
+		return Token{}, ErrUserIsDisabled
+	}
+
+	if err := s.verifyTwoFactor(ctx, u.ID, totp.ActionLogin); err != nil {
+		return Token{}, err
+	}
+
+	s.linkDevice(ctx, u.ID, deviceID)
+
+	token, err := s.startSession(ctx, u, deviceID)
+	if err != nil {
+		return Token{}, err
+	}
+
+	s.trackLogin(ctx, u.ID)
+
+	return token, nil
+}
+
+// signUpWithIdentity creates a new account with email verified by identity provider,
+// so the account is verified and gets the wallet right away.
+func (s *Service) signUpWithIdentity(ctx context.Context, provider string, claims idtoken.Claims, deviceID, invitationToken string) (Token, error) {
+	sanitizedEmail, err := utils.SanitizeEmail(claims.Email)
+	if err != nil {
+		return Token{}, validator.NewValidationError(url.Values{
+			"email": []string{ErrInvalidEmailFormat.Error()},
+		})
+	}
+
+	if s.isEmailRestricted(ctx, claims.Email, sanitizedEmail) {
+		return Token{}, validator.NewValidationError(url.Values{
+			"email": []string{ErrRestrictedEmailDomain.Error()},
+		})
+	}
+
+	if _, err := s.ur.GetUserBySanitizedEmail(ctx, sanitizedEmail); err == nil {
+		return Token{}, ErrIdentityAccountNotVerified
+	} else if !db.IsNotFoundError(err) {
+		return Token{}, fmt.Errorf("could not create a new account: %w", err)
+	}
+
+	username, err := s.generateUsername(ctx, claims.Email)
+	if err != nil {
+		return Token{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return Token{}, fmt.Errorf("could not begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.ur.WithTx(tx)
+
+	u, err := repo.CreateUser(ctx, repository.CreateUserParams{
+		Email:          claims.Email,
+		SanitizedEmail: sql.NullString{String: sanitizedEmail, Valid: true},
+		EmailHash:      sql.NullString{String: fmt.Sprintf("%x", md5.Sum([]byte(sanitizedEmail))), Valid: true},
+		Username:       username,
+		Role:           rbac.RoleUser.String(),
+	})
+	if err != nil {
+		return Token{}, fmt.Errorf("could not create a new account: %w", err)
+	}
+
+	if err := repo.AddUserIdentity(ctx, repository.AddUserIdentityParams{
+		Provider: provider,
+		Subject:  claims.Subject,
+		UserID:   u.ID,
+		Email:    claims.Email,
+	}); err != nil {
+		return Token{}, fmt.Errorf("could not link %s account: %w", provider, err)
+	}
+
+	if err := repo.UpdateUserVerifiedAt(ctx, repository.UpdateUserVerifiedAtParams{
+		UserID:     u.ID,
+		VerifiedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	}); err != nil {
+		return Token{}, fmt.Errorf("could not verify email address: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Token{}, fmt.Errorf("could not create a new account: %w", err)
+	}
+
+	// the wallet is created out of the transaction as in the email verification flow:
+	// the account stays verified if it fails, so the user can sign in again
+	// and the wallet is created by the missed wallets worker.
+	if err := s.ws.CreateWallet(ctx, u.ID); err != nil {
+		return Token{}, fmt.Errorf("could not create solana wallet: %w", err)
+	}
+
+	s.trackAccountVerified(ctx, u.ID)
+	s.linkDevice(ctx, u.ID, deviceID)
+
+	token, err := s.startSession(ctx, u, deviceID)
+	if err != nil {
+		return Token{}, err
+	}
+
+	if invitationToken != "" {
+		if err := s.ic.AcceptInvitationByToken(ctx, u.ID, invitationToken); err != nil {
+			log.Printf("could not accept invitation by token for user id = %s: %v", u.ID, err)
+		}
+	} else if isInvited, _ := s.ic.IsEmailInvited(ctx, claims.Email); isInvited {
+		if err := s.ic.AcceptInvitation(ctx, u.ID, claims.Email); err != nil {
+			log.Printf("could not accept invitation for user id = %s: %v", u.ID, err)
+		}
+	}
+
+	return token, nil
+}
+
+// isEmailRestricted reports whether the email is blacklisted or not whitelisted
+func (s *Service) isEmailRestricted(ctx context.Context, email, sanitizedEmail string) bool {
+	if strings.HasSuffix(email, "@sator.io") {
+		return false
+	}
+
+	if s.blacklistEnabled {
+		if yes, _ := s.ur.IsEmailBlacklisted(ctx, email); yes {
+			return true
+		}
+		if sanitizedEmail != "" {
+			if yes, _ := s.ur.IsEmailBlacklisted(ctx, sanitizedEmail); yes {
+				return true
+			}
+		}
+	}
+
+	if s.whitelistEnabled {
+		if yes, _ := s.ur.IsEmailWhitelisted(ctx, email); !yes {
+			return true
+		}
+	}
+
+	return false
+}
+
+// linkDevice links the device to the user, errors are logged only
+func (s *Service) linkDevice(ctx context.Context, userID uuid.UUID, deviceID string) {
+	if deviceID == "" {
+		return
+	}
+	if err := s.ur.LinkDeviceToUser(ctx, repository.LinkDeviceToUserParams{
+		UserID:   userID,
+		DeviceID: deviceID,
+	}); err != nil {
+		log.Printf("could not link device to user: %v", err)
+	}
+}
+
+// generateUsername returns unique username based on the email,
+// the user can change it later.
+func (s *Service) generateUsername(ctx context.Context, email string) (string, error) {
+	base := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return r
+		}
+		return -1
+	}, strings.SplitN(email, "@", 2)[0])
+	if len(base) > usernameBaseMaxLen {
+		base = base[:usernameBaseMaxLen]
+	}
+	if len(base)+usernameSuffixLen < usernameMinLen {
+		base = "user" + base
+	}
+
+	for i := 0; i < usernameMaxAttempts; i++ {
+		username := base + random.String(usernameSuffixLen, random.Numeric)
+		if _, err := s.ur.GetUserByUsername(ctx, username); db.IsNotFoundError(err) {
+			return username, nil
+		} else if err != nil {
+			return "", fmt.Errorf("could not generate username: %w", err)
+		}
+	}
+
+	return "", fmt.Errorf("could not generate unique username for %s", email)
+}
@@ -0,0 +1,215 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/auth/repository"
+)
+
+// Flows protected from brute-force, failed attempts are counted per flow
+const (
+	attemptFlowLogin         = "login"
+	attemptFlowVerifyAccount = "verify_account"
+	attemptFlowResetPassword = "reset_password"
+	attemptFlowChangeEmail   = "change_email"
+	attemptFlowTwoFactor     = "two_factor"
+)
+
+// Default lockout policy
+const (
+	defaultMaxAccountFailures = 5  // failed attempts per account before lockout
+	defaultMaxIPFailures      = 20 // failed attempts per client ip before lockout
+	defaultBaseLockout        = time.Minute
+	defaultMaxLockout         = 24 * time.Hour
+
+	// failures older than the window are not counted
+	failedAttemptsWindow = time.Hour
+	// lockout duration starts over if the previous lockout ended before the period
+	lockoutResetPeriod = 24 * time.Hour
+)
+
+// attemptFlowVerificationTypes maps OTP flows to verification codes invalidated on lockout
+var attemptFlowVerificationTypes = map[string]int32{
+	attemptFlowVerifyAccount: repository.VerifyConfirmAccount,
+	attemptFlowResetPassword: repository.VerifyResetPassword,
+	attemptFlowChangeEmail:   repository.VerifyChangeEmail,
+}
+
+// UnlockAccount resets failed attempts and lockouts of the account with the given email in all flows
+func (s *Service) UnlockAccount(ctx context.Context, email string) error {
+	u, err := s.ur.GetUserByEmail(ctx, email)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return fmt.Errorf("user %w", ErrNotFound)
+		}
+		return fmt.Errorf("could not get user: %w", err)
+	}
+
+	if err := s.ur.DeleteAuthAttemptsBySubject(ctx, accountAttemptSubject(u.ID)); err != nil {
+		return fmt.Errorf("could not unlock account: %w", err)
+	}
+
+	if s.audit != nil {
+		if err := s.audit(ctx, "account.unlock", "user", u.ID.String(), nil, map[string]string{"email": u.Email}); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkLockout returns ErrTooManyAttempts if the account or the client ip is locked in the flow.
+// User id is nil if the account is unknown yet.
+func (s *Service) checkLockout(ctx context.Context, flow string, userID uuid.UUID) error {
+	for _, subject := range attemptSubjects(ctx, userID) {
+		a, err := s.ur.GetAuthAttempt(ctx, repository.GetAuthAttemptParams{
+			Flow:    flow,
+			Subject: subject,
+		})
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("could not get failed attempts: %w", err)
+		}
+
+		if a.LockedUntil.Valid && a.LockedUntil.Time.After(time.Now()) {
+			return fmt.Errorf("%w, try again in %s", ErrTooManyAttempts, time.Until(a.LockedUntil.Time).Round(time.Second))
+		}
+	}
+
+	return nil
+}
+
+// registerFailedAttempt counts failed attempt of the account and the client ip in the flow
+// and locks them once the limit is reached. On account lockout the OTP of the flow is invalidated
+// and the user is notified by email. Returns ErrTooManyAttempts if the attempt caused a lockout.
+// User id is nil if the account is unknown.
+func (s *Service) registerFailedAttempt(ctx context.Context, flow string, userID uuid.UUID) error {
+	var locked error
+	for _, subject := range attemptSubjects(ctx, userID) {
+		maxFailures := s.maxIPFailures
+		if subject == accountAttemptSubject(userID) {
+			maxFailures = s.maxAccountFailures
+		}
+
+		a, err := s.ur.AddFailedAuthAttempt(ctx, repository.AddFailedAuthAttemptParams{
+			Flow:        flow,
+			Subject:     subject,
+			WindowStart: time.Now().Add(-failedAttemptsWindow),
+		})
+		if err != nil {
+			log.Printf("could not count failed %s attempt of %s: %v", flow, subject, err)
+			continue
+		}
+		if int(a.Failures) < maxFailures {
+			continue
+		}
+
+		now := time.Now()
+		lockouts := nextLockouts(a, now)
+		lockedUntil := now.Add(lockoutDuration(lockouts, s.baseLockout, s.maxLockout))
+		if err := s.ur.LockAuthAttempts(ctx, repository.LockAuthAttemptsParams{
+			Lockouts:    int32(lockouts),
+			LockedUntil: sql.NullTime{Time: lockedUntil, Valid: true},
+			Flow:        flow,
+			Subject:     subject,
+		}); err != nil {
+			log.Printf("could not lock %s of %s: %v", flow, subject, err)
+			continue
+		}
+		locked = fmt.Errorf("%w, try again in %s", ErrTooManyAttempts, time.Until(lockedUntil).Round(time.Second))
+
+		if subject == accountAttemptSubject(userID) {
+			s.onAccountLockout(ctx, flow, userID, lockedUntil)
+		}
+	}
+
+	return locked
+}
+
+// resetFailedAttempts resets failed attempts of the account in the flow after successful attempt.
+// Failed attempts of the client ip are kept, so they can't be reset with another account.
+func (s *Service) resetFailedAttempts(ctx context.Context, flow string, userID uuid.UUID) {
+	if err := s.ur.DeleteAuthAttempts(ctx, repository.DeleteAuthAttemptsParams{
+		Flow:    flow,
+		Subject: accountAttemptSubject(userID),
+	}); err != nil {
+		log.Printf("could not reset failed %s attempts of user with id=%s: %v", flow, userID, err)
+	}
+}
+
+// onAccountLockout invalidates the OTP of the flow and notifies the user, errors are logged only
+func (s *Service) onAccountLockout(ctx context.Context, flow string, userID uuid.UUID, lockedUntil time.Time) {
+	if requestType, ok := attemptFlowVerificationTypes[flow]; ok {
+		if err := s.ur.DeleteUserVerificationsByUserID(ctx, repository.DeleteUserVerificationsByUserIDParams{
+			RequestType: requestType,
+			UserID:      userID,
+		}); err != nil {
+			log.Printf("could not invalidate verification code of user with id=%s: %v", userID, err)
+		}
+	}
+
+	if s.mail == nil {
+		return
+	}
+
+	u, err := s.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Printf("could not get user with id=%s to send account locked alert: %v", userID, err)
+		return
+	}
+
+	ip, _ := clientFromContext(ctx)
+	if err := s.mail.SendAccountLockedAlert(ctx, u.Email, ip, lockedUntil); err != nil {
+		log.Printf("could not send account locked alert to user with id=%s: %v", userID, err)
+	}
+}
+
+// attemptSubjects returns subjects failed attempts are counted for: the account if known and the client ip
+func attemptSubjects(ctx context.Context, userID uuid.UUID) []string {
+	subjects := make([]string, 0, 2)
+	if userID != uuid.Nil {
+		subjects = append(subjects, accountAttemptSubject(userID))
+	}
+	if ip, _ := clientFromContext(ctx); ip != "" {
+		subjects = append(subjects, "ip:"+ip)
+	}
+	return subjects
+}
+
+func accountAttemptSubject(userID uuid.UUID) string {
+	return "user:" + userID.String()
+}
+
+// nextLockouts returns the number of consecutive lockouts including the new one,
+// the count starts over if the previous lockout ended long ago.
+func nextLockouts(a repository.AuthAttempt, now time.Time) int {
+	if a.LockedUntil.Valid && a.LockedUntil.Time.Add(lockoutResetPeriod).Before(now) {
+		return 1
+	}
+	return int(a.Lockouts) + 1
+}
+
+// lockoutDuration returns exponentially growing duration of the lockout: base, 2*base, 4*base...
+// limited by max duration.
+func lockoutDuration(lockouts int, base, max time.Duration) time.Duration {
+	d := base
+	for i := 1; i < lockouts; i++ {
+		d *= 2
+		if d >= max {
+			return max
+		}
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
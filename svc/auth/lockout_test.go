@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/auth/repository"
+)
+
+func TestLockoutDuration(t *testing.T) {
+	tests := []struct {
+		lockouts int
+		want     time.Duration
+	}{
+		{lockouts: 1, want: time.Minute},
+		{lockouts: 2, want: 2 * time.Minute},
+		{lockouts: 3, want: 4 * time.Minute},
+		{lockouts: 11, want: 1024 * time.Minute},
+		{lockouts: 12, want: 24 * time.Hour},
+		{lockouts: 100, want: 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := lockoutDuration(tt.lockouts, time.Minute, 24*time.Hour); got != tt.want {
+			t.Errorf("lockoutDuration(%d) = %s, want %s", tt.lockouts, got, tt.want)
+		}
+	}
+}
+
+func TestNextLockouts(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		attempt repository.AuthAttempt
+		want    int
+	}{
+		{name: "first lockout", attempt: repository.AuthAttempt{}, want: 1},
+		{
+			name: "recent lockout",
+			attempt: repository.AuthAttempt{
+				Lockouts:    2,
+				LockedUntil: sql.NullTime{Time: now.Add(-time.Hour), Valid: true},
+			},
+			want: 3,
+		},
+		{
+			name: "lockout ended long ago",
+			attempt: repository.AuthAttempt{
+				Lockouts:    5,
+				LockedUntil: sql.NullTime{Time: now.Add(-lockoutResetPeriod - time.Minute), Valid: true},
+			},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextLockouts(tt.attempt, now); got != tt.want {
+				t.Errorf("nextLockouts() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/signedtoken"
+	"github.com/SatorNetwork/sator-api/lib/totp"
+	"github.com/SatorNetwork/sator-api/svc/auth/repository"
+)
+
+// Default magic link settings
+const (
+	defaultMagicLinkTTL        = 15 * time.Minute
+	defaultMagicLinkEmailLimit = 5  // links per email within the rate limit window
+	defaultMagicLinkIPLimit    = 20 // links per client ip within the rate limit window
+	magicLinkRateLimitWindow   = time.Hour
+	magicLinkTokenPurpose      = "magic_link" // signed along with the link id of magic link tokens
+)
+
+// RequestMagicLink emails a single-use link to log in without password.
+// The link is bound to the device it was requested from.
+// Unknown and disabled accounts get no email, but the request succeeds,
+// so the endpoint can't be used to find out registered emails.
+func (s *Service) RequestMagicLink(ctx context.Context, email, deviceID string) error {
+	if s.magicLinkSecret == "" {
+		return ErrMagicLinkUnavailable
+	}
+	if deviceID == "" && !s.skipDeviceIDCheck {
+		return ErrEmptyDeviceID
+	}
+
+	email = strings.ToLower(strings.TrimSpace(email))
+	ip, _ := clientFromContext(ctx)
+	if err := s.checkMagicLinkRateLimits(ctx, email, ip); err != nil {
+		return err
+	}
+
+	u, err := s.ur.GetUserByEmail(ctx, email)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get user: %w", err)
+	}
+	if u.Disabled {
+		return nil
+	}
+
+	linkID := uuid.New()
+	if err := s.ur.AddUserMagicLink(ctx, repository.AddUserMagicLinkParams{
+		ID:        linkID,
+		UserID:    u.ID,
+		Email:     email,
+		DeviceID:  deviceID,
+		Ip:        ip,
+		ExpiresAt: time.Now().Add(s.magicLinkTTL),
+	}); err != nil {
+		return fmt.Errorf("could not store magic link: %w", err)
+	}
+
+	link, err := magicLink(s.magicLinkURL, signedtoken.NewID(s.magicLinkSecret, magicLinkTokenPurpose, linkID))
+	if err != nil {
+		return err
+	}
+
+	if s.mail != nil {
+		if err := s.mail.SendMagicLink(ctx, email, link); err != nil {
+			return fmt.Errorf("could not send magic link: %w", err)
+		}
+	} else {
+		log.Println("mail service is not set, magic link email is skipped")
+	}
+
+	return nil
+}
+
+// LoginWithMagicLink logs in the user by the token of the magic link, returns token.
+// A link opened on another device than it was requested from works
+// only after logging in on this device is approved on the device the link was requested from.
+func (s *Service) LoginWithMagicLink(ctx context.Context, token, deviceID string) (Token, error) {
+	if s.magicLinkSecret == "" {
+		return Token{}, ErrMagicLinkUnavailable
+	}
+	if deviceID == "" && !s.skipDeviceIDCheck {
+		return Token{}, ErrEmptyDeviceID
+	}
+
+	linkID, ok := signedtoken.VerifyID(s.magicLinkSecret, magicLinkTokenPurpose, token)
+	if !ok {
+		return Token{}, ErrInvalidMagicLink
+	}
+
+	ml, err := s.ur.GetUserMagicLinkByID(ctx, linkID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Token{}, ErrInvalidMagicLink
+		}
+		return Token{}, fmt.Errorf("could not get magic link: %w", err)
+	}
+	if ml.UsedAt.Valid {
+		return Token{}, ErrInvalidMagicLink
+	}
+	if !ml.ExpiresAt.After(time.Now()) {
+		return Token{}, ErrMagicLinkExpired
+	}
+	if ml.DeviceID != deviceID {
+		if err := s.checkMagicLinkApproval(ctx, ml, deviceID); err != nil {
+			return Token{}, err
+		}
+	}
+
+	u, err := s.ur.GetUserByID(ctx, ml.UserID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Token{}, ErrInvalidMagicLink
+		}
+		return Token{}, fmt.Errorf("could not get user: %w", err)
+	}
+	if u.Disabled || s.isEmailRestricted(ctx, u.Email, u.SanitizedEmail.String) {
+		return Token{}, ErrUserIsDisabled
+	}
+	// the link is sent to the email of the account, so it's no longer valid once the email is changed
+	if u.Email != ml.Email {
+		return Token{}, ErrInvalidMagicLink
+	}
+
+	if err := s.verifyTwoFactor(ctx, u.ID, totp.ActionLogin); err != nil {
+		return Token{}, err
+	}
+
+	used, err := s.ur.UseUserMagicLink(ctx, ml.ID)
+	if err != nil {
+		return Token{}, fmt.Errorf("could not use magic link: %w", err)
+	}
+	if used == 0 {
+		return Token{}, ErrInvalidMagicLink
+	}
+
+	s.linkDevice(ctx, u.ID, deviceID)
+
+	t, err := s.startSession(ctx, u, deviceID)
+	if err != nil {
+		return Token{}, err
+	}
+
+	s.trackLogin(ctx, u.ID)
+
+	return t, nil
+}
+
+// ApproveMagicLink approves logging in with the magic link opened on another device.
+// It's called from the device the link was requested from.
+func (s *Service) ApproveMagicLink(ctx context.Context, email, deviceID string) error {
+	if s.magicLinkSecret == "" {
+		return ErrMagicLinkUnavailable
+	}
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+
+	approved, err := s.ur.ApproveUserMagicLink(ctx, repository.ApproveUserMagicLinkParams{
+		Email:    strings.ToLower(strings.TrimSpace(email)),
+		DeviceID: deviceID,
+	})
+	if err != nil {
+		return fmt.Errorf("could not approve magic link: %w", err)
+	}
+	if approved == 0 {
+		return fmt.Errorf("%w: no login link is waiting for approval", ErrNotFound)
+	}
+
+	return nil
+}
+
+// checkMagicLinkApproval returns nil if logging in with the link on the device has been approved,
+// otherwise the approval is requested and ErrMagicLinkApprovalRequired is returned.
+// Only the first device which opened the link could be approved.
+func (s *Service) checkMagicLinkApproval(ctx context.Context, ml repository.UserMagicLink, deviceID string) error {
+	if ml.ApprovedAt.Valid && ml.ApprovalDeviceID == deviceID {
+		return nil
+	}
+
+	requested, err := s.ur.RequestUserMagicLinkApproval(ctx, repository.RequestUserMagicLinkApprovalParams{
+		ApprovalDeviceID: deviceID,
+		ID:               ml.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("could not request magic link approval: %w", err)
+	}
+	if requested == 0 {
+		return ErrInvalidMagicLink
+	}
+
+	return ErrMagicLinkApprovalRequired
+}
+
+// checkMagicLinkRateLimits returns ErrTooManyRequests if too many links
+// were requested for the email or from the client ip within the rate limit window.
+func (s *Service) checkMagicLinkRateLimits(ctx context.Context, email, ip string) error {
+	since := time.Now().Add(-magicLinkRateLimitWindow)
+
+	n, err := s.ur.CountUserMagicLinksByEmail(ctx, repository.CountUserMagicLinksByEmailParams{
+		Email:        email,
+		CreatedAfter: since,
+	})
+	if err != nil {
+		return fmt.Errorf("could not count magic links: %w", err)
+	}
+	if n >= int64(s.magicLinkEmailLimit) {
+		return ErrTooManyRequests
+	}
+
+	if ip == "" {
+		return nil
+	}
+
+	n, err = s.ur.CountUserMagicLinksByIP(ctx, repository.CountUserMagicLinksByIPParams{
+		Ip:           ip,
+		CreatedAfter: since,
+	})
+	if err != nil {
+		return fmt.Errorf("could not count magic links: %w", err)
+	}
+	if n >= int64(s.magicLinkIPLimit) {
+		return ErrTooManyRequests
+	}
+
+	return nil
+}
+
+// magicLink appends the magic link token to the base magic link URL
+func magicLink(baseURL, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("could not parse magic link url: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,13 @@
+package auth
+
+import "testing"
+
+func TestMagicLink(t *testing.T) {
+	got, err := magicLink("https://sator.io/magic-link?lang=en", "abc.def")
+	if err != nil {
+		t.Fatalf("magicLink() error = %v", err)
+	}
+	if want := "https://sator.io/magic-link?lang=en&token=abc.def"; got != want {
+		t.Errorf("magicLink() = %s, want %s", got, want)
+	}
+}
@@ -24,12 +24,42 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.addFailedAuthAttemptStmt, err = db.PrepareContext(ctx, addFailedAuthAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query AddFailedAuthAttempt: %w", err)
+	}
+	if q.addIDTokenNonceStmt, err = db.PrepareContext(ctx, addIDTokenNonce); err != nil {
+		return nil, fmt.Errorf("error preparing query AddIDTokenNonce: %w", err)
+	}
+	if q.addJWTSigningKeyStmt, err = db.PrepareContext(ctx, addJWTSigningKey); err != nil {
+		return nil, fmt.Errorf("error preparing query AddJWTSigningKey: %w", err)
+	}
+	if q.addSolanaSignInNonceStmt, err = db.PrepareContext(ctx, addSolanaSignInNonce); err != nil {
+		return nil, fmt.Errorf("error preparing query AddSolanaSignInNonce: %w", err)
+	}
 	if q.addToBlacklistStmt, err = db.PrepareContext(ctx, addToBlacklist); err != nil {
 		return nil, fmt.Errorf("error preparing query AddToBlacklist: %w", err)
 	}
 	if q.addToWhitelistStmt, err = db.PrepareContext(ctx, addToWhitelist); err != nil {
 		return nil, fmt.Errorf("error preparing query AddToWhitelist: %w", err)
 	}
+	if q.addUserIdentityStmt, err = db.PrepareContext(ctx, addUserIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query AddUserIdentity: %w", err)
+	}
+	if q.addUserMagicLinkStmt, err = db.PrepareContext(ctx, addUserMagicLink); err != nil {
+		return nil, fmt.Errorf("error preparing query AddUserMagicLink: %w", err)
+	}
+	if q.addUserSessionStmt, err = db.PrepareContext(ctx, addUserSession); err != nil {
+		return nil, fmt.Errorf("error preparing query AddUserSession: %w", err)
+	}
+	if q.addUserTOTPRecoveryCodeStmt, err = db.PrepareContext(ctx, addUserTOTPRecoveryCode); err != nil {
+		return nil, fmt.Errorf("error preparing query AddUserTOTPRecoveryCode: %w", err)
+	}
+	if q.anonymizeUserSessionsStmt, err = db.PrepareContext(ctx, anonymizeUserSessions); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymizeUserSessions: %w", err)
+	}
+	if q.approveUserMagicLinkStmt, err = db.PrepareContext(ctx, approveUserMagicLink); err != nil {
+		return nil, fmt.Errorf("error preparing query ApproveUserMagicLink: %w", err)
+	}
 	if q.blockUsersOnTheSameDeviceStmt, err = db.PrepareContext(ctx, blockUsersOnTheSameDevice); err != nil {
 		return nil, fmt.Errorf("error preparing query BlockUsersOnTheSameDevice: %w", err)
 	}
@@ -39,12 +69,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.countAllUsersStmt, err = db.PrepareContext(ctx, countAllUsers); err != nil {
 		return nil, fmt.Errorf("error preparing query CountAllUsers: %w", err)
 	}
+	if q.countIDTokenNoncesByIPStmt, err = db.PrepareContext(ctx, countIDTokenNoncesByIP); err != nil {
+		return nil, fmt.Errorf("error preparing query CountIDTokenNoncesByIP: %w", err)
+	}
+	if q.countSolanaSignInNoncesByAddressStmt, err = db.PrepareContext(ctx, countSolanaSignInNoncesByAddress); err != nil {
+		return nil, fmt.Errorf("error preparing query CountSolanaSignInNoncesByAddress: %w", err)
+	}
+	if q.countSolanaSignInNoncesByIPStmt, err = db.PrepareContext(ctx, countSolanaSignInNoncesByIP); err != nil {
+		return nil, fmt.Errorf("error preparing query CountSolanaSignInNoncesByIP: %w", err)
+	}
+	if q.countUnusedUserTOTPRecoveryCodesStmt, err = db.PrepareContext(ctx, countUnusedUserTOTPRecoveryCodes); err != nil {
+		return nil, fmt.Errorf("error preparing query CountUnusedUserTOTPRecoveryCodes: %w", err)
+	}
+	if q.countUserMagicLinksByEmailStmt, err = db.PrepareContext(ctx, countUserMagicLinksByEmail); err != nil {
+		return nil, fmt.Errorf("error preparing query CountUserMagicLinksByEmail: %w", err)
+	}
+	if q.countUserMagicLinksByIPStmt, err = db.PrepareContext(ctx, countUserMagicLinksByIP); err != nil {
+		return nil, fmt.Errorf("error preparing query CountUserMagicLinksByIP: %w", err)
+	}
 	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
 	}
 	if q.createUserVerificationStmt, err = db.PrepareContext(ctx, createUserVerification); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUserVerification: %w", err)
 	}
+	if q.deleteAllUserVerificationsByUserIDStmt, err = db.PrepareContext(ctx, deleteAllUserVerificationsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAllUserVerificationsByUserID: %w", err)
+	}
+	if q.deleteAuthAttemptsStmt, err = db.PrepareContext(ctx, deleteAuthAttempts); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAuthAttempts: %w", err)
+	}
+	if q.deleteAuthAttemptsBySubjectStmt, err = db.PrepareContext(ctx, deleteAuthAttemptsBySubject); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAuthAttemptsBySubject: %w", err)
+	}
 	if q.deleteFromBlacklistStmt, err = db.PrepareContext(ctx, deleteFromBlacklist); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteFromBlacklist: %w", err)
 	}
@@ -54,6 +111,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteUserByIDStmt, err = db.PrepareContext(ctx, deleteUserByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteUserByID: %w", err)
 	}
+	if q.deleteUserDevicesByUserIDStmt, err = db.PrepareContext(ctx, deleteUserDevicesByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUserDevicesByUserID: %w", err)
+	}
+	if q.deleteUserIdentitiesByUserIDStmt, err = db.PrepareContext(ctx, deleteUserIdentitiesByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUserIdentitiesByUserID: %w", err)
+	}
+	if q.deleteUserIdentityStmt, err = db.PrepareContext(ctx, deleteUserIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUserIdentity: %w", err)
+	}
+	if q.deleteUserMagicLinksByUserIDStmt, err = db.PrepareContext(ctx, deleteUserMagicLinksByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUserMagicLinksByUserID: %w", err)
+	}
+	if q.deleteUserTOTPStmt, err = db.PrepareContext(ctx, deleteUserTOTP); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUserTOTP: %w", err)
+	}
+	if q.deleteUserTOTPRecoveryCodesStmt, err = db.PrepareContext(ctx, deleteUserTOTPRecoveryCodes); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteUserTOTPRecoveryCodes: %w", err)
+	}
 	if q.deleteUserVerificationsByEmailStmt, err = db.PrepareContext(ctx, deleteUserVerificationsByEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteUserVerificationsByEmail: %w", err)
 	}
@@ -63,15 +138,30 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.destroyUserStmt, err = db.PrepareContext(ctx, destroyUser); err != nil {
 		return nil, fmt.Errorf("error preparing query DestroyUser: %w", err)
 	}
+	if q.doUsersShareDeviceStmt, err = db.PrepareContext(ctx, doUsersShareDevice); err != nil {
+		return nil, fmt.Errorf("error preparing query DoUsersShareDevice: %w", err)
+	}
 	if q.doesUserHaveMoreThanOneAccountStmt, err = db.PrepareContext(ctx, doesUserHaveMoreThanOneAccount); err != nil {
 		return nil, fmt.Errorf("error preparing query DoesUserHaveMoreThanOneAccount: %w", err)
 	}
+	if q.enableUserTOTPStmt, err = db.PrepareContext(ctx, enableUserTOTP); err != nil {
+		return nil, fmt.Errorf("error preparing query EnableUserTOTP: %w", err)
+	}
+	if q.getActiveUserSessionsStmt, err = db.PrepareContext(ctx, getActiveUserSessions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetActiveUserSessions: %w", err)
+	}
+	if q.getAuthAttemptStmt, err = db.PrepareContext(ctx, getAuthAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAuthAttempt: %w", err)
+	}
 	if q.getBlacklistStmt, err = db.PrepareContext(ctx, getBlacklist); err != nil {
 		return nil, fmt.Errorf("error preparing query GetBlacklist: %w", err)
 	}
 	if q.getBlacklistByRestrictedValueStmt, err = db.PrepareContext(ctx, getBlacklistByRestrictedValue); err != nil {
 		return nil, fmt.Errorf("error preparing query GetBlacklistByRestrictedValue: %w", err)
 	}
+	if q.getJWTSigningKeysStmt, err = db.PrepareContext(ctx, getJWTSigningKeys); err != nil {
+		return nil, fmt.Errorf("error preparing query GetJWTSigningKeys: %w", err)
+	}
 	if q.getKYCStatusStmt, err = db.PrepareContext(ctx, getKYCStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query GetKYCStatus: %w", err)
 	}
@@ -81,6 +171,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getPublicKeyStmt, err = db.PrepareContext(ctx, getPublicKey); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPublicKey: %w", err)
 	}
+	if q.getRevokedUserSessionsStmt, err = db.PrepareContext(ctx, getRevokedUserSessions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRevokedUserSessions: %w", err)
+	}
+	if q.getSolanaSignInNonceStmt, err = db.PrepareContext(ctx, getSolanaSignInNonce); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSolanaSignInNonce: %w", err)
+	}
 	if q.getUserByEmailStmt, err = db.PrepareContext(ctx, getUserByEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByEmail: %w", err)
 	}
@@ -96,6 +192,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getUserIDsOnTheSameDeviceStmt, err = db.PrepareContext(ctx, getUserIDsOnTheSameDevice); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserIDsOnTheSameDevice: %w", err)
 	}
+	if q.getUserIdentitiesByUserIDStmt, err = db.PrepareContext(ctx, getUserIdentitiesByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserIdentitiesByUserID: %w", err)
+	}
+	if q.getUserIdentityStmt, err = db.PrepareContext(ctx, getUserIdentity); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserIdentity: %w", err)
+	}
+	if q.getUserMagicLinkByIDStmt, err = db.PrepareContext(ctx, getUserMagicLinkByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserMagicLinkByID: %w", err)
+	}
+	if q.getUserSessionByIDStmt, err = db.PrepareContext(ctx, getUserSessionByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserSessionByID: %w", err)
+	}
+	if q.getUserTOTPStmt, err = db.PrepareContext(ctx, getUserTOTP); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserTOTP: %w", err)
+	}
 	if q.getUserVerificationByEmailStmt, err = db.PrepareContext(ctx, getUserVerificationByEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserVerificationByEmail: %w", err)
 	}
@@ -129,6 +240,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.linkDeviceToUserStmt, err = db.PrepareContext(ctx, linkDeviceToUser); err != nil {
 		return nil, fmt.Errorf("error preparing query LinkDeviceToUser: %w", err)
 	}
+	if q.lockAuthAttemptsStmt, err = db.PrepareContext(ctx, lockAuthAttempts); err != nil {
+		return nil, fmt.Errorf("error preparing query LockAuthAttempts: %w", err)
+	}
+	if q.requestUserMagicLinkApprovalStmt, err = db.PrepareContext(ctx, requestUserMagicLinkApproval); err != nil {
+		return nil, fmt.Errorf("error preparing query RequestUserMagicLinkApproval: %w", err)
+	}
+	if q.revokeUserSessionStmt, err = db.PrepareContext(ctx, revokeUserSession); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeUserSession: %w", err)
+	}
+	if q.revokeUserSessionsStmt, err = db.PrepareContext(ctx, revokeUserSessions); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeUserSessions: %w", err)
+	}
+	if q.rotateUserSessionRefreshTokenStmt, err = db.PrepareContext(ctx, rotateUserSessionRefreshToken); err != nil {
+		return nil, fmt.Errorf("error preparing query RotateUserSessionRefreshToken: %w", err)
+	}
 	if q.updateKYCStatusStmt, err = db.PrepareContext(ctx, updateKYCStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateKYCStatus: %w", err)
 	}
@@ -147,20 +273,64 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateUserSanitizedEmailStmt, err = db.PrepareContext(ctx, updateUserSanitizedEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUserSanitizedEmail: %w", err)
 	}
+	if q.updateUserSessionLastSeenStmt, err = db.PrepareContext(ctx, updateUserSessionLastSeen); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUserSessionLastSeen: %w", err)
+	}
 	if q.updateUserStatusStmt, err = db.PrepareContext(ctx, updateUserStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUserStatus: %w", err)
 	}
+	if q.updateUserTOTPLastUsedStepStmt, err = db.PrepareContext(ctx, updateUserTOTPLastUsedStep); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUserTOTPLastUsedStep: %w", err)
+	}
+	if q.updateUserTOTPRequiredActionsStmt, err = db.PrepareContext(ctx, updateUserTOTPRequiredActions); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUserTOTPRequiredActions: %w", err)
+	}
 	if q.updateUserVerifiedAtStmt, err = db.PrepareContext(ctx, updateUserVerifiedAt); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUserVerifiedAt: %w", err)
 	}
 	if q.updateUsernameStmt, err = db.PrepareContext(ctx, updateUsername); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUsername: %w", err)
 	}
+	if q.upsertUserTOTPStmt, err = db.PrepareContext(ctx, upsertUserTOTP); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertUserTOTP: %w", err)
+	}
+	if q.useIDTokenNonceStmt, err = db.PrepareContext(ctx, useIDTokenNonce); err != nil {
+		return nil, fmt.Errorf("error preparing query UseIDTokenNonce: %w", err)
+	}
+	if q.useSolanaSignInNonceStmt, err = db.PrepareContext(ctx, useSolanaSignInNonce); err != nil {
+		return nil, fmt.Errorf("error preparing query UseSolanaSignInNonce: %w", err)
+	}
+	if q.useUserMagicLinkStmt, err = db.PrepareContext(ctx, useUserMagicLink); err != nil {
+		return nil, fmt.Errorf("error preparing query UseUserMagicLink: %w", err)
+	}
+	if q.useUserTOTPRecoveryCodeStmt, err = db.PrepareContext(ctx, useUserTOTPRecoveryCode); err != nil {
+		return nil, fmt.Errorf("error preparing query UseUserTOTPRecoveryCode: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.addFailedAuthAttemptStmt != nil {
+		if cerr := q.addFailedAuthAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addFailedAuthAttemptStmt: %w", cerr)
+		}
+	}
+	if q.addIDTokenNonceStmt != nil {
+		if cerr := q.addIDTokenNonceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addIDTokenNonceStmt: %w", cerr)
+		}
+	}
+	if q.addJWTSigningKeyStmt != nil {
+		if cerr := q.addJWTSigningKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addJWTSigningKeyStmt: %w", cerr)
+		}
+	}
+	if q.addSolanaSignInNonceStmt != nil {
+		if cerr := q.addSolanaSignInNonceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addSolanaSignInNonceStmt: %w", cerr)
+		}
+	}
 	if q.addToBlacklistStmt != nil {
 		if cerr := q.addToBlacklistStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addToBlacklistStmt: %w", cerr)
@@ -171,6 +341,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addToWhitelistStmt: %w", cerr)
 		}
 	}
+	if q.addUserIdentityStmt != nil {
+		if cerr := q.addUserIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addUserIdentityStmt: %w", cerr)
+		}
+	}
+	if q.addUserMagicLinkStmt != nil {
+		if cerr := q.addUserMagicLinkStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addUserMagicLinkStmt: %w", cerr)
+		}
+	}
+	if q.addUserSessionStmt != nil {
+		if cerr := q.addUserSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addUserSessionStmt: %w", cerr)
+		}
+	}
+	if q.addUserTOTPRecoveryCodeStmt != nil {
+		if cerr := q.addUserTOTPRecoveryCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addUserTOTPRecoveryCodeStmt: %w", cerr)
+		}
+	}
+	if q.anonymizeUserSessionsStmt != nil {
+		if cerr := q.anonymizeUserSessionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymizeUserSessionsStmt: %w", cerr)
+		}
+	}
+	if q.approveUserMagicLinkStmt != nil {
+		if cerr := q.approveUserMagicLinkStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing approveUserMagicLinkStmt: %w", cerr)
+		}
+	}
 	if q.blockUsersOnTheSameDeviceStmt != nil {
 		if cerr := q.blockUsersOnTheSameDeviceStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing blockUsersOnTheSameDeviceStmt: %w", cerr)
@@ -186,6 +386,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countAllUsersStmt: %w", cerr)
 		}
 	}
+	if q.countIDTokenNoncesByIPStmt != nil {
+		if cerr := q.countIDTokenNoncesByIPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countIDTokenNoncesByIPStmt: %w", cerr)
+		}
+	}
+	if q.countSolanaSignInNoncesByAddressStmt != nil {
+		if cerr := q.countSolanaSignInNoncesByAddressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countSolanaSignInNoncesByAddressStmt: %w", cerr)
+		}
+	}
+	if q.countSolanaSignInNoncesByIPStmt != nil {
+		if cerr := q.countSolanaSignInNoncesByIPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countSolanaSignInNoncesByIPStmt: %w", cerr)
+		}
+	}
+	if q.countUnusedUserTOTPRecoveryCodesStmt != nil {
+		if cerr := q.countUnusedUserTOTPRecoveryCodesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countUnusedUserTOTPRecoveryCodesStmt: %w", cerr)
+		}
+	}
+	if q.countUserMagicLinksByEmailStmt != nil {
+		if cerr := q.countUserMagicLinksByEmailStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countUserMagicLinksByEmailStmt: %w", cerr)
+		}
+	}
+	if q.countUserMagicLinksByIPStmt != nil {
+		if cerr := q.countUserMagicLinksByIPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countUserMagicLinksByIPStmt: %w", cerr)
+		}
+	}
 	if q.createUserStmt != nil {
 		if cerr := q.createUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
@@ -196,6 +426,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createUserVerificationStmt: %w", cerr)
 		}
 	}
+	if q.deleteAllUserVerificationsByUserIDStmt != nil {
+		if cerr := q.deleteAllUserVerificationsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAllUserVerificationsByUserIDStmt: %w", cerr)
+		}
+	}
+	if q.deleteAuthAttemptsStmt != nil {
+		if cerr := q.deleteAuthAttemptsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAuthAttemptsStmt: %w", cerr)
+		}
+	}
+	if q.deleteAuthAttemptsBySubjectStmt != nil {
+		if cerr := q.deleteAuthAttemptsBySubjectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAuthAttemptsBySubjectStmt: %w", cerr)
+		}
+	}
 	if q.deleteFromBlacklistStmt != nil {
 		if cerr := q.deleteFromBlacklistStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteFromBlacklistStmt: %w", cerr)
@@ -211,6 +456,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteUserByIDStmt: %w", cerr)
 		}
 	}
+	if q.deleteUserDevicesByUserIDStmt != nil {
+		if cerr := q.deleteUserDevicesByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUserDevicesByUserIDStmt: %w", cerr)
+		}
+	}
+	if q.deleteUserIdentitiesByUserIDStmt != nil {
+		if cerr := q.deleteUserIdentitiesByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUserIdentitiesByUserIDStmt: %w", cerr)
+		}
+	}
+	if q.deleteUserIdentityStmt != nil {
+		if cerr := q.deleteUserIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUserIdentityStmt: %w", cerr)
+		}
+	}
+	if q.deleteUserMagicLinksByUserIDStmt != nil {
+		if cerr := q.deleteUserMagicLinksByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUserMagicLinksByUserIDStmt: %w", cerr)
+		}
+	}
+	if q.deleteUserTOTPStmt != nil {
+		if cerr := q.deleteUserTOTPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUserTOTPStmt: %w", cerr)
+		}
+	}
+	if q.deleteUserTOTPRecoveryCodesStmt != nil {
+		if cerr := q.deleteUserTOTPRecoveryCodesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteUserTOTPRecoveryCodesStmt: %w", cerr)
+		}
+	}
 	if q.deleteUserVerificationsByEmailStmt != nil {
 		if cerr := q.deleteUserVerificationsByEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteUserVerificationsByEmailStmt: %w", cerr)
@@ -226,11 +501,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing destroyUserStmt: %w", cerr)
 		}
 	}
+	if q.doUsersShareDeviceStmt != nil {
+		if cerr := q.doUsersShareDeviceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing doUsersShareDeviceStmt: %w", cerr)
+		}
+	}
 	if q.doesUserHaveMoreThanOneAccountStmt != nil {
 		if cerr := q.doesUserHaveMoreThanOneAccountStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing doesUserHaveMoreThanOneAccountStmt: %w", cerr)
 		}
 	}
+	if q.enableUserTOTPStmt != nil {
+		if cerr := q.enableUserTOTPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing enableUserTOTPStmt: %w", cerr)
+		}
+	}
+	if q.getActiveUserSessionsStmt != nil {
+		if cerr := q.getActiveUserSessionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getActiveUserSessionsStmt: %w", cerr)
+		}
+	}
+	if q.getAuthAttemptStmt != nil {
+		if cerr := q.getAuthAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAuthAttemptStmt: %w", cerr)
+		}
+	}
 	if q.getBlacklistStmt != nil {
 		if cerr := q.getBlacklistStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getBlacklistStmt: %w", cerr)
@@ -241,6 +536,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getBlacklistByRestrictedValueStmt: %w", cerr)
 		}
 	}
+	if q.getJWTSigningKeysStmt != nil {
+		if cerr := q.getJWTSigningKeysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getJWTSigningKeysStmt: %w", cerr)
+		}
+	}
 	if q.getKYCStatusStmt != nil {
 		if cerr := q.getKYCStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getKYCStatusStmt: %w", cerr)
@@ -256,6 +556,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getPublicKeyStmt: %w", cerr)
 		}
 	}
+	if q.getRevokedUserSessionsStmt != nil {
+		if cerr := q.getRevokedUserSessionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRevokedUserSessionsStmt: %w", cerr)
+		}
+	}
+	if q.getSolanaSignInNonceStmt != nil {
+		if cerr := q.getSolanaSignInNonceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSolanaSignInNonceStmt: %w", cerr)
+		}
+	}
 	if q.getUserByEmailStmt != nil {
 		if cerr := q.getUserByEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserByEmailStmt: %w", cerr)
@@ -281,6 +591,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserIDsOnTheSameDeviceStmt: %w", cerr)
 		}
 	}
+	if q.getUserIdentitiesByUserIDStmt != nil {
+		if cerr := q.getUserIdentitiesByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserIdentitiesByUserIDStmt: %w", cerr)
+		}
+	}
+	if q.getUserIdentityStmt != nil {
+		if cerr := q.getUserIdentityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserIdentityStmt: %w", cerr)
+		}
+	}
+	if q.getUserMagicLinkByIDStmt != nil {
+		if cerr := q.getUserMagicLinkByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserMagicLinkByIDStmt: %w", cerr)
+		}
+	}
+	if q.getUserSessionByIDStmt != nil {
+		if cerr := q.getUserSessionByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserSessionByIDStmt: %w", cerr)
+		}
+	}
+	if q.getUserTOTPStmt != nil {
+		if cerr := q.getUserTOTPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserTOTPStmt: %w", cerr)
+		}
+	}
 	if q.getUserVerificationByEmailStmt != nil {
 		if cerr := q.getUserVerificationByEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserVerificationByEmailStmt: %w", cerr)
@@ -336,6 +671,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing linkDeviceToUserStmt: %w", cerr)
 		}
 	}
+	if q.lockAuthAttemptsStmt != nil {
+		if cerr := q.lockAuthAttemptsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockAuthAttemptsStmt: %w", cerr)
+		}
+	}
+	if q.requestUserMagicLinkApprovalStmt != nil {
+		if cerr := q.requestUserMagicLinkApprovalStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing requestUserMagicLinkApprovalStmt: %w", cerr)
+		}
+	}
+	if q.revokeUserSessionStmt != nil {
+		if cerr := q.revokeUserSessionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeUserSessionStmt: %w", cerr)
+		}
+	}
+	if q.revokeUserSessionsStmt != nil {
+		if cerr := q.revokeUserSessionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeUserSessionsStmt: %w", cerr)
+		}
+	}
+	if q.rotateUserSessionRefreshTokenStmt != nil {
+		if cerr := q.rotateUserSessionRefreshTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing rotateUserSessionRefreshTokenStmt: %w", cerr)
+		}
+	}
 	if q.updateKYCStatusStmt != nil {
 		if cerr := q.updateKYCStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateKYCStatusStmt: %w", cerr)
@@ -366,11 +726,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateUserSanitizedEmailStmt: %w", cerr)
 		}
 	}
+	if q.updateUserSessionLastSeenStmt != nil {
+		if cerr := q.updateUserSessionLastSeenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserSessionLastSeenStmt: %w", cerr)
+		}
+	}
 	if q.updateUserStatusStmt != nil {
 		if cerr := q.updateUserStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateUserStatusStmt: %w", cerr)
 		}
 	}
+	if q.updateUserTOTPLastUsedStepStmt != nil {
+		if cerr := q.updateUserTOTPLastUsedStepStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserTOTPLastUsedStepStmt: %w", cerr)
+		}
+	}
+	if q.updateUserTOTPRequiredActionsStmt != nil {
+		if cerr := q.updateUserTOTPRequiredActionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserTOTPRequiredActionsStmt: %w", cerr)
+		}
+	}
 	if q.updateUserVerifiedAtStmt != nil {
 		if cerr := q.updateUserVerifiedAtStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateUserVerifiedAtStmt: %w", cerr)
@@ -381,6 +756,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateUsernameStmt: %w", cerr)
 		}
 	}
+	if q.upsertUserTOTPStmt != nil {
+		if cerr := q.upsertUserTOTPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertUserTOTPStmt: %w", cerr)
+		}
+	}
+	if q.useIDTokenNonceStmt != nil {
+		if cerr := q.useIDTokenNonceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useIDTokenNonceStmt: %w", cerr)
+		}
+	}
+	if q.useSolanaSignInNonceStmt != nil {
+		if cerr := q.useSolanaSignInNonceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useSolanaSignInNonceStmt: %w", cerr)
+		}
+	}
+	if q.useUserMagicLinkStmt != nil {
+		if cerr := q.useUserMagicLinkStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useUserMagicLinkStmt: %w", cerr)
+		}
+	}
+	if q.useUserTOTPRecoveryCodeStmt != nil {
+		if cerr := q.useUserTOTPRecoveryCodeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing useUserTOTPRecoveryCodeStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -418,101 +818,201 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                                  DBTX
-	tx                                  *sql.Tx
-	addToBlacklistStmt                  *sql.Stmt
-	addToWhitelistStmt                  *sql.Stmt
-	blockUsersOnTheSameDeviceStmt       *sql.Stmt
-	blockUsersWithDuplicateEmailStmt    *sql.Stmt
-	countAllUsersStmt                   *sql.Stmt
-	createUserStmt                      *sql.Stmt
-	createUserVerificationStmt          *sql.Stmt
-	deleteFromBlacklistStmt             *sql.Stmt
-	deleteFromWhitelistStmt             *sql.Stmt
-	deleteUserByIDStmt                  *sql.Stmt
-	deleteUserVerificationsByEmailStmt  *sql.Stmt
-	deleteUserVerificationsByUserIDStmt *sql.Stmt
-	destroyUserStmt                     *sql.Stmt
-	doesUserHaveMoreThanOneAccountStmt  *sql.Stmt
-	getBlacklistStmt                    *sql.Stmt
-	getBlacklistByRestrictedValueStmt   *sql.Stmt
-	getKYCStatusStmt                    *sql.Stmt
-	getNotSanitizedUsersListDescStmt    *sql.Stmt
-	getPublicKeyStmt                    *sql.Stmt
-	getUserByEmailStmt                  *sql.Stmt
-	getUserByIDStmt                     *sql.Stmt
-	getUserBySanitizedEmailStmt         *sql.Stmt
-	getUserByUsernameStmt               *sql.Stmt
-	getUserIDsOnTheSameDeviceStmt       *sql.Stmt
-	getUserVerificationByEmailStmt      *sql.Stmt
-	getUserVerificationByUserIDStmt     *sql.Stmt
-	getUsernameByIDStmt                 *sql.Stmt
-	getUsersListDescStmt                *sql.Stmt
-	getVerifiedUsersListDescStmt        *sql.Stmt
-	getWhitelistStmt                    *sql.Stmt
-	getWhitelistByAllowedValueStmt      *sql.Stmt
-	isEmailBlacklistedStmt              *sql.Stmt
-	isEmailWhitelistedStmt              *sql.Stmt
-	isUserDisabledStmt                  *sql.Stmt
-	linkDeviceToUserStmt                *sql.Stmt
-	updateKYCStatusStmt                 *sql.Stmt
-	updatePublicKeyStmt                 *sql.Stmt
-	updateUserEmailStmt                 *sql.Stmt
-	updateUserPasswordStmt              *sql.Stmt
-	updateUserRoleStmt                  *sql.Stmt
-	updateUserSanitizedEmailStmt        *sql.Stmt
-	updateUserStatusStmt                *sql.Stmt
-	updateUserVerifiedAtStmt            *sql.Stmt
-	updateUsernameStmt                  *sql.Stmt
+	db                                     DBTX
+	tx                                     *sql.Tx
+	addFailedAuthAttemptStmt               *sql.Stmt
+	addIDTokenNonceStmt                    *sql.Stmt
+	addJWTSigningKeyStmt                   *sql.Stmt
+	addSolanaSignInNonceStmt               *sql.Stmt
+	addToBlacklistStmt                     *sql.Stmt
+	addToWhitelistStmt                     *sql.Stmt
+	addUserIdentityStmt                    *sql.Stmt
+	addUserMagicLinkStmt                   *sql.Stmt
+	addUserSessionStmt                     *sql.Stmt
+	addUserTOTPRecoveryCodeStmt            *sql.Stmt
+	anonymizeUserSessionsStmt              *sql.Stmt
+	approveUserMagicLinkStmt               *sql.Stmt
+	blockUsersOnTheSameDeviceStmt          *sql.Stmt
+	blockUsersWithDuplicateEmailStmt       *sql.Stmt
+	countAllUsersStmt                      *sql.Stmt
+	countIDTokenNoncesByIPStmt             *sql.Stmt
+	countSolanaSignInNoncesByAddressStmt   *sql.Stmt
+	countSolanaSignInNoncesByIPStmt        *sql.Stmt
+	countUnusedUserTOTPRecoveryCodesStmt   *sql.Stmt
+	countUserMagicLinksByEmailStmt         *sql.Stmt
+	countUserMagicLinksByIPStmt            *sql.Stmt
+	createUserStmt                         *sql.Stmt
+	createUserVerificationStmt             *sql.Stmt
+	deleteAllUserVerificationsByUserIDStmt *sql.Stmt
+	deleteAuthAttemptsStmt                 *sql.Stmt
+	deleteAuthAttemptsBySubjectStmt        *sql.Stmt
+	deleteFromBlacklistStmt                *sql.Stmt
+	deleteFromWhitelistStmt                *sql.Stmt
+	deleteUserByIDStmt                     *sql.Stmt
+	deleteUserDevicesByUserIDStmt          *sql.Stmt
+	deleteUserIdentitiesByUserIDStmt       *sql.Stmt
+	deleteUserIdentityStmt                 *sql.Stmt
+	deleteUserMagicLinksByUserIDStmt       *sql.Stmt
+	deleteUserTOTPStmt                     *sql.Stmt
+	deleteUserTOTPRecoveryCodesStmt        *sql.Stmt
+	deleteUserVerificationsByEmailStmt     *sql.Stmt
+	deleteUserVerificationsByUserIDStmt    *sql.Stmt
+	destroyUserStmt                        *sql.Stmt
+	doUsersShareDeviceStmt                 *sql.Stmt
+	doesUserHaveMoreThanOneAccountStmt     *sql.Stmt
+	enableUserTOTPStmt                     *sql.Stmt
+	getActiveUserSessionsStmt              *sql.Stmt
+	getAuthAttemptStmt                     *sql.Stmt
+	getBlacklistStmt                       *sql.Stmt
+	getBlacklistByRestrictedValueStmt      *sql.Stmt
+	getJWTSigningKeysStmt                  *sql.Stmt
+	getKYCStatusStmt                       *sql.Stmt
+	getNotSanitizedUsersListDescStmt       *sql.Stmt
+	getPublicKeyStmt                       *sql.Stmt
+	getRevokedUserSessionsStmt             *sql.Stmt
+	getSolanaSignInNonceStmt               *sql.Stmt
+	getUserByEmailStmt                     *sql.Stmt
+	getUserByIDStmt                        *sql.Stmt
+	getUserBySanitizedEmailStmt            *sql.Stmt
+	getUserByUsernameStmt                  *sql.Stmt
+	getUserIDsOnTheSameDeviceStmt          *sql.Stmt
+	getUserIdentitiesByUserIDStmt          *sql.Stmt
+	getUserIdentityStmt                    *sql.Stmt
+	getUserMagicLinkByIDStmt               *sql.Stmt
+	getUserSessionByIDStmt                 *sql.Stmt
+	getUserTOTPStmt                        *sql.Stmt
+	getUserVerificationByEmailStmt         *sql.Stmt
+	getUserVerificationByUserIDStmt        *sql.Stmt
+	getUsernameByIDStmt                    *sql.Stmt
+	getUsersListDescStmt                   *sql.Stmt
+	getVerifiedUsersListDescStmt           *sql.Stmt
+	getWhitelistStmt                       *sql.Stmt
+	getWhitelistByAllowedValueStmt         *sql.Stmt
+	isEmailBlacklistedStmt                 *sql.Stmt
+	isEmailWhitelistedStmt                 *sql.Stmt
+	isUserDisabledStmt                     *sql.Stmt
+	linkDeviceToUserStmt                   *sql.Stmt
+	lockAuthAttemptsStmt                   *sql.Stmt
+	requestUserMagicLinkApprovalStmt       *sql.Stmt
+	revokeUserSessionStmt                  *sql.Stmt
+	revokeUserSessionsStmt                 *sql.Stmt
+	rotateUserSessionRefreshTokenStmt      *sql.Stmt
+	updateKYCStatusStmt                    *sql.Stmt
+	updatePublicKeyStmt                    *sql.Stmt
+	updateUserEmailStmt                    *sql.Stmt
+	updateUserPasswordStmt                 *sql.Stmt
+	updateUserRoleStmt                     *sql.Stmt
+	updateUserSanitizedEmailStmt           *sql.Stmt
+	updateUserSessionLastSeenStmt          *sql.Stmt
+	updateUserStatusStmt                   *sql.Stmt
+	updateUserTOTPLastUsedStepStmt         *sql.Stmt
+	updateUserTOTPRequiredActionsStmt      *sql.Stmt
+	updateUserVerifiedAtStmt               *sql.Stmt
+	updateUsernameStmt                     *sql.Stmt
+	upsertUserTOTPStmt                     *sql.Stmt
+	useIDTokenNonceStmt                    *sql.Stmt
+	useSolanaSignInNonceStmt               *sql.Stmt
+	useUserMagicLinkStmt                   *sql.Stmt
+	useUserTOTPRecoveryCodeStmt            *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                                  tx,
-		tx:                                  tx,
-		addToBlacklistStmt:                  q.addToBlacklistStmt,
-		addToWhitelistStmt:                  q.addToWhitelistStmt,
-		blockUsersOnTheSameDeviceStmt:       q.blockUsersOnTheSameDeviceStmt,
-		blockUsersWithDuplicateEmailStmt:    q.blockUsersWithDuplicateEmailStmt,
-		countAllUsersStmt:                   q.countAllUsersStmt,
-		createUserStmt:                      q.createUserStmt,
-		createUserVerificationStmt:          q.createUserVerificationStmt,
-		deleteFromBlacklistStmt:             q.deleteFromBlacklistStmt,
-		deleteFromWhitelistStmt:             q.deleteFromWhitelistStmt,
-		deleteUserByIDStmt:                  q.deleteUserByIDStmt,
-		deleteUserVerificationsByEmailStmt:  q.deleteUserVerificationsByEmailStmt,
-		deleteUserVerificationsByUserIDStmt: q.deleteUserVerificationsByUserIDStmt,
-		destroyUserStmt:                     q.destroyUserStmt,
-		doesUserHaveMoreThanOneAccountStmt:  q.doesUserHaveMoreThanOneAccountStmt,
-		getBlacklistStmt:                    q.getBlacklistStmt,
-		getBlacklistByRestrictedValueStmt:   q.getBlacklistByRestrictedValueStmt,
-		getKYCStatusStmt:                    q.getKYCStatusStmt,
-		getNotSanitizedUsersListDescStmt:    q.getNotSanitizedUsersListDescStmt,
-		getPublicKeyStmt:                    q.getPublicKeyStmt,
-		getUserByEmailStmt:                  q.getUserByEmailStmt,
-		getUserByIDStmt:                     q.getUserByIDStmt,
-		getUserBySanitizedEmailStmt:         q.getUserBySanitizedEmailStmt,
-		getUserByUsernameStmt:               q.getUserByUsernameStmt,
-		getUserIDsOnTheSameDeviceStmt:       q.getUserIDsOnTheSameDeviceStmt,
-		getUserVerificationByEmailStmt:      q.getUserVerificationByEmailStmt,
-		getUserVerificationByUserIDStmt:     q.getUserVerificationByUserIDStmt,
-		getUsernameByIDStmt:                 q.getUsernameByIDStmt,
-		getUsersListDescStmt:                q.getUsersListDescStmt,
-		getVerifiedUsersListDescStmt:        q.getVerifiedUsersListDescStmt,
-		getWhitelistStmt:                    q.getWhitelistStmt,
-		getWhitelistByAllowedValueStmt:      q.getWhitelistByAllowedValueStmt,
-		isEmailBlacklistedStmt:              q.isEmailBlacklistedStmt,
-		isEmailWhitelistedStmt:              q.isEmailWhitelistedStmt,
-		isUserDisabledStmt:                  q.isUserDisabledStmt,
-		linkDeviceToUserStmt:                q.linkDeviceToUserStmt,
-		updateKYCStatusStmt:                 q.updateKYCStatusStmt,
-		updatePublicKeyStmt:                 q.updatePublicKeyStmt,
-		updateUserEmailStmt:                 q.updateUserEmailStmt,
-		updateUserPasswordStmt:              q.updateUserPasswordStmt,
-		updateUserRoleStmt:                  q.updateUserRoleStmt,
-		updateUserSanitizedEmailStmt:        q.updateUserSanitizedEmailStmt,
-		updateUserStatusStmt:                q.updateUserStatusStmt,
-		updateUserVerifiedAtStmt:            q.updateUserVerifiedAtStmt,
-		updateUsernameStmt:                  q.updateUsernameStmt,
+		db:                                     tx,
+		tx:                                     tx,
+		addFailedAuthAttemptStmt:               q.addFailedAuthAttemptStmt,
+		addIDTokenNonceStmt:                    q.addIDTokenNonceStmt,
+		addJWTSigningKeyStmt:                   q.addJWTSigningKeyStmt,
+		addSolanaSignInNonceStmt:               q.addSolanaSignInNonceStmt,
+		addToBlacklistStmt:                     q.addToBlacklistStmt,
+		addToWhitelistStmt:                     q.addToWhitelistStmt,
+		addUserIdentityStmt:                    q.addUserIdentityStmt,
+		addUserMagicLinkStmt:                   q.addUserMagicLinkStmt,
+		addUserSessionStmt:                     q.addUserSessionStmt,
+		addUserTOTPRecoveryCodeStmt:            q.addUserTOTPRecoveryCodeStmt,
+		anonymizeUserSessionsStmt:              q.anonymizeUserSessionsStmt,
+		approveUserMagicLinkStmt:               q.approveUserMagicLinkStmt,
+		blockUsersOnTheSameDeviceStmt:          q.blockUsersOnTheSameDeviceStmt,
+		blockUsersWithDuplicateEmailStmt:       q.blockUsersWithDuplicateEmailStmt,
+		countAllUsersStmt:                      q.countAllUsersStmt,
+		countIDTokenNoncesByIPStmt:             q.countIDTokenNoncesByIPStmt,
+		countSolanaSignInNoncesByAddressStmt:   q.countSolanaSignInNoncesByAddressStmt,
+		countSolanaSignInNoncesByIPStmt:        q.countSolanaSignInNoncesByIPStmt,
+		countUnusedUserTOTPRecoveryCodesStmt:   q.countUnusedUserTOTPRecoveryCodesStmt,
+		countUserMagicLinksByEmailStmt:         q.countUserMagicLinksByEmailStmt,
+		countUserMagicLinksByIPStmt:            q.countUserMagicLinksByIPStmt,
+		createUserStmt:                         q.createUserStmt,
+		createUserVerificationStmt:             q.createUserVerificationStmt,
+		deleteAllUserVerificationsByUserIDStmt: q.deleteAllUserVerificationsByUserIDStmt,
+		deleteAuthAttemptsStmt:                 q.deleteAuthAttemptsStmt,
+		deleteAuthAttemptsBySubjectStmt:        q.deleteAuthAttemptsBySubjectStmt,
+		deleteFromBlacklistStmt:                q.deleteFromBlacklistStmt,
+		deleteFromWhitelistStmt:                q.deleteFromWhitelistStmt,
+		deleteUserByIDStmt:                     q.deleteUserByIDStmt,
+		deleteUserDevicesByUserIDStmt:          q.deleteUserDevicesByUserIDStmt,
+		deleteUserIdentitiesByUserIDStmt:       q.deleteUserIdentitiesByUserIDStmt,
+		deleteUserIdentityStmt:                 q.deleteUserIdentityStmt,
+		deleteUserMagicLinksByUserIDStmt:       q.deleteUserMagicLinksByUserIDStmt,
+		deleteUserTOTPStmt:                     q.deleteUserTOTPStmt,
+		deleteUserTOTPRecoveryCodesStmt:        q.deleteUserTOTPRecoveryCodesStmt,
+		deleteUserVerificationsByEmailStmt:     q.deleteUserVerificationsByEmailStmt,
+		deleteUserVerificationsByUserIDStmt:    q.deleteUserVerificationsByUserIDStmt,
+		destroyUserStmt:                        q.destroyUserStmt,
+		doUsersShareDeviceStmt:                 q.doUsersShareDeviceStmt,
+		doesUserHaveMoreThanOneAccountStmt:     q.doesUserHaveMoreThanOneAccountStmt,
+		enableUserTOTPStmt:                     q.enableUserTOTPStmt,
+		getActiveUserSessionsStmt:              q.getActiveUserSessionsStmt,
+		getAuthAttemptStmt:                     q.getAuthAttemptStmt,
+		getBlacklistStmt:                       q.getBlacklistStmt,
+		getBlacklistByRestrictedValueStmt:      q.getBlacklistByRestrictedValueStmt,
+		getJWTSigningKeysStmt:                  q.getJWTSigningKeysStmt,
+		getKYCStatusStmt:                       q.getKYCStatusStmt,
+		getNotSanitizedUsersListDescStmt:       q.getNotSanitizedUsersListDescStmt,
+		getPublicKeyStmt:                       q.getPublicKeyStmt,
+		getRevokedUserSessionsStmt:             q.getRevokedUserSessionsStmt,
+		getSolanaSignInNonceStmt:               q.getSolanaSignInNonceStmt,
+		getUserByEmailStmt:                     q.getUserByEmailStmt,
+		getUserByIDStmt:                        q.getUserByIDStmt,
+		getUserBySanitizedEmailStmt:            q.getUserBySanitizedEmailStmt,
+		getUserByUsernameStmt:                  q.getUserByUsernameStmt,
+		getUserIDsOnTheSameDeviceStmt:          q.getUserIDsOnTheSameDeviceStmt,
+		getUserIdentitiesByUserIDStmt:          q.getUserIdentitiesByUserIDStmt,
+		getUserIdentityStmt:                    q.getUserIdentityStmt,
+		getUserMagicLinkByIDStmt:               q.getUserMagicLinkByIDStmt,
+		getUserSessionByIDStmt:                 q.getUserSessionByIDStmt,
+		getUserTOTPStmt:                        q.getUserTOTPStmt,
+		getUserVerificationByEmailStmt:         q.getUserVerificationByEmailStmt,
+		getUserVerificationByUserIDStmt:        q.getUserVerificationByUserIDStmt,
+		getUsernameByIDStmt:                    q.getUsernameByIDStmt,
+		getUsersListDescStmt:                   q.getUsersListDescStmt,
+		getVerifiedUsersListDescStmt:           q.getVerifiedUsersListDescStmt,
+		getWhitelistStmt:                       q.getWhitelistStmt,
+		getWhitelistByAllowedValueStmt:         q.getWhitelistByAllowedValueStmt,
+		isEmailBlacklistedStmt:                 q.isEmailBlacklistedStmt,
+		isEmailWhitelistedStmt:                 q.isEmailWhitelistedStmt,
+		isUserDisabledStmt:                     q.isUserDisabledStmt,
+		linkDeviceToUserStmt:                   q.linkDeviceToUserStmt,
+		lockAuthAttemptsStmt:                   q.lockAuthAttemptsStmt,
+		requestUserMagicLinkApprovalStmt:       q.requestUserMagicLinkApprovalStmt,
+		revokeUserSessionStmt:                  q.revokeUserSessionStmt,
+		revokeUserSessionsStmt:                 q.revokeUserSessionsStmt,
+		rotateUserSessionRefreshTokenStmt:      q.rotateUserSessionRefreshTokenStmt,
+		updateKYCStatusStmt:                    q.updateKYCStatusStmt,
+		updatePublicKeyStmt:                    q.updatePublicKeyStmt,
+		updateUserEmailStmt:                    q.updateUserEmailStmt,
+		updateUserPasswordStmt:                 q.updateUserPasswordStmt,
+		updateUserRoleStmt:                     q.updateUserRoleStmt,
+		updateUserSanitizedEmailStmt:           q.updateUserSanitizedEmailStmt,
+		updateUserSessionLastSeenStmt:          q.updateUserSessionLastSeenStmt,
+		updateUserStatusStmt:                   q.updateUserStatusStmt,
+		updateUserTOTPLastUsedStepStmt:         q.updateUserTOTPLastUsedStepStmt,
+		updateUserTOTPRequiredActionsStmt:      q.updateUserTOTPRequiredActionsStmt,
+		updateUserVerifiedAtStmt:               q.updateUserVerifiedAtStmt,
+		updateUsernameStmt:                     q.updateUsernameStmt,
+		upsertUserTOTPStmt:                     q.upsertUserTOTPStmt,
+		useIDTokenNonceStmt:                    q.useIDTokenNonceStmt,
+		useSolanaSignInNonceStmt:               q.useSolanaSignInNonceStmt,
+		useUserMagicLinkStmt:                   q.useUserMagicLinkStmt,
+		useUserTOTPRecoveryCodeStmt:            q.useUserTOTPRecoveryCodeStmt,
 	}
 }
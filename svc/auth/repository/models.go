@@ -11,11 +11,45 @@ import (
 	"github.com/google/uuid"
 )
 
+type AuthAttempt struct {
+	Flow        string       `json:"flow"`
+	Subject     string       `json:"subject"`
+	Failures    int32        `json:"failures"`
+	Lockouts    int32        `json:"lockouts"`
+	LockedUntil sql.NullTime `json:"locked_until"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
 type Blacklist struct {
 	RestrictedType  string `json:"restricted_type"`
 	RestrictedValue string `json:"restricted_value"`
 }
 
+type IDTokenNonce struct {
+	Nonce     string       `json:"nonce"`
+	Provider  string       `json:"provider"`
+	Ip        string       `json:"ip"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	UsedAt    sql.NullTime `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type JwtSigningKey struct {
+	ID         string    `json:"id"`
+	PrivateKey []byte    `json:"private_key"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type SolanaSignInNonce struct {
+	Nonce     string       `json:"nonce"`
+	Address   string       `json:"address"`
+	Message   string       `json:"message"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	UsedAt    sql.NullTime `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
+	Ip        string       `json:"ip"`
+}
+
 type User struct {
 	ID             uuid.UUID      `json:"id"`
 	Username       string         `json:"username"`
@@ -34,6 +68,57 @@ type User struct {
 	DeletedAt      sql.NullTime   `json:"deleted_at"`
 }
 
+type UserIdentity struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	UserID    uuid.UUID `json:"user_id"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type UserMagicLink struct {
+	ID               uuid.UUID    `json:"id"`
+	UserID           uuid.UUID    `json:"user_id"`
+	Email            string       `json:"email"`
+	DeviceID         string       `json:"device_id"`
+	Ip               string       `json:"ip"`
+	ExpiresAt        time.Time    `json:"expires_at"`
+	UsedAt           sql.NullTime `json:"used_at"`
+	CreatedAt        time.Time    `json:"created_at"`
+	ApprovalDeviceID string       `json:"approval_device_id"`
+	ApprovedAt       sql.NullTime `json:"approved_at"`
+}
+
+type UserSession struct {
+	ID             uuid.UUID     `json:"id"`
+	UserID         uuid.UUID     `json:"user_id"`
+	DeviceID       string        `json:"device_id"`
+	Ip             string        `json:"ip"`
+	UserAgent      string        `json:"user_agent"`
+	LastSeenAt     time.Time     `json:"last_seen_at"`
+	ExpiresAt      time.Time     `json:"expires_at"`
+	RevokedAt      sql.NullTime  `json:"revoked_at"`
+	CreatedAt      time.Time     `json:"created_at"`
+	RefreshTokenID uuid.NullUUID `json:"refresh_token_id"`
+}
+
+type UserTotp struct {
+	UserID          uuid.UUID    `json:"user_id"`
+	Secret          []byte       `json:"secret"`
+	RequiredActions []string     `json:"required_actions"`
+	LastUsedStep    int64        `json:"last_used_step"`
+	EnabledAt       sql.NullTime `json:"enabled_at"`
+	UpdatedAt       sql.NullTime `json:"updated_at"`
+	CreatedAt       time.Time    `json:"created_at"`
+}
+
+type UserTotpRecoveryCode struct {
+	UserID    uuid.UUID    `json:"user_id"`
+	CodeHash  string       `json:"code_hash"`
+	UsedAt    sql.NullTime `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
 type UserVerification struct {
 	RequestType      int32     `json:"request_type"`
 	UserID           uuid.UUID `json:"user_id"`
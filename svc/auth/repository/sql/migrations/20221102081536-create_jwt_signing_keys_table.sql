@@ -0,0 +1,10 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS jwt_signing_keys (
+    id VARCHAR PRIMARY KEY,
+    private_key BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX jwt_signing_keys_created_at ON jwt_signing_keys USING BTREE (created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS jwt_signing_keys;
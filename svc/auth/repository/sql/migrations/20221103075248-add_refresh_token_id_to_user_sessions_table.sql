@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE user_sessions ADD COLUMN refresh_token_id uuid DEFAULT NULL;
+
+-- +migrate Down
+ALTER TABLE user_sessions DROP COLUMN refresh_token_id;
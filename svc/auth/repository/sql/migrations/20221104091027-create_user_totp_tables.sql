@@ -0,0 +1,32 @@
+-- +migrate Up
+-- +migrate StatementBegin
+CREATE
+OR REPLACE FUNCTION user_totp_update_updated_at_column() RETURNS TRIGGER AS $$
+BEGIN NEW .updated_at = NOW();
+RETURN NEW;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+CREATE TABLE IF NOT EXISTS user_totp (
+    user_id uuid PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret BYTEA NOT NULL,
+    required_actions VARCHAR[] NOT NULL DEFAULT '{}',
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    enabled_at TIMESTAMP DEFAULT NULL,
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE TRIGGER update_user_totp_modtime BEFORE
+UPDATE ON user_totp FOR EACH ROW EXECUTE PROCEDURE user_totp_update_updated_at_column();
+CREATE TABLE IF NOT EXISTS user_totp_recovery_codes (
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR NOT NULL,
+    used_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, code_hash)
+);
+-- +migrate Down
+DROP TABLE IF EXISTS user_totp_recovery_codes;
+DROP TRIGGER IF EXISTS update_user_totp_modtime ON user_totp;
+DROP TABLE IF EXISTS user_totp;
+DROP FUNCTION IF EXISTS user_totp_update_updated_at_column();
@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS user_identities (
+    provider VARCHAR NOT NULL,
+    subject VARCHAR NOT NULL,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (provider, subject)
+);
+CREATE UNIQUE INDEX user_identities_user_id_provider ON user_identities USING BTREE (user_id, provider);
+-- +migrate Down
+DROP TABLE IF EXISTS user_identities;
@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS user_magic_links (
+    id uuid PRIMARY KEY,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email VARCHAR NOT NULL,
+    device_id VARCHAR NOT NULL DEFAULT '',
+    ip VARCHAR NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX user_magic_links_email_created_at ON user_magic_links USING BTREE (email, created_at);
+CREATE INDEX user_magic_links_ip_created_at ON user_magic_links USING BTREE (ip, created_at);
+-- +migrate Down
+DROP TABLE IF EXISTS user_magic_links;
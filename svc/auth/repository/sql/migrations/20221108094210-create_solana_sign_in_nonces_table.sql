@@ -0,0 +1,11 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS solana_sign_in_nonces (
+    nonce VARCHAR PRIMARY KEY,
+    address VARCHAR NOT NULL,
+    message TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+-- +migrate Down
+DROP TABLE IF EXISTS solana_sign_in_nonces;
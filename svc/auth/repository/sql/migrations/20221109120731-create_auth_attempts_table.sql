@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS auth_attempts (
+    flow VARCHAR NOT NULL,
+    subject VARCHAR NOT NULL,
+    failures INTEGER NOT NULL DEFAULT 0,
+    lockouts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP DEFAULT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (flow, subject)
+);
+CREATE INDEX auth_attempts_subject ON auth_attempts USING BTREE (subject);
+-- +migrate Down
+DROP TABLE IF EXISTS auth_attempts;
@@ -0,0 +1,5 @@
+-- +migrate Up
+UPDATE user_totp
+SET required_actions = array_remove(required_actions, 'export_recovery_phrase')
+WHERE 'export_recovery_phrase' = ANY(required_actions);
+-- +migrate Down
@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS id_token_nonces (
+    nonce VARCHAR PRIMARY KEY,
+    provider VARCHAR NOT NULL,
+    ip VARCHAR NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX id_token_nonces_ip_created_at ON id_token_nonces USING BTREE (ip, created_at);
+-- +migrate Down
+DROP TABLE IF EXISTS id_token_nonces;
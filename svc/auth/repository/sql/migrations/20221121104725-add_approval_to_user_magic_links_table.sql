@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE user_magic_links
+    ADD COLUMN approval_device_id VARCHAR NOT NULL DEFAULT '',
+    ADD COLUMN approved_at TIMESTAMP DEFAULT NULL;
+-- +migrate Down
+ALTER TABLE user_magic_links DROP COLUMN approved_at;
+ALTER TABLE user_magic_links DROP COLUMN approval_device_id;
@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE solana_sign_in_nonces
+    ADD COLUMN ip VARCHAR NOT NULL DEFAULT '';
+CREATE INDEX solana_sign_in_nonces_ip_created_at ON solana_sign_in_nonces USING BTREE (ip, created_at);
+CREATE INDEX solana_sign_in_nonces_address_created_at ON solana_sign_in_nonces USING BTREE (address, created_at);
+-- +migrate Down
+DROP INDEX IF EXISTS solana_sign_in_nonces_address_created_at;
+DROP INDEX IF EXISTS solana_sign_in_nonces_ip_created_at;
+ALTER TABLE solana_sign_in_nonces DROP COLUMN ip;
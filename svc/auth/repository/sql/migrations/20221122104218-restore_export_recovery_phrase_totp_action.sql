@@ -0,0 +1,11 @@
+-- +migrate Up
+-- the action was removed from user settings by 20221121093042, so it's required again
+-- for all users with enabled two-factor authentication, as if they enabled it now
+UPDATE user_totp
+SET required_actions = array_append(required_actions, 'export_recovery_phrase')
+WHERE enabled_at IS NOT NULL
+    AND NOT 'export_recovery_phrase' = ANY(required_actions);
+-- +migrate Down
+UPDATE user_totp
+SET required_actions = array_remove(required_actions, 'export_recovery_phrase')
+WHERE 'export_recovery_phrase' = ANY(required_actions);
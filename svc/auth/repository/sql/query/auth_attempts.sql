@@ -0,0 +1,28 @@
+-- name: GetAuthAttempt :one
+SELECT * FROM auth_attempts
+WHERE flow = @flow AND subject = @subject
+LIMIT 1;
+
+-- name: AddFailedAuthAttempt :one
+INSERT INTO auth_attempts (flow, subject, failures)
+VALUES (@flow, @subject, 1)
+ON CONFLICT (flow, subject) DO UPDATE
+SET failures = CASE WHEN auth_attempts.updated_at < @window_start THEN 1 ELSE auth_attempts.failures + 1 END,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: LockAuthAttempts :exec
+UPDATE auth_attempts
+SET failures = 0,
+    lockouts = @lockouts,
+    locked_until = @locked_until,
+    updated_at = NOW()
+WHERE flow = @flow AND subject = @subject;
+
+-- name: DeleteAuthAttempts :exec
+DELETE FROM auth_attempts
+WHERE flow = @flow AND subject = @subject;
+
+-- name: DeleteAuthAttemptsBySubject :exec
+DELETE FROM auth_attempts
+WHERE subject = $1;
@@ -0,0 +1,12 @@
+-- name: AddIDTokenNonce :exec
+INSERT INTO id_token_nonces (nonce, provider, ip, expires_at)
+VALUES (@nonce, @provider, @ip, @expires_at);
+
+-- name: UseIDTokenNonce :execrows
+UPDATE id_token_nonces
+SET used_at = NOW()
+WHERE nonce = @nonce AND provider = @provider AND used_at IS NULL AND expires_at > NOW();
+
+-- name: CountIDTokenNoncesByIP :one
+SELECT COUNT(*) FROM id_token_nonces
+WHERE ip = @ip AND created_at > @created_after;
@@ -0,0 +1,8 @@
+-- name: AddJWTSigningKey :exec
+INSERT INTO jwt_signing_keys (id, private_key, created_at)
+VALUES (@id, @private_key, @created_at);
+
+-- name: GetJWTSigningKeys :many
+SELECT * FROM jwt_signing_keys
+WHERE created_at > $1
+ORDER BY created_at DESC;
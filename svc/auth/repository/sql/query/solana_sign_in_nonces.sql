@@ -0,0 +1,21 @@
+-- name: AddSolanaSignInNonce :exec
+INSERT INTO solana_sign_in_nonces (nonce, address, message, ip, expires_at)
+VALUES (@nonce, @address, @message, @ip, @expires_at);
+
+-- name: GetSolanaSignInNonce :one
+SELECT * FROM solana_sign_in_nonces
+WHERE nonce = $1
+LIMIT 1;
+
+-- name: UseSolanaSignInNonce :execrows
+UPDATE solana_sign_in_nonces
+SET used_at = NOW()
+WHERE nonce = $1 AND used_at IS NULL AND expires_at > NOW();
+
+-- name: CountSolanaSignInNoncesByAddress :one
+SELECT COUNT(*) FROM solana_sign_in_nonces
+WHERE address = @address AND created_at > @created_after;
+
+-- name: CountSolanaSignInNoncesByIP :one
+SELECT COUNT(*) FROM solana_sign_in_nonces
+WHERE ip = @ip AND created_at > @created_after;
@@ -35,4 +35,15 @@ WHERE t2.device_id IN (
     SELECT t.device_id 
     FROM users_devices AS t
     WHERE t.user_id = @user_id
-) GROUP BY t2.device_id;
\ No newline at end of file
+) GROUP BY t2.device_id;
+
+-- name: DoUsersShareDevice :one
+SELECT EXISTS(
+    SELECT 1 FROM users_devices AS t
+    JOIN users_devices AS t2 ON t2.device_id = t.device_id
+    WHERE t.user_id = @user_id AND t2.user_id = @other_user_id
+);
+
+-- name: DeleteUserDevicesByUserID :exec
+DELETE FROM users_devices
+WHERE user_id = @user_id;
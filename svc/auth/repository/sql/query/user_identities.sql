@@ -0,0 +1,21 @@
+-- name: AddUserIdentity :exec
+INSERT INTO user_identities (provider, subject, user_id, email)
+VALUES (@provider, @subject, @user_id, @email);
+
+-- name: GetUserIdentity :one
+SELECT * FROM user_identities
+WHERE provider = @provider AND subject = @subject
+LIMIT 1;
+
+-- name: GetUserIdentitiesByUserID :many
+SELECT * FROM user_identities
+WHERE user_id = $1
+ORDER BY created_at ASC;
+
+-- name: DeleteUserIdentity :execrows
+DELETE FROM user_identities
+WHERE user_id = @user_id AND provider = @provider;
+
+-- name: DeleteUserIdentitiesByUserID :exec
+DELETE FROM user_identities
+WHERE user_id = $1;
@@ -0,0 +1,39 @@
+-- name: AddUserMagicLink :exec
+INSERT INTO user_magic_links (id, user_id, email, device_id, ip, expires_at)
+VALUES (@id, @user_id, @email, @device_id, @ip, @expires_at);
+
+-- name: GetUserMagicLinkByID :one
+SELECT * FROM user_magic_links
+WHERE id = $1
+LIMIT 1;
+
+-- name: UseUserMagicLink :execrows
+UPDATE user_magic_links
+SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL AND expires_at > NOW();
+
+-- name: RequestUserMagicLinkApproval :execrows
+UPDATE user_magic_links
+SET approval_device_id = @approval_device_id
+WHERE id = @id AND used_at IS NULL AND expires_at > NOW()
+    AND approved_at IS NULL
+    AND (approval_device_id = '' OR approval_device_id = @approval_device_id);
+
+-- name: ApproveUserMagicLink :execrows
+UPDATE user_magic_links
+SET approved_at = NOW()
+WHERE email = @email AND device_id = @device_id AND used_at IS NULL AND expires_at > NOW()
+    AND approval_device_id <> ''
+    AND approved_at IS NULL;
+
+-- name: CountUserMagicLinksByEmail :one
+SELECT COUNT(*) FROM user_magic_links
+WHERE email = @email AND created_at > @created_after;
+
+-- name: CountUserMagicLinksByIP :one
+SELECT COUNT(*) FROM user_magic_links
+WHERE ip = @ip AND created_at > @created_after;
+
+-- name: DeleteUserMagicLinksByUserID :exec
+DELETE FROM user_magic_links
+WHERE user_id = $1;
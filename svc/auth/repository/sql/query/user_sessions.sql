@@ -0,0 +1,50 @@
+-- name: AddUserSession :exec
+INSERT INTO user_sessions (id, user_id, device_id, ip, user_agent, expires_at, refresh_token_id)
+VALUES (@id, @user_id, @device_id, @ip, @user_agent, @expires_at, @refresh_token_id);
+
+-- name: GetUserSessionByID :one
+SELECT * FROM user_sessions
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetActiveUserSessions :many
+SELECT * FROM user_sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+ORDER BY last_seen_at DESC;
+
+-- name: GetRevokedUserSessions :many
+SELECT id, expires_at FROM user_sessions
+WHERE revoked_at IS NOT NULL AND expires_at > NOW();
+
+-- name: RotateUserSessionRefreshToken :execrows
+UPDATE user_sessions
+SET refresh_token_id = @new_refresh_token_id,
+    device_id = @device_id,
+    ip = @ip,
+    user_agent = @user_agent,
+    expires_at = @expires_at,
+    last_seen_at = NOW()
+WHERE id = @id AND revoked_at IS NULL AND COALESCE(refresh_token_id, @refresh_token_id) = @refresh_token_id;
+
+-- name: RevokeUserSession :one
+UPDATE user_sessions
+SET revoked_at = NOW()
+WHERE id = @id AND user_id = @user_id AND revoked_at IS NULL
+RETURNING id, expires_at;
+
+-- name: RevokeUserSessions :many
+UPDATE user_sessions
+SET revoked_at = NOW()
+WHERE user_id = @user_id AND id != @except_id AND revoked_at IS NULL AND expires_at > NOW()
+RETURNING id, expires_at;
+
+-- name: UpdateUserSessionLastSeen :exec
+UPDATE user_sessions
+SET last_seen_at = @last_seen_at
+WHERE id = @id AND last_seen_at < @last_seen_at;
+
+-- name: AnonymizeUserSessions :exec
+UPDATE user_sessions
+SET ip = '',
+    user_agent = ''
+WHERE user_id = @user_id;
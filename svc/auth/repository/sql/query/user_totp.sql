@@ -0,0 +1,47 @@
+-- name: UpsertUserTOTP :exec
+INSERT INTO user_totp (user_id, secret)
+VALUES (@user_id, @secret)
+ON CONFLICT (user_id) DO UPDATE
+SET secret = EXCLUDED.secret, required_actions = '{}', last_used_step = 0, enabled_at = NULL
+WHERE user_totp.enabled_at IS NULL;
+
+-- name: GetUserTOTP :one
+SELECT * FROM user_totp
+WHERE user_id = $1
+LIMIT 1;
+
+-- name: EnableUserTOTP :exec
+UPDATE user_totp
+SET enabled_at = NOW(), required_actions = @required_actions, last_used_step = @last_used_step
+WHERE user_id = @user_id AND enabled_at IS NULL;
+
+-- name: UpdateUserTOTPLastUsedStep :execrows
+UPDATE user_totp
+SET last_used_step = @last_used_step
+WHERE user_id = @user_id AND last_used_step < @last_used_step;
+
+-- name: UpdateUserTOTPRequiredActions :exec
+UPDATE user_totp
+SET required_actions = @required_actions
+WHERE user_id = @user_id;
+
+-- name: DeleteUserTOTP :exec
+DELETE FROM user_totp
+WHERE user_id = $1;
+
+-- name: AddUserTOTPRecoveryCode :exec
+INSERT INTO user_totp_recovery_codes (user_id, code_hash)
+VALUES (@user_id, @code_hash);
+
+-- name: DeleteUserTOTPRecoveryCodes :exec
+DELETE FROM user_totp_recovery_codes
+WHERE user_id = $1;
+
+-- name: UseUserTOTPRecoveryCode :execrows
+UPDATE user_totp_recovery_codes
+SET used_at = NOW()
+WHERE user_id = @user_id AND code_hash = @code_hash AND used_at IS NULL;
+
+-- name: CountUnusedUserTOTPRecoveryCodes :one
+SELECT COUNT(*) FROM user_totp_recovery_codes
+WHERE user_id = $1 AND used_at IS NULL;
@@ -33,4 +33,7 @@ WHERE request_type = @request_type
 -- name: DeleteUserVerificationsByUserID :exec
 DELETE FROM user_verifications
 WHERE request_type = @request_type
-    AND user_id = @user_id;
\ No newline at end of file
+    AND user_id = @user_id;
+-- name: DeleteAllUserVerificationsByUserID :exec
+DELETE FROM user_verifications
+WHERE user_id = @user_id;
@@ -34,6 +34,36 @@ func (q *Queries) BlockUsersOnTheSameDevice(ctx context.Context, excludeDeviceID
 	return err
 }
 
+const deleteUserDevicesByUserID = `-- name: DeleteUserDevicesByUserID :exec
+DELETE FROM users_devices
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserDevicesByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteUserDevicesByUserIDStmt, deleteUserDevicesByUserID, userID)
+	return err
+}
+
+const doUsersShareDevice = `-- name: DoUsersShareDevice :one
+SELECT EXISTS(
+    SELECT 1 FROM users_devices AS t
+    JOIN users_devices AS t2 ON t2.device_id = t.device_id
+    WHERE t.user_id = $1 AND t2.user_id = $2
+)
+`
+
+type DoUsersShareDeviceParams struct {
+	UserID      uuid.UUID `json:"user_id"`
+	OtherUserID uuid.UUID `json:"other_user_id"`
+}
+
+func (q *Queries) DoUsersShareDevice(ctx context.Context, arg DoUsersShareDeviceParams) (bool, error) {
+	row := q.queryRow(ctx, q.doUsersShareDeviceStmt, doUsersShareDevice, arg.UserID, arg.OtherUserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const doesUserHaveMoreThanOneAccount = `-- name: DoesUserHaveMoreThanOneAccount :one
 SELECT count(t2.device_id) > 1 FROM users_devices AS t2
 WHERE t2.device_id IN (
@@ -40,6 +40,16 @@ func (q *Queries) CreateUserVerification(ctx context.Context, arg CreateUserVeri
 	return err
 }
 
+const deleteAllUserVerificationsByUserID = `-- name: DeleteAllUserVerificationsByUserID :exec
+DELETE FROM user_verifications
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteAllUserVerificationsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteAllUserVerificationsByUserIDStmt, deleteAllUserVerificationsByUserID, userID)
+	return err
+}
+
 const deleteUserVerificationsByEmail = `-- name: DeleteUserVerificationsByEmail :exec
 DELETE FROM user_verifications
 WHERE request_type = $1
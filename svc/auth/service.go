@@ -19,16 +19,20 @@ import (
 
 	"github.com/SatorNetwork/sator-api/lib/db"
 	internal_rsa "github.com/SatorNetwork/sator-api/lib/encryption/rsa"
+	"github.com/SatorNetwork/sator-api/lib/jwt"
 	"github.com/SatorNetwork/sator-api/lib/rbac"
 	"github.com/SatorNetwork/sator-api/lib/sumsub"
+	"github.com/SatorNetwork/sator-api/lib/totp"
 	"github.com/SatorNetwork/sator-api/lib/utils"
 	"github.com/SatorNetwork/sator-api/lib/validator"
 	"github.com/SatorNetwork/sator-api/svc/auth/repository"
+	"github.com/SatorNetwork/sator-api/svc/campaigns"
 )
 
 type (
 	// Service struct
 	Service struct {
+		db                    *sql.DB
 		ur                    userRepository
 		ws                    walletService
 		fs                    firebaseService
@@ -42,6 +46,26 @@ type (
 		whitelistEnabled      bool
 		blacklistEnabled      bool
 		skipDeviceIDCheck     bool
+		sessions              sessionCache               // keeps revoked sessions for jwt middleware
+		sessionMaxLifetime    time.Duration              // absolute session lifetime, refresh doesn't prolong the session beyond it
+		tf                    *TwoFactor                 // verifies step-up codes of sensitive actions
+		idProviders           map[string]idTokenVerifier // identity providers to sign in with, e.g. Apple
+		magicLinkSecret       string                     // signs magic links, login by link is disabled if not set
+		magicLinkURL          string
+		magicLinkTTL          time.Duration
+		magicLinkEmailLimit   int
+		magicLinkIPLimit      int
+		solanaSignInDomain    string // domain the sign in with Solana messages are bound to, disabled if not set
+		solanaSignInURI       string
+		solanaSignInChainID   string
+		maxAccountFailures    int           // failed login or OTP attempts per account before lockout
+		maxIPFailures         int           // failed login or OTP attempts per client ip before lockout
+		baseLockout           time.Duration // the first lockout duration, doubled on each next lockout
+		maxLockout            time.Duration
+
+		trackEvent       trackEventFunc       // reports user's activity to reward campaigns
+		scheduleDeletion scheduleDeletionFunc // deletes account after the grace period, immediately if not set
+		audit            auditFunc            // records admin actions with users to the audit log
 	}
 
 	Whitelist struct {
@@ -67,8 +91,19 @@ type (
 	// interface to extend service via options
 	ServiceOption func(*Service)
 
+	trackEventFunc func(ctx context.Context, userID uuid.UUID, event string)
+
+	scheduleDeletionFunc func(ctx context.Context, userID uuid.UUID) error
+
+	auditFunc func(ctx context.Context, action, entityType, entityID string, before, after interface{}) error
+
 	jwtInteractor interface {
-		NewWithRefreshToken(userID uuid.UUID, username, role, deviceID string) (access, refresh string, err error)
+		NewWithRefreshToken(sessionID, refreshTokenID, userID uuid.UUID, username, role, deviceID string, refreshExpiresAt time.Time) (access, refresh string, err error)
+		RefreshTokenTTL() time.Duration
+	}
+
+	sessionCache interface {
+		Revoke(sessions ...jwt.RevokedSession)
 	}
 
 	userRepository interface {
@@ -89,6 +124,7 @@ type (
 		GetUserVerificationByUserID(ctx context.Context, arg repository.GetUserVerificationByUserIDParams) (repository.UserVerification, error)
 		GetUserVerificationByEmail(ctx context.Context, arg repository.GetUserVerificationByEmailParams) (repository.UserVerification, error)
 		DeleteUserVerificationsByUserID(ctx context.Context, arg repository.DeleteUserVerificationsByUserIDParams) error
+		DeleteAllUserVerificationsByUserID(ctx context.Context, userID uuid.UUID) error
 
 		// Blacklist
 		IsEmailBlacklisted(ctx context.Context, email string) (bool, error)
@@ -106,6 +142,8 @@ type (
 
 		LinkDeviceToUser(ctx context.Context, arg repository.LinkDeviceToUserParams) error
 		DoesUserHaveMoreThanOneAccount(ctx context.Context, userID uuid.UUID) (bool, error)
+		DoUsersShareDevice(ctx context.Context, arg repository.DoUsersShareDeviceParams) (bool, error)
+		DeleteUserDevicesByUserID(ctx context.Context, userID uuid.UUID) error
 
 		// KYC
 		UpdateKYCStatus(ctx context.Context, arg repository.UpdateKYCStatusParams) error
@@ -113,12 +151,60 @@ type (
 
 		UpdatePublicKey(ctx context.Context, arg repository.UpdatePublicKeyParams) error
 		GetPublicKey(ctx context.Context, id uuid.UUID) (sql.NullString, error)
+
+		// Sessions
+		AddUserSession(ctx context.Context, arg repository.AddUserSessionParams) error
+		GetUserSessionByID(ctx context.Context, id uuid.UUID) (repository.UserSession, error)
+		RotateUserSessionRefreshToken(ctx context.Context, arg repository.RotateUserSessionRefreshTokenParams) (int64, error)
+		GetActiveUserSessions(ctx context.Context, userID uuid.UUID) ([]repository.UserSession, error)
+		RevokeUserSession(ctx context.Context, arg repository.RevokeUserSessionParams) (repository.RevokeUserSessionRow, error)
+		RevokeUserSessions(ctx context.Context, arg repository.RevokeUserSessionsParams) ([]repository.RevokeUserSessionsRow, error)
+		AnonymizeUserSessions(ctx context.Context, userID uuid.UUID) error
+
+		// Identity providers
+		AddUserIdentity(ctx context.Context, arg repository.AddUserIdentityParams) error
+		GetUserIdentity(ctx context.Context, arg repository.GetUserIdentityParams) (repository.UserIdentity, error)
+		GetUserIdentitiesByUserID(ctx context.Context, userID uuid.UUID) ([]repository.UserIdentity, error)
+		DeleteUserIdentity(ctx context.Context, arg repository.DeleteUserIdentityParams) (int64, error)
+		DeleteUserIdentitiesByUserID(ctx context.Context, userID uuid.UUID) error
+		AddIDTokenNonce(ctx context.Context, arg repository.AddIDTokenNonceParams) error
+		UseIDTokenNonce(ctx context.Context, arg repository.UseIDTokenNonceParams) (int64, error)
+		CountIDTokenNoncesByIP(ctx context.Context, arg repository.CountIDTokenNoncesByIPParams) (int64, error)
+
+		// Magic links
+		AddUserMagicLink(ctx context.Context, arg repository.AddUserMagicLinkParams) error
+		GetUserMagicLinkByID(ctx context.Context, id uuid.UUID) (repository.UserMagicLink, error)
+		UseUserMagicLink(ctx context.Context, id uuid.UUID) (int64, error)
+		RequestUserMagicLinkApproval(ctx context.Context, arg repository.RequestUserMagicLinkApprovalParams) (int64, error)
+		ApproveUserMagicLink(ctx context.Context, arg repository.ApproveUserMagicLinkParams) (int64, error)
+		CountUserMagicLinksByEmail(ctx context.Context, arg repository.CountUserMagicLinksByEmailParams) (int64, error)
+		CountUserMagicLinksByIP(ctx context.Context, arg repository.CountUserMagicLinksByIPParams) (int64, error)
+		DeleteUserMagicLinksByUserID(ctx context.Context, userID uuid.UUID) error
+
+		// Sign in with Solana
+		AddSolanaSignInNonce(ctx context.Context, arg repository.AddSolanaSignInNonceParams) error
+		GetSolanaSignInNonce(ctx context.Context, nonce string) (repository.SolanaSignInNonce, error)
+		UseSolanaSignInNonce(ctx context.Context, nonce string) (int64, error)
+		CountSolanaSignInNoncesByAddress(ctx context.Context, arg repository.CountSolanaSignInNoncesByAddressParams) (int64, error)
+		CountSolanaSignInNoncesByIP(ctx context.Context, arg repository.CountSolanaSignInNoncesByIPParams) (int64, error)
+
+		// Brute-force protection
+		GetAuthAttempt(ctx context.Context, arg repository.GetAuthAttemptParams) (repository.AuthAttempt, error)
+		AddFailedAuthAttempt(ctx context.Context, arg repository.AddFailedAuthAttemptParams) (repository.AuthAttempt, error)
+		LockAuthAttempts(ctx context.Context, arg repository.LockAuthAttemptsParams) error
+		DeleteAuthAttempts(ctx context.Context, arg repository.DeleteAuthAttemptsParams) error
+		DeleteAuthAttemptsBySubject(ctx context.Context, subject string) error
+
+		WithTx(tx *sql.Tx) *repository.Queries
 	}
 
 	mailer interface {
 		SendVerificationCode(ctx context.Context, email, otp string) error
 		SendResetPasswordCode(ctx context.Context, email, otp string) error
 		SendDestroyAccountCode(ctx context.Context, email, otp string) error
+		SendSessionCompromisedAlert(ctx context.Context, email, deviceID, ip string) error
+		SendMagicLink(ctx context.Context, email, link string) error
+		SendAccountLockedAlert(ctx context.Context, email, ip string, lockedUntil time.Time) error
 	}
 
 	walletService interface {
@@ -131,6 +217,7 @@ type (
 
 	invitationsClient interface {
 		AcceptInvitation(ctx context.Context, inviteeID uuid.UUID, inviteeEmail string) error
+		AcceptInvitationByToken(ctx context.Context, inviteeID uuid.UUID, token string) error
 		IsEmailInvited(ctx context.Context, inviteeEmail string) (bool, error)
 	}
 
@@ -153,7 +240,10 @@ type (
 )
 
 // NewService is a factory function, returns a new instance of the Service interface implementation.
-func NewService(ji jwtInteractor, ur userRepository, ws walletService, fs firebaseService, ic invitationsClient, kyc kycClient, opt ...ServiceOption) *Service {
+func NewService(dbConn *sql.DB, ji jwtInteractor, ur userRepository, ws walletService, fs firebaseService, ic invitationsClient, kyc kycClient, opt ...ServiceOption) *Service {
+	if dbConn == nil {
+		log.Fatalln("db connection is not set")
+	}
 	if ur == nil {
 		log.Fatalln("user repository is not set")
 	}
@@ -174,6 +264,7 @@ func NewService(ji jwtInteractor, ur userRepository, ws walletService, fs fireba
 	}
 
 	s := &Service{
+		db:     dbConn,
 		jwt:    ji,
 		ur:     ur,
 		ic:     ic,
@@ -181,6 +272,18 @@ func NewService(ji jwtInteractor, ur userRepository, ws walletService, fs fireba
 		ws:     ws,
 		fs:     fs,
 		otpLen: 5,
+
+		sessionMaxLifetime: defaultSessionMaxLifetime,
+		idProviders:        make(map[string]idTokenVerifier),
+
+		magicLinkTTL:        defaultMagicLinkTTL,
+		magicLinkEmailLimit: defaultMagicLinkEmailLimit,
+		magicLinkIPLimit:    defaultMagicLinkIPLimit,
+
+		maxAccountFailures: defaultMaxAccountFailures,
+		maxIPFailures:      defaultMaxIPFailures,
+		baseLockout:        defaultBaseLockout,
+		maxLockout:         defaultMaxLockout,
 	}
 
 	// Set up options.
@@ -201,11 +304,21 @@ func (s *Service) Login(ctx context.Context, email, password, deviceID string) (
 	user, err := s.ur.GetUserByEmail(ctx, email)
 	if err != nil {
 		if db.IsNotFoundError(err) {
+			if err := s.checkLockout(ctx, attemptFlowLogin, uuid.Nil); err != nil {
+				return Token{}, err
+			}
+			if err := s.registerFailedAttempt(ctx, attemptFlowLogin, uuid.Nil); err != nil {
+				return Token{}, err
+			}
 			return Token{}, ErrInvalidCredentials
 		}
 		return Token{}, fmt.Errorf("could not log in: %w", err)
 	}
 
+	if err := s.checkLockout(ctx, attemptFlowLogin, user.ID); err != nil {
+		return Token{}, err
+	}
+
 	if user.Disabled {
 		return Token{}, ErrUserIsDisabled
 	}
@@ -258,23 +371,36 @@ func (s *Service) Login(ctx context.Context, email, password, deviceID string) (
 	}
 
 	if err := bcrypt.CompareHashAndPassword(user.Password, []byte(password)); err != nil {
+		if err := s.registerFailedAttempt(ctx, attemptFlowLogin, user.ID); err != nil {
+			return Token{}, err
+		}
 		return Token{}, ErrInvalidCredentials
 	}
 
-	token, refreshToken, err := s.jwt.NewWithRefreshToken(user.ID, user.Username, user.Role, deviceID)
+	// failed logins are reset only when the second factor is passed too
+	if err := s.verifyTwoFactor(ctx, user.ID, totp.ActionLogin); err != nil {
+		return Token{}, err
+	}
+	s.resetFailedAttempts(ctx, attemptFlowLogin, user.ID)
+
+	token, err := s.startSession(ctx, user, deviceID)
 	if err != nil {
-		return Token{}, fmt.Errorf("could not generate new access token: %w", err)
+		return Token{}, err
 	}
 
-	return Token{
-		AccessToken:  token,
-		RefreshToken: refreshToken,
-	}, nil
+	s.trackLogin(ctx, user.ID)
+
+	return token, nil
 }
 
-// Logout revokes JWT token.
-func (s *Service) Logout(ctx context.Context, tid string, userID uuid.UUID, deviceID string) error {
-	// TODO: add JWT id into the revoked tokens list
+// Logout revokes the current session, so its access and refresh tokens become invalid.
+// Session id is not set for tokens issued before sessions were introduced.
+func (s *Service) Logout(ctx context.Context, sessionID, userID uuid.UUID, deviceID string) error {
+	if sessionID != uuid.Nil {
+		if err := s.RevokeSession(ctx, userID, sessionID); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+	}
 
 	err := s.fs.UnregisterToken(ctx, userID, deviceID)
 	if err != nil && err != sql.ErrNoRows {
@@ -284,11 +410,16 @@ func (s *Service) Logout(ctx context.Context, tid string, userID uuid.UUID, devi
 	return nil
 }
 
-// RefreshToken returns new jwt string.
-func (s *Service) RefreshToken(ctx context.Context, uid uuid.UUID, username, role, deviceID string) (Token, error) {
+// RefreshToken returns new access and refresh tokens and prolongs the session,
+// the presented refresh token becomes invalid.
+// Tokens issued before sessions were introduced can't be revoked, so they are not refreshed.
+func (s *Service) RefreshToken(ctx context.Context, uid, sessionID, refreshTokenID uuid.UUID, username, role, deviceID string) (Token, error) {
 	if deviceID == "" && !s.skipDeviceIDCheck {
 		return Token{}, ErrEmptyDeviceID
 	}
+	if sessionID == uuid.Nil {
+		return Token{}, ErrSessionExpired
+	}
 
 	u, err := s.ur.GetUserByID(ctx, uid)
 	if err != nil {
@@ -344,20 +475,40 @@ func (s *Service) RefreshToken(ctx context.Context, uid uuid.UUID, username, rol
 		}
 	}
 
-	// TODO: add JWT id into the revoked tokens list
-	token, refreshToken, err := s.jwt.NewWithRefreshToken(u.ID, u.Username, u.Role, deviceID)
+	token, err := s.rotateRefreshToken(ctx, u, deviceID, sessionID, refreshTokenID)
 	if err != nil {
-		return Token{}, fmt.Errorf("could not generate new access token: %w", err)
+		return Token{}, err
 	}
 
-	return Token{
-		AccessToken:  token,
-		RefreshToken: refreshToken,
-	}, nil
+	s.trackLogin(ctx, u.ID)
+
+	return token, nil
+}
+
+// trackLogin reports the user's daily activity to reward campaigns, e.g. login streaks
+func (s *Service) trackLogin(ctx context.Context, userID uuid.UUID) {
+	if s.trackEvent != nil {
+		s.trackEvent(ctx, userID, campaigns.EventLogin)
+	}
+}
+
+// trackKYCPassed reports the user's identity verification to reward campaigns
+func (s *Service) trackKYCPassed(ctx context.Context, userID uuid.UUID) {
+	if s.trackEvent != nil {
+		s.trackEvent(ctx, userID, campaigns.EventKYCPassed)
+	}
+}
+
+// trackAccountVerified reports the user's email verification to reward campaigns
+func (s *Service) trackAccountVerified(ctx context.Context, userID uuid.UUID) {
+	if s.trackEvent != nil {
+		s.trackEvent(ctx, userID, campaigns.EventAccountVerified)
+	}
 }
 
 // SignUp registers account with email, password and username.
-func (s *Service) SignUp(ctx context.Context, email, password, username, deviceID string) (Token, error) {
+// Invitation token is optional, it's used to accept the invitation sent to another email address.
+func (s *Service) SignUp(ctx context.Context, email, password, username, deviceID, invitationToken string) (Token, error) {
 	if deviceID == "" && !s.skipDeviceIDCheck {
 		return Token{}, ErrEmptyDeviceID
 	}
@@ -478,21 +629,22 @@ func (s *Service) SignUp(ctx context.Context, email, password, username, deviceI
 		log.Printf("[email verification] email: %s, otp: %s", email, otp)
 	}
 
-	token, refreshToken, err := s.jwt.NewWithRefreshToken(u.ID, u.Username, u.Role, deviceID)
+	token, err := s.startSession(ctx, u, deviceID)
 	if err != nil {
-		return Token{}, fmt.Errorf("could not generate new access token: %w", err)
+		return Token{}, err
 	}
 
-	if isInvited, _ := s.ic.IsEmailInvited(ctx, email); isInvited {
+	if invitationToken != "" {
+		if err := s.ic.AcceptInvitationByToken(ctx, u.ID, invitationToken); err != nil {
+			log.Printf("could not accept invitation by token for user id = %s: %v", u.ID, err)
+		}
+	} else if isInvited, _ := s.ic.IsEmailInvited(ctx, email); isInvited {
 		if err := s.ic.AcceptInvitation(ctx, u.ID, email); err != nil {
 			log.Printf("could not accept invitation for user id = %s: %v", u.ID, err)
 		}
 	}
 
-	return Token{
-		AccessToken:  token,
-		RefreshToken: refreshToken,
-	}, nil
+	return token, nil
 }
 
 // ForgotPassword requests password reset with email.
@@ -591,17 +743,31 @@ func (s *Service) ValidateResetPasswordCode(ctx context.Context, email, otp stri
 	})
 	if err != nil {
 		if db.IsNotFoundError(err) {
+			if err := s.checkLockout(ctx, attemptFlowResetPassword, uuid.Nil); err != nil {
+				return uuid.Nil, err
+			}
+			if err := s.registerFailedAttempt(ctx, attemptFlowResetPassword, uuid.Nil); err != nil {
+				return uuid.Nil, err
+			}
 			return uuid.Nil, fmt.Errorf("%w user with given email address", ErrNotFound)
 		}
 		return uuid.Nil, fmt.Errorf("could not get user with given email address: %w", err)
 	}
 
+	if err := s.checkLockout(ctx, attemptFlowResetPassword, v.UserID); err != nil {
+		return uuid.Nil, err
+	}
+
 	err = bcrypt.CompareHashAndPassword(v.VerificationCode, []byte(otp))
 	if err != nil {
 		if err := bcrypt.CompareHashAndPassword([]byte(s.masterCode), []byte(otp)); err != nil {
+			if err := s.registerFailedAttempt(ctx, attemptFlowResetPassword, v.UserID); err != nil {
+				return uuid.Nil, err
+			}
 			return uuid.Nil, ErrOTPCode
 		}
 	}
+	s.resetFailedAttempts(ctx, attemptFlowResetPassword, v.UserID)
 
 	return v.UserID, nil
 }
@@ -635,11 +801,13 @@ func (s *Service) ResetPassword(ctx context.Context, email, password, otp string
 		log.Printf("could not delete password resets for user with id=%s: %v", userID.String(), err)
 	}
 
+	s.revokeSessionsOnSecurityEvent(ctx, userID, uuid.Nil, "password reset")
+
 	return nil
 }
 
-// ChangePassword changing password.
-func (s *Service) ChangePassword(ctx context.Context, userID uuid.UUID, oldPassword, newPassword string) error {
+// ChangePassword changing password, revokes all user sessions except the current one.
+func (s *Service) ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, oldPassword, newPassword string) error {
 	user, err := s.ur.GetUserByID(ctx, userID)
 	if err != nil {
 		if db.IsNotFoundError(err) {
@@ -666,6 +834,8 @@ func (s *Service) ChangePassword(ctx context.Context, userID uuid.UUID, oldPassw
 		return fmt.Errorf("could not reset password: %w", err)
 	}
 
+	s.revokeSessionsOnSecurityEvent(ctx, userID, sessionID, "password change")
+
 	return nil
 }
 
@@ -723,6 +893,10 @@ func (s *Service) VerifyAccount(ctx context.Context, userID uuid.UUID, otp strin
 		}
 	}
 
+	if err := s.checkLockout(ctx, attemptFlowVerifyAccount, userID); err != nil {
+		return err
+	}
+
 	uv, err := s.ur.GetUserVerificationByUserID(ctx, repository.GetUserVerificationByUserIDParams{
 		RequestType: repository.VerifyConfirmAccount,
 		UserID:      userID,
@@ -734,9 +908,13 @@ func (s *Service) VerifyAccount(ctx context.Context, userID uuid.UUID, otp strin
 	err = bcrypt.CompareHashAndPassword(uv.VerificationCode, []byte(otp))
 	if err != nil {
 		if err := bcrypt.CompareHashAndPassword([]byte(s.masterCode), []byte(otp)); err != nil {
+			if err := s.registerFailedAttempt(ctx, attemptFlowVerifyAccount, userID); err != nil {
+				return err
+			}
 			return ErrOTPCode
 		}
 	}
+	s.resetFailedAttempts(ctx, attemptFlowVerifyAccount, userID)
 
 	if err := s.ur.UpdateUserVerifiedAt(ctx, repository.UpdateUserVerifiedAtParams{
 		UserID:     userID,
@@ -757,6 +935,8 @@ func (s *Service) VerifyAccount(ctx context.Context, userID uuid.UUID, otp strin
 		log.Printf("could not delete verification code for user with id=%s: %v", userID.String(), err)
 	}
 
+	s.trackAccountVerified(ctx, userID)
+
 	return nil
 }
 
@@ -862,26 +1042,38 @@ func (s *Service) RequestChangeEmail(ctx context.Context, userID uuid.UUID, emai
 func (s *Service) ValidateChangeEmailCode(ctx context.Context, userID uuid.UUID, email, otp string) error {
 	email = strings.ToLower(strings.TrimSpace(email))
 
+	if err := s.checkLockout(ctx, attemptFlowChangeEmail, userID); err != nil {
+		return err
+	}
+
 	v, err := s.ur.GetUserVerificationByEmail(ctx, repository.GetUserVerificationByEmailParams{
 		RequestType: repository.VerifyChangeEmail,
 		Email:       email,
 	})
 	if err != nil || v.UserID != userID {
+		if err := s.registerFailedAttempt(ctx, attemptFlowChangeEmail, userID); err != nil {
+			return err
+		}
 		return ErrOTPCode
 	}
 
 	err = bcrypt.CompareHashAndPassword(v.VerificationCode, []byte(otp))
 	if err != nil {
 		if err := bcrypt.CompareHashAndPassword([]byte(s.masterCode), []byte(otp)); err != nil {
+			if err := s.registerFailedAttempt(ctx, attemptFlowChangeEmail, userID); err != nil {
+				return err
+			}
 			return ErrOTPCode
 		}
 	}
+	s.resetFailedAttempts(ctx, attemptFlowChangeEmail, userID)
 
 	return nil
 }
 
-// UpdateEmail updates user's email to provided new one in case of correct otp provided.
-func (s *Service) UpdateEmail(ctx context.Context, userID uuid.UUID, email, otp string) error {
+// UpdateEmail updates user's email to provided new one in case of correct otp provided,
+// revokes all user sessions except the current one.
+func (s *Service) UpdateEmail(ctx context.Context, userID, sessionID uuid.UUID, email, otp string) error {
 	email = strings.ToLower(strings.TrimSpace(email))
 
 	// Sanitize email address
@@ -892,10 +1084,19 @@ func (s *Service) UpdateEmail(ctx context.Context, userID uuid.UUID, email, otp
 		})
 	}
 
+	if err := s.verifyTwoFactor(ctx, userID, totp.ActionChangeEmail); err != nil {
+		return err
+	}
+
 	if err := s.ValidateChangeEmailCode(ctx, userID, email, otp); err != nil {
 		return fmt.Errorf("could not update email: %w", err)
 	}
 
+	u, err := s.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("could not get user: %w", err)
+	}
+
 	if err := s.ur.UpdateUserEmail(ctx, repository.UpdateUserEmailParams{
 		ID:             userID,
 		Email:          email,
@@ -920,6 +1121,16 @@ func (s *Service) UpdateEmail(ctx context.Context, userID uuid.UUID, email, otp
 		log.Printf("could not delete verification code for user with id=%s: %v", userID.String(), err)
 	}
 
+	// accounts signed up with wallet are verified by the first confirmed email
+	if !u.VerifiedAt.Valid {
+		if err := s.ws.CreateWallet(ctx, userID); err != nil {
+			return fmt.Errorf("could not create solana wallet: %w", err)
+		}
+		s.trackAccountVerified(ctx, userID)
+	}
+
+	s.revokeSessionsOnSecurityEvent(ctx, userID, sessionID, "email change")
+
 	return nil
 }
 
@@ -1077,13 +1288,19 @@ func (s *Service) ValidateDestroyAccountCode(ctx context.Context, uid uuid.UUID,
 	return nil
 }
 
-// DestroyAccount destroys account.
+// DestroyAccount deletes account of the user.
+// If account deletion is scheduled by the deletion pipeline, the user's data is erased
+// after the grace period, otherwise the account is anonymized right away.
 func (s *Service) DestroyAccount(ctx context.Context, uid uuid.UUID, otp string) error {
 	if err := s.ValidateDestroyAccountCode(ctx, uid, otp); err != nil {
 		return err
 	}
 
-	if err := s.ur.DestroyUser(ctx, uid); err != nil {
+	if s.scheduleDeletion != nil {
+		if err := s.scheduleDeletion(ctx, uid); err != nil {
+			return fmt.Errorf("could not schedule account deletion: %w", err)
+		}
+	} else if err := s.EraseUserData(ctx, uid); err != nil {
 		return fmt.Errorf("could not destroy account: %w", err)
 	}
 
@@ -1108,6 +1325,15 @@ func (s *Service) AddToWhitelist(ctx context.Context, allowedType, allowedValue
 		return fmt.Errorf("could not add llowed type and value to whitelist: %w", err)
 	}
 
+	if s.audit != nil {
+		if err := s.audit(ctx, "whitelist.add", "whitelist", allowedType+":"+allowedValue, nil, Whitelist{
+			AllowedType:  allowedType,
+			AllowedValue: allowedValue,
+		}); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1165,6 +1391,15 @@ func (s *Service) DeleteFromWhitelist(ctx context.Context, allowedType, allowedV
 		return fmt.Errorf("could not delete record from whitelist: %w", err)
 	}
 
+	if s.audit != nil {
+		if err := s.audit(ctx, "whitelist.remove", "whitelist", allowedType+":"+allowedValue, Whitelist{
+			AllowedType:  allowedType,
+			AllowedValue: allowedValue,
+		}, nil); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1178,6 +1413,22 @@ func (s *Service) AddToBlacklist(ctx context.Context, restrictedType, restricted
 		return fmt.Errorf("could not add llowed type and value to blacklist: %w", err)
 	}
 
+	// blacklisted user can't log in or refresh token, so the active sessions are revoked as well
+	if restrictedType == "email" {
+		if u, err := s.ur.GetUserByEmail(ctx, restrictedValue); err == nil {
+			s.revokeSessionsOnSecurityEvent(ctx, u.ID, uuid.Nil, "ban")
+		}
+	}
+
+	if s.audit != nil {
+		if err := s.audit(ctx, "blacklist.add", "blacklist", restrictedType+":"+restrictedValue, nil, Blacklist{
+			RestrictedType:  restrictedType,
+			RestrictedValue: restrictedValue,
+		}); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1235,6 +1486,15 @@ func (s *Service) DeleteFromBlacklist(ctx context.Context, restrictedType, restr
 		return fmt.Errorf("could not delete record from blacklist: %w", err)
 	}
 
+	if s.audit != nil {
+		if err := s.audit(ctx, "blacklist.remove", "blacklist", restrictedType+":"+restrictedValue, Blacklist{
+			RestrictedType:  restrictedType,
+			RestrictedValue: restrictedValue,
+		}, nil); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1384,6 +1644,8 @@ func (s *Service) VerificationCallback(ctx context.Context, userID uuid.UUID) er
 		if err != nil {
 			return fmt.Errorf("could not update kyc status for user: %v: %w", userID, err)
 		}
+
+		s.trackKYCPassed(ctx, userID)
 	}
 
 	if resp.Review.ReviewResult.ReviewAnswer == sumsub.KYCProviderStatusRed && resp.Review.ReviewResult.ReviewRejectType == sumsub.KYCProviderStatusFinal {
@@ -1399,6 +1661,8 @@ func (s *Service) VerificationCallback(ctx context.Context, userID uuid.UUID) er
 			return fmt.Errorf("could not block user: %v: %w", userID, err)
 		}
 
+		s.revokeSessionsOnSecurityEvent(ctx, userID, uuid.Nil, "ban")
+
 		err = s.ur.UpdateKYCStatus(ctx, repository.UpdateKYCStatusParams{
 			KycStatus: sumsub.KYCStatusRejected,
 			ID:        userID,
@@ -1429,9 +1693,65 @@ func (s *Service) UpdateKYCStatus(ctx context.Context, uid uuid.UUID) error {
 		return fmt.Errorf("could not update kyc status for user: %v: %w", uid, err)
 	}
 
+	s.trackKYCPassed(ctx, uid)
+
 	return nil
 }
 
+// AreUsersLinked reports whether both accounts seem to belong to the same person:
+// they have the same sanitized email address or were used on the same device.
+func (s *Service) AreUsersLinked(ctx context.Context, userID, otherUserID uuid.UUID) (bool, error) {
+	u, err := s.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("could not get user by id: %v: %w", userID, err)
+	}
+	other, err := s.ur.GetUserByID(ctx, otherUserID)
+	if err != nil {
+		return false, fmt.Errorf("could not get user by id: %v: %w", otherUserID, err)
+	}
+
+	if u.SanitizedEmail.Valid && u.SanitizedEmail.String != "" &&
+		u.SanitizedEmail.String == other.SanitizedEmail.String {
+		return true, nil
+	}
+
+	shared, err := s.ur.DoUsersShareDevice(ctx, repository.DoUsersShareDeviceParams{
+		UserID:      userID,
+		OtherUserID: otherUserID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("could not check users devices: %w", err)
+	}
+
+	return shared, nil
+}
+
+// HasMultipleAccounts reports whether any of the user's devices is linked to another account.
+func (s *Service) HasMultipleAccounts(ctx context.Context, userID uuid.UUID) (bool, error) {
+	yes, err := s.ur.DoesUserHaveMoreThanOneAccount(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("could not check user devices: %w", err)
+	}
+
+	return yes, nil
+}
+
+// IsKYCVerified reports whether the user has passed identity verification.
+func (s *Service) IsKYCVerified(ctx context.Context, userID uuid.UUID) (bool, error) {
+	user, err := s.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("could not get user by id: %v: %w", userID, err)
+	}
+
+	return user.KycStatus.String == sumsub.KYCStatusApproved, nil
+}
+
 func (s *Service) GetUsernameByID(ctx context.Context, uid uuid.UUID) (string, error) {
 	user, err := s.ur.GetUserByID(ctx, uid)
 	if err != nil {
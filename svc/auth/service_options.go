@@ -1,5 +1,12 @@
 package auth
 
+import (
+	"strings"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/lib/idtoken"
+)
+
 // WithMailService option
 // Sets up service to send emails
 func WithMailService(m mailer) ServiceOption {
@@ -55,3 +62,131 @@ func WithSkipDeviceIDCheck(skip bool) ServiceOption {
 		s.skipDeviceIDCheck = skip
 	}
 }
+
+// WithCampaignEventFunc option
+// Reports logins and passed KYC to reward campaigns
+func WithCampaignEventFunc(fn trackEventFunc) ServiceOption {
+	return func(s *Service) {
+		s.trackEvent = fn
+	}
+}
+
+// WithAccountDeletionFunc option
+// Account deletion is scheduled with the grace period the user can cancel it within,
+// the account is anonymized by EraseUserData when the grace period is over.
+func WithAccountDeletionFunc(fn scheduleDeletionFunc) ServiceOption {
+	return func(s *Service) {
+		s.scheduleDeletion = fn
+	}
+}
+
+// WithSessionMaxLifetime option
+// Sets up absolute session lifetime, the user has to log in again after it
+func WithSessionMaxLifetime(d time.Duration) ServiceOption {
+	return func(s *Service) {
+		if d > 0 {
+			s.sessionMaxLifetime = d
+		}
+	}
+}
+
+// WithTwoFactor option
+// Enables TOTP second factor, users choose which actions require step-up code
+func WithTwoFactor(tf *TwoFactor) ServiceOption {
+	return func(s *Service) {
+		s.tf = tf
+		// wrong step-up codes lock the account like failed logins,
+		// including the checks of other services sharing the two-factor
+		tf.limiter = s
+	}
+}
+
+// WithIdentityProvider option
+// Enables sign in with ID tokens of the identity provider, e.g. Apple or Google.
+// Client ids are ids of the apps registered in the provider, the provider is disabled if not set.
+func WithIdentityProvider(p idtoken.Provider, clientIDs ...string) ServiceOption {
+	return func(s *Service) {
+		audiences := make([]string, 0, len(clientIDs))
+		for _, id := range clientIDs {
+			if id = strings.TrimSpace(id); id != "" {
+				audiences = append(audiences, id)
+			}
+		}
+		if len(audiences) > 0 {
+			s.idProviders[p.Name] = idtoken.NewVerifier(p, audiences)
+		}
+	}
+}
+
+// WithMagicLinks option
+// Enables login by single-use links sent to email,
+// links are signed with the secret and lead to the given url with the token query parameter.
+func WithMagicLinks(secret, linkURL string, ttl time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.magicLinkSecret = secret
+		s.magicLinkURL = linkURL
+		if ttl > 0 {
+			s.magicLinkTTL = ttl
+		}
+	}
+}
+
+// WithMagicLinkRateLimits option
+// Sets how many magic links can be requested per email and per client ip within an hour
+func WithMagicLinkRateLimits(perEmail, perIP int) ServiceOption {
+	return func(s *Service) {
+		if perEmail > 0 {
+			s.magicLinkEmailLimit = perEmail
+		}
+		if perIP > 0 {
+			s.magicLinkIPLimit = perIP
+		}
+	}
+}
+
+// WithSolanaSignIn option
+// Enables sign in with Solana wallet, the messages signed by wallet are bound to the domain,
+// uri and solana cluster, e.g. mainnet.
+func WithSolanaSignIn(domain, uri, chainID string) ServiceOption {
+	return func(s *Service) {
+		s.solanaSignInDomain = domain
+		s.solanaSignInURI = uri
+		s.solanaSignInChainID = chainID
+	}
+}
+
+// WithLockoutPolicy option
+// Sets how many failed login or OTP attempts are allowed per account and per client ip within an hour,
+// and the lockout duration that is doubled on each next lockout up to the max duration.
+func WithLockoutPolicy(maxAccountFailures, maxIPFailures int, baseLockout, maxLockout time.Duration) ServiceOption {
+	return func(s *Service) {
+		if maxAccountFailures > 0 {
+			s.maxAccountFailures = maxAccountFailures
+		}
+		if maxIPFailures > 0 {
+			s.maxIPFailures = maxIPFailures
+		}
+		if baseLockout > 0 {
+			s.baseLockout = baseLockout
+		}
+		if maxLockout > 0 {
+			s.maxLockout = maxLockout
+		}
+	}
+}
+
+// WithAuditFunc option
+// Records bans, whitelist changes and account unlocks to the audit log
+func WithAuditFunc(fn auditFunc) ServiceOption {
+	return func(s *Service) {
+		s.audit = fn
+	}
+}
+
+// WithSessionCache option
+// Revoked sessions are added to the cache used by jwt middleware right away
+func WithSessionCache(c sessionCache) ServiceOption {
+	return func(s *Service) {
+		s.sessions = c
+	}
+}
@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+	"github.com/SatorNetwork/sator-api/svc/auth/repository"
+)
+
+type (
+	// Session is a login of the user on a device,
+	// access and refresh tokens issued on login share the session id.
+	Session struct {
+		ID         string    `json:"id"`
+		DeviceID   string    `json:"device_id,omitempty"`
+		IP         string    `json:"ip,omitempty"`
+		UserAgent  string    `json:"user_agent,omitempty"`
+		Current    bool      `json:"current"`
+		LastSeenAt time.Time `json:"last_seen_at"`
+		CreatedAt  time.Time `json:"created_at"`
+	}
+
+	contextKey string
+)
+
+// Default absolute session lifetime
+const defaultSessionMaxLifetime = 90 * 24 * time.Hour
+
+// Context keys of the client info, used to describe user's sessions
+const (
+	clientIPContextKey        contextKey = "ClientIP"
+	clientUserAgentContextKey contextKey = "ClientUserAgent"
+)
+
+// clientToContext moves client ip and user agent from request to context.
+// Remote address is set to the real client ip by the router middleware.
+func clientToContext() httptransport.RequestFunc {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		ip := r.RemoteAddr
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			ip = host
+		}
+		ctx = context.WithValue(ctx, clientIPContextKey, ip)
+		return context.WithValue(ctx, clientUserAgentContextKey, r.UserAgent())
+	}
+}
+
+// clientFromContext returns client ip and user agent from context
+func clientFromContext(ctx context.Context) (ip, userAgent string) {
+	ip, _ = ctx.Value(clientIPContextKey).(string)
+	userAgent, _ = ctx.Value(clientUserAgentContextKey).(string)
+	return ip, userAgent
+}
+
+// startSession starts a new session of the user, e.g. on login,
+// returns access and refresh tokens of the session.
+func (s *Service) startSession(ctx context.Context, u repository.User, deviceID string) (Token, error) {
+	ip, userAgent := clientFromContext(ctx)
+	now := time.Now()
+	sessionID, refreshTokenID := uuid.New(), uuid.New()
+	expiresAt := sessionExpiresAt(now, now, s.jwt.RefreshTokenTTL(), s.sessionMaxLifetime)
+
+	if err := s.ur.AddUserSession(ctx, repository.AddUserSessionParams{
+		ID:             sessionID,
+		UserID:         u.ID,
+		DeviceID:       deviceID,
+		Ip:             ip,
+		UserAgent:      userAgent,
+		ExpiresAt:      expiresAt,
+		RefreshTokenID: uuid.NullUUID{UUID: refreshTokenID, Valid: true},
+	}); err != nil {
+		return Token{}, fmt.Errorf("could not start user session: %w", err)
+	}
+
+	return s.issueTokens(u, deviceID, sessionID, refreshTokenID, expiresAt)
+}
+
+// rotateRefreshToken prolongs the session and replaces its refresh token with a new one.
+// The presented refresh token must be the last one issued for the session,
+// otherwise it was already used, e.g. stolen, so the whole session is revoked.
+func (s *Service) rotateRefreshToken(ctx context.Context, u repository.User, deviceID string, sessionID, refreshTokenID uuid.UUID) (Token, error) {
+	ss, err := s.ur.GetUserSessionByID(ctx, sessionID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Token{}, ErrInvalidCredentials
+		}
+		return Token{}, fmt.Errorf("could not get user session: %w", err)
+	}
+	if ss.UserID != u.ID {
+		return Token{}, ErrInvalidCredentials
+	}
+	if ss.RevokedAt.Valid {
+		return Token{}, jwt.ErrSessionRevoked
+	}
+
+	now := time.Now()
+	expiresAt := sessionExpiresAt(ss.CreatedAt, now, s.jwt.RefreshTokenTTL(), s.sessionMaxLifetime)
+	if !expiresAt.After(now) {
+		return Token{}, ErrSessionExpired
+	}
+
+	ip, userAgent := clientFromContext(ctx)
+	newRefreshTokenID := uuid.New()
+	rotated, err := s.ur.RotateUserSessionRefreshToken(ctx, repository.RotateUserSessionRefreshTokenParams{
+		NewRefreshTokenID: uuid.NullUUID{UUID: newRefreshTokenID, Valid: true},
+		DeviceID:          deviceID,
+		Ip:                ip,
+		UserAgent:         userAgent,
+		ExpiresAt:         expiresAt,
+		ID:                sessionID,
+		RefreshTokenID:    uuid.NullUUID{UUID: refreshTokenID, Valid: true},
+	})
+	if err != nil {
+		return Token{}, fmt.Errorf("could not rotate refresh token: %w", err)
+	}
+	if rotated == 0 {
+		s.revokeCompromisedSession(ctx, u, ss, deviceID, ip)
+		return Token{}, ErrRefreshTokenReused
+	}
+
+	return s.issueTokens(u, deviceID, sessionID, newRefreshTokenID, expiresAt)
+}
+
+// revokeCompromisedSession revokes the session whose rotated refresh token was presented again
+// and notifies the user, errors are logged only, since the token is rejected anyway.
+func (s *Service) revokeCompromisedSession(ctx context.Context, u repository.User, ss repository.UserSession, deviceID, ip string) {
+	if err := s.RevokeSession(ctx, u.ID, ss.ID); err != nil && !errors.Is(err, ErrNotFound) {
+		log.Printf("could not revoke compromised session %s of user with id=%s: %v", ss.ID, u.ID, err)
+	}
+
+	if s.mail != nil {
+		if err := s.mail.SendSessionCompromisedAlert(ctx, u.Email, deviceID, ip); err != nil {
+			log.Printf("could not send session compromised alert to user with id=%s: %v", u.ID, err)
+		}
+	}
+}
+
+// issueTokens returns signed access and refresh tokens of the session
+func (s *Service) issueTokens(u repository.User, deviceID string, sessionID, refreshTokenID uuid.UUID, expiresAt time.Time) (Token, error) {
+	token, refreshToken, err := s.jwt.NewWithRefreshToken(sessionID, refreshTokenID, u.ID, u.Username, u.Role, deviceID, expiresAt)
+	if err != nil {
+		return Token{}, fmt.Errorf("could not generate new access token: %w", err)
+	}
+
+	return Token{
+		AccessToken:  token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// sessionExpiresAt returns expiration time of the session refreshed at the given time:
+// the sliding lifetime from now, but not later than the absolute lifetime since the session start.
+func sessionExpiresAt(createdAt, now time.Time, slidingTTL, maxLifetime time.Duration) time.Time {
+	expiresAt := now.Add(slidingTTL)
+	if deadline := createdAt.Add(maxLifetime); maxLifetime > 0 && deadline.Before(expiresAt) {
+		return deadline
+	}
+	return expiresAt
+}
+
+// GetSessions returns active sessions of the user
+func (s *Service) GetSessions(ctx context.Context, userID, currentSessionID uuid.UUID) ([]Session, error) {
+	sessions, err := s.ur.GetActiveUserSessions(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get user sessions: %w", err)
+	}
+
+	result := make([]Session, 0, len(sessions))
+	for _, ss := range sessions {
+		result = append(result, Session{
+			ID:         ss.ID.String(),
+			DeviceID:   ss.DeviceID,
+			IP:         ss.Ip,
+			UserAgent:  ss.UserAgent,
+			Current:    ss.ID == currentSessionID,
+			LastSeenAt: ss.LastSeenAt,
+			CreatedAt:  ss.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// RevokeSession revokes the user session, access and refresh tokens of the session become invalid
+func (s *Service) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	ss, err := s.ur.RevokeUserSession(ctx, repository.RevokeUserSessionParams{
+		ID:     sessionID,
+		UserID: userID,
+	})
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return fmt.Errorf("session %w", ErrNotFound)
+		}
+		return fmt.Errorf("could not revoke session: %w", err)
+	}
+
+	if s.sessions != nil {
+		s.sessions.Revoke(jwt.RevokedSession{ID: ss.ID, ExpiresAt: ss.ExpiresAt})
+	}
+
+	return nil
+}
+
+// RevokeAllSessions revokes all sessions of the user, including the current one
+func (s *Service) RevokeAllSessions(ctx context.Context, userID uuid.UUID) error {
+	return s.revokeSessions(ctx, userID, uuid.Nil)
+}
+
+// revokeSessions revokes all sessions of the user except the given one
+func (s *Service) revokeSessions(ctx context.Context, userID, exceptSessionID uuid.UUID) error {
+	sessions, err := s.ur.RevokeUserSessions(ctx, repository.RevokeUserSessionsParams{
+		UserID:   userID,
+		ExceptID: exceptSessionID,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not revoke user sessions: %w", err)
+	}
+
+	if s.sessions != nil {
+		revoked := make([]jwt.RevokedSession, 0, len(sessions))
+		for _, ss := range sessions {
+			revoked = append(revoked, jwt.RevokedSession{ID: ss.ID, ExpiresAt: ss.ExpiresAt})
+		}
+		s.sessions.Revoke(revoked...)
+	}
+
+	return nil
+}
+
+// revokeSessionsOnSecurityEvent revokes user sessions after the account security was changed,
+// errors are logged only, since the change itself is already stored.
+func (s *Service) revokeSessionsOnSecurityEvent(ctx context.Context, userID, exceptSessionID uuid.UUID, event string) {
+	if err := s.revokeSessions(ctx, userID, exceptSessionID); err != nil {
+		log.Printf("could not revoke sessions of user with id=%s on %s: %v", userID, event, err)
+	}
+}
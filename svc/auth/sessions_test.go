@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSessionExpiresAt(t *testing.T) {
+	createdAt := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		maxLifetime time.Duration
+		want        time.Time
+	}{
+		{"sliding lifetime", createdAt.Add(10 * day), 90 * day, createdAt.Add(40 * day)},
+		{"limited by absolute lifetime", createdAt.Add(80 * day), 90 * day, createdAt.Add(90 * day)},
+		{"absolute lifetime exceeded", createdAt.Add(100 * day), 90 * day, createdAt.Add(90 * day)},
+		{"absolute lifetime is not set", createdAt.Add(100 * day), 0, createdAt.Add(130 * day)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sessionExpiresAt(createdAt, tt.now, 30*day, tt.maxLifetime); !got.Equal(tt.want) {
+				t.Errorf("sessionExpiresAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefreshTokenWithoutSession(t *testing.T) {
+	s := &Service{}
+	_, err := s.RefreshToken(context.Background(), uuid.New(), uuid.Nil, uuid.Nil, "user", "user", "device")
+	if !errors.Is(err, ErrSessionExpired) {
+		t.Errorf("RefreshToken() error = %v, want %v", err, ErrSessionExpired)
+	}
+}
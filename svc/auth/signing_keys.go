@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/encryption/aes"
+	internal_rsa "github.com/SatorNetwork/sator-api/lib/encryption/rsa"
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+	"github.com/SatorNetwork/sator-api/svc/auth/repository"
+)
+
+type (
+	// KeyStore stores jwt signing keys in db,
+	// private keys are encrypted with AES key derived from the given secret.
+	KeyStore struct {
+		repo          signingKeyRepository
+		encryptionKey []byte
+	}
+
+	signingKeyRepository interface {
+		AddJWTSigningKey(ctx context.Context, arg repository.AddJWTSigningKeyParams) error
+		GetJWTSigningKeys(ctx context.Context, createdAt time.Time) ([]repository.JwtSigningKey, error)
+	}
+)
+
+// NewKeyStore is a factory function,
+// returns a new instance of the KeyStore struct
+func NewKeyStore(repo signingKeyRepository, encryptionSecret string) *KeyStore {
+	if repo == nil {
+		log.Fatalln("signing key repository is not set")
+	}
+	if encryptionSecret == "" {
+		log.Fatalln("signing keys encryption secret is not set")
+	}
+
+	key := sha256.Sum256([]byte(encryptionSecret))
+
+	return &KeyStore{
+		repo:          repo,
+		encryptionKey: key[:],
+	}
+}
+
+// GetSigningKeys returns signing keys created after the given time
+func (ks *KeyStore) GetSigningKeys(ctx context.Context, createdAfter time.Time) ([]jwt.SigningKey, error) {
+	keys, err := ks.repo.GetJWTSigningKeys(ctx, createdAfter)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get signing keys: %w", err)
+	}
+
+	result := make([]jwt.SigningKey, 0, len(keys))
+	for _, k := range keys {
+		pemKey, err := aes.Decrypt(ks.encryptionKey, k.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt signing key %s: %w", k.ID, err)
+		}
+
+		pk, err := internal_rsa.BytesToPrivateKey(pemKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse signing key %s: %w", k.ID, err)
+		}
+
+		result = append(result, jwt.SigningKey{
+			ID:         k.ID,
+			PrivateKey: pk,
+			CreatedAt:  k.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// AddSigningKey stores a new signing key
+func (ks *KeyStore) AddSigningKey(ctx context.Context, key jwt.SigningKey) error {
+	encrypted, err := aes.Encrypt(ks.encryptionKey, internal_rsa.PrivateKeyToBytes(key.PrivateKey))
+	if err != nil {
+		return fmt.Errorf("could not encrypt signing key: %w", err)
+	}
+
+	if err := ks.repo.AddJWTSigningKey(ctx, repository.AddJWTSigningKeyParams{
+		ID:         key.ID,
+		PrivateKey: encrypted,
+		CreatedAt:  key.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("could not store signing key: %w", err)
+	}
+
+	return nil
+}
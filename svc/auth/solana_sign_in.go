@@ -0,0 +1,290 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/lib/siws"
+	"github.com/SatorNetwork/sator-api/svc/auth/repository"
+)
+
+// ProviderSolana is a name of the identity provider of accounts signed in with Solana wallet,
+// the wallet address is the subject of the identity.
+const ProviderSolana = "solana"
+
+// Sign in with Solana settings
+const (
+	solanaSignInNonceTTL          = 5 * time.Minute
+	solanaSignInStatement         = "Sign in to Sator with your Solana account."
+	solanaSignInNonceAddressLimit = 10 // nonces per wallet address within the rate limit window
+	solanaSignInNonceIPLimit      = 30 // nonces per client ip within the rate limit window
+	solanaSignInRateLimitWindow   = time.Hour
+
+	// accounts signed up with wallet have no email, the placeholder keeps emails unique
+	walletAccountEmailDomain = "wallet.sator.invalid"
+)
+
+// SolanaSignInMessage is a message to be signed by the wallet to sign in or link the wallet
+type SolanaSignInMessage struct {
+	Message   string    `json:"message"`
+	Nonce     string    `json:"nonce"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// RequestSolanaSignInMessage returns a single-use message bound to the domain
+// to be signed by the wallet with the given address.
+func (s *Service) RequestSolanaSignInMessage(ctx context.Context, address string) (SolanaSignInMessage, error) {
+	if s.solanaSignInDomain == "" {
+		return SolanaSignInMessage{}, ErrSolanaSignInUnavailable
+	}
+	if err := siws.ValidateAddress(address); err != nil {
+		return SolanaSignInMessage{}, fmt.Errorf("%w: %v", ErrInvalidParameter, err)
+	}
+
+	ip, _ := clientFromContext(ctx)
+	if err := s.checkSolanaSignInRateLimits(ctx, address, ip); err != nil {
+		return SolanaSignInMessage{}, err
+	}
+
+	nonce, err := siws.NewNonce()
+	if err != nil {
+		return SolanaSignInMessage{}, err
+	}
+
+	now := time.Now()
+	msg := siws.Message{
+		Domain:         s.solanaSignInDomain,
+		Address:        address,
+		Statement:      solanaSignInStatement,
+		URI:            s.solanaSignInURI,
+		ChainID:        s.solanaSignInChainID,
+		Nonce:          nonce,
+		IssuedAt:       now,
+		ExpirationTime: now.Add(solanaSignInNonceTTL),
+	}
+
+	if err := s.ur.AddSolanaSignInNonce(ctx, repository.AddSolanaSignInNonceParams{
+		Nonce:     nonce,
+		Address:   address,
+		Message:   msg.String(),
+		Ip:        ip,
+		ExpiresAt: msg.ExpirationTime,
+	}); err != nil {
+		return SolanaSignInMessage{}, fmt.Errorf("could not store sign in nonce: %w", err)
+	}
+
+	return SolanaSignInMessage{
+		Message:   msg.String(),
+		Nonce:     nonce,
+		ExpiresAt: msg.ExpirationTime,
+	}, nil
+}
+
+// checkSolanaSignInRateLimits returns ErrTooManyRequests if too many sign in messages
+// were requested for the wallet address or from the client ip recently.
+func (s *Service) checkSolanaSignInRateLimits(ctx context.Context, address, ip string) error {
+	since := time.Now().Add(-solanaSignInRateLimitWindow)
+
+	n, err := s.ur.CountSolanaSignInNoncesByAddress(ctx, repository.CountSolanaSignInNoncesByAddressParams{
+		Address:      address,
+		CreatedAfter: since,
+	})
+	if err != nil {
+		return fmt.Errorf("could not count sign in nonces: %w", err)
+	}
+	if n >= solanaSignInNonceAddressLimit {
+		return ErrTooManyRequests
+	}
+
+	if ip == "" {
+		return nil
+	}
+
+	n, err = s.ur.CountSolanaSignInNoncesByIP(ctx, repository.CountSolanaSignInNoncesByIPParams{
+		Ip:           ip,
+		CreatedAfter: since,
+	})
+	if err != nil {
+		return fmt.Errorf("could not count sign in nonces: %w", err)
+	}
+	if n >= solanaSignInNonceIPLimit {
+		return ErrTooManyRequests
+	}
+
+	return nil
+}
+
+// SignInWithSolana signs in the user by the message signed with Solana wallet.
+// A new unverified account is created for unknown wallet.
+func (s *Service) SignInWithSolana(ctx context.Context, nonce, signature, deviceID, invitationToken string) (Token, error) {
+	if deviceID == "" && !s.skipDeviceIDCheck {
+		return Token{}, ErrEmptyDeviceID
+	}
+
+	address, err := s.verifySolanaSignIn(ctx, nonce, signature)
+	if err != nil {
+		return Token{}, err
+	}
+
+	identity, err := s.ur.GetUserIdentity(ctx, repository.GetUserIdentityParams{
+		Provider: ProviderSolana,
+		Subject:  address,
+	})
+	if err == nil {
+		u, err := s.ur.GetUserByID(ctx, identity.UserID)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return Token{}, ErrInvalidCredentials
+			}
+			return Token{}, fmt.Errorf("could not get user: %w", err)
+		}
+		return s.signInWithIdentity(ctx, u, deviceID)
+	} else if !db.IsNotFoundError(err) {
+		return Token{}, fmt.Errorf("could not get user identity: %w", err)
+	}
+
+	return s.signUpWithSolana(ctx, address, deviceID, invitationToken)
+}
+
+// LinkSolanaWallet links Solana wallet to the user by the message signed with the wallet
+func (s *Service) LinkSolanaWallet(ctx context.Context, userID uuid.UUID, nonce, signature string) error {
+	address, err := s.verifySolanaSignIn(ctx, nonce, signature)
+	if err != nil {
+		return err
+	}
+
+	identity, err := s.ur.GetUserIdentity(ctx, repository.GetUserIdentityParams{
+		Provider: ProviderSolana,
+		Subject:  address,
+	})
+	if err == nil {
+		if identity.UserID == userID {
+			return nil
+		}
+		return ErrIdentityAlreadyLinked
+	} else if !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get user identity: %w", err)
+	}
+
+	identities, err := s.GetIdentities(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, i := range identities {
+		if i.Provider == ProviderSolana {
+			return ErrIdentityAlreadyLinked
+		}
+	}
+
+	if err := s.ur.AddUserIdentity(ctx, repository.AddUserIdentityParams{
+		Provider: ProviderSolana,
+		Subject:  address,
+		UserID:   userID,
+	}); err != nil {
+		return fmt.Errorf("could not link solana wallet: %w", err)
+	}
+
+	return nil
+}
+
+// verifySolanaSignIn verifies signature of the sign in message and marks its nonce as used,
+// so the signed message can't be replayed. Returns address of the wallet.
+func (s *Service) verifySolanaSignIn(ctx context.Context, nonce, signature string) (string, error) {
+	if s.solanaSignInDomain == "" {
+		return "", ErrSolanaSignInUnavailable
+	}
+
+	n, err := s.ur.GetSolanaSignInNonce(ctx, nonce)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return "", fmt.Errorf("%w: unknown nonce", ErrInvalidCredentials)
+		}
+		return "", fmt.Errorf("could not get sign in nonce: %w", err)
+	}
+	if n.UsedAt.Valid {
+		return "", fmt.Errorf("%w: nonce has already been used", ErrInvalidCredentials)
+	}
+	if !n.ExpiresAt.After(time.Now()) {
+		return "", ErrSolanaSignInExpired
+	}
+
+	if err := siws.VerifySignature(n.Address, n.Message, signature); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	used, err := s.ur.UseSolanaSignInNonce(ctx, nonce)
+	if err != nil {
+		return "", fmt.Errorf("could not use sign in nonce: %w", err)
+	}
+	if used == 0 {
+		return "", fmt.Errorf("%w: nonce has already been used", ErrInvalidCredentials)
+	}
+
+	return n.Address, nil
+}
+
+// signUpWithSolana creates a new account owned by Solana wallet,
+// the account has no email and password until the user sets them in profile.
+// The account stays unverified and gets its wallet once the user verifies a real email.
+func (s *Service) signUpWithSolana(ctx context.Context, address, deviceID, invitationToken string) (Token, error) {
+	email := fmt.Sprintf("%s@%s", address, walletAccountEmailDomain)
+	if s.isEmailRestricted(ctx, email, "") {
+		return Token{}, ErrUserIsDisabled
+	}
+
+	username, err := s.generateUsername(ctx, address[:usernameBaseMaxLen/2])
+	if err != nil {
+		return Token{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return Token{}, fmt.Errorf("could not begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.ur.WithTx(tx)
+
+	u, err := repo.CreateUser(ctx, repository.CreateUserParams{
+		Email:    email,
+		Username: username,
+		Role:     rbac.RoleUser.String(),
+	})
+	if err != nil {
+		return Token{}, fmt.Errorf("could not create a new account: %w", err)
+	}
+
+	if err := repo.AddUserIdentity(ctx, repository.AddUserIdentityParams{
+		Provider: ProviderSolana,
+		Subject:  address,
+		UserID:   u.ID,
+	}); err != nil {
+		return Token{}, fmt.Errorf("could not link solana wallet: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Token{}, fmt.Errorf("could not create a new account: %w", err)
+	}
+
+	s.linkDevice(ctx, u.ID, deviceID)
+
+	token, err := s.startSession(ctx, u, deviceID)
+	if err != nil {
+		return Token{}, err
+	}
+
+	if invitationToken != "" {
+		if err := s.ic.AcceptInvitationByToken(ctx, u.ID, invitationToken); err != nil {
+			log.Printf("could not accept invitation by token for user id = %s: %v", u.ID, err)
+		}
+	}
+
+	return token, nil
+}
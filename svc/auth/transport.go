@@ -9,6 +9,7 @@ import (
 
 	"github.com/SatorNetwork/sator-api/lib/deviceid"
 	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/totp"
 	"github.com/SatorNetwork/sator-api/lib/utils"
 
 	"github.com/go-chi/chi/v5"
@@ -36,7 +37,7 @@ func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
 	options := []httptransport.ServerOption{
 		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
 		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
-		httptransport.ServerBefore(jwtkit.HTTPToContext(), deviceid.ToContext()),
+		httptransport.ServerBefore(jwtkit.HTTPToContext(), deviceid.ToContext(), clientToContext(), totp.ToContext()),
 	}
 
 	r.Get("/", httptransport.NewServer(
@@ -228,6 +229,13 @@ func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
 		options...,
 	).ServeHTTP)
 
+	r.Post("/unlock-account", httptransport.NewServer(
+		e.UnlockAccount,
+		decodeUnlockAccountRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
 	r.Post("/kyc/callback", httptransport.NewServer(
 		e.VerificationCallback,
 		decodeVerificationCallBack,
@@ -242,6 +250,160 @@ func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
 		options...,
 	).ServeHTTP)
 
+	r.Get("/sessions", httptransport.NewServer(
+		e.GetSessions,
+		decodeAuthRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/sessions", httptransport.NewServer(
+		e.RevokeAllSessions,
+		decodeAuthRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/sessions/{session_id}", httptransport.NewServer(
+		e.RevokeSession,
+		decodeRevokeSessionRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/login/magic-link", httptransport.NewServer(
+		e.RequestMagicLink,
+		decodeRequestMagicLinkRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/login/magic-link/approve", httptransport.NewServer(
+		e.ApproveMagicLink,
+		decodeRequestMagicLinkRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/login/magic-link/verify", httptransport.NewServer(
+		e.LoginWithMagicLink,
+		decodeLoginWithMagicLinkRequest,
+		encodeTokenResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/login/solana/message", httptransport.NewServer(
+		e.RequestSolanaSignInMessage,
+		decodeRequestSolanaSignInMessageRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/login/solana", httptransport.NewServer(
+		e.SignInWithSolana,
+		decodeSolanaSignatureRequest,
+		encodeTokenResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/login/{provider}/nonce", httptransport.NewServer(
+		e.RequestIDTokenNonce,
+		decodeProviderRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/login/{provider}", httptransport.NewServer(
+		e.SignInWithProvider,
+		decodeIDTokenRequest,
+		encodeTokenResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/signup/{provider}", httptransport.NewServer(
+		e.SignInWithProvider,
+		decodeIDTokenRequest,
+		encodeTokenResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/identities", httptransport.NewServer(
+		e.GetIdentities,
+		decodeAuthRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/identities/solana", httptransport.NewServer(
+		e.LinkSolanaWallet,
+		decodeSolanaSignatureRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/identities/{provider}", httptransport.NewServer(
+		e.LinkIdentity,
+		decodeIDTokenRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/identities/{provider}", httptransport.NewServer(
+		e.UnlinkIdentity,
+		decodeProviderRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/2fa", httptransport.NewServer(
+		e.GetTwoFactorSettings,
+		decodeAuthRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Put("/2fa/actions", httptransport.NewServer(
+		e.UpdateTwoFactorActions,
+		decodeUpdateTwoFactorActionsRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/2fa/verify", httptransport.NewServer(
+		e.VerifyTwoFactorAction,
+		decodeVerifyTwoFactorActionRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/2fa/recovery-codes", httptransport.NewServer(
+		e.RegenerateRecoveryCodes,
+		decodeAuthRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/2fa/totp", httptransport.NewServer(
+		e.EnrollTOTP,
+		decodeAuthRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/2fa/totp/enable", httptransport.NewServer(
+		e.EnableTOTP,
+		decodeEnableTOTPRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/2fa/totp", httptransport.NewServer(
+		e.DisableTOTP,
+		decodeAuthRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
 	return r
 }
 
@@ -375,19 +537,141 @@ func decodeResendOTPRequest(ctx context.Context, _ *http.Request) (request inter
 
 // returns http error code by error type
 func codeAndMessageFrom(err error) (int, interface{}) {
-	if errors.Is(err, ErrInvalidCredentials) {
+	if errors.Is(err, ErrInvalidCredentials) ||
+		errors.Is(err, ErrSessionExpired) ||
+		errors.Is(err, ErrRefreshTokenReused) ||
+		errors.Is(err, ErrInvalidMagicLink) ||
+		errors.Is(err, ErrMagicLinkExpired) ||
+		errors.Is(err, ErrSolanaSignInExpired) {
 		return http.StatusUnauthorized, err.Error()
 	}
 
+	// apps ask the user to approve logging in on the device the link was requested from and repeat the request
+	if errors.Is(err, ErrMagicLinkApprovalRequired) {
+		return http.StatusConflict, err.Error()
+	}
+
+	if errors.Is(err, ErrTooManyRequests) || errors.Is(err, ErrTooManyAttempts) {
+		return http.StatusTooManyRequests, err.Error()
+	}
+
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+
+	if errors.Is(err, ErrInvalidParameter) {
+		return http.StatusBadRequest, err.Error()
+	}
+
 	if errors.Is(err, ErrEmailAlreadyTaken) ||
 		errors.Is(err, ErrEmailAlreadyVerified) ||
-		errors.Is(err, ErrOTPCode) {
+		errors.Is(err, ErrOTPCode) ||
+		errors.Is(err, ErrTwoFactorAlreadyEnabled) ||
+		errors.Is(err, ErrTwoFactorNotEnabled) ||
+		errors.Is(err, ErrTwoFactorNotEnrolled) ||
+		errors.Is(err, ErrIdentityEmailNotVerified) ||
+		errors.Is(err, ErrIdentityAccountNotVerified) ||
+		errors.Is(err, ErrIdentityAlreadyLinked) ||
+		errors.Is(err, ErrLastSignInMethod) ||
+		errors.Is(err, ErrMagicLinkUnavailable) ||
+		errors.Is(err, ErrSolanaSignInUnavailable) {
 		return http.StatusBadRequest, err.Error()
 	}
 
 	return httpencoder.CodeAndMessageFrom(err)
 }
 
+func decodeEnableTOTPRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req EnableTOTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeUpdateTwoFactorActionsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req UpdateTwoFactorActionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeVerifyTwoFactorActionRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req VerifyTwoFactorActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeRequestMagicLinkRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req RequestMagicLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeLoginWithMagicLinkRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req LoginWithMagicLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeRequestSolanaSignInMessageRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req RequestSolanaSignInMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeSolanaSignatureRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req SolanaSignatureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeIDTokenRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req IDTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+	req.Provider = chi.URLParam(r, "provider")
+
+	return req, nil
+}
+
+func decodeProviderRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	provider := chi.URLParam(r, "provider")
+	if provider == "" {
+		return nil, fmt.Errorf("%w: missed provider", ErrInvalidParameter)
+	}
+
+	return provider, nil
+}
+
+func decodeRevokeSessionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	sid := chi.URLParam(r, "session_id")
+	if sid == "" {
+		return nil, fmt.Errorf("%w: missed session id", ErrInvalidParameter)
+	}
+
+	return sid, nil
+}
+
 func encodeTokenResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
 	w.Header().Set(httpencoder.ContentTypeHeader, httpencoder.ContentType)
 	return json.NewEncoder(w).Encode(response)
@@ -441,6 +725,15 @@ func decodeGetUserStatusRequest(_ context.Context, r *http.Request) (request int
 	}, nil
 }
 
+func decodeUnlockAccountRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req UnlockAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
 func decodeVerificationCallBack(_ context.Context, r *http.Request) (interface{}, error) {
 	var req VerificationCallbackRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
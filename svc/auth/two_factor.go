@@ -0,0 +1,499 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/encryption/aes"
+	"github.com/SatorNetwork/sator-api/lib/totp"
+	"github.com/SatorNetwork/sator-api/svc/auth/repository"
+)
+
+// Recovery codes settings
+const (
+	recoveryCodesNumber = 10
+	recoveryCodeSize    = 5 // bytes, encoded into 8 base32 chars
+)
+
+type (
+	// TwoFactor manages TOTP second factor of users and verifies step-up codes.
+	// It's shared with other services to protect sensitive actions,
+	// TOTP secrets are encrypted with AES key derived from the given secret.
+	TwoFactor struct {
+		repo          twoFactorRepository
+		limiter       attemptLimiter // counts wrong codes and locks the checks, not limited if not set
+		encryptionKey []byte
+		issuer        string
+	}
+
+	// TwoFactorSettings struct
+	TwoFactorSettings struct {
+		Enabled           bool     `json:"enabled"`
+		RequiredActions   []string `json:"required_actions"`
+		AvailableActions  []string `json:"available_actions"`
+		RecoveryCodesLeft int64    `json:"recovery_codes_left"`
+	}
+
+	// TOTPEnrollment is a pending TOTP secret,
+	// the uri is encoded into QR code to add the secret to authenticator app.
+	TOTPEnrollment struct {
+		Secret string `json:"secret"`
+		URI    string `json:"uri"`
+	}
+
+	twoFactorRepository interface {
+		UpsertUserTOTP(ctx context.Context, arg repository.UpsertUserTOTPParams) error
+		GetUserTOTP(ctx context.Context, userID uuid.UUID) (repository.UserTotp, error)
+		EnableUserTOTP(ctx context.Context, arg repository.EnableUserTOTPParams) error
+		UpdateUserTOTPLastUsedStep(ctx context.Context, arg repository.UpdateUserTOTPLastUsedStepParams) (int64, error)
+		UpdateUserTOTPRequiredActions(ctx context.Context, arg repository.UpdateUserTOTPRequiredActionsParams) error
+		DeleteUserTOTP(ctx context.Context, userID uuid.UUID) error
+
+		AddUserTOTPRecoveryCode(ctx context.Context, arg repository.AddUserTOTPRecoveryCodeParams) error
+		DeleteUserTOTPRecoveryCodes(ctx context.Context, userID uuid.UUID) error
+		UseUserTOTPRecoveryCode(ctx context.Context, arg repository.UseUserTOTPRecoveryCodeParams) (int64, error)
+		CountUnusedUserTOTPRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+	}
+
+	attemptLimiter interface {
+		checkLockout(ctx context.Context, flow string, userID uuid.UUID) error
+		registerFailedAttempt(ctx context.Context, flow string, userID uuid.UUID) error
+		resetFailedAttempts(ctx context.Context, flow string, userID uuid.UUID)
+	}
+)
+
+// NewTwoFactor is a factory function,
+// returns a new instance of the TwoFactor struct
+func NewTwoFactor(repo twoFactorRepository, encryptionSecret, issuer string) *TwoFactor {
+	if repo == nil {
+		log.Fatalln("two-factor repository is not set")
+	}
+	if encryptionSecret == "" {
+		log.Fatalln("two-factor encryption secret is not set")
+	}
+
+	key := sha256.Sum256([]byte(encryptionSecret))
+
+	return &TwoFactor{
+		repo:          repo,
+		encryptionKey: key[:],
+		issuer:        issuer,
+	}
+}
+
+// Settings returns two-factor settings of the user
+func (tf *TwoFactor) Settings(ctx context.Context, userID uuid.UUID) (TwoFactorSettings, error) {
+	result := TwoFactorSettings{
+		RequiredActions:  []string{},
+		AvailableActions: totp.Actions,
+	}
+
+	t, err := tf.repo.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return result, nil
+		}
+		return TwoFactorSettings{}, fmt.Errorf("could not get user totp: %w", err)
+	}
+	if !t.EnabledAt.Valid {
+		return result, nil
+	}
+
+	left, err := tf.repo.CountUnusedUserTOTPRecoveryCodes(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return TwoFactorSettings{}, fmt.Errorf("could not count recovery codes: %w", err)
+	}
+
+	result.Enabled = true
+	result.RequiredActions = t.RequiredActions
+	result.RecoveryCodesLeft = left
+
+	return result, nil
+}
+
+// Enroll generates a new TOTP secret of the user,
+// the secret is not used until it's confirmed with a code from authenticator app.
+func (tf *TwoFactor) Enroll(ctx context.Context, userID uuid.UUID, account string) (TOTPEnrollment, error) {
+	if t, err := tf.repo.GetUserTOTP(ctx, userID); err == nil && t.EnabledAt.Valid {
+		return TOTPEnrollment{}, ErrTwoFactorAlreadyEnabled
+	} else if err != nil && !db.IsNotFoundError(err) {
+		return TOTPEnrollment{}, fmt.Errorf("could not get user totp: %w", err)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+
+	encrypted, err := aes.Encrypt(tf.encryptionKey, []byte(secret))
+	if err != nil {
+		return TOTPEnrollment{}, fmt.Errorf("could not encrypt totp secret: %w", err)
+	}
+
+	if err := tf.repo.UpsertUserTOTP(ctx, repository.UpsertUserTOTPParams{
+		UserID: userID,
+		Secret: encrypted,
+	}); err != nil {
+		return TOTPEnrollment{}, fmt.Errorf("could not store totp secret: %w", err)
+	}
+
+	return TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.ProvisioningURI(tf.issuer, account, secret),
+	}, nil
+}
+
+// Enable confirms the pending TOTP secret with a code from authenticator app,
+// all actions require step-up code by default. Returns recovery codes, they are shown once.
+func (tf *TwoFactor) Enable(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	t, err := tf.repo.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, ErrTwoFactorNotEnrolled
+		}
+		return nil, fmt.Errorf("could not get user totp: %w", err)
+	}
+	if t.EnabledAt.Valid {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := tf.secret(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var step int64
+	if err := tf.limitAttempts(ctx, userID, func() error {
+		var ok bool
+		step, ok = totp.Validate(secret, code, time.Now(), t.LastUsedStep)
+		if !ok {
+			return totp.ErrInvalidCode
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tf.repo.EnableUserTOTP(ctx, repository.EnableUserTOTPParams{
+		RequiredActions: totp.Actions,
+		LastUsedStep:    step,
+		UserID:          userID,
+	}); err != nil {
+		return nil, fmt.Errorf("could not enable totp: %w", err)
+	}
+
+	return tf.resetRecoveryCodes(ctx, userID)
+}
+
+// Disable removes TOTP secret and recovery codes of the user, requires step-up code
+func (tf *TwoFactor) Disable(ctx context.Context, userID uuid.UUID) error {
+	if err := tf.verifyEnabled(ctx, userID); err != nil {
+		return err
+	}
+
+	return tf.Remove(ctx, userID)
+}
+
+// Remove deletes TOTP secret and recovery codes of the user without step-up code,
+// e.g. when the account is deleted.
+func (tf *TwoFactor) Remove(ctx context.Context, userID uuid.UUID) error {
+	if err := tf.repo.DeleteUserTOTPRecoveryCodes(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete recovery codes: %w", err)
+	}
+	if err := tf.repo.DeleteUserTOTP(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete user totp: %w", err)
+	}
+
+	return nil
+}
+
+// SetRequiredActions updates the list of actions which require step-up code, requires step-up code
+func (tf *TwoFactor) SetRequiredActions(ctx context.Context, userID uuid.UUID, actions []string) error {
+	for _, a := range actions {
+		if !totp.IsValidAction(a) {
+			return fmt.Errorf("%w: unknown action %s", ErrInvalidParameter, a)
+		}
+	}
+
+	if err := tf.verifyEnabled(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := tf.repo.UpdateUserTOTPRequiredActions(ctx, repository.UpdateUserTOTPRequiredActionsParams{
+		RequiredActions: actions,
+		UserID:          userID,
+	}); err != nil {
+		return fmt.Errorf("could not update required actions: %w", err)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces recovery codes of the user, requires step-up code
+func (tf *TwoFactor) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	if err := tf.verifyEnabled(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return tf.resetRecoveryCodes(ctx, userID)
+}
+
+// Verify checks step-up code from context, if the user has enabled TOTP
+// and the action requires it. Returns totp.ErrTwoFactorRequired if the code is missed.
+func (tf *TwoFactor) Verify(ctx context.Context, userID uuid.UUID, action string) error {
+	t, err := tf.repo.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get user totp: %w", err)
+	}
+	if !t.EnabledAt.Valid || !isActionRequired(t.RequiredActions, action) {
+		return nil
+	}
+
+	return tf.checkCode(ctx, t, totp.CodeFromContext(ctx))
+}
+
+// verifyEnabled checks step-up code from context regardless of required actions,
+// used to change two-factor settings.
+func (tf *TwoFactor) verifyEnabled(ctx context.Context, userID uuid.UUID) error {
+	t, err := tf.repo.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return ErrTwoFactorNotEnabled
+		}
+		return fmt.Errorf("could not get user totp: %w", err)
+	}
+	if !t.EnabledAt.Valid {
+		return ErrTwoFactorNotEnabled
+	}
+
+	return tf.checkCode(ctx, t, totp.CodeFromContext(ctx))
+}
+
+// checkCode accepts either TOTP code or unused recovery code,
+// both can be used only once. Wrong codes are limited like failed logins.
+func (tf *TwoFactor) checkCode(ctx context.Context, t repository.UserTotp, code string) error {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return totp.ErrTwoFactorRequired
+	}
+
+	return tf.limitAttempts(ctx, t.UserID, func() error {
+		return tf.validateCode(ctx, t, code)
+	})
+}
+
+// limitAttempts runs the code check unless the user is locked out in the two-factor flow,
+// counts wrong codes and resets the failures once the code is accepted.
+func (tf *TwoFactor) limitAttempts(ctx context.Context, userID uuid.UUID, check func() error) error {
+	if tf.limiter == nil {
+		return check()
+	}
+
+	// the checks are shared with other services, they know nothing about auth errors
+	if err := tf.limiter.checkLockout(ctx, attemptFlowTwoFactor, userID); err != nil {
+		if errors.Is(err, ErrTooManyAttempts) {
+			return fmt.Errorf("%w: %v", totp.ErrTooManyAttempts, err)
+		}
+		return err
+	}
+
+	if err := check(); err != nil {
+		if errors.Is(err, totp.ErrInvalidCode) {
+			if err := tf.limiter.registerFailedAttempt(ctx, attemptFlowTwoFactor, userID); err != nil {
+				return fmt.Errorf("%w: %v", totp.ErrTooManyAttempts, err)
+			}
+		}
+		return err
+	}
+	tf.limiter.resetFailedAttempts(ctx, attemptFlowTwoFactor, userID)
+
+	return nil
+}
+
+// validateCode checks TOTP or recovery code and marks it as used
+func (tf *TwoFactor) validateCode(ctx context.Context, t repository.UserTotp, code string) error {
+	if len(code) == totp.Digits {
+		secret, err := tf.secret(t)
+		if err != nil {
+			return err
+		}
+
+		step, ok := totp.Validate(secret, code, time.Now(), t.LastUsedStep)
+		if !ok {
+			return totp.ErrInvalidCode
+		}
+
+		// concurrent request with the same code updates nothing
+		updated, err := tf.repo.UpdateUserTOTPLastUsedStep(ctx, repository.UpdateUserTOTPLastUsedStepParams{
+			LastUsedStep: step,
+			UserID:       t.UserID,
+		})
+		if err != nil {
+			return fmt.Errorf("could not update totp last used step: %w", err)
+		}
+		if updated == 0 {
+			return totp.ErrInvalidCode
+		}
+
+		return nil
+	}
+
+	used, err := tf.repo.UseUserTOTPRecoveryCode(ctx, repository.UseUserTOTPRecoveryCodeParams{
+		UserID:   t.UserID,
+		CodeHash: hashRecoveryCode(code),
+	})
+	if err != nil {
+		return fmt.Errorf("could not use recovery code: %w", err)
+	}
+	if used == 0 {
+		return totp.ErrInvalidCode
+	}
+
+	return nil
+}
+
+// secret returns decrypted TOTP secret
+func (tf *TwoFactor) secret(t repository.UserTotp) (string, error) {
+	secret, err := aes.Decrypt(tf.encryptionKey, t.Secret)
+	if err != nil {
+		return "", fmt.Errorf("could not decrypt totp secret: %w", err)
+	}
+	return string(secret), nil
+}
+
+// resetRecoveryCodes replaces recovery codes of the user,
+// only hashes of the codes are stored.
+func (tf *TwoFactor) resetRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	if err := tf.repo.DeleteUserTOTPRecoveryCodes(ctx, userID); err != nil {
+		return nil, fmt.Errorf("could not delete recovery codes: %w", err)
+	}
+
+	codes := make([]string, 0, recoveryCodesNumber)
+	for i := 0; i < recoveryCodesNumber; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		if err := tf.repo.AddUserTOTPRecoveryCode(ctx, repository.AddUserTOTPRecoveryCodeParams{
+			UserID:   userID,
+			CodeHash: hashRecoveryCode(code),
+		}); err != nil {
+			return nil, fmt.Errorf("could not store recovery code: %w", err)
+		}
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+// generateRecoveryCode returns random code formatted as XXXX-XXXX
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, recoveryCodeSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("could not generate recovery code: %w", err)
+	}
+	code := base32.StdEncoding.EncodeToString(b)
+	return code[:4] + "-" + code[4:], nil
+}
+
+// hashRecoveryCode returns hash of the normalized recovery code,
+// so the code is accepted regardless of case and dashes.
+func hashRecoveryCode(code string) string {
+	code = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func isActionRequired(required []string, action string) bool {
+	for _, a := range required {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTwoFactorSettings returns two-factor settings of the user
+func (s *Service) GetTwoFactorSettings(ctx context.Context, userID uuid.UUID) (TwoFactorSettings, error) {
+	if s.tf == nil {
+		return TwoFactorSettings{RequiredActions: []string{}, AvailableActions: totp.Actions}, nil
+	}
+	return s.tf.Settings(ctx, userID)
+}
+
+// EnrollTOTP starts TOTP enrollment, returns the secret to add to authenticator app
+func (s *Service) EnrollTOTP(ctx context.Context, userID uuid.UUID) (TOTPEnrollment, error) {
+	if s.tf == nil {
+		return TOTPEnrollment{}, ErrTwoFactorUnavailable
+	}
+
+	u, err := s.ur.GetUserByID(ctx, userID)
+	if err != nil {
+		return TOTPEnrollment{}, fmt.Errorf("could not get user: %w", err)
+	}
+
+	return s.tf.Enroll(ctx, userID, u.Email)
+}
+
+// EnableTOTP confirms TOTP enrollment, returns recovery codes
+func (s *Service) EnableTOTP(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	if s.tf == nil {
+		return nil, ErrTwoFactorUnavailable
+	}
+	return s.tf.Enable(ctx, userID, code)
+}
+
+// DisableTOTP turns off two-factor authentication of the user
+func (s *Service) DisableTOTP(ctx context.Context, userID uuid.UUID) error {
+	if s.tf == nil {
+		return ErrTwoFactorUnavailable
+	}
+	return s.tf.Disable(ctx, userID)
+}
+
+// UpdateTwoFactorActions sets the actions which require step-up code
+func (s *Service) UpdateTwoFactorActions(ctx context.Context, userID uuid.UUID, actions []string) error {
+	if s.tf == nil {
+		return ErrTwoFactorUnavailable
+	}
+	return s.tf.SetRequiredActions(ctx, userID, actions)
+}
+
+// RegenerateRecoveryCodes replaces recovery codes of the user, the old ones become invalid
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	if s.tf == nil {
+		return nil, ErrTwoFactorUnavailable
+	}
+	return s.tf.RegenerateRecoveryCodes(ctx, userID)
+}
+
+// VerifyTwoFactorAction checks step-up code of the action performed on the client side,
+// e.g. the app reveals the wallet recovery phrase only if the code is accepted.
+func (s *Service) VerifyTwoFactorAction(ctx context.Context, userID uuid.UUID, action string) error {
+	if !totp.IsValidAction(action) {
+		return fmt.Errorf("%w: unknown action %s", ErrInvalidParameter, action)
+	}
+	return s.verifyTwoFactor(ctx, userID, action)
+}
+
+// verifyTwoFactor checks step-up code of the action, if two-factor authentication is set up
+func (s *Service) verifyTwoFactor(ctx context.Context, userID uuid.UUID, action string) error {
+	if s.tf == nil {
+		return nil
+	}
+	return s.tf.Verify(ctx, userID, action)
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/totp"
+)
+
+type attemptLimiterMock struct {
+	maxFailures int
+	failures    int
+}
+
+func (l *attemptLimiterMock) checkLockout(ctx context.Context, flow string, userID uuid.UUID) error {
+	if l.failures >= l.maxFailures {
+		return ErrTooManyAttempts
+	}
+	return nil
+}
+
+func (l *attemptLimiterMock) registerFailedAttempt(ctx context.Context, flow string, userID uuid.UUID) error {
+	l.failures++
+	return l.checkLockout(ctx, flow, userID)
+}
+
+func (l *attemptLimiterMock) resetFailedAttempts(ctx context.Context, flow string, userID uuid.UUID) {
+	l.failures = 0
+}
+
+func TestTwoFactorLimitAttempts(t *testing.T) {
+	ctx := context.Background()
+	limiter := &attemptLimiterMock{maxFailures: 3}
+	tf := &TwoFactor{limiter: limiter}
+
+	invalid := func() error { return totp.ErrInvalidCode }
+	valid := func() error { return nil }
+
+	if err := tf.limitAttempts(ctx, uuid.New(), invalid); !errors.Is(err, totp.ErrInvalidCode) {
+		t.Fatalf("wrong code: got %v, want %v", err, totp.ErrInvalidCode)
+	}
+	if err := tf.limitAttempts(ctx, uuid.New(), func() error { return errors.New("db error") }); err == nil {
+		t.Fatal("failed check: got nil error")
+	}
+	if limiter.failures != 1 {
+		t.Fatalf("only wrong codes are counted: got %d failures, want 1", limiter.failures)
+	}
+
+	if err := tf.limitAttempts(ctx, uuid.New(), valid); err != nil {
+		t.Fatalf("valid code: got %v", err)
+	}
+	if limiter.failures != 0 {
+		t.Fatalf("failures are reset by valid code: got %d", limiter.failures)
+	}
+
+	tf.limitAttempts(ctx, uuid.New(), invalid)
+	tf.limitAttempts(ctx, uuid.New(), invalid)
+	if err := tf.limitAttempts(ctx, uuid.New(), invalid); !errors.Is(err, totp.ErrTooManyAttempts) {
+		t.Fatalf("lockout attempt: got %v, want %v", err, totp.ErrTooManyAttempts)
+	}
+	if err := tf.limitAttempts(ctx, uuid.New(), valid); !errors.Is(err, totp.ErrTooManyAttempts) {
+		t.Fatalf("valid code while locked: got %v, want %v", err, totp.ErrTooManyAttempts)
+	}
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/svc/budgets"
+)
+
+type (
+	// Client struct
+	Client struct {
+		s service
+	}
+
+	service interface {
+		GetRemainingAmount(ctx context.Context, scopes ...budgets.Scope) (float64, bool, error)
+		Spend(ctx context.Context, amount float64, scopes ...budgets.Scope) (float64, error)
+		Refund(ctx context.Context, amount float64, scopes ...budgets.Scope) error
+	}
+)
+
+// New budgets service client implementation
+func New(s service) *Client {
+	return &Client{s: s}
+}
+
+// GetChallengeRemainingBudget returns amount left in budgets of the challenge and its show.
+// The second returned value is false if neither of them has a budget.
+func (c *Client) GetChallengeRemainingBudget(ctx context.Context, challengeID, showID uuid.UUID) (float64, bool, error) {
+	return c.s.GetRemainingAmount(ctx,
+		budgets.Scope{Type: budgets.ScopeChallenge, ID: challengeID},
+		budgets.Scope{Type: budgets.ScopeShow, ID: showID},
+	)
+}
+
+// SpendChallengeBudget consumes quiz rewards from budgets of the challenge and its show,
+// returns the granted amount.
+func (c *Client) SpendChallengeBudget(ctx context.Context, challengeID, showID uuid.UUID, amount float64) (float64, error) {
+	return c.s.Spend(ctx, amount,
+		budgets.Scope{Type: budgets.ScopeChallenge, ID: challengeID},
+		budgets.Scope{Type: budgets.ScopeShow, ID: showID},
+	)
+}
+
+// RefundChallengeBudget returns undelivered quiz rewards to budgets of the challenge and its show.
+func (c *Client) RefundChallengeBudget(ctx context.Context, challengeID, showID uuid.UUID, amount float64) error {
+	return c.s.Refund(ctx, amount,
+		budgets.Scope{Type: budgets.ScopeChallenge, ID: challengeID},
+		budgets.Scope{Type: budgets.ScopeShow, ID: showID},
+	)
+}
+
+// SpendQRCodeBudget consumes qrcode reward from budgets of the qrcode and its show,
+// returns the granted amount.
+func (c *Client) SpendQRCodeBudget(ctx context.Context, qrcodeID, showID uuid.UUID, amount float64) (float64, error) {
+	return c.s.Spend(ctx, amount,
+		budgets.Scope{Type: budgets.ScopeQRCode, ID: qrcodeID},
+		budgets.Scope{Type: budgets.ScopeShow, ID: showID},
+	)
+}
+
+// RefundQRCodeBudget returns undelivered qrcode reward to budgets of the qrcode and its show.
+func (c *Client) RefundQRCodeBudget(ctx context.Context, qrcodeID, showID uuid.UUID, amount float64) error {
+	return c.s.Refund(ctx, amount,
+		budgets.Scope{Type: budgets.ScopeQRCode, ID: qrcodeID},
+		budgets.Scope{Type: budgets.ScopeShow, ID: showID},
+	)
+}
+
+// SpendPuzzleGameBudget consumes puzzle game reward from the puzzle game budget,
+// returns the granted amount.
+func (c *Client) SpendPuzzleGameBudget(ctx context.Context, puzzleGameID uuid.UUID, amount float64) (float64, error) {
+	return c.s.Spend(ctx, amount, budgets.Scope{Type: budgets.ScopePuzzleGame, ID: puzzleGameID})
+}
+
+// RefundPuzzleGameBudget returns undelivered puzzle game reward to the puzzle game budget.
+func (c *Client) RefundPuzzleGameBudget(ctx context.Context, puzzleGameID uuid.UUID, amount float64) error {
+	return c.s.Refund(ctx, amount, budgets.Scope{Type: budgets.ScopePuzzleGame, ID: puzzleGameID})
+}
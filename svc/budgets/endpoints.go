@@ -0,0 +1,194 @@
+package budgets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+	"github.com/SatorNetwork/sator-api/lib/validator"
+)
+
+type (
+	// Endpoints collection of budgets service
+	Endpoints struct {
+		AddBudget        endpoint.Endpoint
+		GetBudgetByID    endpoint.Endpoint
+		GetBudgets       endpoint.Endpoint
+		UpdateBudget     endpoint.Endpoint
+		DeleteBudgetByID endpoint.Endpoint
+	}
+
+	service interface {
+		AddBudget(ctx context.Context, b Budget) (Budget, error)
+		GetBudgetByID(ctx context.Context, id uuid.UUID) (Budget, error)
+		GetBudgets(ctx context.Context, limit, offset int32) ([]Budget, error)
+		UpdateBudget(ctx context.Context, b Budget) error
+		DeleteBudgetByID(ctx context.Context, id uuid.UUID) error
+	}
+
+	// AddBudgetRequest struct
+	AddBudgetRequest struct {
+		ScopeType       string  `json:"scope_type" validate:"required,oneof=show challenge qrcode puzzle_game"`
+		ScopeID         string  `json:"scope_id" validate:"required,uuid"`
+		TotalAmount     float64 `json:"total_amount" validate:"required,gt=0"`
+		OwnerEmail      string  `json:"owner_email" validate:"required,email"`
+		AlertThresholds []int32 `json:"alert_thresholds,omitempty" validate:"dive,gte=1,lte=100"`
+	}
+
+	// UpdateBudgetRequest struct
+	UpdateBudgetRequest struct {
+		ID              string  `json:"-" validate:"required,uuid"`
+		TotalAmount     float64 `json:"total_amount" validate:"required,gt=0"`
+		OwnerEmail      string  `json:"owner_email" validate:"required,email"`
+		AlertThresholds []int32 `json:"alert_thresholds,omitempty" validate:"dive,gte=1,lte=100"`
+	}
+
+	// GetBudgetsRequest struct
+	GetBudgetsRequest struct {
+		utils.PaginationRequest
+	}
+)
+
+// MakeEndpoints ...
+func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
+	validateFunc := validator.ValidateStruct()
+
+	e := Endpoints{
+		AddBudget:        MakeAddBudgetEndpoint(s, validateFunc),
+		GetBudgetByID:    MakeGetBudgetByIDEndpoint(s),
+		GetBudgets:       MakeGetBudgetsEndpoint(s, validateFunc),
+		UpdateBudget:     MakeUpdateBudgetEndpoint(s, validateFunc),
+		DeleteBudgetByID: MakeDeleteBudgetByIDEndpoint(s),
+	}
+
+	// setup middlewares for each endpoints
+	if len(m) > 0 {
+		for _, mdw := range m {
+			e.AddBudget = mdw(e.AddBudget)
+			e.GetBudgetByID = mdw(e.GetBudgetByID)
+			e.GetBudgets = mdw(e.GetBudgets)
+			e.UpdateBudget = mdw(e.UpdateBudget)
+			e.DeleteBudgetByID = mdw(e.DeleteBudgetByID)
+		}
+	}
+
+	return e
+}
+
+// MakeAddBudgetEndpoint ...
+func MakeAddBudgetEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(AddBudgetRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.AddBudget(ctx, Budget{
+			ScopeType:       req.ScopeType,
+			ScopeID:         req.ScopeID,
+			TotalAmount:     req.TotalAmount,
+			OwnerEmail:      req.OwnerEmail,
+			AlertThresholds: req.AlertThresholds,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetBudgetByIDEndpoint ...
+func MakeGetBudgetByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w budget id: %v", ErrInvalidParameter, err)
+		}
+
+		resp, err := s.GetBudgetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetBudgetsEndpoint ...
+func MakeGetBudgetsEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(GetBudgetsRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.GetBudgets(ctx, req.Limit(), req.Offset())
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeUpdateBudgetEndpoint ...
+func MakeUpdateBudgetEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(UpdateBudgetRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := s.UpdateBudget(ctx, Budget{
+			ID:              req.ID,
+			TotalAmount:     req.TotalAmount,
+			OwnerEmail:      req.OwnerEmail,
+			AlertThresholds: req.AlertThresholds,
+		}); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeDeleteBudgetByIDEndpoint ...
+func MakeDeleteBudgetByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w budget id: %v", ErrInvalidParameter, err)
+		}
+
+		if err := s.DeleteBudgetByID(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
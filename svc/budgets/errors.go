@@ -0,0 +1,10 @@
+package budgets
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrNotFound         = errors.New("not found")
+	ErrAlreadyExists    = errors.New("budget for this scope already exists")
+)
@@ -0,0 +1,29 @@
+-- +migrate Up
+-- +migrate StatementBegin
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE
+OR REPLACE FUNCTION reward_budgets_update_updated_at_column() RETURNS TRIGGER AS $$
+BEGIN NEW .updated_at = NOW();
+RETURN NEW;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+CREATE TABLE IF NOT EXISTS reward_budgets (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    scope_type VARCHAR NOT NULL, -- show, challenge, qrcode or puzzle_game
+    scope_id uuid NOT NULL,
+    total_amount DOUBLE PRECISION NOT NULL,
+    consumed_amount DOUBLE PRECISION NOT NULL DEFAULT 0,
+    owner_email VARCHAR NOT NULL,
+    alert_thresholds INT[] NOT NULL DEFAULT '{50,80,100}', -- percents of consumed budget
+    notified_threshold INT NOT NULL DEFAULT 0, -- the highest threshold the owner is notified about
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (scope_type, scope_id)
+);
+CREATE TRIGGER update_reward_budgets_modtime BEFORE
+UPDATE ON reward_budgets FOR EACH ROW EXECUTE PROCEDURE reward_budgets_update_updated_at_column();
+-- +migrate Down
+DROP TRIGGER IF EXISTS update_reward_budgets_modtime ON reward_budgets;
+DROP TABLE IF EXISTS reward_budgets;
+DROP FUNCTION IF EXISTS reward_budgets_update_updated_at_column();
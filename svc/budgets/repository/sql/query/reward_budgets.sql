@@ -0,0 +1,55 @@
+-- name: AddRewardBudget :one
+INSERT INTO reward_budgets (
+    scope_type,
+    scope_id,
+    total_amount,
+    owner_email,
+    alert_thresholds
+)
+VALUES (
+    @scope_type,
+    @scope_id,
+    @total_amount,
+    @owner_email,
+    @alert_thresholds
+) RETURNING *;
+-- name: GetRewardBudgetByID :one
+SELECT *
+FROM reward_budgets
+WHERE id = @id;
+-- name: GetRewardBudgetByScope :one
+SELECT *
+FROM reward_budgets
+WHERE scope_type = @scope_type
+    AND scope_id = @scope_id;
+-- name: GetRewardBudgetByScopeForUpdate :one
+SELECT *
+FROM reward_budgets
+WHERE scope_type = @scope_type
+    AND scope_id = @scope_id
+FOR UPDATE;
+-- name: GetRewardBudgetsPaginated :many
+SELECT *
+FROM reward_budgets
+ORDER BY created_at DESC
+LIMIT @limit_val OFFSET @offset_val;
+-- name: UpdateRewardBudget :exec
+UPDATE reward_budgets
+SET total_amount = @total_amount,
+    owner_email = @owner_email,
+    alert_thresholds = @alert_thresholds,
+    notified_threshold = @notified_threshold
+WHERE id = @id;
+-- name: ConsumeRewardBudget :exec
+UPDATE reward_budgets
+SET consumed_amount = consumed_amount + @amount,
+    notified_threshold = @notified_threshold
+WHERE id = @id;
+-- name: DeleteRewardBudgetByID :exec
+DELETE FROM reward_budgets
+WHERE id = @id;
+-- name: RefundRewardBudget :exec
+UPDATE reward_budgets
+SET consumed_amount = GREATEST(consumed_amount - @amount, 0)
+WHERE scope_type = @scope_type
+    AND scope_id = @scope_id;
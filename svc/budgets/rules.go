@@ -0,0 +1,108 @@
+package budgets
+
+import (
+	"sort"
+
+	"github.com/SatorNetwork/sator-api/svc/budgets/repository"
+)
+
+// Budget scope types
+const (
+	ScopeShow       = "show"
+	ScopeChallenge  = "challenge"
+	ScopeQRCode     = "qrcode"
+	ScopePuzzleGame = "puzzle_game"
+)
+
+// DefaultAlertThresholds are used if budget is created without alert thresholds
+var DefaultAlertThresholds = []int32{50, 80, 100}
+
+// ScopeTypes returns list of supported budget scope types
+func ScopeTypes() []string {
+	return []string{ScopeShow, ScopeChallenge, ScopeQRCode, ScopePuzzleGame}
+}
+
+func isScopeTypeSupported(scopeType string) bool {
+	for _, t := range ScopeTypes() {
+		if t == scopeType {
+			return true
+		}
+	}
+	return false
+}
+
+// remainingAmount returns amount which is still available to spend
+func remainingAmount(b repository.RewardBudget) float64 {
+	if left := b.TotalAmount - b.ConsumedAmount; left > 0 {
+		return left
+	}
+	return 0
+}
+
+// grantableAmount returns the part of requested amount which fits into all budgets,
+// so rewards are prorated when any of the budgets is running out.
+func grantableAmount(requested float64, budgets []repository.RewardBudget) float64 {
+	granted := requested
+	for _, b := range budgets {
+		if left := remainingAmount(b); left < granted {
+			granted = left
+		}
+	}
+	if granted < 0 {
+		return 0
+	}
+	return granted
+}
+
+// reachedThreshold returns the highest alert threshold reached by consumed amount, or 0.
+func reachedThreshold(thresholds []int32, consumed, total float64) int32 {
+	if total <= 0 {
+		return 0
+	}
+
+	percent := consumed / total * 100
+	var reached int32
+	for _, t := range thresholds {
+		if float64(t) <= percent && t > reached {
+			reached = t
+		}
+	}
+
+	return reached
+}
+
+// normalizeThresholds sorts thresholds and removes duplicates,
+// returns false if any of them is out of 1..100 range.
+func normalizeThresholds(thresholds []int32) ([]int32, bool) {
+	if len(thresholds) == 0 {
+		return DefaultAlertThresholds, true
+	}
+
+	seen := make(map[int32]bool, len(thresholds))
+	result := make([]int32, 0, len(thresholds))
+	for _, t := range thresholds {
+		if t < 1 || t > 100 {
+			return nil, false
+		}
+		if !seen[t] {
+			seen[t] = true
+			result = append(result, t)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result, true
+}
+
+// sortScopes returns scopes in a stable order, so concurrent spends lock budgets in the same order.
+func sortScopes(scopes []Scope) []Scope {
+	result := make([]Scope, len(scopes))
+	copy(result, scopes)
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Type != result[j].Type {
+			return result[i].Type < result[j].Type
+		}
+		return result[i].ID.String() < result[j].ID.String()
+	})
+	return result
+}
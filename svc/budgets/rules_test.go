@@ -0,0 +1,97 @@
+package budgets
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SatorNetwork/sator-api/svc/budgets/repository"
+)
+
+func TestGrantableAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested float64
+		budgets   []repository.RewardBudget
+		want      float64
+	}{
+		{
+			name:      "enough budget",
+			requested: 10,
+			budgets:   []repository.RewardBudget{{TotalAmount: 100, ConsumedAmount: 50}},
+			want:      10,
+		},
+		{
+			name:      "prorated by the least budget",
+			requested: 10,
+			budgets: []repository.RewardBudget{
+				{TotalAmount: 100, ConsumedAmount: 50},
+				{TotalAmount: 1000, ConsumedAmount: 996},
+			},
+			want: 4,
+		},
+		{
+			name:      "exhausted",
+			requested: 10,
+			budgets:   []repository.RewardBudget{{TotalAmount: 100, ConsumedAmount: 120}},
+			want:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grantableAmount(tt.requested, tt.budgets); got != tt.want {
+				t.Errorf("grantableAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReachedThreshold(t *testing.T) {
+	thresholds := []int32{50, 80, 100}
+
+	tests := []struct {
+		name     string
+		consumed float64
+		total    float64
+		want     int32
+	}{
+		{name: "nothing reached", consumed: 49, total: 100, want: 0},
+		{name: "first threshold", consumed: 50, total: 100, want: 50},
+		{name: "skipped threshold", consumed: 90, total: 100, want: 80},
+		{name: "exhausted", consumed: 100, total: 100, want: 100},
+		{name: "zero total", consumed: 10, total: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reachedThreshold(thresholds, tt.consumed, tt.total); got != tt.want {
+				t.Errorf("reachedThreshold() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeThresholds(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []int32
+		want   []int32
+		wantOk bool
+	}{
+		{name: "defaults", input: nil, want: DefaultAlertThresholds, wantOk: true},
+		{name: "sorted and deduplicated", input: []int32{90, 25, 90}, want: []int32{25, 90}, wantOk: true},
+		{name: "out of range", input: []int32{0, 50}, want: nil, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := normalizeThresholds(tt.input)
+			if ok != tt.wantOk {
+				t.Errorf("normalizeThresholds() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeThresholds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package budgets
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/budgets/repository"
+)
+
+type (
+	// Service struct
+	Service struct {
+		db     *sql.DB
+		repo   budgetsRepository
+		mailer mailer
+	}
+
+	// Scope identifies an entity which rewards are limited by budget
+	Scope struct {
+		Type string
+		ID   uuid.UUID
+	}
+
+	// Budget is a ceiling of rewards which can be paid out within the scope
+	Budget struct {
+		ID              string  `json:"id"`
+		ScopeType       string  `json:"scope_type"`
+		ScopeID         string  `json:"scope_id"`
+		TotalAmount     float64 `json:"total_amount"`
+		ConsumedAmount  float64 `json:"consumed_amount"`
+		RemainingAmount float64 `json:"remaining_amount"`
+		OwnerEmail      string  `json:"owner_email"`
+		AlertThresholds []int32 `json:"alert_thresholds"` // percents of consumed budget to notify owner about
+	}
+
+	budgetsRepository interface {
+		AddRewardBudget(ctx context.Context, arg repository.AddRewardBudgetParams) (repository.RewardBudget, error)
+		DeleteRewardBudgetByID(ctx context.Context, id uuid.UUID) error
+		GetRewardBudgetByID(ctx context.Context, id uuid.UUID) (repository.RewardBudget, error)
+		GetRewardBudgetByScope(ctx context.Context, arg repository.GetRewardBudgetByScopeParams) (repository.RewardBudget, error)
+		GetRewardBudgetsPaginated(ctx context.Context, arg repository.GetRewardBudgetsPaginatedParams) ([]repository.RewardBudget, error)
+		UpdateRewardBudget(ctx context.Context, arg repository.UpdateRewardBudgetParams) error
+
+		WithTx(tx *sql.Tx) *repository.Queries
+	}
+
+	mailer interface {
+		SendRewardBudgetAlert(ctx context.Context, email, scopeType, scopeID string, threshold int32, consumed, total float64) error
+	}
+
+	budgetAlert struct {
+		budget    repository.RewardBudget
+		threshold int32
+		consumed  float64
+	}
+)
+
+// NewService is a factory function,
+// returns a new instance of the Service interface implementation
+func NewService(dbConn *sql.DB, repo budgetsRepository, m mailer) *Service {
+	if repo == nil {
+		log.Fatalln("budgets repository is not set")
+	}
+	if m == nil {
+		log.Fatalln("mail service is not set")
+	}
+
+	return &Service{db: dbConn, repo: repo, mailer: m}
+}
+
+// AddBudget sets a rewards ceiling for the scope
+func (s *Service) AddBudget(ctx context.Context, b Budget) (Budget, error) {
+	scopeID, err := uuid.Parse(b.ScopeID)
+	if err != nil {
+		return Budget{}, fmt.Errorf("%w: scope id", ErrInvalidParameter)
+	}
+	if !isScopeTypeSupported(b.ScopeType) {
+		return Budget{}, fmt.Errorf("%w: unsupported scope type %s", ErrInvalidParameter, b.ScopeType)
+	}
+	if b.TotalAmount <= 0 {
+		return Budget{}, fmt.Errorf("%w: total amount must be greater than zero", ErrInvalidParameter)
+	}
+	thresholds, ok := normalizeThresholds(b.AlertThresholds)
+	if !ok {
+		return Budget{}, fmt.Errorf("%w: alert thresholds must be in range 1..100", ErrInvalidParameter)
+	}
+
+	budget, err := s.repo.AddRewardBudget(ctx, repository.AddRewardBudgetParams{
+		ScopeType:       b.ScopeType,
+		ScopeID:         scopeID,
+		TotalAmount:     b.TotalAmount,
+		OwnerEmail:      b.OwnerEmail,
+		AlertThresholds: thresholds,
+	})
+	if err != nil {
+		if db.IsDuplicateError(err) {
+			return Budget{}, ErrAlreadyExists
+		}
+		return Budget{}, fmt.Errorf("could not add budget: %w", err)
+	}
+
+	return castToBudget(budget), nil
+}
+
+// GetBudgetByID returns budget details including consumed amount
+func (s *Service) GetBudgetByID(ctx context.Context, id uuid.UUID) (Budget, error) {
+	budget, err := s.repo.GetRewardBudgetByID(ctx, id)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Budget{}, fmt.Errorf("%w budget", ErrNotFound)
+		}
+		return Budget{}, fmt.Errorf("could not get budget: %w", err)
+	}
+
+	return castToBudget(budget), nil
+}
+
+// GetBudgets returns list of all budgets
+func (s *Service) GetBudgets(ctx context.Context, limit, offset int32) ([]Budget, error) {
+	list, err := s.repo.GetRewardBudgetsPaginated(ctx, repository.GetRewardBudgetsPaginatedParams{
+		LimitVal:  limit,
+		OffsetVal: offset,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get budgets list: %w", err)
+	}
+
+	result := make([]Budget, 0, len(list))
+	for _, b := range list {
+		result = append(result, castToBudget(b))
+	}
+
+	return result, nil
+}
+
+// UpdateBudget changes total amount, owner and alert thresholds of the budget.
+// Owner is notified again about thresholds which are not reached anymore after the budget is raised.
+func (s *Service) UpdateBudget(ctx context.Context, b Budget) error {
+	id, err := uuid.Parse(b.ID)
+	if err != nil {
+		return fmt.Errorf("%w: budget id", ErrInvalidParameter)
+	}
+	if b.TotalAmount <= 0 {
+		return fmt.Errorf("%w: total amount must be greater than zero", ErrInvalidParameter)
+	}
+	thresholds, ok := normalizeThresholds(b.AlertThresholds)
+	if !ok {
+		return fmt.Errorf("%w: alert thresholds must be in range 1..100", ErrInvalidParameter)
+	}
+
+	budget, err := s.repo.GetRewardBudgetByID(ctx, id)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return fmt.Errorf("%w budget", ErrNotFound)
+		}
+		return fmt.Errorf("could not get budget: %w", err)
+	}
+
+	if err := s.repo.UpdateRewardBudget(ctx, repository.UpdateRewardBudgetParams{
+		TotalAmount:       b.TotalAmount,
+		OwnerEmail:        b.OwnerEmail,
+		AlertThresholds:   thresholds,
+		NotifiedThreshold: reachedThreshold(thresholds, budget.ConsumedAmount, b.TotalAmount),
+		ID:                id,
+	}); err != nil {
+		return fmt.Errorf("could not update budget: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteBudgetByID removes rewards ceiling from the scope
+func (s *Service) DeleteBudgetByID(ctx context.Context, id uuid.UUID) error {
+	if err := s.repo.DeleteRewardBudgetByID(ctx, id); err != nil {
+		return fmt.Errorf("could not delete budget: %w", err)
+	}
+
+	return nil
+}
+
+// GetRemainingAmount returns the least amount left in budgets of the given scopes.
+// The second returned value is false if none of the scopes has a budget.
+func (s *Service) GetRemainingAmount(ctx context.Context, scopes ...Scope) (float64, bool, error) {
+	var (
+		remaining float64
+		limited   bool
+	)
+
+	for _, sc := range scopes {
+		b, err := s.repo.GetRewardBudgetByScope(ctx, repository.GetRewardBudgetByScopeParams{
+			ScopeType: sc.Type,
+			ScopeID:   sc.ID,
+		})
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				continue
+			}
+			return 0, false, fmt.Errorf("could not get %s budget: %w", sc.Type, err)
+		}
+
+		if left := remainingAmount(b); !limited || left < remaining {
+			remaining = left
+		}
+		limited = true
+	}
+
+	return remaining, limited, nil
+}
+
+// Spend consumes amount from budgets of all given scopes and returns the granted amount.
+// The granted amount is less than requested one if any of the budgets is running out,
+// and zero if any of them is exhausted. Scopes without budget are not limited.
+func (s *Service) Spend(ctx context.Context, amount float64, scopes ...Scope) (float64, error) {
+	if amount <= 0 {
+		return 0, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	budgets := make([]repository.RewardBudget, 0, len(scopes))
+	for _, sc := range sortScopes(scopes) {
+		b, err := repo.GetRewardBudgetByScopeForUpdate(ctx, repository.GetRewardBudgetByScopeForUpdateParams{
+			ScopeType: sc.Type,
+			ScopeID:   sc.ID,
+		})
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				continue
+			}
+			return 0, fmt.Errorf("could not get %s budget: %w", sc.Type, err)
+		}
+		budgets = append(budgets, b)
+	}
+
+	if len(budgets) == 0 {
+		return amount, nil
+	}
+
+	granted := grantableAmount(amount, budgets)
+	if granted <= 0 {
+		return 0, nil
+	}
+
+	alerts := make([]budgetAlert, 0, len(budgets))
+	for _, b := range budgets {
+		consumed := b.ConsumedAmount + granted
+		notified := b.NotifiedThreshold
+		if t := reachedThreshold(b.AlertThresholds, consumed, b.TotalAmount); t > notified {
+			notified = t
+			alerts = append(alerts, budgetAlert{budget: b, threshold: t, consumed: consumed})
+		}
+
+		if err := repo.ConsumeRewardBudget(ctx, repository.ConsumeRewardBudgetParams{
+			Amount:            granted,
+			NotifiedThreshold: notified,
+			ID:                b.ID,
+		}); err != nil {
+			return 0, fmt.Errorf("could not consume %s budget: %w", b.ScopeType, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("could not commit budget consumption: %w", err)
+	}
+
+	for _, a := range alerts {
+		s.notifyOwner(ctx, a)
+	}
+
+	return granted, nil
+}
+
+// Refund returns amount spent on rewards which were not delivered back to budgets of all given scopes.
+func (s *Service) Refund(ctx context.Context, amount float64, scopes ...Scope) error {
+	if amount <= 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	for _, sc := range sortScopes(scopes) {
+		if err := repo.RefundRewardBudget(ctx, repository.RefundRewardBudgetParams{
+			Amount:    amount,
+			ScopeType: sc.Type,
+			ScopeID:   sc.ID,
+		}); err != nil {
+			return fmt.Errorf("could not refund %s budget: %w", sc.Type, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit budget refund: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) notifyOwner(ctx context.Context, a budgetAlert) {
+	if a.budget.OwnerEmail == "" {
+		return
+	}
+
+	if err := s.mailer.SendRewardBudgetAlert(
+		ctx,
+		a.budget.OwnerEmail,
+		a.budget.ScopeType,
+		a.budget.ScopeID.String(),
+		a.threshold,
+		a.consumed,
+		a.budget.TotalAmount,
+	); err != nil {
+		log.Printf("could not notify owner of budget %s: %v", a.budget.ID, err)
+	}
+}
+
+func castToBudget(b repository.RewardBudget) Budget {
+	return Budget{
+		ID:              b.ID.String(),
+		ScopeType:       b.ScopeType,
+		ScopeID:         b.ScopeID.String(),
+		TotalAmount:     b.TotalAmount,
+		ConsumedAmount:  b.ConsumedAmount,
+		RemainingAmount: remainingAmount(b),
+		OwnerEmail:      b.OwnerEmail,
+		AlertThresholds: b.AlertThresholds,
+	}
+}
@@ -0,0 +1,136 @@
+package budgets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	jwtkit "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+)
+
+// Predefined request query keys
+const (
+	pageParam         = "page"
+	itemsPerPageParam = "items_per_page"
+)
+
+type (
+	logger interface {
+		Log(keyvals ...interface{}) error
+	}
+)
+
+// MakeHTTPHandler ...
+func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
+	r := chi.NewRouter()
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
+		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
+		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+	}
+
+	r.Post("/", httptransport.NewServer(
+		e.AddBudget,
+		decodeAddBudgetRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/", httptransport.NewServer(
+		e.GetBudgets,
+		decodeGetBudgetsRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/{budget_id}", httptransport.NewServer(
+		e.GetBudgetByID,
+		decodeBudgetIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Put("/{budget_id}", httptransport.NewServer(
+		e.UpdateBudget,
+		decodeUpdateBudgetRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/{budget_id}", httptransport.NewServer(
+		e.DeleteBudgetByID,
+		decodeBudgetIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	return r
+}
+
+func decodeAddBudgetRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req AddBudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeGetBudgetsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return GetBudgetsRequest{
+		PaginationRequest: utils.PaginationRequest{
+			Page:         utils.StrToInt32(r.URL.Query().Get(pageParam)),
+			ItemsPerPage: utils.StrToInt32(r.URL.Query().Get(itemsPerPageParam)),
+		},
+	}, nil
+}
+
+func decodeBudgetIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "budget_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed budget_id", ErrInvalidParameter)
+	}
+
+	return id, nil
+}
+
+func decodeUpdateBudgetRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req UpdateBudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	id := chi.URLParam(r, "budget_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed budget_id", ErrInvalidParameter)
+	}
+	req.ID = id
+
+	return req, nil
+}
+
+// returns http error code by error type
+func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+
+	if errors.Is(err, ErrInvalidParameter) {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	if errors.Is(err, ErrAlreadyExists) {
+		return http.StatusConflict, err.Error()
+	}
+
+	return httpencoder.CodeAndMessageFrom(err)
+}
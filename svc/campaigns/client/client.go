@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Client struct
+	Client struct {
+		s service
+	}
+
+	service interface {
+		TrackEvent(ctx context.Context, userID uuid.UUID, event string) error
+		GetLoginStreak(ctx context.Context, userID uuid.UUID) (int32, error)
+	}
+)
+
+// New campaigns service client implementation
+func New(s service) *Client {
+	return &Client{s: s}
+}
+
+// TrackEvent evaluates campaigns triggered by the user's event in background,
+// so the caller's flow is never blocked or failed by campaign rewards.
+func (c *Client) TrackEvent(_ context.Context, userID uuid.UUID, event string) {
+	go func() {
+		if err := c.s.TrackEvent(context.Background(), userID, event); err != nil {
+			log.Printf("could not track campaign event %s of user %s: %v", event, userID, err)
+		}
+	}()
+}
+
+// GetLoginStreak returns number of days in a row the user has logged in
+func (c *Client) GetLoginStreak(ctx context.Context, userID uuid.UUID) (int32, error) {
+	return c.s.GetLoginStreak(ctx, userID)
+}
@@ -0,0 +1,222 @@
+package campaigns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+	"github.com/SatorNetwork/sator-api/lib/validator"
+)
+
+type (
+	// Endpoints collection of campaigns service
+	Endpoints struct {
+		AddCampaign        endpoint.Endpoint
+		GetCampaignByID    endpoint.Endpoint
+		GetCampaigns       endpoint.Endpoint
+		UpdateCampaign     endpoint.Endpoint
+		DeleteCampaignByID endpoint.Endpoint
+		GetTriggerTypes    endpoint.Endpoint
+	}
+
+	service interface {
+		AddCampaign(ctx context.Context, c Campaign) (Campaign, error)
+		GetCampaignByID(ctx context.Context, id uuid.UUID) (Campaign, error)
+		GetCampaigns(ctx context.Context, limit, offset int32) ([]Campaign, error)
+		UpdateCampaign(ctx context.Context, c Campaign) error
+		DeleteCampaignByID(ctx context.Context, id uuid.UUID) error
+	}
+
+	// AddCampaignRequest struct
+	AddCampaignRequest struct {
+		Title            string     `json:"title" validate:"required"`
+		Description      string     `json:"description,omitempty"`
+		TriggerType      string     `json:"trigger_type" validate:"required"`
+		TriggerThreshold int32      `json:"trigger_threshold,omitempty" validate:"gte=0"`
+		RewardAmount     float64    `json:"reward_amount" validate:"required,gt=0"`
+		PerUserCap       int32      `json:"per_user_cap" validate:"gte=0"`
+		TotalBudget      float64    `json:"total_budget" validate:"gte=0"`
+		StartsAt         time.Time  `json:"starts_at" validate:"required"`
+		EndsAt           *time.Time `json:"ends_at,omitempty"`
+		Disabled         bool       `json:"disabled"`
+	}
+
+	// UpdateCampaignRequest struct
+	UpdateCampaignRequest struct {
+		ID string `json:"-" validate:"required,uuid"`
+		AddCampaignRequest
+	}
+
+	// GetCampaignsRequest struct
+	GetCampaignsRequest struct {
+		utils.PaginationRequest
+	}
+)
+
+// MakeEndpoints ...
+func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
+	validateFunc := validator.ValidateStruct()
+
+	e := Endpoints{
+		AddCampaign:        MakeAddCampaignEndpoint(s, validateFunc),
+		GetCampaignByID:    MakeGetCampaignByIDEndpoint(s),
+		GetCampaigns:       MakeGetCampaignsEndpoint(s, validateFunc),
+		UpdateCampaign:     MakeUpdateCampaignEndpoint(s, validateFunc),
+		DeleteCampaignByID: MakeDeleteCampaignByIDEndpoint(s),
+		GetTriggerTypes:    MakeGetTriggerTypesEndpoint(),
+	}
+
+	// setup middlewares for each endpoints
+	if len(m) > 0 {
+		for _, mdw := range m {
+			e.AddCampaign = mdw(e.AddCampaign)
+			e.GetCampaignByID = mdw(e.GetCampaignByID)
+			e.GetCampaigns = mdw(e.GetCampaigns)
+			e.UpdateCampaign = mdw(e.UpdateCampaign)
+			e.DeleteCampaignByID = mdw(e.DeleteCampaignByID)
+			e.GetTriggerTypes = mdw(e.GetTriggerTypes)
+		}
+	}
+
+	return e
+}
+
+// MakeAddCampaignEndpoint ...
+func MakeAddCampaignEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(AddCampaignRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.AddCampaign(ctx, req.toCampaign(""))
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetCampaignByIDEndpoint ...
+func MakeGetCampaignByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w campaign id: %v", ErrInvalidParameter, err)
+		}
+
+		resp, err := s.GetCampaignByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetCampaignsEndpoint ...
+func MakeGetCampaignsEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(GetCampaignsRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.GetCampaigns(ctx, req.Limit(), req.Offset())
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeUpdateCampaignEndpoint ...
+func MakeUpdateCampaignEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(UpdateCampaignRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := s.UpdateCampaign(ctx, req.toCampaign(req.ID)); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeDeleteCampaignByIDEndpoint ...
+func MakeDeleteCampaignByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w campaign id: %v", ErrInvalidParameter, err)
+		}
+
+		if err := s.DeleteCampaignByID(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeGetTriggerTypesEndpoint ...
+func MakeGetTriggerTypesEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		return TriggerTypes(), nil
+	}
+}
+
+func (r AddCampaignRequest) toCampaign(id string) Campaign {
+	threshold := r.TriggerThreshold
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	return Campaign{
+		ID:               id,
+		Title:            r.Title,
+		Description:      r.Description,
+		TriggerType:      r.TriggerType,
+		TriggerThreshold: threshold,
+		RewardAmount:     r.RewardAmount,
+		PerUserCap:       r.PerUserCap,
+		TotalBudget:      r.TotalBudget,
+		StartsAt:         r.StartsAt,
+		EndsAt:           r.EndsAt,
+		Disabled:         r.Disabled,
+	}
+}
@@ -0,0 +1,9 @@
+package campaigns
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrNotFound         = errors.New("not found")
+)
@@ -0,0 +1,55 @@
+-- +migrate Up
+-- +migrate StatementBegin
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE
+OR REPLACE FUNCTION campaigns_update_updated_at_column() RETURNS TRIGGER AS $$
+BEGIN NEW .updated_at = NOW();
+RETURN NEW;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+CREATE TABLE IF NOT EXISTS campaigns (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title VARCHAR NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    trigger_type VARCHAR NOT NULL,
+    trigger_threshold INT NOT NULL DEFAULT 1,
+    reward_amount DOUBLE PRECISION NOT NULL,
+    per_user_cap INT NOT NULL DEFAULT 1, -- 0 means unlimited
+    total_budget DOUBLE PRECISION NOT NULL DEFAULT 0, -- 0 means unlimited
+    spent_amount DOUBLE PRECISION NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP NOT NULL DEFAULT now(),
+    ends_at TIMESTAMP DEFAULT NULL,
+    disabled BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX campaigns_trigger_type ON campaigns USING BTREE (trigger_type);
+CREATE TRIGGER update_campaigns_modtime BEFORE
+UPDATE ON campaigns FOR EACH ROW EXECUTE PROCEDURE campaigns_update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS campaign_rewards (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    campaign_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY (campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+CREATE INDEX campaign_rewards_campaign_id_user_id ON campaign_rewards USING BTREE (campaign_id, user_id);
+
+-- counters of the user's events which campaigns are triggered by
+CREATE TABLE IF NOT EXISTS campaign_user_stats (
+    user_id uuid NOT NULL,
+    event_type VARCHAR NOT NULL,
+    counter INT NOT NULL DEFAULT 0,
+    streak INT NOT NULL DEFAULT 0,
+    last_event_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, event_type)
+);
+-- +migrate Down
+DROP TABLE IF EXISTS campaign_user_stats;
+DROP TABLE IF EXISTS campaign_rewards;
+DROP TRIGGER IF EXISTS update_campaigns_modtime ON campaigns;
+DROP TABLE IF EXISTS campaigns;
+DROP FUNCTION IF EXISTS campaigns_update_updated_at_column();
@@ -0,0 +1,12 @@
+-- name: AddCampaignReward :one
+INSERT INTO campaign_rewards (campaign_id, user_id, amount)
+VALUES (@campaign_id, @user_id, @amount)
+RETURNING id;
+-- name: CountCampaignRewardsByUserID :one
+SELECT COUNT(*)
+FROM campaign_rewards
+WHERE campaign_id = @campaign_id
+    AND user_id = @user_id;
+-- name: DeleteCampaignRewardByID :exec
+DELETE FROM campaign_rewards
+WHERE id = @id;
@@ -0,0 +1,19 @@
+-- name: GetCampaignUserStats :one
+SELECT *
+FROM campaign_user_stats
+WHERE user_id = @user_id
+    AND event_type = @event_type;
+-- name: IncrementCampaignUserStats :one
+INSERT INTO campaign_user_stats (user_id, event_type, counter, streak, last_event_at)
+VALUES (@user_id, @event_type, 1, CASE WHEN @daily_streak::BOOLEAN THEN 1 ELSE 0 END, @last_event_at)
+ON CONFLICT (user_id, event_type) DO UPDATE
+SET counter = campaign_user_stats.counter + 1,
+    streak = CASE
+        WHEN NOT @daily_streak::BOOLEAN THEN campaign_user_stats.streak
+        WHEN DATE(campaign_user_stats.last_event_at) = DATE(EXCLUDED.last_event_at) - 1 THEN campaign_user_stats.streak + 1
+        ELSE 1
+    END,
+    last_event_at = EXCLUDED.last_event_at
+WHERE NOT @daily_streak::BOOLEAN
+    OR DATE(campaign_user_stats.last_event_at) < DATE(EXCLUDED.last_event_at)
+RETURNING *;
@@ -0,0 +1,72 @@
+-- name: AddCampaign :one
+INSERT INTO campaigns (
+    title,
+    description,
+    trigger_type,
+    trigger_threshold,
+    reward_amount,
+    per_user_cap,
+    total_budget,
+    starts_at,
+    ends_at,
+    disabled
+)
+VALUES (
+    @title,
+    @description,
+    @trigger_type,
+    @trigger_threshold,
+    @reward_amount,
+    @per_user_cap,
+    @total_budget,
+    @starts_at,
+    @ends_at,
+    @disabled
+) RETURNING *;
+-- name: GetCampaignByID :one
+SELECT *
+FROM campaigns
+WHERE id = @id;
+-- name: GetCampaignByIDForUpdate :one
+SELECT *
+FROM campaigns
+WHERE id = @id
+FOR UPDATE;
+-- name: GetCampaignsPaginated :many
+SELECT *
+FROM campaigns
+ORDER BY created_at DESC
+LIMIT @limit_val OFFSET @offset_val;
+-- name: GetActiveCampaignsByTriggerType :many
+SELECT *
+FROM campaigns
+WHERE trigger_type = @trigger_type
+    AND disabled = FALSE
+    AND starts_at <= @now
+    AND (ends_at IS NULL OR ends_at > @now)
+    AND (total_budget = 0 OR spent_amount < total_budget)
+ORDER BY created_at ASC;
+-- name: UpdateCampaign :exec
+UPDATE campaigns
+SET title = @title,
+    description = @description,
+    trigger_type = @trigger_type,
+    trigger_threshold = @trigger_threshold,
+    reward_amount = @reward_amount,
+    per_user_cap = @per_user_cap,
+    total_budget = @total_budget,
+    starts_at = @starts_at,
+    ends_at = @ends_at,
+    disabled = @disabled
+WHERE id = @id;
+-- name: SpendCampaignBudget :exec
+UPDATE campaigns
+SET spent_amount = spent_amount + @amount
+WHERE id = @id;
+-- name: RefundCampaignBudget :exec
+UPDATE campaigns
+SET spent_amount = spent_amount - @amount
+WHERE id = @id;
+-- name: DeleteCampaignByID :exec
+DELETE FROM campaigns
+WHERE id = @id;
@@ -0,0 +1,128 @@
+package campaigns
+
+import (
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/campaigns/repository"
+	"github.com/SatorNetwork/sator-api/svc/multipliers"
+)
+
+// Campaign trigger types
+const (
+	TriggerLoginStreak         = "login_streak"          // user logged in N days in a row
+	TriggerFirstEpisodeWatched = "first_episode_watched" // user got access to the first episode
+	TriggerFirstReview         = "first_review"          // user reviewed the first episode
+	TriggerFirstStake          = "first_stake"           // user locked tokens for the first time
+	TriggerQuizzesPlayed       = "quizzes_played"        // user played N quizzes
+	TriggerKYCPassed           = "kyc_passed"            // user passed identity verification
+	TriggerAccountVerified     = "account_verified"      // user verified email address
+	TriggerFirstClaim          = "first_claim"           // user claimed rewards for the first time
+)
+
+// Events which campaign triggers are evaluated on
+const (
+	EventLogin           = "login"
+	EventEpisodeWatched  = "episode_watched"
+	EventReview          = "review"
+	EventStake           = "stake"
+	EventQuizPlayed      = "quiz_played"
+	EventKYCPassed       = "kyc_passed"
+	EventAccountVerified = "account_verified"
+	EventRewardsClaimed  = "rewards_claimed"
+)
+
+// eventTriggers maps tracked events to campaign trigger types evaluated on them
+var eventTriggers = map[string]string{
+	EventLogin:           TriggerLoginStreak,
+	EventEpisodeWatched:  TriggerFirstEpisodeWatched,
+	EventReview:          TriggerFirstReview,
+	EventStake:           TriggerFirstStake,
+	EventQuizPlayed:      TriggerQuizzesPlayed,
+	EventKYCPassed:       TriggerKYCPassed,
+	EventAccountVerified: TriggerAccountVerified,
+	EventRewardsClaimed:  TriggerFirstClaim,
+}
+
+// TriggerTypes returns list of supported campaign trigger types
+func TriggerTypes() []string {
+	return []string{
+		TriggerLoginStreak,
+		TriggerFirstEpisodeWatched,
+		TriggerFirstReview,
+		TriggerFirstStake,
+		TriggerQuizzesPlayed,
+		TriggerKYCPassed,
+		TriggerAccountVerified,
+		TriggerFirstClaim,
+	}
+}
+
+func isTriggerTypeSupported(triggerType string) bool {
+	for _, t := range TriggerTypes() {
+		if t == triggerType {
+			return true
+		}
+	}
+	return false
+}
+
+// isTriggered reports whether the user's counters match the campaign trigger
+func isTriggered(c repository.Campaign, st repository.CampaignUserStat) bool {
+	threshold := c.TriggerThreshold
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	switch c.TriggerType {
+	case TriggerLoginStreak:
+		return st.Streak > 0 && st.Streak%threshold == 0
+	case TriggerFirstEpisodeWatched, TriggerFirstReview, TriggerFirstStake, TriggerKYCPassed,
+		TriggerAccountVerified, TriggerFirstClaim:
+		return st.Counter == 1
+	case TriggerQuizzesPlayed:
+		return st.Counter > 0 && st.Counter%threshold == 0
+	}
+
+	return false
+}
+
+// isCampaignActive reports whether the campaign is enabled and running at the moment
+func isCampaignActive(c repository.Campaign, now time.Time) bool {
+	if c.Disabled || c.StartsAt.After(now) {
+		return false
+	}
+	return !c.EndsAt.Valid || c.EndsAt.Time.After(now)
+}
+
+// campaignRewardAmount returns amount of reward for the user,
+// zero means per-user cap or total budget of the campaign is reached.
+// The user's multiplier bonus is paid only as far as the rest of the budget allows.
+func campaignRewardAmount(c repository.Campaign, userRewardsCount int64, multiplier int32) float64 {
+	if c.PerUserCap > 0 && userRewardsCount >= int64(c.PerUserCap) {
+		return 0
+	}
+	if c.TotalBudget > 0 && c.SpentAmount+c.RewardAmount > c.TotalBudget {
+		return 0
+	}
+
+	amount := multipliers.Apply(c.RewardAmount, multiplier)
+	if c.TotalBudget > 0 && c.SpentAmount+amount > c.TotalBudget {
+		amount = c.TotalBudget - c.SpentAmount
+	}
+
+	return amount
+}
+
+// currentStreak returns the user's login streak which is still alive,
+// it's broken if the user missed a day since the last login.
+func currentStreak(st repository.CampaignUserStat, now time.Time) int32 {
+	if dayStart(now).Sub(dayStart(st.LastEventAt)) > 24*time.Hour {
+		return 0
+	}
+	return st.Streak
+}
+
+func dayStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,160 @@
+package campaigns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/campaigns/repository"
+)
+
+func TestIsTriggered(t *testing.T) {
+	tests := []struct {
+		name string
+		c    repository.Campaign
+		st   repository.CampaignUserStat
+		want bool
+	}{
+		{
+			name: "login streak reached",
+			c:    repository.Campaign{TriggerType: TriggerLoginStreak, TriggerThreshold: 7},
+			st:   repository.CampaignUserStat{Streak: 7},
+			want: true,
+		},
+		{
+			name: "login streak not reached",
+			c:    repository.Campaign{TriggerType: TriggerLoginStreak, TriggerThreshold: 7},
+			st:   repository.CampaignUserStat{Streak: 6},
+			want: false,
+		},
+		{
+			name: "first review",
+			c:    repository.Campaign{TriggerType: TriggerFirstReview},
+			st:   repository.CampaignUserStat{Counter: 1},
+			want: true,
+		},
+		{
+			name: "second review",
+			c:    repository.Campaign{TriggerType: TriggerFirstReview},
+			st:   repository.CampaignUserStat{Counter: 2},
+			want: false,
+		},
+		{
+			name: "every 10 quizzes",
+			c:    repository.Campaign{TriggerType: TriggerQuizzesPlayed, TriggerThreshold: 10},
+			st:   repository.CampaignUserStat{Counter: 20},
+			want: true,
+		},
+		{
+			name: "kyc passed",
+			c:    repository.Campaign{TriggerType: TriggerKYCPassed},
+			st:   repository.CampaignUserStat{Counter: 1},
+			want: true,
+		},
+		{
+			name: "first claim",
+			c:    repository.Campaign{TriggerType: TriggerFirstClaim},
+			st:   repository.CampaignUserStat{Counter: 2},
+			want: false,
+		},
+		{
+			name: "unknown trigger",
+			c:    repository.Campaign{TriggerType: "unknown"},
+			st:   repository.CampaignUserStat{Counter: 1},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTriggered(tt.c, tt.st); got != tt.want {
+				t.Errorf("isTriggered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCampaignRewardAmount(t *testing.T) {
+	tests := []struct {
+		name       string
+		c          repository.Campaign
+		count      int64
+		multiplier int32
+		want       float64
+	}{
+		{
+			name: "unlimited",
+			c:    repository.Campaign{RewardAmount: 10},
+			want: 10,
+		},
+		{
+			name:  "per-user cap reached",
+			c:     repository.Campaign{RewardAmount: 10, PerUserCap: 1},
+			count: 1,
+			want:  0,
+		},
+		{
+			name: "budget exhausted",
+			c:    repository.Campaign{RewardAmount: 10, TotalBudget: 100, SpentAmount: 95},
+			want: 0,
+		},
+		{
+			name: "budget is enough",
+			c:    repository.Campaign{RewardAmount: 10, TotalBudget: 100, SpentAmount: 90},
+			want: 10,
+		},
+		{
+			name:       "multiplier applied",
+			c:          repository.Campaign{RewardAmount: 10},
+			multiplier: 50,
+			want:       15,
+		},
+		{
+			name:       "multiplier bonus limited by budget",
+			c:          repository.Campaign{RewardAmount: 10, TotalBudget: 100, SpentAmount: 88},
+			multiplier: 50,
+			want:       12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := campaignRewardAmount(tt.c, tt.count, tt.multiplier); got != tt.want {
+				t.Errorf("campaignRewardAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrentStreak(t *testing.T) {
+	now := time.Date(2022, 10, 24, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		st   repository.CampaignUserStat
+		want int32
+	}{
+		{
+			name: "logged in today",
+			st:   repository.CampaignUserStat{Streak: 3, LastEventAt: now.Add(-time.Hour)},
+			want: 3,
+		},
+		{
+			name: "logged in yesterday",
+			st:   repository.CampaignUserStat{Streak: 3, LastEventAt: now.Add(-24 * time.Hour)},
+			want: 3,
+		},
+		{
+			name: "missed a day",
+			st:   repository.CampaignUserStat{Streak: 3, LastEventAt: now.Add(-48 * time.Hour)},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := currentStreak(tt.st, now); got != tt.want {
+				t.Errorf("currentStreak() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,411 @@
+package campaigns
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/campaigns/repository"
+)
+
+// RelationTypeCampaign indicates that reward is issued by a campaign
+const RelationTypeCampaign = "campaign"
+
+type (
+	// Service struct
+	Service struct {
+		db   *sql.DB
+		repo campaignsRepository
+		rc   rewardsClient
+
+		subscribers  []eventSubscriberFunc // services which are interested in user's activity as well
+		multiplierFn rewardMultiplierFunc  // returns the user's bonus percent added to rewards
+	}
+
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
+	eventSubscriberFunc  func(ctx context.Context, userID uuid.UUID, event string)
+	rewardMultiplierFunc func(ctx context.Context, userID uuid.UUID) (int32, error)
+
+	// Campaign defines which users are rewarded, how much and for how long
+	Campaign struct {
+		ID               string     `json:"id"`
+		Title            string     `json:"title"`
+		Description      string     `json:"description"`
+		TriggerType      string     `json:"trigger_type"`
+		TriggerThreshold int32      `json:"trigger_threshold"` // streak days or number of quizzes, depends on trigger type
+		RewardAmount     float64    `json:"reward_amount"`
+		PerUserCap       int32      `json:"per_user_cap"` // max number of rewards per user, 0 means unlimited
+		TotalBudget      float64    `json:"total_budget"` // 0 means unlimited
+		SpentAmount      float64    `json:"spent_amount"`
+		StartsAt         time.Time  `json:"starts_at"`
+		EndsAt           *time.Time `json:"ends_at,omitempty"`
+		Disabled         bool       `json:"disabled"`
+	}
+
+	campaignsRepository interface {
+		AddCampaign(ctx context.Context, arg repository.AddCampaignParams) (repository.Campaign, error)
+		DeleteCampaignByID(ctx context.Context, id uuid.UUID) error
+		GetActiveCampaignsByTriggerType(ctx context.Context, arg repository.GetActiveCampaignsByTriggerTypeParams) ([]repository.Campaign, error)
+		GetCampaignByID(ctx context.Context, id uuid.UUID) (repository.Campaign, error)
+		GetCampaignsPaginated(ctx context.Context, arg repository.GetCampaignsPaginatedParams) ([]repository.Campaign, error)
+		UpdateCampaign(ctx context.Context, arg repository.UpdateCampaignParams) error
+
+		GetCampaignUserStats(ctx context.Context, arg repository.GetCampaignUserStatsParams) (repository.CampaignUserStat, error)
+		IncrementCampaignUserStats(ctx context.Context, arg repository.IncrementCampaignUserStatsParams) (repository.CampaignUserStat, error)
+
+		WithTx(tx *sql.Tx) *repository.Queries
+	}
+
+	rewardsClient interface {
+		AddDepositTransaction(ctx context.Context, userID, relationID uuid.UUID, relationType string, amount float64) error
+	}
+)
+
+// NewService is a factory function,
+// returns a new instance of the Service interface implementation
+func NewService(dbConn *sql.DB, repo campaignsRepository, rc rewardsClient, opt ...ServiceOption) *Service {
+	if repo == nil {
+		log.Fatalln("campaigns repository is not set")
+	}
+	if rc == nil {
+		log.Fatalln("rewards client is not set")
+	}
+
+	s := &Service{db: dbConn, repo: repo, rc: rc}
+
+	// Set up options.
+	for _, o := range opt {
+		o(s)
+	}
+
+	return s
+}
+
+// WithEventSubscriber forwards every tracked event to the given function
+func WithEventSubscriber(fn eventSubscriberFunc) ServiceOption {
+	return func(s *Service) {
+		s.subscribers = append(s.subscribers, fn)
+	}
+}
+
+// WithRewardMultiplierFunc sets function which returns the user's reward multiplier
+func WithRewardMultiplierFunc(fn rewardMultiplierFunc) ServiceOption {
+	return func(s *Service) {
+		s.multiplierFn = fn
+	}
+}
+
+// AddCampaign creates a new reward campaign
+func (s *Service) AddCampaign(ctx context.Context, c Campaign) (Campaign, error) {
+	if err := validateCampaign(c); err != nil {
+		return Campaign{}, err
+	}
+
+	campaign, err := s.repo.AddCampaign(ctx, repository.AddCampaignParams{
+		Title:            c.Title,
+		Description:      c.Description,
+		TriggerType:      c.TriggerType,
+		TriggerThreshold: c.TriggerThreshold,
+		RewardAmount:     c.RewardAmount,
+		PerUserCap:       c.PerUserCap,
+		TotalBudget:      c.TotalBudget,
+		StartsAt:         c.StartsAt,
+		EndsAt:           toNullTime(c.EndsAt),
+		Disabled:         c.Disabled,
+	})
+	if err != nil {
+		return Campaign{}, fmt.Errorf("could not add campaign: %w", err)
+	}
+
+	return castToCampaign(campaign), nil
+}
+
+// GetCampaignByID returns campaign details
+func (s *Service) GetCampaignByID(ctx context.Context, id uuid.UUID) (Campaign, error) {
+	campaign, err := s.repo.GetCampaignByID(ctx, id)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Campaign{}, fmt.Errorf("%w campaign", ErrNotFound)
+		}
+		return Campaign{}, fmt.Errorf("could not get campaign: %w", err)
+	}
+
+	return castToCampaign(campaign), nil
+}
+
+// GetCampaigns returns list of all campaigns
+func (s *Service) GetCampaigns(ctx context.Context, limit, offset int32) ([]Campaign, error) {
+	list, err := s.repo.GetCampaignsPaginated(ctx, repository.GetCampaignsPaginatedParams{
+		LimitVal:  limit,
+		OffsetVal: offset,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get campaigns list: %w", err)
+	}
+
+	result := make([]Campaign, 0, len(list))
+	for _, c := range list {
+		result = append(result, castToCampaign(c))
+	}
+
+	return result, nil
+}
+
+// UpdateCampaign updates campaign rules, already issued rewards are not affected
+func (s *Service) UpdateCampaign(ctx context.Context, c Campaign) error {
+	id, err := uuid.Parse(c.ID)
+	if err != nil {
+		return fmt.Errorf("%w: campaign id", ErrInvalidParameter)
+	}
+
+	if err := validateCampaign(c); err != nil {
+		return err
+	}
+
+	if _, err := s.GetCampaignByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateCampaign(ctx, repository.UpdateCampaignParams{
+		Title:            c.Title,
+		Description:      c.Description,
+		TriggerType:      c.TriggerType,
+		TriggerThreshold: c.TriggerThreshold,
+		RewardAmount:     c.RewardAmount,
+		PerUserCap:       c.PerUserCap,
+		TotalBudget:      c.TotalBudget,
+		StartsAt:         c.StartsAt,
+		EndsAt:           toNullTime(c.EndsAt),
+		Disabled:         c.Disabled,
+		ID:               id,
+	}); err != nil {
+		return fmt.Errorf("could not update campaign: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteCampaignByID deletes campaign
+func (s *Service) DeleteCampaignByID(ctx context.Context, id uuid.UUID) error {
+	if err := s.repo.DeleteCampaignByID(ctx, id); err != nil {
+		return fmt.Errorf("could not delete campaign: %w", err)
+	}
+
+	return nil
+}
+
+// TrackEvent updates the user's counters and rewards the user
+// by all active campaigns triggered by the event.
+func (s *Service) TrackEvent(ctx context.Context, userID uuid.UUID, event string) error {
+	triggerType, ok := eventTriggers[event]
+	if !ok {
+		return fmt.Errorf("%w: unknown event %s", ErrInvalidParameter, event)
+	}
+
+	for _, fn := range s.subscribers {
+		fn(ctx, userID, event)
+	}
+
+	// Counters are incremented by a single statement, so concurrent events are not lost.
+	// Login is counted once per day to keep the streak.
+	now := time.Now().UTC()
+	stats, err := s.repo.IncrementCampaignUserStats(ctx, repository.IncrementCampaignUserStatsParams{
+		UserID:      userID,
+		EventType:   event,
+		DailyStreak: event == EventLogin,
+		LastEventAt: now,
+	})
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("could not store user stats: %w", err)
+	}
+
+	list, err := s.repo.GetActiveCampaignsByTriggerType(ctx, repository.GetActiveCampaignsByTriggerTypeParams{
+		TriggerType: triggerType,
+		Now:         now,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get active campaigns: %w", err)
+	}
+
+	for _, c := range list {
+		if !isTriggered(c, stats) {
+			continue
+		}
+		if err := s.rewardUser(ctx, c.ID, userID); err != nil {
+			log.Printf("could not reward user %s by campaign %s: %v", userID, c.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetLoginStreak returns number of days in a row the user has logged in
+func (s *Service) GetLoginStreak(ctx context.Context, userID uuid.UUID) (int32, error) {
+	stats, err := s.repo.GetCampaignUserStats(ctx, repository.GetCampaignUserStatsParams{
+		UserID:    userID,
+		EventType: EventLogin,
+	})
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("could not get user stats: %w", err)
+	}
+
+	return currentStreak(stats, time.Now()), nil
+}
+
+// rewardUser deposits campaign reward to the user's rewards wallet.
+// Campaign row is locked to keep per-user cap and total budget consistent.
+// The deposit is made only after the reward is committed and the reward is reverted if it fails.
+func (s *Service) rewardUser(ctx context.Context, campaignID, userID uuid.UUID) error {
+	var multiplier int32
+	if s.multiplierFn != nil {
+		m, err := s.multiplierFn(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("could not get user's multiplier: %w", err)
+		}
+		multiplier = m
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	c, err := repo.GetCampaignByIDForUpdate(ctx, campaignID)
+	if err != nil {
+		return fmt.Errorf("could not get campaign: %w", err)
+	}
+	if !isCampaignActive(c, time.Now()) {
+		return nil
+	}
+
+	count, err := repo.CountCampaignRewardsByUserID(ctx, repository.CountCampaignRewardsByUserIDParams{
+		CampaignID: campaignID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return fmt.Errorf("could not count user rewards: %w", err)
+	}
+
+	amount := campaignRewardAmount(c, count, multiplier)
+	if amount <= 0 {
+		return nil
+	}
+
+	rewardID, err := repo.AddCampaignReward(ctx, repository.AddCampaignRewardParams{
+		CampaignID: campaignID,
+		UserID:     userID,
+		Amount:     amount,
+	})
+	if err != nil {
+		return fmt.Errorf("could not store campaign reward: %w", err)
+	}
+
+	if err := repo.SpendCampaignBudget(ctx, repository.SpendCampaignBudgetParams{
+		Amount: amount,
+		ID:     campaignID,
+	}); err != nil {
+		return fmt.Errorf("could not update campaign budget: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit campaign reward: %w", err)
+	}
+
+	if err := s.rc.AddDepositTransaction(ctx, userID, campaignID, RelationTypeCampaign, amount); err != nil {
+		if rerr := s.revertReward(ctx, rewardID, campaignID, amount); rerr != nil {
+			log.Printf("could not revert campaign reward %s: %v", rewardID, rerr)
+		}
+		return fmt.Errorf("could not add deposit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// revertReward deletes the campaign reward which has not been deposited and returns its amount to the budget
+func (s *Service) revertReward(ctx context.Context, rewardID, campaignID uuid.UUID, amount float64) error {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	if err := repo.DeleteCampaignRewardByID(ctx, rewardID); err != nil {
+		return fmt.Errorf("could not delete campaign reward: %w", err)
+	}
+
+	if err := repo.RefundCampaignBudget(ctx, repository.RefundCampaignBudgetParams{
+		Amount: amount,
+		ID:     campaignID,
+	}); err != nil {
+		return fmt.Errorf("could not update campaign budget: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func validateCampaign(c Campaign) error {
+	if !isTriggerTypeSupported(c.TriggerType) {
+		return fmt.Errorf("%w: unsupported trigger type %s", ErrInvalidParameter, c.TriggerType)
+	}
+	if c.RewardAmount <= 0 {
+		return fmt.Errorf("%w: reward amount must be greater than zero", ErrInvalidParameter)
+	}
+	if c.TriggerThreshold < 1 {
+		return fmt.Errorf("%w: trigger threshold must be greater than zero", ErrInvalidParameter)
+	}
+	if c.PerUserCap < 0 || c.TotalBudget < 0 {
+		return fmt.Errorf("%w: per-user cap and total budget must not be negative", ErrInvalidParameter)
+	}
+	if c.EndsAt != nil && !c.EndsAt.After(c.StartsAt) {
+		return fmt.Errorf("%w: campaign must end after it starts", ErrInvalidParameter)
+	}
+
+	return nil
+}
+
+func castToCampaign(c repository.Campaign) Campaign {
+	result := Campaign{
+		ID:               c.ID.String(),
+		Title:            c.Title,
+		Description:      c.Description,
+		TriggerType:      c.TriggerType,
+		TriggerThreshold: c.TriggerThreshold,
+		RewardAmount:     c.RewardAmount,
+		PerUserCap:       c.PerUserCap,
+		TotalBudget:      c.TotalBudget,
+		SpentAmount:      c.SpentAmount,
+		StartsAt:         c.StartsAt,
+		Disabled:         c.Disabled,
+	}
+	if c.EndsAt.Valid {
+		result.EndsAt = &c.EndsAt.Time
+	}
+
+	return result
+}
+
+func toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
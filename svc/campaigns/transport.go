@@ -0,0 +1,143 @@
+package campaigns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	jwtkit "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+)
+
+// Predefined request query keys
+const (
+	pageParam         = "page"
+	itemsPerPageParam = "items_per_page"
+)
+
+type (
+	logger interface {
+		Log(keyvals ...interface{}) error
+	}
+)
+
+// MakeHTTPHandler ...
+func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
+	r := chi.NewRouter()
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
+		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
+		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+	}
+
+	r.Post("/", httptransport.NewServer(
+		e.AddCampaign,
+		decodeAddCampaignRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/", httptransport.NewServer(
+		e.GetCampaigns,
+		decodeGetCampaignsRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/triggers", httptransport.NewServer(
+		e.GetTriggerTypes,
+		decodeGetTriggerTypesRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/{campaign_id}", httptransport.NewServer(
+		e.GetCampaignByID,
+		decodeCampaignIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Put("/{campaign_id}", httptransport.NewServer(
+		e.UpdateCampaign,
+		decodeUpdateCampaignRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/{campaign_id}", httptransport.NewServer(
+		e.DeleteCampaignByID,
+		decodeCampaignIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	return r
+}
+
+func decodeAddCampaignRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req AddCampaignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeGetCampaignsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return GetCampaignsRequest{
+		PaginationRequest: utils.PaginationRequest{
+			Page:         utils.StrToInt32(r.URL.Query().Get(pageParam)),
+			ItemsPerPage: utils.StrToInt32(r.URL.Query().Get(itemsPerPageParam)),
+		},
+	}, nil
+}
+
+func decodeGetTriggerTypesRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodeCampaignIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "campaign_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed campaign_id", ErrInvalidParameter)
+	}
+
+	return id, nil
+}
+
+func decodeUpdateCampaignRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req UpdateCampaignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	id := chi.URLParam(r, "campaign_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed campaign_id", ErrInvalidParameter)
+	}
+	req.ID = id
+
+	return req, nil
+}
+
+// returns http error code by error type
+func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+
+	if errors.Is(err, ErrInvalidParameter) {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	return httpencoder.CodeAndMessageFrom(err)
+}
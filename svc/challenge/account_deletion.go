@@ -0,0 +1,18 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// EraseUserData deletes the user's answers to episode verification questions on account deletion.
+// Episode access is kept, since it could be paid for.
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	if err := s.cr.DeleteAttemptsByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete verification question attempts: %w", err)
+	}
+
+	return nil
+}
@@ -32,6 +32,7 @@ type (
 
 		NumberUsersWhoHaveAccessToEpisode(ctx context.Context, episodeID uuid.UUID) (int32, error)
 		ListIDsAvailableUserEpisodes(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]uuid.UUID, error)
+		GrantEpisodeAccess(ctx context.Context, userID, episodeID uuid.UUID, unlockOption string) error
 
 		AddChallenge(ctx context.Context, ch challenge.Challenge) (challenge.Challenge, error)
 	}
@@ -187,3 +188,8 @@ func (c *Client) CreateQuizChallenge(ctx context.Context, showID, epID uuid.UUID
 
 	return res.ID, nil
 }
+
+// GrantEpisodeAccess unlocks episode realm for the user for free
+func (c *Client) GrantEpisodeAccess(ctx context.Context, userID, episodeID uuid.UUID, unlockOption string) error {
+	return c.s.GrantEpisodeAccess(ctx, userID, episodeID, unlockOption)
+}
@@ -0,0 +1,99 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+)
+
+type (
+	// ChallengesData is the user's challenges activity included into the data export archive
+	ChallengesData struct {
+		EpisodeAccess       []ExportedEpisodeAccess   `json:"episode_access"`
+		VerificationAnswers []ExportedAttempt         `json:"verification_answers"`
+		PassedChallenges    []ExportedPassedChallenge `json:"passed_challenges"`
+	}
+
+	// ExportedEpisodeAccess struct
+	ExportedEpisodeAccess struct {
+		EpisodeID       uuid.UUID  `json:"episode_id"`
+		ActivatedAt     *time.Time `json:"activated_at,omitempty"`
+		ActivatedBefore *time.Time `json:"activated_before,omitempty"`
+	}
+
+	// ExportedAttempt is an answer to the episode verification question
+	ExportedAttempt struct {
+		EpisodeID  uuid.UUID  `json:"episode_id"`
+		QuestionID uuid.UUID  `json:"question_id"`
+		AnswerID   *uuid.UUID `json:"answer_id,omitempty"`
+		Valid      bool       `json:"valid"`
+		CreatedAt  *time.Time `json:"created_at,omitempty"`
+	}
+
+	// ExportedPassedChallenge struct
+	ExportedPassedChallenge struct {
+		ChallengeID  uuid.UUID `json:"challenge_id"`
+		RewardAmount float64   `json:"reward_amount"`
+	}
+)
+
+// ExportUserData returns episodes unlocked by the user, answers to verification questions
+// and passed challenges for the data export archive.
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	data := ChallengesData{
+		EpisodeAccess:       []ExportedEpisodeAccess{},
+		VerificationAnswers: []ExportedAttempt{},
+		PassedChallenges:    []ExportedPassedChallenge{},
+	}
+
+	access, err := s.cr.GetEpisodeAccessByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get episode access data: %w", err)
+	}
+	for _, a := range access {
+		ea := ExportedEpisodeAccess{EpisodeID: a.EpisodeID}
+		if a.ActivatedAt.Valid {
+			ea.ActivatedAt = &a.ActivatedAt.Time
+		}
+		if a.ActivatedBefore.Valid {
+			ea.ActivatedBefore = &a.ActivatedBefore.Time
+		}
+		data.EpisodeAccess = append(data.EpisodeAccess, ea)
+	}
+
+	attempts, err := s.cr.GetAttemptsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get verification question attempts: %w", err)
+	}
+	for _, a := range attempts {
+		ea := ExportedAttempt{
+			EpisodeID:  a.EpisodeID,
+			QuestionID: a.QuestionID,
+			Valid:      a.Valid.Bool,
+		}
+		if a.AnswerID.Valid {
+			ea.AnswerID = &a.AnswerID.UUID
+		}
+		if a.CreatedAt.Valid {
+			ea.CreatedAt = &a.CreatedAt.Time
+		}
+		data.VerificationAnswers = append(data.VerificationAnswers, ea)
+	}
+
+	passed, err := s.cr.GetPassedChallengesDataByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get passed challenges: %w", err)
+	}
+	for _, p := range passed {
+		data.PassedChallenges = append(data.PassedChallenges, ExportedPassedChallenge{
+			ChallengeID:  p.ChallengeID,
+			RewardAmount: p.RewardAmount,
+		})
+	}
+
+	return data, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/SatorNetwork/sator-api/lib/jwt"
 	"github.com/SatorNetwork/sator-api/lib/rbac"
 	"github.com/SatorNetwork/sator-api/lib/validator"
+	"github.com/SatorNetwork/sator-api/svc/pricing"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/google/uuid"
@@ -37,7 +38,8 @@ type (
 		CheckAnswer       endpoint.Endpoint
 		UpdateAnswer      endpoint.Endpoint
 
-		UnlockEpisode endpoint.Endpoint
+		UnlockEpisode         endpoint.Endpoint
+		GetEpisodeUnlockQuote endpoint.Endpoint
 	}
 
 	service interface {
@@ -64,7 +66,8 @@ type (
 		CheckAnswer(ctx context.Context, aid, qid uuid.UUID) (bool, error)
 		UpdateAnswer(ctx context.Context, ao AnswerOption) error
 
-		UnlockEpisode(ctx context.Context, uid, episodeID uuid.UUID, unlockOption string) error
+		UnlockEpisode(ctx context.Context, uid, episodeID uuid.UUID, unlockOption string, quoteID uuid.UUID) error
+		GetEpisodeUnlockQuote(ctx context.Context, uid, episodeID uuid.UUID, unlockOption, asset string) (pricing.Quote, error)
 	}
 
 	// AddChallengeRequest struct
@@ -151,6 +154,14 @@ type (
 	UnlockEpisodeRequest struct {
 		EpisodeID string `json:"episode_id" validate:"required,uuid"`
 		Option    string `json:"option" validate:"required"`
+		QuoteID   string `json:"quote_id,omitempty" validate:"omitempty,uuid"`
+	}
+
+	// GetEpisodeUnlockQuoteRequest ...
+	GetEpisodeUnlockQuoteRequest struct {
+		EpisodeID string `json:"episode_id" validate:"required,uuid"`
+		Option    string `json:"option" validate:"required"`
+		Asset     string `json:"asset" validate:"required,oneof=SAO SOL USDC"`
 	}
 
 	//	GetAttemptsLeftForVerificationQuestionResponse...
@@ -184,7 +195,8 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 		CheckAnswer:       MakeCheckAnswerEndpoint(s, validateFunc),
 		UpdateAnswer:      MakeUpdateAnswerEndpoint(s, validateFunc),
 
-		UnlockEpisode: MakeUnlockEpisodeEndpoint(s, validateFunc),
+		UnlockEpisode:         MakeUnlockEpisodeEndpoint(s, validateFunc),
+		GetEpisodeUnlockQuote: MakeGetEpisodeUnlockQuoteEndpoint(s, validateFunc),
 	}
 
 	// setup middlewares for each endpoints
@@ -212,6 +224,7 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 			e.UpdateAnswer = mdw(e.UpdateAnswer)
 
 			e.UnlockEpisode = mdw(e.UnlockEpisode)
+			e.GetEpisodeUnlockQuote = mdw(e.GetEpisodeUnlockQuote)
 		}
 	}
 
@@ -776,7 +789,14 @@ func MakeUnlockEpisodeEndpoint(s service, v validator.ValidateFunc) endpoint.End
 			return nil, fmt.Errorf("could not get episode id: %w", err)
 		}
 
-		if err := s.UnlockEpisode(ctx, uid, episodeID, req.Option); err != nil {
+		quoteID := uuid.Nil
+		if req.QuoteID != "" {
+			if quoteID, err = uuid.Parse(req.QuoteID); err != nil {
+				return nil, fmt.Errorf("could not get quote id: %w", err)
+			}
+		}
+
+		if err := s.UnlockEpisode(ctx, uid, episodeID, req.Option, quoteID); err != nil {
 			return false, err
 		}
 
@@ -789,6 +809,37 @@ func MakeUnlockEpisodeEndpoint(s service, v validator.ValidateFunc) endpoint.End
 	}
 }
 
+// MakeGetEpisodeUnlockQuoteEndpoint ...
+func MakeGetEpisodeUnlockQuoteEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		req := request.(GetEpisodeUnlockQuoteRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		episodeID, err := uuid.Parse(req.EpisodeID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get episode id: %w", err)
+		}
+
+		resp, err := s.GetEpisodeUnlockQuote(ctx, uid, episodeID, req.Option, req.Asset)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
 // MakeGetAttemptsLeftForVerificationQuestionEndpoint ...
 func MakeGetAttemptsLeftForVerificationQuestionEndpoint(s service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
@@ -55,6 +55,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteAnswersByQuestionIDStmt, err = db.PrepareContext(ctx, deleteAnswersByQuestionID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAnswersByQuestionID: %w", err)
 	}
+	if q.deleteAttemptsByUserIDStmt, err = db.PrepareContext(ctx, deleteAttemptsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAttemptsByUserID: %w", err)
+	}
 	if q.deleteChallengeByIDStmt, err = db.PrepareContext(ctx, deleteChallengeByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteChallengeByID: %w", err)
 	}
@@ -79,6 +82,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getAskedQuestionsByEpisodeIDStmt, err = db.PrepareContext(ctx, getAskedQuestionsByEpisodeID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAskedQuestionsByEpisodeID: %w", err)
 	}
+	if q.getAttemptsByUserIDStmt, err = db.PrepareContext(ctx, getAttemptsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAttemptsByUserID: %w", err)
+	}
 	if q.getChallengeByEpisodeIDStmt, err = db.PrepareContext(ctx, getChallengeByEpisodeID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetChallengeByEpisodeID: %w", err)
 	}
@@ -97,12 +103,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getChallengesStmt, err = db.PrepareContext(ctx, getChallenges); err != nil {
 		return nil, fmt.Errorf("error preparing query GetChallenges: %w", err)
 	}
+	if q.getEpisodeAccessByUserIDStmt, err = db.PrepareContext(ctx, getEpisodeAccessByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEpisodeAccessByUserID: %w", err)
+	}
 	if q.getEpisodeAccessDataStmt, err = db.PrepareContext(ctx, getEpisodeAccessData); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEpisodeAccessData: %w", err)
 	}
 	if q.getEpisodeIDByQuestionIDStmt, err = db.PrepareContext(ctx, getEpisodeIDByQuestionID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEpisodeIDByQuestionID: %w", err)
 	}
+	if q.getPassedChallengesDataByUserIDStmt, err = db.PrepareContext(ctx, getPassedChallengesDataByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPassedChallengesDataByUserID: %w", err)
+	}
 	if q.getQuestionByIDStmt, err = db.PrepareContext(ctx, getQuestionByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetQuestionByID: %w", err)
 	}
@@ -196,6 +208,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteAnswersByQuestionIDStmt: %w", cerr)
 		}
 	}
+	if q.deleteAttemptsByUserIDStmt != nil {
+		if cerr := q.deleteAttemptsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAttemptsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.deleteChallengeByIDStmt != nil {
 		if cerr := q.deleteChallengeByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteChallengeByIDStmt: %w", cerr)
@@ -236,6 +253,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getAskedQuestionsByEpisodeIDStmt: %w", cerr)
 		}
 	}
+	if q.getAttemptsByUserIDStmt != nil {
+		if cerr := q.getAttemptsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAttemptsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getChallengeByEpisodeIDStmt != nil {
 		if cerr := q.getChallengeByEpisodeIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getChallengeByEpisodeIDStmt: %w", cerr)
@@ -266,6 +288,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getChallengesStmt: %w", cerr)
 		}
 	}
+	if q.getEpisodeAccessByUserIDStmt != nil {
+		if cerr := q.getEpisodeAccessByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEpisodeAccessByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getEpisodeAccessDataStmt != nil {
 		if cerr := q.getEpisodeAccessDataStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getEpisodeAccessDataStmt: %w", cerr)
@@ -276,6 +303,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getEpisodeIDByQuestionIDStmt: %w", cerr)
 		}
 	}
+	if q.getPassedChallengesDataByUserIDStmt != nil {
+		if cerr := q.getPassedChallengesDataByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPassedChallengesDataByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getQuestionByIDStmt != nil {
 		if cerr := q.getQuestionByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getQuestionByIDStmt: %w", cerr)
@@ -381,6 +413,7 @@ type Queries struct {
 	countPassedChallengeAttemptsStmt             *sql.Stmt
 	deleteAnswerByIDStmt                         *sql.Stmt
 	deleteAnswersByQuestionIDStmt                *sql.Stmt
+	deleteAttemptsByUserIDStmt                   *sql.Stmt
 	deleteChallengeByIDStmt                      *sql.Stmt
 	deleteEpisodeAccessDataStmt                  *sql.Stmt
 	deleteQuestionByIDStmt                       *sql.Stmt
@@ -389,14 +422,17 @@ type Queries struct {
 	getAnswersByIDsStmt                          *sql.Stmt
 	getAnswersByQuestionIDStmt                   *sql.Stmt
 	getAskedQuestionsByEpisodeIDStmt             *sql.Stmt
+	getAttemptsByUserIDStmt                      *sql.Stmt
 	getChallengeByEpisodeIDStmt                  *sql.Stmt
 	getChallengeByIDStmt                         *sql.Stmt
 	getChallengeByTitleStmt                      *sql.Stmt
 	getChallengeReceivedRewardAmountStmt         *sql.Stmt
 	getChallengeReceivedRewardAmountByUserIDStmt *sql.Stmt
 	getChallengesStmt                            *sql.Stmt
+	getEpisodeAccessByUserIDStmt                 *sql.Stmt
 	getEpisodeAccessDataStmt                     *sql.Stmt
 	getEpisodeIDByQuestionIDStmt                 *sql.Stmt
+	getPassedChallengesDataByUserIDStmt          *sql.Stmt
 	getQuestionByIDStmt                          *sql.Stmt
 	getQuestionsByChallengeIDStmt                *sql.Stmt
 	getQuestionsByChallengeIDWithExceptionsStmt  *sql.Stmt
@@ -425,6 +461,7 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		countPassedChallengeAttemptsStmt:             q.countPassedChallengeAttemptsStmt,
 		deleteAnswerByIDStmt:                         q.deleteAnswerByIDStmt,
 		deleteAnswersByQuestionIDStmt:                q.deleteAnswersByQuestionIDStmt,
+		deleteAttemptsByUserIDStmt:                   q.deleteAttemptsByUserIDStmt,
 		deleteChallengeByIDStmt:                      q.deleteChallengeByIDStmt,
 		deleteEpisodeAccessDataStmt:                  q.deleteEpisodeAccessDataStmt,
 		deleteQuestionByIDStmt:                       q.deleteQuestionByIDStmt,
@@ -433,14 +470,17 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getAnswersByIDsStmt:                          q.getAnswersByIDsStmt,
 		getAnswersByQuestionIDStmt:                   q.getAnswersByQuestionIDStmt,
 		getAskedQuestionsByEpisodeIDStmt:             q.getAskedQuestionsByEpisodeIDStmt,
+		getAttemptsByUserIDStmt:                      q.getAttemptsByUserIDStmt,
 		getChallengeByEpisodeIDStmt:                  q.getChallengeByEpisodeIDStmt,
 		getChallengeByIDStmt:                         q.getChallengeByIDStmt,
 		getChallengeByTitleStmt:                      q.getChallengeByTitleStmt,
 		getChallengeReceivedRewardAmountStmt:         q.getChallengeReceivedRewardAmountStmt,
 		getChallengeReceivedRewardAmountByUserIDStmt: q.getChallengeReceivedRewardAmountByUserIDStmt,
 		getChallengesStmt:                            q.getChallengesStmt,
+		getEpisodeAccessByUserIDStmt:                 q.getEpisodeAccessByUserIDStmt,
 		getEpisodeAccessDataStmt:                     q.getEpisodeAccessDataStmt,
 		getEpisodeIDByQuestionIDStmt:                 q.getEpisodeIDByQuestionIDStmt,
+		getPassedChallengesDataByUserIDStmt:          q.getPassedChallengesDataByUserIDStmt,
 		getQuestionByIDStmt:                          q.getQuestionByIDStmt,
 		getQuestionsByChallengeIDStmt:                q.getQuestionsByChallengeIDStmt,
 		getQuestionsByChallengeIDWithExceptionsStmt:  q.getQuestionsByChallengeIDWithExceptionsStmt,
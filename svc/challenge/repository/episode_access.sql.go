@@ -74,6 +74,41 @@ func (q *Queries) DoesUserHaveAccessToEpisode(ctx context.Context, arg DoesUserH
 	return exists, err
 }
 
+const getEpisodeAccessByUserID = `-- name: GetEpisodeAccessByUserID :many
+SELECT episode_id, user_id, activated_at, activated_before
+FROM episode_access
+WHERE user_id = $1
+ORDER BY activated_at DESC
+`
+
+func (q *Queries) GetEpisodeAccessByUserID(ctx context.Context, userID uuid.UUID) ([]EpisodeAccess, error) {
+	rows, err := q.query(ctx, q.getEpisodeAccessByUserIDStmt, getEpisodeAccessByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EpisodeAccess
+	for rows.Next() {
+		var i EpisodeAccess
+		if err := rows.Scan(
+			&i.EpisodeID,
+			&i.UserID,
+			&i.ActivatedAt,
+			&i.ActivatedBefore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getEpisodeAccessData = `-- name: GetEpisodeAccessData :one
 SELECT episode_id, user_id, activated_at, activated_before
 FROM episode_access
@@ -62,6 +62,16 @@ func (q *Queries) CountAttempts(ctx context.Context, arg CountAttemptsParams) (i
 	return count, err
 }
 
+const deleteAttemptsByUserID = `-- name: DeleteAttemptsByUserID :exec
+DELETE FROM attempts
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteAttemptsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteAttemptsByUserIDStmt, deleteAttemptsByUserID, userID)
+	return err
+}
+
 const getAskedQuestionsByEpisodeID = `-- name: GetAskedQuestionsByEpisodeID :many
 SELECT question_id
 FROM attempts
@@ -97,6 +107,43 @@ func (q *Queries) GetAskedQuestionsByEpisodeID(ctx context.Context, arg GetAsked
 	return items, nil
 }
 
+const getAttemptsByUserID = `-- name: GetAttemptsByUserID :many
+SELECT user_id, episode_id, question_id, answer_id, valid, created_at
+FROM attempts
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetAttemptsByUserID(ctx context.Context, userID uuid.UUID) ([]Attempt, error) {
+	rows, err := q.query(ctx, q.getAttemptsByUserIDStmt, getAttemptsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Attempt
+	for rows.Next() {
+		var i Attempt
+		if err := rows.Scan(
+			&i.UserID,
+			&i.EpisodeID,
+			&i.QuestionID,
+			&i.AnswerID,
+			&i.Valid,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getEpisodeIDByQuestionID = `-- name: GetEpisodeIDByQuestionID :one
 SELECT episode_id
 FROM attempts
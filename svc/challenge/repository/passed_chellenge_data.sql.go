@@ -76,6 +76,39 @@ func (q *Queries) GetChallengeReceivedRewardAmountByUserID(ctx context.Context,
 	return reward_amount, err
 }
 
+const getPassedChallengesDataByUserID = `-- name: GetPassedChallengesDataByUserID :many
+SELECT user_id, challenge_id, reward_amount
+FROM passed_challenges_data
+WHERE user_id = $1
+`
+
+func (q *Queries) GetPassedChallengesDataByUserID(ctx context.Context, userID uuid.UUID) ([]PassedChallengesDatum, error) {
+	rows, err := q.query(ctx, q.getPassedChallengesDataByUserIDStmt, getPassedChallengesDataByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PassedChallengesDatum
+	for rows.Next() {
+		var i PassedChallengesDatum
+		if err := rows.Scan(
+			&i.UserID,
+			&i.ChallengeID,
+			&i.RewardAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const storeChallengeReceivedRewardAmount = `-- name: StoreChallengeReceivedRewardAmount :exec
 UPDATE passed_challenges_data
 SET reward_amount = $1
@@ -30,3 +30,8 @@ FROM episode_access
 WHERE user_id = $1 AND activated_before > NOW()
 ORDER BY activated_at DESC, activated_before DESC
     LIMIT $2 OFFSET $3;
+-- name: GetEpisodeAccessByUserID :many
+SELECT *
+FROM episode_access
+WHERE user_id = $1
+ORDER BY activated_at DESC;
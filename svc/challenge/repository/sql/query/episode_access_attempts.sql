@@ -20,3 +20,12 @@ WHERE user_id = $1 AND episode_id = $2 AND created_at > $3;
 UPDATE attempts
 SET answer_id = @answer_id, valid = @valid
 WHERE user_id = @user_id AND question_id = @question_id;
+-- name: GetAttemptsByUserID :many
+SELECT *
+FROM attempts
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: DeleteAttemptsByUserID :exec
+DELETE FROM attempts
+WHERE user_id = $1;
\ No newline at end of file
@@ -18,3 +18,7 @@ SELECT SUM(COALESCE(reward_amount, 0))::DOUBLE PRECISION
 FROM passed_challenges_data
 WHERE challenge_id = $1
 GROUP BY challenge_id;
+-- name: GetPassedChallengesDataByUserID :many
+SELECT *
+FROM passed_challenges_data
+WHERE user_id = $1;
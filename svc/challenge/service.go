@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/campaigns"
 	"github.com/SatorNetwork/sator-api/svc/challenge/repository"
+	"github.com/SatorNetwork/sator-api/svc/pricing"
 	showRepository "github.com/SatorNetwork/sator-api/svc/shows/repository"
 
 	"github.com/google/uuid"
@@ -38,11 +40,17 @@ type (
 		attemptsNumber       int64
 		activatedRealmPeriod time.Duration
 		chargeForUnlockFn    chargeForUnlockFunc
+		quoteFn              quoteFunc
+		payFn                payFunc
 		showRepo             showsRepository
 		disableVerification  bool
+		trackEventFn         trackEventFunc
 	}
 
 	chargeForUnlockFunc func(ctx context.Context, uid uuid.UUID, amount float64, info string) error
+	quoteFunc           func(ctx context.Context, uid uuid.UUID, productType, productRef string, listPriceSAO float64, asset string) (pricing.Quote, error)
+	payFunc             func(ctx context.Context, uid, quoteID uuid.UUID, productType, productRef string, listPriceSAO float64, info string) (pricing.Purchase, error)
+	trackEventFunc      func(ctx context.Context, userID uuid.UUID, event string)
 
 	// ServiceOption function
 	// interface to extend service via options
@@ -88,6 +96,7 @@ type (
 		DoesUserHaveAccessToEpisode(ctx context.Context, arg repository.DoesUserHaveAccessToEpisodeParams) (bool, error)
 		NumberUsersWhoHaveAccessToEpisode(ctx context.Context, episodeID uuid.UUID) (int32, error)
 		ListIDsAvailableUserEpisodes(ctx context.Context, arg repository.ListIDsAvailableUserEpisodesParams) ([]uuid.UUID, error)
+		GetEpisodeAccessByUserID(ctx context.Context, userID uuid.UUID) ([]repository.EpisodeAccess, error)
 
 		// Verification Question Attempts
 		AddAttempt(ctx context.Context, arg repository.AddAttemptParams) (repository.Attempt, error)
@@ -95,6 +104,8 @@ type (
 		CountAttempts(ctx context.Context, arg repository.CountAttemptsParams) (int64, error)
 		GetAskedQuestionsByEpisodeID(ctx context.Context, arg repository.GetAskedQuestionsByEpisodeIDParams) ([]uuid.UUID, error)
 		UpdateAttempt(ctx context.Context, arg repository.UpdateAttemptParams) error
+		GetAttemptsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.Attempt, error)
+		DeleteAttemptsByUserID(ctx context.Context, userID uuid.UUID) error
 
 		// Challenge Attempts
 		AddChallengeAttempt(ctx context.Context, arg repository.AddChallengeAttemptParams) (repository.PassedChallengesDatum, error)
@@ -102,6 +113,7 @@ type (
 		CountPassedChallengeAttempts(ctx context.Context, arg repository.CountPassedChallengeAttemptsParams) (int64, error)
 		GetChallengeReceivedRewardAmount(ctx context.Context, challengeID uuid.UUID) (float64, error)
 		GetChallengeReceivedRewardAmountByUserID(ctx context.Context, arg repository.GetChallengeReceivedRewardAmountByUserIDParams) (float64, error)
+		GetPassedChallengesDataByUserID(ctx context.Context, userID uuid.UUID) ([]repository.PassedChallengesDatum, error)
 	}
 
 	playURLGenerator func(challengeID uuid.UUID) string
@@ -390,6 +402,10 @@ func (s *Service) CheckVerificationQuestionAnswer(ctx context.Context, questionI
 		return false, fmt.Errorf("could not store episode access data: %w", err)
 	}
 
+	if s.trackEventFn != nil {
+		s.trackEventFn(ctx, userID, campaigns.EventEpisodeWatched)
+	}
+
 	return true, nil
 }
 
@@ -953,10 +969,75 @@ func (s *Service) UpdateAnswer(ctx context.Context, ao AnswerOption) error {
 	return nil
 }
 
+// unlockEpisodeOption returns access period and price in SAO for the given unlock option
+func unlockEpisodeOption(unlockOption string) (time.Duration, float64) {
+	switch unlockOption {
+	case "unlock_opt_2h":
+		return time.Hour * 2, 2
+	case "unlock_opt_24h":
+		return time.Hour * 24, 10
+	case "unlock_opt_week":
+		return time.Hour * 24 * 7, 50
+	}
+
+	return 0, 0
+}
+
+func episodeUnlockProductRef(episodeID uuid.UUID, unlockOption string) string {
+	return fmt.Sprintf("%s:%s", episodeID.String(), unlockOption)
+}
+
+// GetEpisodeUnlockQuote returns price quote to unlock episode realm with the given asset.
+func (s *Service) GetEpisodeUnlockQuote(ctx context.Context, userID, episodeID uuid.UUID, unlockOption, asset string) (pricing.Quote, error) {
+	if s.quoteFn == nil {
+		return pricing.Quote{}, fmt.Errorf("%w: payments in alternative assets are not supported", ErrInvalidParameter)
+	}
+
+	_, amount := unlockEpisodeOption(unlockOption)
+	if amount <= 0 {
+		return pricing.Quote{}, fmt.Errorf("%w: unknown unlock option: %s", ErrInvalidParameter, unlockOption)
+	}
+
+	return s.quoteFn(ctx, userID, pricing.ProductEpisodeUnlock, episodeUnlockProductRef(episodeID, unlockOption), amount, asset)
+}
+
 // UnlockEpisode ...
-func (s *Service) UnlockEpisode(ctx context.Context, userID, episodeID uuid.UUID, unlockOption string) error {
+func (s *Service) UnlockEpisode(ctx context.Context, userID, episodeID uuid.UUID, unlockOption string, quoteID uuid.UUID) error {
+	period, amount := unlockEpisodeOption(unlockOption)
+
+	if amount > 0 {
+		info := fmt.Sprintf("unlock episode realm: %s", episodeID.String())
+		if s.payFn != nil {
+			if _, err := s.payFn(
+				ctx, userID, quoteID,
+				pricing.ProductEpisodeUnlock, episodeUnlockProductRef(episodeID, unlockOption),
+				amount, info,
+			); err != nil {
+				return fmt.Errorf("could not unlock episode realm: %w", err)
+			}
+		} else if s.chargeForUnlockFn != nil {
+			if err := s.chargeForUnlockFn(ctx, userID, amount, info); err != nil {
+				return fmt.Errorf("could not unlock episode realm: %w", err)
+			}
+		}
+	}
+
+	return s.extendEpisodeAccess(ctx, userID, episodeID, period)
+}
+
+// GrantEpisodeAccess unlocks episode realm for free, e.g. as a promo code reward.
+func (s *Service) GrantEpisodeAccess(ctx context.Context, userID, episodeID uuid.UUID, unlockOption string) error {
+	period, _ := unlockEpisodeOption(unlockOption)
+	if period <= 0 {
+		return fmt.Errorf("%w: unknown unlock option: %s", ErrInvalidParameter, unlockOption)
+	}
+
+	return s.extendEpisodeAccess(ctx, userID, episodeID, period)
+}
+
+// extendEpisodeAccess adds the period to the user's current access to episode realm
+func (s *Service) extendEpisodeAccess(ctx context.Context, userID, episodeID uuid.UUID, period time.Duration) error {
 	activateBefore := time.Now()
-	var amount float64
 
 	if data, err := s.cr.GetEpisodeAccessData(ctx, repository.GetEpisodeAccessDataParams{
 		EpisodeID: episodeID,
@@ -964,27 +1045,7 @@ func (s *Service) UnlockEpisode(ctx context.Context, userID, episodeID uuid.UUID
 	}); err == nil && data.ActivatedBefore.Valid && data.ActivatedBefore.Time.After(time.Now()) {
 		activateBefore = data.ActivatedBefore.Time
 	}
-
-	switch unlockOption {
-	case "unlock_opt_2h":
-		activateBefore = activateBefore.Add(time.Hour * 2)
-		amount = 2
-	case "unlock_opt_24h":
-		activateBefore = activateBefore.Add(time.Hour * 24)
-		amount = 10
-	case "unlock_opt_week":
-		activateBefore = activateBefore.Add(time.Hour * 24 * 7)
-		amount = 50
-	}
-
-	if s.chargeForUnlockFn != nil && amount > 0 {
-		if err := s.chargeForUnlockFn(
-			ctx, userID, amount,
-			fmt.Sprintf("unlock episode realm: %s", episodeID.String()),
-		); err != nil {
-			return fmt.Errorf("could not unlock episode realm: %w", err)
-		}
-	}
+	activateBefore = activateBefore.Add(period)
 
 	if _, err := s.cr.AddEpisodeAccessData(ctx, repository.AddEpisodeAccessDataParams{
 		EpisodeID: episodeID,
@@ -25,6 +25,15 @@ func WithChargeForUnlockFunc(fn chargeForUnlockFunc) ServiceOption {
 	}
 }
 
+// WithPricingFunctions sets functions to quote and charge episode unlock
+// in SAO, SOL or USDC. It takes precedence over WithChargeForUnlockFunc.
+func WithPricingFunctions(quoteFn quoteFunc, payFn payFunc) ServiceOption {
+	return func(s *Service) {
+		s.quoteFn = quoteFn
+		s.payFn = payFn
+	}
+}
+
 // WithDisabledVerification ...
 // Disables verification.
 func WithDisabledVerification(disable bool) ServiceOption {
@@ -32,3 +41,11 @@ func WithDisabledVerification(disable bool) ServiceOption {
 		s.disableVerification = disable
 	}
 }
+
+// WithCampaignEventFunc ...
+// Reports watched episodes to reward campaigns.
+func WithCampaignEventFunc(fn trackEventFunc) ServiceOption {
+	return func(s *Service) {
+		s.trackEventFn = fn
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/svc/pricing"
 
 	"github.com/go-chi/chi/v5"
 	jwtkit "github.com/go-kit/kit/auth/jwt"
@@ -113,6 +114,13 @@ func MakeHTTPHandlerChallenges(e Endpoints, log logger) http.Handler {
 		options...,
 	).ServeHTTP)
 
+	r.Post("/unlock/{episode_id}/quote", httptransport.NewServer(
+		e.GetEpisodeUnlockQuote,
+		decodeGetEpisodeUnlockQuoteRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
 	return r
 }
 
@@ -230,7 +238,7 @@ func codeAndMessageFrom(err error) (int, interface{}) {
 		return http.StatusBadRequest, err.Error()
 	}
 
-	return httpencoder.CodeAndMessageFrom(err)
+	return pricing.CodeAndMessageFrom(err)
 }
 
 func decodeAddChallengeRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -395,6 +403,22 @@ func decodeUnlockEpisodeRequest(_ context.Context, r *http.Request) (interface{}
 	return req, nil
 }
 
+func decodeGetEpisodeUnlockQuoteRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	episodeID := chi.URLParam(r, "episode_id")
+	if episodeID == "" {
+		return nil, fmt.Errorf("%w: missed episode id", ErrInvalidParameter)
+	}
+
+	var req GetEpisodeUnlockQuoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	req.EpisodeID = episodeID
+
+	return req, nil
+}
+
 func encodeEpisodeAccessResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
 	if _, ok := response.(EpisodeAccess); !ok {
 		return httpencoder.EncodeResponse(ctx, w, response)
@@ -0,0 +1,34 @@
+package data_export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// EraseUserData removes the user's data export archives and requests on account deletion.
+// Audit log is kept without client ips.
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	requests, err := s.repo.GetDataExportRequestsByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("could not get data export requests: %w", err)
+	}
+
+	for _, req := range requests {
+		if req.FilePath.Valid {
+			if err := s.storage.Remove(req.FilePath.String); err != nil {
+				return fmt.Errorf("could not remove archive of data export request %s: %w", req.ID, err)
+			}
+		}
+	}
+
+	if err := s.repo.DeleteDataExportRequestsByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete data export requests: %w", err)
+	}
+	if err := s.repo.AnonymizeDataExportAuditLogByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not anonymize data export audit log: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package data_export
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+)
+
+type (
+	// Endpoints collection of data export service
+	Endpoints struct {
+		RequestExport endpoint.Endpoint
+		GetRequests   endpoint.Endpoint
+		Download      endpoint.Endpoint
+	}
+
+	service interface {
+		RequestExport(ctx context.Context, userID uuid.UUID) (Request, error)
+		GetRequests(ctx context.Context, userID uuid.UUID) ([]Request, error)
+		Download(ctx context.Context, token string) (io.ReadCloser, error)
+	}
+)
+
+// MakeEndpoints ...
+func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
+	e := Endpoints{
+		RequestExport: MakeRequestExportEndpoint(s),
+		GetRequests:   MakeGetRequestsEndpoint(s),
+		Download:      MakeDownloadEndpoint(s),
+	}
+
+	// setup middlewares for each endpoints
+	// except Download, which is authorized by the signed link sent to the user's email
+	if len(m) > 0 {
+		for _, mdw := range m {
+			e.RequestExport = mdw(e.RequestExport)
+			e.GetRequests = mdw(e.GetRequests)
+		}
+	}
+
+	return e
+}
+
+// MakeRequestExportEndpoint ...
+func MakeRequestExportEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		return s.RequestExport(ctx, uid)
+	}
+}
+
+// MakeGetRequestsEndpoint ...
+func MakeGetRequestsEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		return s.GetRequests(ctx, uid)
+	}
+}
+
+// MakeDownloadEndpoint ...
+func MakeDownloadEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return s.Download(ctx, request.(string))
+	}
+}
@@ -0,0 +1,13 @@
+package data_export
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrNotFound         = errors.New("not found")
+	ErrTooManyRequests  = errors.New("data export was requested recently, try again later")
+	ErrNoEmail          = errors.New("account has no email to send data export link to")
+	ErrInvalidLink      = errors.New("invalid data export link")
+	ErrExportExpired    = errors.New("data export link is expired")
+)
@@ -0,0 +1,25 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS data_export_requests (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'pending',
+    file_path VARCHAR DEFAULT NULL,
+    expires_at TIMESTAMP DEFAULT NULL,
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+CREATE INDEX data_export_requests_user_id_created_at ON data_export_requests USING BTREE (user_id, created_at);
+CREATE INDEX data_export_requests_status_created_at ON data_export_requests USING BTREE (status, created_at);
+CREATE TABLE IF NOT EXISTS data_export_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    request_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    action VARCHAR NOT NULL,
+    ip VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX data_export_audit_log_user_id ON data_export_audit_log USING BTREE (user_id);
+-- +migrate Down
+DROP TABLE IF EXISTS data_export_audit_log;
+DROP TABLE IF EXISTS data_export_requests;
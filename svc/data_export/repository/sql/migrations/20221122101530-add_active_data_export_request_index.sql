@@ -0,0 +1,5 @@
+-- +migrate Up
+CREATE UNIQUE INDEX data_export_requests_user_id_active ON data_export_requests USING BTREE (user_id)
+WHERE status IN ('pending', 'processing');
+-- +migrate Down
+DROP INDEX IF EXISTS data_export_requests_user_id_active;
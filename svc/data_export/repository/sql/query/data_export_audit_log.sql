@@ -0,0 +1,7 @@
+-- name: AddDataExportAuditLog :exec
+INSERT INTO data_export_audit_log (request_id, user_id, action, ip)
+VALUES (@request_id, @user_id, @action, @ip);
+-- name: AnonymizeDataExportAuditLogByUserID :exec
+UPDATE data_export_audit_log
+SET ip = ''
+WHERE user_id = @user_id;
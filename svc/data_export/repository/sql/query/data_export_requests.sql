@@ -0,0 +1,58 @@
+-- name: AddDataExportRequest :one
+INSERT INTO data_export_requests (user_id)
+VALUES (@user_id) RETURNING *;
+-- name: GetDataExportRequestByID :one
+SELECT *
+FROM data_export_requests
+WHERE id = @id;
+-- name: GetDataExportRequestsByUserID :many
+SELECT *
+FROM data_export_requests
+WHERE user_id = @user_id
+ORDER BY created_at DESC;
+-- name: GetLastDataExportRequestByUserID :one
+SELECT *
+FROM data_export_requests
+WHERE user_id = @user_id
+    AND status <> 'failed'
+ORDER BY created_at DESC
+LIMIT 1;
+-- name: ClaimDataExportRequest :one
+UPDATE data_export_requests
+SET status = 'processing',
+    updated_at = now()
+WHERE id = (
+    SELECT id
+    FROM data_export_requests
+    WHERE status = 'pending'
+        OR (status = 'processing' AND updated_at < @stale_before)
+    ORDER BY created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+) RETURNING *;
+-- name: CompleteDataExportRequest :exec
+UPDATE data_export_requests
+SET status = 'completed',
+    file_path = @file_path,
+    expires_at = @expires_at,
+    updated_at = now()
+WHERE id = @id;
+-- name: FailDataExportRequest :exec
+UPDATE data_export_requests
+SET status = 'failed',
+    updated_at = now()
+WHERE id = @id;
+-- name: GetExpiredDataExportRequests :many
+SELECT *
+FROM data_export_requests
+WHERE status = 'completed'
+    AND expires_at < now();
+-- name: ExpireDataExportRequest :exec
+UPDATE data_export_requests
+SET status = 'expired',
+    file_path = NULL,
+    updated_at = now()
+WHERE id = @id;
+-- name: DeleteDataExportRequestsByUserID :exec
+DELETE FROM data_export_requests
+WHERE user_id = @user_id;
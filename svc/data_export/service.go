@@ -0,0 +1,279 @@
+package data_export
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/signedtoken"
+	"github.com/SatorNetwork/sator-api/lib/storage"
+	"github.com/SatorNetwork/sator-api/svc/data_export/repository"
+	"github.com/SatorNetwork/sator-api/svc/files"
+)
+
+// Data export request statuses
+const (
+	StatusPending    = "pending"
+	StatusProcessing = "processing"
+	StatusCompleted  = "completed"
+	StatusFailed     = "failed"
+	StatusExpired    = "expired"
+)
+
+// Audit log actions
+const (
+	actionRequested  = "requested"
+	actionCompleted  = "completed"
+	actionFailed     = "failed"
+	actionEmailed    = "emailed"
+	actionDownloaded = "downloaded"
+	actionExpired    = "expired"
+)
+
+// Default data export settings
+const (
+	defaultLinkTTL           = 7 * 24 * time.Hour
+	defaultRequestInterval   = 24 * time.Hour
+	defaultProcessingTimeout = time.Hour
+)
+
+type (
+	// Service struct
+	Service struct {
+		repo       dataExportRepository
+		storage    *storage.Interactor
+		mail       mailer
+		ac         authClient
+		fc         filesClient
+		collectors []collector
+		config     Config
+	}
+
+	// Config struct
+	Config struct {
+		DownloadURL       string
+		TokenSecret       string        // used to sign download links
+		LinkTTL           time.Duration // archive is removed after this period since the export is completed
+		RequestInterval   time.Duration // min period between export requests of the same user
+		ProcessingTimeout time.Duration // export is restarted if it is processed longer, e.g. the worker crashed
+	}
+
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
+	// CollectFunc returns the user's data of a service, it's stored in the archive as JSON
+	CollectFunc func(ctx context.Context, userID uuid.UUID) (interface{}, error)
+
+	collector struct {
+		domain  string
+		collect CollectFunc
+	}
+
+	// Request struct
+	Request struct {
+		ID          uuid.UUID  `json:"id"`
+		Status      string     `json:"status"`
+		ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+		RequestedAt time.Time  `json:"requested_at"`
+	}
+
+	dataExportRepository interface {
+		AddDataExportRequest(ctx context.Context, userID uuid.UUID) (repository.DataExportRequest, error)
+		GetDataExportRequestByID(ctx context.Context, id uuid.UUID) (repository.DataExportRequest, error)
+		GetDataExportRequestsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.DataExportRequest, error)
+		GetLastDataExportRequestByUserID(ctx context.Context, userID uuid.UUID) (repository.DataExportRequest, error)
+		ClaimDataExportRequest(ctx context.Context, staleBefore sql.NullTime) (repository.DataExportRequest, error)
+		CompleteDataExportRequest(ctx context.Context, arg repository.CompleteDataExportRequestParams) error
+		FailDataExportRequest(ctx context.Context, id uuid.UUID) error
+		GetExpiredDataExportRequests(ctx context.Context) ([]repository.DataExportRequest, error)
+		ExpireDataExportRequest(ctx context.Context, id uuid.UUID) error
+		DeleteDataExportRequestsByUserID(ctx context.Context, userID uuid.UUID) error
+
+		AddDataExportAuditLog(ctx context.Context, arg repository.AddDataExportAuditLogParams) error
+		AnonymizeDataExportAuditLogByUserID(ctx context.Context, userID uuid.UUID) error
+	}
+
+	mailer interface {
+		SendDataExportLink(ctx context.Context, email, link string, expiresAt time.Time) error
+	}
+
+	authClient interface {
+		GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error)
+	}
+
+	filesClient interface {
+		GetFilesByUploader(ctx context.Context, userID uuid.UUID) ([]files.File, error)
+	}
+)
+
+// NewService is a factory function,
+// returns a new instance of the Service interface implementation.
+func NewService(repo dataExportRepository, st *storage.Interactor, m mailer, ac authClient, fc filesClient, config Config, opt ...ServiceOption) *Service {
+	if repo == nil {
+		log.Fatalln("data export repository is not set")
+	}
+	if st == nil {
+		log.Fatalln("storage interactor is not set")
+	}
+	if m == nil {
+		log.Fatalln("mail service is not set")
+	}
+	if ac == nil {
+		log.Fatalln("auth client is not set")
+	}
+	if fc == nil {
+		log.Fatalln("files client is not set")
+	}
+	if config.TokenSecret == "" {
+		log.Fatalln("data export token secret is not set")
+	}
+	if config.LinkTTL <= 0 {
+		config.LinkTTL = defaultLinkTTL
+	}
+	if config.RequestInterval <= 0 {
+		config.RequestInterval = defaultRequestInterval
+	}
+	if config.ProcessingTimeout <= 0 {
+		config.ProcessingTimeout = defaultProcessingTimeout
+	}
+
+	s := &Service{
+		repo:    repo,
+		storage: st,
+		mail:    m,
+		ac:      ac,
+		fc:      fc,
+		config:  config,
+	}
+
+	for _, fn := range opt {
+		fn(s)
+	}
+
+	return s
+}
+
+// WithCollector adds the user's data of the domain to the archive as "<domain>.json"
+func WithCollector(domain string, fn CollectFunc) ServiceOption {
+	return func(s *Service) {
+		s.collectors = append(s.collectors, collector{domain: domain, collect: fn})
+	}
+}
+
+// RequestExport schedules building of the archive with all the user's data.
+// The download link is sent to the user's email when the archive is ready.
+func (s *Service) RequestExport(ctx context.Context, userID uuid.UUID) (Request, error) {
+	email, err := s.ac.GetUserEmail(ctx, userID)
+	if err != nil {
+		return Request{}, fmt.Errorf("could not get user email: %w", err)
+	}
+	if email == "" {
+		return Request{}, ErrNoEmail
+	}
+
+	// failed requests are not counted, so the user can retry right away
+	last, err := s.repo.GetLastDataExportRequestByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return Request{}, fmt.Errorf("could not get last data export request: %w", err)
+	}
+	if err == nil && (isInProgress(last.Status) || last.CreatedAt.After(time.Now().Add(-s.config.RequestInterval))) {
+		return Request{}, ErrTooManyRequests
+	}
+
+	// concurrent requests are rejected by the unique index of active requests
+	req, err := s.repo.AddDataExportRequest(ctx, userID)
+	if err != nil {
+		if db.IsDuplicateError(err) {
+			return Request{}, ErrTooManyRequests
+		}
+		return Request{}, fmt.Errorf("could not add data export request: %w", err)
+	}
+
+	s.audit(ctx, req, actionRequested, clientIPFromContext(ctx))
+
+	return castToRequest(req), nil
+}
+
+// GetRequests returns list of data export requests of the user
+func (s *Service) GetRequests(ctx context.Context, userID uuid.UUID) ([]Request, error) {
+	requests, err := s.repo.GetDataExportRequestsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get data export requests: %w", err)
+	}
+
+	result := make([]Request, 0, len(requests))
+	for _, r := range requests {
+		result = append(result, castToRequest(r))
+	}
+
+	return result, nil
+}
+
+// Download returns the archive of the data export by the token of the download link.
+// The caller must close the returned reader.
+func (s *Service) Download(ctx context.Context, token string) (io.ReadCloser, error) {
+	requestID, ok := signedtoken.VerifyID(s.config.TokenSecret, downloadTokenPurpose, token)
+	if !ok {
+		return nil, ErrInvalidLink
+	}
+
+	req, err := s.repo.GetDataExportRequestByID(ctx, requestID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, ErrInvalidLink
+		}
+		return nil, fmt.Errorf("could not get data export request: %w", err)
+	}
+	if req.Status == StatusExpired || (req.ExpiresAt.Valid && !req.ExpiresAt.Time.After(time.Now())) {
+		return nil, ErrExportExpired
+	}
+	if req.Status != StatusCompleted || !req.FilePath.Valid {
+		return nil, ErrNotFound
+	}
+
+	body, _, err := s.storage.Download(req.FilePath.String)
+	if err != nil {
+		return nil, fmt.Errorf("could not download data export archive: %w", err)
+	}
+
+	s.audit(ctx, req, actionDownloaded, clientIPFromContext(ctx))
+
+	return body, nil
+}
+
+// audit stores the action with the data export request.
+// Audit failure doesn't break the export, so it's just logged.
+func (s *Service) audit(ctx context.Context, req repository.DataExportRequest, action, ip string) {
+	if err := s.repo.AddDataExportAuditLog(ctx, repository.AddDataExportAuditLogParams{
+		RequestID: req.ID,
+		UserID:    req.UserID,
+		Action:    action,
+		Ip:        ip,
+	}); err != nil {
+		log.Printf("data export: could not add audit log %s of request %s: %v", action, req.ID, err)
+	}
+}
+
+func isInProgress(status string) bool {
+	return status == StatusPending || status == StatusProcessing
+}
+
+func castToRequest(source repository.DataExportRequest) Request {
+	r := Request{
+		ID:          source.ID,
+		Status:      source.Status,
+		RequestedAt: source.CreatedAt,
+	}
+	if source.ExpiresAt.Valid {
+		r.ExpiresAt = &source.ExpiresAt.Time
+	}
+
+	return r
+}
@@ -0,0 +1,23 @@
+package data_export
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// downloadTokenPurpose is signed along with the export request id of download tokens
+const downloadTokenPurpose = "data_export"
+
+// downloadLink appends the download token to the base download URL
+func downloadLink(baseURL, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("could not parse data export download url: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,13 @@
+package data_export
+
+import "testing"
+
+func TestDownloadLink(t *testing.T) {
+	got, err := downloadLink("https://sator.io/data-export?lang=en", "abc.def")
+	if err != nil {
+		t.Fatalf("downloadLink() error = %v", err)
+	}
+	if want := "https://sator.io/data-export?lang=en&token=abc.def"; got != want {
+		t.Errorf("downloadLink() = %s, want %s", got, want)
+	}
+}
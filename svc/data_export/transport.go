@@ -0,0 +1,130 @@
+package data_export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	jwtkit "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+)
+
+type (
+	logger interface {
+		Log(keyvals ...interface{}) error
+	}
+
+	contextKey string
+)
+
+const clientIPContextKey contextKey = "ClientIP"
+
+// MakeHTTPHandler ...
+func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
+	r := chi.NewRouter()
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
+		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
+		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+		httptransport.ServerBefore(clientIPToContext()),
+	}
+
+	r.Post("/", httptransport.NewServer(
+		e.RequestExport,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/", httptransport.NewServer(
+		e.GetRequests,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/download", httptransport.NewServer(
+		e.Download,
+		decodeDownloadRequest,
+		encodeArchiveResponse("sator-data-export.zip"),
+		options...,
+	).ServeHTTP)
+
+	return r
+}
+
+func decodeEmptyRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodeDownloadRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		return nil, fmt.Errorf("%w: missed download token", ErrInvalidParameter)
+	}
+	return token, nil
+}
+
+// encodeArchiveResponse streams the archive returned by endpoint as a file attachment
+func encodeArchiveResponse(filename string) httptransport.EncodeResponseFunc {
+	return func(_ context.Context, w http.ResponseWriter, response interface{}) error {
+		body, ok := response.(io.ReadCloser)
+		if !ok {
+			return fmt.Errorf("unexpected archive response type %T", response)
+		}
+		defer body.Close()
+
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+
+		_, err := io.Copy(w, body)
+		return err
+	}
+}
+
+// clientIPToContext moves client ip from request to context for the audit log.
+// Remote address is set to the real client ip by the router middleware.
+func clientIPToContext() httptransport.RequestFunc {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		ip := r.RemoteAddr
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			ip = host
+		}
+		return context.WithValue(ctx, clientIPContextKey, ip)
+	}
+}
+
+func clientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPContextKey).(string)
+	return ip
+}
+
+// returns http error code by error type
+func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrInvalidParameter) || errors.Is(err, ErrNoEmail) {
+		return http.StatusBadRequest, err.Error()
+	}
+	if errors.Is(err, ErrInvalidLink) {
+		return http.StatusForbidden, err.Error()
+	}
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+	if errors.Is(err, ErrExportExpired) {
+		return http.StatusGone, err.Error()
+	}
+	if errors.Is(err, ErrTooManyRequests) {
+		return http.StatusTooManyRequests, err.Error()
+	}
+	return httpencoder.CodeAndMessageFrom(err)
+}
@@ -0,0 +1,245 @@
+package data_export
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/signedtoken"
+	"github.com/SatorNetwork/sator-api/lib/storage"
+	"github.com/SatorNetwork/sator-api/svc/data_export/repository"
+)
+
+// Run processes pending data export requests and removes expired archives
+// every interval until the context is canceled.
+func (s *Service) Run(ctx context.Context, interval time.Duration) error {
+	s.work(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.work(ctx)
+		}
+	}
+}
+
+func (s *Service) work(ctx context.Context) {
+	if err := s.removeExpired(ctx); err != nil {
+		log.Printf("data export: %v", err)
+	}
+
+	for ctx.Err() == nil {
+		req, err := s.repo.ClaimDataExportRequest(ctx, sql.NullTime{
+			Time:  time.Now().Add(-s.config.ProcessingTimeout),
+			Valid: true,
+		})
+		if err != nil {
+			if !db.IsNotFoundError(err) {
+				log.Printf("data export: could not claim request: %v", err)
+			}
+			return
+		}
+
+		if err := s.process(ctx, req); err != nil {
+			log.Printf("data export: request %s: %v", req.ID, err)
+			if err := s.repo.FailDataExportRequest(ctx, req.ID); err != nil {
+				log.Printf("data export: could not mark request %s as failed: %v", req.ID, err)
+			}
+			s.audit(ctx, req, actionFailed, "")
+		}
+	}
+}
+
+// process builds the archive of the request, stores it privately
+// and emails the download link to the user.
+func (s *Service) process(ctx context.Context, req repository.DataExportRequest) error {
+	email, err := s.ac.GetUserEmail(ctx, req.UserID)
+	if err != nil {
+		return fmt.Errorf("could not get user email: %w", err)
+	}
+	if email == "" {
+		return ErrNoEmail
+	}
+
+	// the archive is streamed to the storage while it's being built,
+	// so exports with many uploaded files are not kept in memory
+	pr, pw := io.Pipe()
+	built := make(chan error, 1)
+	go func() {
+		err := s.buildArchive(ctx, req.UserID, pw)
+		pw.CloseWithError(err)
+		built <- err
+	}()
+
+	filePath := s.storage.FilePath(fmt.Sprintf("data-export-%s.zip", req.ID))
+	if err := s.storage.UploadStream(pr, filePath, storage.Private, "application/zip"); err != nil {
+		// errors of building are passed to the upload through the pipe
+		pr.CloseWithError(err)
+		<-built
+		return fmt.Errorf("could not upload data export archive: %w", err)
+	}
+	if err := <-built; err != nil {
+		s.removeArchive(filePath)
+		return err
+	}
+
+	expiresAt := time.Now().Add(s.config.LinkTTL)
+	if err := s.repo.CompleteDataExportRequest(ctx, repository.CompleteDataExportRequestParams{
+		FilePath:  sql.NullString{String: filePath, Valid: true},
+		ExpiresAt: sql.NullTime{Time: expiresAt, Valid: true},
+		ID:        req.ID,
+	}); err != nil {
+		s.removeArchive(filePath)
+		return fmt.Errorf("could not complete data export request: %w", err)
+	}
+	s.audit(ctx, req, actionCompleted, "")
+
+	link, err := downloadLink(s.config.DownloadURL, signedtoken.NewID(s.config.TokenSecret, downloadTokenPurpose, req.ID))
+	if err != nil {
+		s.removeArchive(filePath)
+		return err
+	}
+	if err := s.mail.SendDataExportLink(ctx, email, link, expiresAt); err != nil {
+		s.removeArchive(filePath)
+		return fmt.Errorf("could not send data export link: %w", err)
+	}
+	s.audit(ctx, req, actionEmailed, "")
+
+	return nil
+}
+
+// buildArchive writes zip archive with JSON file per collector
+// and files uploaded by the user into the "files" directory.
+func (s *Service) buildArchive(ctx context.Context, userID uuid.UUID, w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	for _, c := range s.collectors {
+		data, err := c.collect(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("could not collect %s data: %w", c.domain, err)
+		}
+		if err := writeJSON(zw, c.domain+".json", data); err != nil {
+			return err
+		}
+	}
+
+	userFiles, err := s.fc.GetFilesByUploader(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("could not get uploaded files: %w", err)
+	}
+	if err := writeJSON(zw, "files.json", userFiles); err != nil {
+		return err
+	}
+	used := make(map[string]bool, len(userFiles))
+	for _, f := range userFiles {
+		if err := s.copyFile(zw, f.Filepath, archiveFileName(f.Filename, used)); err != nil {
+			return err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("could not close data export archive: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) copyFile(zw *zip.Writer, src, dst string) error {
+	body, _, err := s.storage.Download(src)
+	if err != nil {
+		return fmt.Errorf("could not download file %s: %w", src, err)
+	}
+	defer body.Close()
+
+	fw, err := zw.Create(dst)
+	if err != nil {
+		return fmt.Errorf("could not add %s to data export archive: %w", dst, err)
+	}
+	if _, err := io.Copy(fw, body); err != nil {
+		return fmt.Errorf("could not add %s to data export archive: %w", dst, err)
+	}
+
+	return nil
+}
+
+// archiveFileName returns a unique name of the uploaded file in the "files" directory of the archive.
+// Directories of the original name are dropped, so entries can't be extracted outside the directory,
+// and the same names get a numeric suffix, e.g. "photo (1).png".
+func archiveFileName(name string, used map[string]bool) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" || name == ".." {
+		name = "file"
+	}
+
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	result := "files/" + name
+	for n := 1; used[result]; n++ {
+		result = fmt.Sprintf("files/%s (%d)%s", base, n, ext)
+	}
+	used[result] = true
+
+	return result
+}
+
+func writeJSON(zw *zip.Writer, name string, data interface{}) error {
+	fw, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("could not add %s to data export archive: %w", name, err)
+	}
+
+	enc := json.NewEncoder(fw)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(data); err != nil {
+		return fmt.Errorf("could not encode %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// removeExpired removes archives of the data exports with expired download links
+func (s *Service) removeExpired(ctx context.Context) error {
+	requests, err := s.repo.GetExpiredDataExportRequests(ctx)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get expired data export requests: %w", err)
+	}
+
+	for _, req := range requests {
+		if req.FilePath.Valid {
+			if err := s.storage.Remove(req.FilePath.String); err != nil {
+				log.Printf("data export: could not remove archive of request %s: %v", req.ID, err)
+				continue
+			}
+		}
+		if err := s.repo.ExpireDataExportRequest(ctx, req.ID); err != nil {
+			return fmt.Errorf("could not expire data export request %s: %w", req.ID, err)
+		}
+		s.audit(ctx, req, actionExpired, "")
+	}
+
+	return nil
+}
+
+func (s *Service) removeArchive(filePath string) {
+	if err := s.storage.Remove(filePath); err != nil {
+		log.Printf("data export: could not remove archive %s: %v", filePath, err)
+	}
+}
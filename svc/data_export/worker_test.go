@@ -0,0 +1,27 @@
+package data_export
+
+import "testing"
+
+func TestArchiveFileName(t *testing.T) {
+	used := make(map[string]bool)
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "photo.png", want: "files/photo.png"},
+		{name: "photo.png", want: "files/photo (1).png"},
+		{name: "photo.png", want: "files/photo (2).png"},
+		{name: "../../etc/passwd", want: "files/passwd"},
+		{name: "/tmp/photo.png", want: "files/photo (3).png"},
+		{name: `..\..\avatar.jpg`, want: "files/avatar.jpg"},
+		{name: "..", want: "files/file"},
+		{name: "", want: "files/file (1)"},
+	}
+
+	for _, tt := range tests {
+		if got := archiveFileName(tt.name, used); got != tt.want {
+			t.Errorf("archiveFileName(%q) = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
@@ -18,6 +18,7 @@ const (
 	AssetTypeSOL AssetType = iota
 	AssetTypeSAO
 	AssetTypeAR
+	AssetTypeUSDC
 )
 
 func (a AssetType) CoingeckoAssetID() string {
@@ -28,6 +29,8 @@ func (a AssetType) CoingeckoAssetID() string {
 		return "sator"
 	case AssetTypeAR:
 		return "arweave"
+	case AssetTypeUSDC:
+		return "usd-coin"
 	default:
 		return ""
 	}
@@ -86,8 +89,9 @@ func (s *exchangeRatesServer) syncExchangeRates() error {
 	solID := AssetTypeSOL.CoingeckoAssetID()
 	saoID := AssetTypeSAO.CoingeckoAssetID()
 	arID := AssetTypeAR.CoingeckoAssetID()
+	usdcID := AssetTypeUSDC.CoingeckoAssetID()
 
-	ids := []string{solID, saoID, arID}
+	ids := []string{solID, saoID, arID, usdcID}
 	priceMap, err := s.coingecko.SimplePrice(ids, []string{"usd"})
 	if err != nil {
 		return errors.Wrap(err, "can't get price map from coingecko")
@@ -104,10 +108,14 @@ func (s *exchangeRatesServer) syncExchangeRates() error {
 	if _, ok := (*priceMap)[arID]; !ok {
 		return errors.Errorf("price map doesn't contain price for AR")
 	}
+	if _, ok := (*priceMap)[usdcID]; !ok {
+		return errors.Errorf("price map doesn't contain price for USDC")
+	}
 
 	solPrice := (*priceMap)[solID]["usd"]
 	saoPrice := (*priceMap)[saoID]["usd"]
 	arPrice := (*priceMap)[arID]["usd"]
+	usdcPrice := (*priceMap)[usdcID]["usd"]
 	if solPrice != 0 {
 		err := s.er.UpsertExchangeRate(context.Background(), exchange_rates_repository.UpsertExchangeRateParams{
 			AssetType: AssetTypeSOL.CoingeckoAssetID(),
@@ -135,6 +143,15 @@ func (s *exchangeRatesServer) syncExchangeRates() error {
 			return errors.Wrapf(err, "can't add exchange rate for %v", AssetTypeAR.CoingeckoAssetID())
 		}
 	}
+	if usdcPrice != 0 {
+		err := s.er.UpsertExchangeRate(context.Background(), exchange_rates_repository.UpsertExchangeRateParams{
+			AssetType: AssetTypeUSDC.CoingeckoAssetID(),
+			UsdPrice:  float64(usdcPrice),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "can't add exchange rate for %v", AssetTypeUSDC.CoingeckoAssetID())
+		}
+	}
 
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"io"
 	"mime/multipart"
 
+	"github.com/SatorNetwork/sator-api/lib/jwt"
 	"github.com/SatorNetwork/sator-api/lib/rbac"
 	"github.com/SatorNetwork/sator-api/lib/utils"
 	"github.com/SatorNetwork/sator-api/lib/validator"
@@ -106,8 +107,14 @@ func MakeAddImageResizeEndpoint(s service, v validator.ValidateFunc) endpoint.En
 			return nil, err
 		}
 
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
 		resp, err := s.AddImageResize(ctx, File{
-			Filename: req.FileHeader.Filename,
+			Filename:   req.FileHeader.Filename,
+			UploadedBy: uid,
 		}, req.File, req.FileHeader, req.MaxHeight, req.MaxWidth)
 		if err != nil {
 			return nil, err
@@ -129,8 +136,14 @@ func MakeAddFileEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint
 			return nil, err
 		}
 
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
 		resp, err := s.AddFile(ctx, File{
-			Filename: req.FileHeader.Filename,
+			Filename:   req.FileHeader.Filename,
+			UploadedBy: uid,
 		}, req.File, req.FileHeader)
 		if err != nil {
 			return nil, err
@@ -34,6 +34,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getFilesByIDsStmt, err = db.PrepareContext(ctx, getFilesByIDs); err != nil {
 		return nil, fmt.Errorf("error preparing query GetFilesByIDs: %w", err)
 	}
+	if q.getFilesByUploaderStmt, err = db.PrepareContext(ctx, getFilesByUploader); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFilesByUploader: %w", err)
+	}
+	if q.getFilesByUploaderAndURLStmt, err = db.PrepareContext(ctx, getFilesByUploaderAndURL); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFilesByUploaderAndURL: %w", err)
+	}
 	if q.getFilesListStmt, err = db.PrepareContext(ctx, getFilesList); err != nil {
 		return nil, fmt.Errorf("error preparing query GetFilesList: %w", err)
 	}
@@ -62,6 +68,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getFilesByIDsStmt: %w", cerr)
 		}
 	}
+	if q.getFilesByUploaderStmt != nil {
+		if cerr := q.getFilesByUploaderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFilesByUploaderStmt: %w", cerr)
+		}
+	}
+	if q.getFilesByUploaderAndURLStmt != nil {
+		if cerr := q.getFilesByUploaderAndURLStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFilesByUploaderAndURLStmt: %w", cerr)
+		}
+	}
 	if q.getFilesListStmt != nil {
 		if cerr := q.getFilesListStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getFilesListStmt: %w", cerr)
@@ -104,23 +120,27 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                 DBTX
-	tx                 *sql.Tx
-	addFileStmt        *sql.Stmt
-	deleteFileByIDStmt *sql.Stmt
-	getFileByIDStmt    *sql.Stmt
-	getFilesByIDsStmt  *sql.Stmt
-	getFilesListStmt   *sql.Stmt
+	db                           DBTX
+	tx                           *sql.Tx
+	addFileStmt                  *sql.Stmt
+	deleteFileByIDStmt           *sql.Stmt
+	getFileByIDStmt              *sql.Stmt
+	getFilesByIDsStmt            *sql.Stmt
+	getFilesByUploaderStmt       *sql.Stmt
+	getFilesByUploaderAndURLStmt *sql.Stmt
+	getFilesListStmt             *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                 tx,
-		tx:                 tx,
-		addFileStmt:        q.addFileStmt,
-		deleteFileByIDStmt: q.deleteFileByIDStmt,
-		getFileByIDStmt:    q.getFileByIDStmt,
-		getFilesByIDsStmt:  q.getFilesByIDsStmt,
-		getFilesListStmt:   q.getFilesListStmt,
+		db:                           tx,
+		tx:                           tx,
+		addFileStmt:                  q.addFileStmt,
+		deleteFileByIDStmt:           q.deleteFileByIDStmt,
+		getFileByIDStmt:              q.getFileByIDStmt,
+		getFilesByIDsStmt:            q.getFilesByIDsStmt,
+		getFilesByUploaderStmt:       q.getFilesByUploaderStmt,
+		getFilesByUploaderAndURLStmt: q.getFilesByUploaderAndURLStmt,
+		getFilesListStmt:             q.getFilesListStmt,
 	}
 }
@@ -11,16 +11,17 @@ import (
 )
 
 const addFile = `-- name: AddFile :one
-INSERT INTO files (id, file_name, file_path, file_url)
-VALUES ($1, $2, $3, $4)
-    RETURNING id, file_name, file_path, file_url, created_at
+INSERT INTO files (id, file_name, file_path, file_url, uploaded_by)
+VALUES ($1, $2, $3, $4, $5)
+    RETURNING id, file_name, file_path, file_url, created_at, uploaded_by
 `
 
 type AddFileParams struct {
-	ID       uuid.UUID `json:"id"`
-	FileName string    `json:"file_name"`
-	FilePath string    `json:"file_path"`
-	FileUrl  string    `json:"file_url"`
+	ID         uuid.UUID     `json:"id"`
+	FileName   string        `json:"file_name"`
+	FilePath   string        `json:"file_path"`
+	FileUrl    string        `json:"file_url"`
+	UploadedBy uuid.NullUUID `json:"uploaded_by"`
 }
 
 func (q *Queries) AddFile(ctx context.Context, arg AddFileParams) (File, error) {
@@ -29,6 +30,7 @@ func (q *Queries) AddFile(ctx context.Context, arg AddFileParams) (File, error)
 		arg.FileName,
 		arg.FilePath,
 		arg.FileUrl,
+		arg.UploadedBy,
 	)
 	var i File
 	err := row.Scan(
@@ -37,6 +39,7 @@ func (q *Queries) AddFile(ctx context.Context, arg AddFileParams) (File, error)
 		&i.FilePath,
 		&i.FileUrl,
 		&i.CreatedAt,
+		&i.UploadedBy,
 	)
 	return i, err
 }
@@ -52,7 +55,7 @@ func (q *Queries) DeleteFileByID(ctx context.Context, id uuid.UUID) error {
 }
 
 const getFileByID = `-- name: GetFileByID :one
-SELECT id, file_name, file_path, file_url, created_at FROM files
+SELECT id, file_name, file_path, file_url, created_at, uploaded_by FROM files
 WHERE id = $1
     LIMIT 1
 `
@@ -66,12 +69,13 @@ func (q *Queries) GetFileByID(ctx context.Context, id uuid.UUID) (File, error) {
 		&i.FilePath,
 		&i.FileUrl,
 		&i.CreatedAt,
+		&i.UploadedBy,
 	)
 	return i, err
 }
 
 const getFilesByIDs = `-- name: GetFilesByIDs :many
-SELECT id, file_name, file_path, file_url, created_at FROM files
+SELECT id, file_name, file_path, file_url, created_at, uploaded_by FROM files
 WHERE id = ANY($1::uuid[])
 ORDER BY created_at DESC
 `
@@ -91,6 +95,84 @@ func (q *Queries) GetFilesByIDs(ctx context.Context, ids []uuid.UUID) ([]File, e
 			&i.FilePath,
 			&i.FileUrl,
 			&i.CreatedAt,
+			&i.UploadedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFilesByUploader = `-- name: GetFilesByUploader :many
+SELECT id, file_name, file_path, file_url, created_at, uploaded_by FROM files
+WHERE uploaded_by = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetFilesByUploader(ctx context.Context, uploadedBy uuid.NullUUID) ([]File, error) {
+	rows, err := q.query(ctx, q.getFilesByUploaderStmt, getFilesByUploader, uploadedBy)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []File
+	for rows.Next() {
+		var i File
+		if err := rows.Scan(
+			&i.ID,
+			&i.FileName,
+			&i.FilePath,
+			&i.FileUrl,
+			&i.CreatedAt,
+			&i.UploadedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFilesByUploaderAndURL = `-- name: GetFilesByUploaderAndURL :many
+SELECT id, file_name, file_path, file_url, created_at, uploaded_by FROM files
+WHERE uploaded_by = $1
+    AND file_url = $2
+`
+
+type GetFilesByUploaderAndURLParams struct {
+	UploadedBy uuid.NullUUID `json:"uploaded_by"`
+	FileUrl    string        `json:"file_url"`
+}
+
+func (q *Queries) GetFilesByUploaderAndURL(ctx context.Context, arg GetFilesByUploaderAndURLParams) ([]File, error) {
+	rows, err := q.query(ctx, q.getFilesByUploaderAndURLStmt, getFilesByUploaderAndURL, arg.UploadedBy, arg.FileUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []File
+	for rows.Next() {
+		var i File
+		if err := rows.Scan(
+			&i.ID,
+			&i.FileName,
+			&i.FilePath,
+			&i.FileUrl,
+			&i.CreatedAt,
+			&i.UploadedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -106,7 +188,7 @@ func (q *Queries) GetFilesByIDs(ctx context.Context, ids []uuid.UUID) ([]File, e
 }
 
 const getFilesList = `-- name: GetFilesList :many
-SELECT id, file_name, file_path, file_url, created_at FROM files
+SELECT id, file_name, file_path, file_url, created_at, uploaded_by FROM files
 LIMIT $1 OFFSET $2
 `
 
@@ -130,6 +212,7 @@ func (q *Queries) GetFilesList(ctx context.Context, arg GetFilesListParams) ([]F
 			&i.FilePath,
 			&i.FileUrl,
 			&i.CreatedAt,
+			&i.UploadedBy,
 		); err != nil {
 			return nil, err
 		}
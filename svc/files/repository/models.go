@@ -9,9 +9,10 @@ import (
 )
 
 type File struct {
-	ID        uuid.UUID `json:"id"`
-	FileName  string    `json:"file_name"`
-	FilePath  string    `json:"file_path"`
-	FileUrl   string    `json:"file_url"`
-	CreatedAt time.Time `json:"created_at"`
+	ID         uuid.UUID     `json:"id"`
+	FileName   string        `json:"file_name"`
+	FilePath   string        `json:"file_path"`
+	FileUrl    string        `json:"file_url"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UploadedBy uuid.NullUUID `json:"uploaded_by"`
 }
@@ -0,0 +1,8 @@
+-- +migrate Up
+ALTER TABLE files
+    ADD COLUMN uploaded_by uuid DEFAULT NULL;
+CREATE INDEX files_uploaded_by ON files USING BTREE (uploaded_by);
+-- +migrate Down
+DROP INDEX IF EXISTS files_uploaded_by;
+ALTER TABLE files
+    DROP COLUMN IF EXISTS uploaded_by;
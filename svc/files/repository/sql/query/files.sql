@@ -12,9 +12,19 @@ ORDER BY created_at DESC;
 SELECT * FROM files
 LIMIT $1 OFFSET $2;
 
+-- name: GetFilesByUploader :many
+SELECT * FROM files
+WHERE uploaded_by = @uploaded_by
+ORDER BY created_at DESC;
+
+-- name: GetFilesByUploaderAndURL :many
+SELECT * FROM files
+WHERE uploaded_by = @uploaded_by
+    AND file_url = @file_url;
+
 -- name: AddFile :one
-INSERT INTO files (id, file_name, file_path, file_url)
-VALUES ($1, $2, $3, $4)
+INSERT INTO files (id, file_name, file_path, file_url, uploaded_by)
+VALUES ($1, $2, $3, $4, $5)
     RETURNING *;
 
 -- name: DeleteFileByID :exec
@@ -32,6 +32,9 @@ type (
 		Filepath  string    `json:"filepath"`
 		FileUrl   string    `json:"file_url"`
 		CreatedAt string    `json:"created_at"`
+
+		// UploadedBy is an id of the user uploaded the file, not exposed in API
+		UploadedBy uuid.UUID `json:"-"`
 	}
 
 	mediaServiceRepository interface {
@@ -39,6 +42,8 @@ type (
 		GetFileByID(ctx context.Context, id uuid.UUID) (repository.File, error)
 		GetFilesList(ctx context.Context, arg repository.GetFilesListParams) ([]repository.File, error)
 		GetFilesByIDs(ctx context.Context, ids []uuid.UUID) ([]repository.File, error)
+		GetFilesByUploader(ctx context.Context, uploadedBy uuid.NullUUID) ([]repository.File, error)
+		GetFilesByUploaderAndURL(ctx context.Context, arg repository.GetFilesByUploaderAndURLParams) ([]repository.File, error)
 		DeleteFileByID(ctx context.Context, id uuid.UUID) error
 	}
 )
@@ -77,6 +82,10 @@ func (s *Service) AddImageResize(ctx context.Context, it File, file multipart.Fi
 		FileName: fileHeader.Filename,
 		FilePath: filePath,
 		FileUrl:  s.storage.FileURL(filePath),
+		UploadedBy: uuid.NullUUID{
+			UUID:  it.UploadedBy,
+			Valid: it.UploadedBy != uuid.Nil,
+		},
 	})
 	if err != nil {
 		return File{}, fmt.Errorf("could not add image with file name=%s: %w", it.Filename, err)
@@ -101,6 +110,10 @@ func (s *Service) AddImage(ctx context.Context, it File, file io.ReadSeeker, fil
 		FileName: fileHeader.Filename,
 		FilePath: filePath,
 		FileUrl:  s.storage.FileURL(filePath),
+		UploadedBy: uuid.NullUUID{
+			UUID:  it.UploadedBy,
+			Valid: it.UploadedBy != uuid.Nil,
+		},
 	})
 	if err != nil {
 		return File{}, fmt.Errorf("could not add image with file name=%s: %w", it.Filename, err)
@@ -163,6 +176,40 @@ func (s *Service) GetImagesListByIDs(ctx context.Context, ids []uuid.UUID) ([]Fi
 	return castToListFiles(images), nil
 }
 
+// GetFilesByUploader returns files uploaded by the user.
+func (s *Service) GetFilesByUploader(ctx context.Context, userID uuid.UUID) ([]File, error) {
+	files, err := s.msr.GetFilesByUploader(ctx, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("could not get files uploaded by user with id=%s: %w", userID, err)
+	}
+
+	return castToListFiles(files), nil
+}
+
+// EraseUploadedFile removes the file with given url from the storage if it's uploaded by the user,
+// e.g. the user's avatar on account deletion.
+// Files uploaded by the user for other entities, e.g. show artwork uploaded by staff, are kept.
+func (s *Service) EraseUploadedFile(ctx context.Context, userID uuid.UUID, fileURL string) error {
+	files, err := s.msr.GetFilesByUploaderAndURL(ctx, repository.GetFilesByUploaderAndURLParams{
+		UploadedBy: uuid.NullUUID{UUID: userID, Valid: true},
+		FileUrl:    fileURL,
+	})
+	if err != nil {
+		return fmt.Errorf("could not get files uploaded by user with id=%s: %w", userID, err)
+	}
+
+	for _, f := range files {
+		if err := s.storage.Remove(f.FilePath); err != nil {
+			return errors.Wrapf(err, "could not delete file %s from storage", f.ID)
+		}
+		if err := s.msr.DeleteFileByID(ctx, f.ID); err != nil {
+			return fmt.Errorf("could not delete file with id=%s: %w", f.ID, err)
+		}
+	}
+
+	return nil
+}
+
 // AddFile used to create new file.
 func (s *Service) AddFile(ctx context.Context, it File, file []byte, fileHeader *multipart.FileHeader) (File, error) {
 	id := uuid.New()
@@ -181,6 +228,10 @@ func (s *Service) AddFile(ctx context.Context, it File, file []byte, fileHeader
 		FileName: fileHeader.Filename,
 		FilePath: filePath,
 		FileUrl:  s.storage.FileURL(filePath),
+		UploadedBy: uuid.NullUUID{
+			UUID:  it.UploadedBy,
+			Valid: it.UploadedBy != uuid.Nil,
+		},
 	})
 	if err != nil {
 		return File{}, fmt.Errorf("could not add image with file name=%s: %w", it.Filename, err)
@@ -192,11 +243,12 @@ func (s *Service) AddFile(ctx context.Context, it File, file []byte, fileHeader
 // Cast repository.File to service File structure
 func castToFile(source repository.File) File {
 	return File{
-		ID:        source.ID,
-		Filename:  source.FileName,
-		Filepath:  source.FilePath,
-		FileUrl:   source.FileUrl,
-		CreatedAt: source.CreatedAt.String(),
+		ID:         source.ID,
+		Filename:   source.FileName,
+		Filepath:   source.FilePath,
+		FileUrl:    source.FileUrl,
+		CreatedAt:  source.CreatedAt.String(),
+		UploadedBy: source.UploadedBy.UUID,
 	}
 }
 
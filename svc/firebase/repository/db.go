@@ -22,9 +22,15 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.deleteDisabledNotificationsByUserIDStmt, err = db.PrepareContext(ctx, deleteDisabledNotificationsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteDisabledNotificationsByUserID: %w", err)
+	}
 	if q.deleteRegistrationTokenStmt, err = db.PrepareContext(ctx, deleteRegistrationToken); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteRegistrationToken: %w", err)
 	}
+	if q.deleteRegistrationTokensByUserIDStmt, err = db.PrepareContext(ctx, deleteRegistrationTokensByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRegistrationTokensByUserID: %w", err)
+	}
 	if q.disableNotificationStmt, err = db.PrepareContext(ctx, disableNotification); err != nil {
 		return nil, fmt.Errorf("error preparing query DisableNotification: %w", err)
 	}
@@ -48,11 +54,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 
 func (q *Queries) Close() error {
 	var err error
+	if q.deleteDisabledNotificationsByUserIDStmt != nil {
+		if cerr := q.deleteDisabledNotificationsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteDisabledNotificationsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.deleteRegistrationTokenStmt != nil {
 		if cerr := q.deleteRegistrationTokenStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteRegistrationTokenStmt: %w", cerr)
 		}
 	}
+	if q.deleteRegistrationTokensByUserIDStmt != nil {
+		if cerr := q.deleteRegistrationTokensByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRegistrationTokensByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.disableNotificationStmt != nil {
 		if cerr := q.disableNotificationStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing disableNotificationStmt: %w", cerr)
@@ -120,27 +136,31 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                          DBTX
-	tx                          *sql.Tx
-	deleteRegistrationTokenStmt *sql.Stmt
-	disableNotificationStmt     *sql.Stmt
-	enableNotificationStmt      *sql.Stmt
-	getRegistrationTokenStmt    *sql.Stmt
-	isNotificationDisabledStmt  *sql.Stmt
-	isNotificationEnabledStmt   *sql.Stmt
-	upsertRegistrationTokenStmt *sql.Stmt
+	db                                      DBTX
+	tx                                      *sql.Tx
+	deleteDisabledNotificationsByUserIDStmt *sql.Stmt
+	deleteRegistrationTokenStmt             *sql.Stmt
+	deleteRegistrationTokensByUserIDStmt    *sql.Stmt
+	disableNotificationStmt                 *sql.Stmt
+	enableNotificationStmt                  *sql.Stmt
+	getRegistrationTokenStmt                *sql.Stmt
+	isNotificationDisabledStmt              *sql.Stmt
+	isNotificationEnabledStmt               *sql.Stmt
+	upsertRegistrationTokenStmt             *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                          tx,
-		tx:                          tx,
-		deleteRegistrationTokenStmt: q.deleteRegistrationTokenStmt,
-		disableNotificationStmt:     q.disableNotificationStmt,
-		enableNotificationStmt:      q.enableNotificationStmt,
-		getRegistrationTokenStmt:    q.getRegistrationTokenStmt,
-		isNotificationDisabledStmt:  q.isNotificationDisabledStmt,
-		isNotificationEnabledStmt:   q.isNotificationEnabledStmt,
-		upsertRegistrationTokenStmt: q.upsertRegistrationTokenStmt,
+		db:                                      tx,
+		tx:                                      tx,
+		deleteDisabledNotificationsByUserIDStmt: q.deleteDisabledNotificationsByUserIDStmt,
+		deleteRegistrationTokenStmt:             q.deleteRegistrationTokenStmt,
+		deleteRegistrationTokensByUserIDStmt:    q.deleteRegistrationTokensByUserIDStmt,
+		disableNotificationStmt:                 q.disableNotificationStmt,
+		enableNotificationStmt:                  q.enableNotificationStmt,
+		getRegistrationTokenStmt:                q.getRegistrationTokenStmt,
+		isNotificationDisabledStmt:              q.isNotificationDisabledStmt,
+		isNotificationEnabledStmt:               q.isNotificationEnabledStmt,
+		upsertRegistrationTokenStmt:             q.upsertRegistrationTokenStmt,
 	}
 }
@@ -9,6 +9,16 @@ import (
 	"github.com/google/uuid"
 )
 
+const deleteDisabledNotificationsByUserID = `-- name: DeleteDisabledNotificationsByUserID :exec
+DELETE FROM disabled_notifications
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteDisabledNotificationsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteDisabledNotificationsByUserIDStmt, deleteDisabledNotificationsByUserID, userID)
+	return err
+}
+
 const disableNotification = `-- name: DisableNotification :exec
 INSERT INTO disabled_notifications (
     user_id,
@@ -24,6 +24,16 @@ func (q *Queries) DeleteRegistrationToken(ctx context.Context, arg DeleteRegistr
 	return err
 }
 
+const deleteRegistrationTokensByUserID = `-- name: DeleteRegistrationTokensByUserID :exec
+DELETE FROM firebase_registration_tokens
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRegistrationTokensByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteRegistrationTokensByUserIDStmt, deleteRegistrationTokensByUserID, userID)
+	return err
+}
+
 const getRegistrationToken = `-- name: GetRegistrationToken :one
 SELECT device_id, user_id, registration_token, updated_at, created_at FROM firebase_registration_tokens
 WHERE device_id = $1 AND user_id = $2
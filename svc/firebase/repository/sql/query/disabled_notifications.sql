@@ -22,4 +22,8 @@ SELECT (NOT EXISTS(
 SELECT EXISTS(
     SELECT * FROM disabled_notifications
     WHERE user_id = @user_id AND topic = @topic
-);
\ No newline at end of file
+);
+
+-- name: DeleteDisabledNotificationsByUserID :exec
+DELETE FROM disabled_notifications
+WHERE user_id = @user_id;
\ No newline at end of file
@@ -21,3 +21,8 @@ WHERE device_id = @device_id AND user_id = @user_id;
 -- name: DeleteRegistrationToken :exec
 DELETE FROM firebase_registration_tokens
 WHERE device_id = @device_id AND user_id = @user_id;
+
+
+-- name: DeleteRegistrationTokensByUserID :exec
+DELETE FROM firebase_registration_tokens
+WHERE user_id = @user_id;
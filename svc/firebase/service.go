@@ -31,9 +31,11 @@ type (
 			arg firebase_repository.UpsertRegistrationTokenParams,
 		) error
 		DeleteRegistrationToken(ctx context.Context, arg firebase_repository.DeleteRegistrationTokenParams) error
+		DeleteRegistrationTokensByUserID(ctx context.Context, userID uuid.UUID) error
 
 		IsNotificationEnabled(ctx context.Context, arg firebase_repository.IsNotificationEnabledParams) (bool, error)
 		IsNotificationDisabled(ctx context.Context, arg firebase_repository.IsNotificationDisabledParams) (bool, error)
+		DeleteDisabledNotificationsByUserID(ctx context.Context, userID uuid.UUID) error
 	}
 
 	Empty struct{}
@@ -115,6 +117,19 @@ func (s *Service) UnregisterToken(ctx context.Context, userID uuid.UUID, deviceI
 	return nil
 }
 
+// EraseUserData unlinks the user's devices from push notifications
+// and deletes notification settings on account deletion.
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	if err := s.fr.DeleteRegistrationTokensByUserID(ctx, userID); err != nil {
+		return errors.Wrap(err, "can't delete registration tokens")
+	}
+	if err := s.fr.DeleteDisabledNotificationsByUserID(ctx, userID); err != nil {
+		return errors.Wrap(err, "can't delete notification settings")
+	}
+
+	return nil
+}
+
 func (s *Service) getUserSubscribedTopics(ctx context.Context, userID uuid.UUID) ([]string, error) {
 	userSubscribedTopics := make([]string, 0)
 	for _, topic := range allTopics {
@@ -0,0 +1,123 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+)
+
+type (
+	// GameData is the in-game data of the user included into the data export archive
+	GameData struct {
+		Player  *ExportedPlayer      `json:"player,omitempty"`
+		NFTs    []NFTInfo            `json:"nfts"`
+		Games   []ExportedGame       `json:"games"`
+		Rewards []ExportedGameReward `json:"rewards"`
+	}
+
+	// ExportedPlayer struct
+	ExportedPlayer struct {
+		EnergyPoints        int32     `json:"energy_points"`
+		SelectedNftID       string    `json:"selected_nft_id,omitempty"`
+		SelectedCharacterID string    `json:"selected_character_id,omitempty"`
+		ElectricitySpent    int32     `json:"electricity_spent"`
+		ElectricityCosts    float64   `json:"electricity_costs"`
+		CreatedAt           time.Time `json:"created_at"`
+	}
+
+	// ExportedGame struct
+	ExportedGame struct {
+		ID               uuid.UUID  `json:"id"`
+		NFTID            string     `json:"nft_id"`
+		Complexity       int32      `json:"complexity"`
+		IsTraining       bool       `json:"is_training"`
+		BlocksDone       int32      `json:"blocks_done"`
+		Result           string     `json:"result,omitempty"`
+		ElectricityCosts float64    `json:"electricity_costs"`
+		StartedAt        time.Time  `json:"started_at"`
+		FinishedAt       *time.Time `json:"finished_at,omitempty"`
+	}
+
+	// ExportedGameReward struct
+	ExportedGameReward struct {
+		ID        uuid.UUID `json:"id"`
+		Operation string    `json:"operation"`
+		Amount    float64   `json:"amount"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+)
+
+// ExportUserData returns player's profile, NFTs, played games and in-game rewards history
+// of the user for the data export archive.
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	data := GameData{
+		Games:   []ExportedGame{},
+		Rewards: []ExportedGameReward{},
+	}
+
+	player, err := s.gameRepo.GetPlayer(ctx, userID)
+	if err != nil {
+		if !db.IsNotFoundError(err) {
+			return nil, fmt.Errorf("could not get player: %w", err)
+		}
+	} else {
+		data.Player = &ExportedPlayer{
+			EnergyPoints:        player.EnergyPoints,
+			SelectedNftID:       player.SelectedNftID.String,
+			SelectedCharacterID: player.SelectedCharacterID.String,
+			ElectricitySpent:    player.ElectricitySpent,
+			ElectricityCosts:    player.ElectricityCosts,
+			CreatedAt:           player.CreatedAt,
+		}
+	}
+
+	if data.NFTs, err = s.GetUserNFTs(ctx, userID); err != nil {
+		return nil, fmt.Errorf("could not get user nfts: %w", err)
+	}
+
+	games, err := s.gameRepo.GetGameResultsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get game results: %w", err)
+	}
+	for _, g := range games {
+		eg := ExportedGame{
+			ID:               g.ID,
+			NFTID:            g.NFTID,
+			Complexity:       g.Complexity,
+			IsTraining:       g.IsTraining,
+			BlocksDone:       g.BlocksDone,
+			ElectricityCosts: g.ElectricityCosts,
+			StartedAt:        g.CreatedAt,
+		}
+		if g.Result.Valid {
+			eg.Result = GameResult(g.Result.Int32).String()
+		}
+		if g.FinishedAt.Valid {
+			eg.FinishedAt = &g.FinishedAt.Time
+		}
+		data.Games = append(data.Games, eg)
+	}
+
+	rewards, err := s.gameRepo.GetUserRewardsHistory(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get rewards history: %w", err)
+	}
+	for _, r := range rewards {
+		operation := "deposit"
+		if r.OperationType == 2 {
+			operation = "withdraw"
+		}
+		data.Rewards = append(data.Rewards, ExportedGameReward{
+			ID:        r.ID,
+			Operation: operation,
+			Amount:    r.Amount,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+
+	return data, nil
+}
@@ -27,8 +27,11 @@ type (
 		minRewardsToClaim    float64
 		craftStepAmount      float64
 		electricityMaxGames  int32
+		recordScoreFn        recordScoreFunc
 	}
 
+	recordScoreFunc func(ctx context.Context, userID uuid.UUID, points float64, wins int32, showID, challengeID uuid.UUID)
+
 	ServiceOption func(*Service)
 
 	gameRepository interface {
@@ -37,6 +40,7 @@ type (
 		StartGame(ctx context.Context, arg repository.StartGameParams) error
 		GetCurrentGame(ctx context.Context, userID uuid.UUID) (repository.UnityGameResult, error)
 		FinishGame(ctx context.Context, arg repository.FinishGameParams) error
+		GetGameResultsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.UnityGameResult, error)
 		SpendEnergyOfPlayer(ctx context.Context, userID uuid.UUID) error
 
 		GetNFTPacksList(ctx context.Context) ([]repository.UnityGameNftPack, error)
@@ -58,6 +62,7 @@ type (
 		RewardsWithdraw(ctx context.Context, arg repository.RewardsWithdrawParams) error
 		GetUserRewardsDeposited(ctx context.Context, userID uuid.UUID) (float64, error)
 		GetUserRewardsWithdrawn(ctx context.Context, userID uuid.UUID) (float64, error)
+		GetUserRewardsHistory(ctx context.Context, userID uuid.UUID) ([]repository.UnityGameReward, error)
 	}
 
 	configer interface {
@@ -279,6 +284,15 @@ func (s *Service) GetNFTPacks(ctx context.Context, uid uuid.UUID) ([]NFTPackInfo
 
 // BuyNFTPack ...
 func (s *Service) BuyNFTPack(ctx context.Context, uid, packID uuid.UUID) (*NFTInfo, error) {
+	return s.openNFTPack(ctx, uid, packID, true)
+}
+
+// GrantNFTPack opens NFT pack for the user for free, e.g. as a promo code reward.
+func (s *Service) GrantNFTPack(ctx context.Context, uid, packID uuid.UUID) (*NFTInfo, error) {
+	return s.openNFTPack(ctx, uid, packID, false)
+}
+
+func (s *Service) openNFTPack(ctx context.Context, uid, packID uuid.UUID, charge bool) (*NFTInfo, error) {
 	tx, err := s.db.Begin()
 	if err != nil {
 		return nil, fmt.Errorf("failed to start transaction: %w", err)
@@ -314,7 +328,7 @@ func (s *Service) BuyNFTPack(ctx context.Context, uid, packID uuid.UUID) (*NFTIn
 		return nil, fmt.Errorf("failed to store selected nft: %w", err)
 	}
 
-	if pack.Price > 0 {
+	if charge && pack.Price > 0 {
 		userBalance, _ := s.GetUserBalance(ctx, uid)
 		if userBalance < pack.Price {
 			return nil, ErrInsufficientBalance
@@ -591,7 +605,15 @@ func (s *Service) FinishGame(ctx context.Context, uid uuid.UUID, result, blocksD
 		return 0, fmt.Errorf("failed to take the energy of player: %w", err)
 	}
 
-	return viewers, tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	if s.recordScoreFn != nil && !currentGame.IsTraining && rewardsAmount > 0 {
+		s.recordScoreFn(ctx, uid, rewardsAmount, 1, uuid.Nil, uuid.Nil)
+	}
+
+	return viewers, nil
 }
 
 // GetMinAmountToClaim ...
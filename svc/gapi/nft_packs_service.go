@@ -11,9 +11,14 @@ import (
 
 type (
 	NFTPackService struct {
-		repo nftPacksRepository
+		repo  nftPacksRepository
+		audit auditFunc
 	}
 
+	// NFTPackServiceOption function
+	// interface to extend nft pack service via options
+	NFTPackServiceOption func(*NFTPackService)
+
 	nftPacksRepository interface {
 		AddNFTPack(ctx context.Context, arg repository.AddNFTPackParams) (repository.UnityGameNftPack, error)
 		DeleteNFTPack(ctx context.Context, id uuid.UUID) error
@@ -25,8 +30,21 @@ type (
 )
 
 // NewNFTPackService creates a new nft pack service
-func NewNFTPackService(repo nftPacksRepository) *NFTPackService {
-	return &NFTPackService{repo: repo}
+func NewNFTPackService(repo nftPacksRepository, opt ...NFTPackServiceOption) *NFTPackService {
+	s := &NFTPackService{repo: repo}
+
+	for _, fn := range opt {
+		fn(s)
+	}
+
+	return s
+}
+
+// WithNFTPackAuditFunc records changes of the nft packs to the audit log
+func WithNFTPackAuditFunc(fn auditFunc) NFTPackServiceOption {
+	return func(s *NFTPackService) {
+		s.audit = fn
+	}
 }
 
 // AddNFTPack adds a new nft pack
@@ -40,20 +58,35 @@ func (s *NFTPackService) AddNFTPack(ctx context.Context, name string, price floa
 		return nil, fmt.Errorf("failed to create new nft pack: %w", err)
 	}
 
-	return &NFTPackInfo{
+	result := &NFTPackInfo{
 		ID:          nftPack.ID.String(),
 		Name:        nftPack.Name,
 		DropChances: dropChances,
 		Price:       nftPack.Price,
-	}, nil
+	}
+	if s.audit != nil {
+		if err := s.audit(ctx, "nft_pack.create", "nft_pack", result.ID, nil, result); err != nil {
+			return nil, fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return result, nil
 }
 
 // DeleteNFTPack deletes a nft pack
 func (s *NFTPackService) DeleteNFTPack(ctx context.Context, id uuid.UUID) error {
+	before := s.nftPackForAudit(ctx, id)
+
 	if err := s.repo.DeleteNFTPack(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete nft pack: %w", err)
 	}
 
+	if s.audit != nil {
+		if err := s.audit(ctx, "nft_pack.delete", "nft_pack", id.String(), before, nil); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -104,15 +137,25 @@ func (s *NFTPackService) GetNFTPacksList(ctx context.Context) ([]NFTPackInfo, er
 
 // SoftDeleteNFTPack soft deletes a nft pack
 func (s *NFTPackService) SoftDeleteNFTPack(ctx context.Context, id uuid.UUID) error {
+	before := s.nftPackForAudit(ctx, id)
+
 	if err := s.repo.SoftDeleteNFTPack(ctx, id); err != nil {
 		return fmt.Errorf("failed to soft delete nft pack: %w", err)
 	}
 
+	if s.audit != nil {
+		if err := s.audit(ctx, "nft_pack.delete", "nft_pack", id.String(), before, nil); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return nil
 }
 
 // UpdateNFTPack updates a nft pack
 func (s *NFTPackService) UpdateNFTPack(ctx context.Context, id uuid.UUID, name string, price float64, dropChances DropChances) (*NFTPackInfo, error) {
+	before := s.nftPackForAudit(ctx, id)
+
 	nftPack, err := s.repo.UpdateNFTPack(ctx, repository.UpdateNFTPackParams{
 		ID:          id,
 		Name:        name,
@@ -123,10 +166,32 @@ func (s *NFTPackService) UpdateNFTPack(ctx context.Context, id uuid.UUID, name s
 		return nil, fmt.Errorf("failed to update nft pack: %w", err)
 	}
 
-	return &NFTPackInfo{
+	result := &NFTPackInfo{
 		ID:          nftPack.ID.String(),
 		Name:        nftPack.Name,
 		DropChances: dropChances,
 		Price:       nftPack.Price,
-	}, nil
+	}
+	if s.audit != nil {
+		if err := s.audit(ctx, "nft_pack.update", "nft_pack", result.ID, before, result); err != nil {
+			return nil, fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// nftPackForAudit returns current state of the nft pack to be stored in the audit log,
+// nil if the audit log is not set or the nft pack is not found.
+func (s *NFTPackService) nftPackForAudit(ctx context.Context, id uuid.UUID) interface{} {
+	if s.audit == nil {
+		return nil
+	}
+
+	nftPack, err := s.GetNFTPack(ctx, id)
+	if err != nil {
+		return nil
+	}
+
+	return nftPack
 }
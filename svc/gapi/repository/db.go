@@ -57,6 +57,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getCurrentGameStmt, err = db.PrepareContext(ctx, getCurrentGame); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCurrentGame: %w", err)
 	}
+	if q.getGameResultsByUserIDStmt, err = db.PrepareContext(ctx, getGameResultsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetGameResultsByUserID: %w", err)
+	}
 	if q.getNFTStmt, err = db.PrepareContext(ctx, getNFT); err != nil {
 		return nil, fmt.Errorf("error preparing query GetNFT: %w", err)
 	}
@@ -90,6 +93,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getUserRewardsDepositedStmt, err = db.PrepareContext(ctx, getUserRewardsDeposited); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserRewardsDeposited: %w", err)
 	}
+	if q.getUserRewardsHistoryStmt, err = db.PrepareContext(ctx, getUserRewardsHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserRewardsHistory: %w", err)
+	}
 	if q.getUserRewardsWithdrawnStmt, err = db.PrepareContext(ctx, getUserRewardsWithdrawn); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserRewardsWithdrawn: %w", err)
 	}
@@ -189,6 +195,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getCurrentGameStmt: %w", cerr)
 		}
 	}
+	if q.getGameResultsByUserIDStmt != nil {
+		if cerr := q.getGameResultsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getGameResultsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getNFTStmt != nil {
 		if cerr := q.getNFTStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getNFTStmt: %w", cerr)
@@ -244,6 +255,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserRewardsDepositedStmt: %w", cerr)
 		}
 	}
+	if q.getUserRewardsHistoryStmt != nil {
+		if cerr := q.getUserRewardsHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserRewardsHistoryStmt: %w", cerr)
+		}
+	}
 	if q.getUserRewardsWithdrawnStmt != nil {
 		if cerr := q.getUserRewardsWithdrawnStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserRewardsWithdrawnStmt: %w", cerr)
@@ -359,6 +375,7 @@ type Queries struct {
 	deleteSettingStmt                 *sql.Stmt
 	finishGameStmt                    *sql.Stmt
 	getCurrentGameStmt                *sql.Stmt
+	getGameResultsByUserIDStmt        *sql.Stmt
 	getNFTStmt                        *sql.Stmt
 	getNFTPackStmt                    *sql.Stmt
 	getNFTPacksListStmt               *sql.Stmt
@@ -370,6 +387,7 @@ type Queries struct {
 	getUserNFTsStmt                   *sql.Stmt
 	getUserRewardsStmt                *sql.Stmt
 	getUserRewardsDepositedStmt       *sql.Stmt
+	getUserRewardsHistoryStmt         *sql.Stmt
 	getUserRewardsWithdrawnStmt       *sql.Stmt
 	refillEnergyOfPlayerStmt          *sql.Stmt
 	resetElectricityForPlayerStmt     *sql.Stmt
@@ -400,6 +418,7 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		deleteSettingStmt:                 q.deleteSettingStmt,
 		finishGameStmt:                    q.finishGameStmt,
 		getCurrentGameStmt:                q.getCurrentGameStmt,
+		getGameResultsByUserIDStmt:        q.getGameResultsByUserIDStmt,
 		getNFTStmt:                        q.getNFTStmt,
 		getNFTPackStmt:                    q.getNFTPackStmt,
 		getNFTPacksListStmt:               q.getNFTPacksListStmt,
@@ -411,6 +430,7 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getUserNFTsStmt:                   q.getUserNFTsStmt,
 		getUserRewardsStmt:                q.getUserRewardsStmt,
 		getUserRewardsDepositedStmt:       q.getUserRewardsDepositedStmt,
+		getUserRewardsHistoryStmt:         q.getUserRewardsHistoryStmt,
 		getUserRewardsWithdrawnStmt:       q.getUserRewardsWithdrawnStmt,
 		refillEnergyOfPlayerStmt:          q.refillEnergyOfPlayerStmt,
 		resetElectricityForPlayerStmt:     q.resetElectricityForPlayerStmt,
@@ -63,6 +63,47 @@ func (q *Queries) GetCurrentGame(ctx context.Context, userID uuid.UUID) (UnityGa
 	return i, err
 }
 
+const getGameResultsByUserID = `-- name: GetGameResultsByUserID :many
+SELECT id, user_id, nft_id, complexity, is_training, blocks_done, finished_at, updated_at, created_at, result, electricity_costs FROM unity_game_results
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetGameResultsByUserID(ctx context.Context, userID uuid.UUID) ([]UnityGameResult, error) {
+	rows, err := q.query(ctx, q.getGameResultsByUserIDStmt, getGameResultsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UnityGameResult
+	for rows.Next() {
+		var i UnityGameResult
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.NFTID,
+			&i.Complexity,
+			&i.IsTraining,
+			&i.BlocksDone,
+			&i.FinishedAt,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.Result,
+			&i.ElectricityCosts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const startGame = `-- name: StartGame :exec
 INSERT INTO unity_game_results (
     user_id,
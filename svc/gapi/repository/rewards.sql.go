@@ -51,6 +51,43 @@ func (q *Queries) GetUserRewardsDeposited(ctx context.Context, userID uuid.UUID)
 	return total_reward_amount, err
 }
 
+const getUserRewardsHistory = `-- name: GetUserRewardsHistory :many
+SELECT id, user_id, relation_id, operation_type, amount, created_at
+FROM unity_game_rewards
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetUserRewardsHistory(ctx context.Context, userID uuid.UUID) ([]UnityGameReward, error) {
+	rows, err := q.query(ctx, q.getUserRewardsHistoryStmt, getUserRewardsHistory, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UnityGameReward
+	for rows.Next() {
+		var i UnityGameReward
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.RelationID,
+			&i.OperationType,
+			&i.Amount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserRewardsWithdrawn = `-- name: GetUserRewardsWithdrawn :one
 SELECT SUM(amount)::DOUBLE PRECISION AS total_reward_amount
 FROM unity_game_rewards
@@ -14,6 +14,11 @@ UPDATE unity_game_results SET
     finished_at = now()
 WHERE id = $4;
 
+-- name: GetGameResultsByUserID :many
+SELECT * FROM unity_game_results
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
 -- name: GetCurrentGame :one
 SELECT * FROM unity_game_results 
 WHERE user_id = $1 AND finished_at IS NULL 
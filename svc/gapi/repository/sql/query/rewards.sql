@@ -16,6 +16,12 @@ LEFT JOIN deposited ON unity_game_players.user_id = deposited.user_id
 LEFT JOIN withdrawn ON unity_game_players.user_id = withdrawn.user_id
 WHERE unity_game_players.user_id = @user_id;
 
+-- name: GetUserRewardsHistory :many
+SELECT *
+FROM unity_game_rewards
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
 -- name: GetUserRewardsDeposited :one
 SELECT SUM(amount)::DOUBLE PRECISION AS total_reward_amount
 FROM unity_game_rewards
@@ -10,3 +10,10 @@ func WithDB(db *sql.DB) ServiceOption {
 		s.db = db
 	}
 }
+
+// WithLeaderboardFunc adds results of finished games to leaderboards
+func WithLeaderboardFunc(fn recordScoreFunc) ServiceOption {
+	return func(s *Service) {
+		s.recordScoreFn = fn
+	}
+}
@@ -13,9 +13,16 @@ import (
 type (
 	// SettingsService handles communication with the settings related
 	SettingsService struct {
-		repo settingsRepository
+		repo  settingsRepository
+		audit auditFunc
 	}
 
+	// SettingsServiceOption function
+	// interface to extend settings service via options
+	SettingsServiceOption func(*SettingsService)
+
+	auditFunc func(ctx context.Context, action, entityType, entityID string, before, after interface{}) error
+
 	// settingsRepository interface abstracts the settings storage
 	settingsRepository interface {
 		AddSetting(ctx context.Context, arg repository.AddSettingParams) (repository.UnityGameSetting, error)
@@ -41,8 +48,21 @@ type (
 )
 
 // NewSettingsService creates a new settings service
-func NewSettingsService(repo settingsRepository) *SettingsService {
-	return &SettingsService{repo}
+func NewSettingsService(repo settingsRepository, opt ...SettingsServiceOption) *SettingsService {
+	s := &SettingsService{repo: repo}
+
+	for _, fn := range opt {
+		fn(s)
+	}
+
+	return s
+}
+
+// WithSettingsAuditFunc records changes of the settings to the audit log
+func WithSettingsAuditFunc(fn auditFunc) SettingsServiceOption {
+	return func(s *SettingsService) {
+		s.audit = fn
+	}
 }
 
 // SettingsValueTypes returns the supported settings value types
@@ -75,7 +95,14 @@ func (s *SettingsService) Add(ctx context.Context, key, name, valueType string,
 		return Settings{}, fmt.Errorf("failed to add setting: %w", err)
 	}
 
-	return castUnityGameSettingToSetting(res), nil
+	setting := castUnityGameSettingToSetting(res)
+	if s.audit != nil {
+		if err := s.audit(ctx, "game_setting.create", "game_setting", setting.Key, nil, setting); err != nil {
+			return Settings{}, fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return setting, nil
 }
 
 // Get function returns the setting by key
@@ -105,6 +132,8 @@ func (s *SettingsService) GetAll(ctx context.Context) []Settings {
 
 // UpdateSetting updates the setting by key
 func (s *SettingsService) Update(ctx context.Context, key string, value interface{}) (Settings, error) {
+	before := s.settingForAudit(ctx, key)
+
 	res, err := s.repo.UpdateSetting(ctx, repository.UpdateSettingParams{
 		Key:   key,
 		Value: settingsValueToString(value),
@@ -113,12 +142,46 @@ func (s *SettingsService) Update(ctx context.Context, key string, value interfac
 		return Settings{}, fmt.Errorf("failed to update setting: %w", err)
 	}
 
-	return castUnityGameSettingToSetting(res), nil
+	setting := castUnityGameSettingToSetting(res)
+	if s.audit != nil {
+		if err := s.audit(ctx, "game_setting.update", "game_setting", key, before, setting); err != nil {
+			return Settings{}, fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return setting, nil
 }
 
 // Delete deletes the setting by key
 func (s *SettingsService) Delete(ctx context.Context, key string) error {
-	return s.repo.DeleteSetting(ctx, key)
+	before := s.settingForAudit(ctx, key)
+
+	if err := s.repo.DeleteSetting(ctx, key); err != nil {
+		return err
+	}
+
+	if s.audit != nil {
+		if err := s.audit(ctx, "game_setting.delete", "game_setting", key, before, nil); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// settingForAudit returns current state of the setting to be stored in the audit log,
+// nil if the audit log is not set or the setting is not found.
+func (s *SettingsService) settingForAudit(ctx context.Context, key string) interface{} {
+	if s.audit == nil {
+		return nil
+	}
+
+	setting, err := s.repo.GetSettingByKey(ctx, key)
+	if err != nil {
+		return nil
+	}
+
+	return castUnityGameSettingToSetting(setting)
 }
 
 // GetInt32 returns the setting value
@@ -0,0 +1,22 @@
+package invitations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// EraseUserData deletes not accepted invitations sent by the user with invitees' emails
+// and removes the user's email from the invitation the user accepted on account deletion.
+// Accepted invitations are kept to account invitation rewards.
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	if err := s.ir.DeleteNotAcceptedInvitationsByInviterID(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete not accepted invitations: %w", err)
+	}
+	if err := s.ir.AnonymizeInvitationByInviteeID(ctx, userID); err != nil {
+		return fmt.Errorf("could not anonymize accepted invitation: %w", err)
+	}
+
+	return nil
+}
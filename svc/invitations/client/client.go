@@ -16,6 +16,7 @@ type (
 
 	service interface {
 		AcceptInvitation(ctx context.Context, inviteeID uuid.UUID, inviteeEmail string) error
+		AcceptInvitationByToken(ctx context.Context, inviteeID uuid.UUID, token string) error
 		GetInvitations(ctx context.Context) ([]invitations.Invitation, error)
 		IsEmailInvited(ctx context.Context, inviteeEmail string) (bool, error)
 	}
@@ -46,6 +47,11 @@ func (c *Client) AcceptInvitation(ctx context.Context, inviteeID uuid.UUID, invi
 	return nil
 }
 
+// AcceptInvitationByToken used to accept invitation from the invitation link.
+func (c *Client) AcceptInvitationByToken(ctx context.Context, inviteeID uuid.UUID, token string) error {
+	return c.s.AcceptInvitationByToken(ctx, inviteeID, token)
+}
+
 // IsEmailInvited returns true if email invited, false if not.
 func (c *Client) IsEmailInvited(ctx context.Context, email string) (bool, error) {
 	resp, err := c.s.IsEmailInvited(ctx, email)
@@ -0,0 +1,39 @@
+package invitations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+)
+
+// InvitationsData is the invitations sent and accepted by the user included into the data export archive
+type InvitationsData struct {
+	Sent     []Invitation `json:"sent"`
+	Accepted *Invitation  `json:"accepted,omitempty"`
+}
+
+// ExportUserData returns invitations sent by the user and the invitation accepted by the user
+// for the data export archive.
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	sent, err := s.GetInvitationsByInviterID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := InvitationsData{Sent: sent}
+
+	accepted, err := s.ir.GetInvitationByInviteeID(ctx, userID)
+	if err != nil {
+		if !db.IsNotFoundError(err) {
+			return nil, fmt.Errorf("could not get accepted invitation: %w", err)
+		}
+	} else {
+		inv := castToInvitation(accepted)
+		data.Accepted = &inv
+	}
+
+	return data, nil
+}
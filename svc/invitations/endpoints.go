@@ -16,11 +16,21 @@ import (
 type (
 	// Endpoints collection of profile service
 	Endpoints struct {
-		SendInvitation endpoint.Endpoint
+		SendInvitation   endpoint.Endpoint
+		ResendInvitation endpoint.Endpoint
+		RevokeInvitation endpoint.Endpoint
+		OpenInvitation   endpoint.Endpoint
+		GetMyInvitations endpoint.Endpoint
+		GetStats         endpoint.Endpoint
 	}
 
 	service interface {
 		SendInvitation(ctx context.Context, invitedByID uuid.UUID, invitedByUsername, inviteeEmail string) error
+		ResendInvitation(ctx context.Context, invitedByID, invitationID uuid.UUID, invitedByUsername string) error
+		RevokeInvitation(ctx context.Context, invitedByID, invitationID uuid.UUID) error
+		OpenInvitation(ctx context.Context, token string) (Invitation, error)
+		GetInvitationsByInviterID(ctx context.Context, invitedByID uuid.UUID) ([]Invitation, error)
+		GetStats(ctx context.Context, invitedByID uuid.UUID) (Stats, error)
 	}
 
 	// SendInvitationRequest struct
@@ -33,13 +43,23 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 	validateFunc := validator.ValidateStruct()
 
 	e := Endpoints{
-		SendInvitation: MakeSendInvitationEndpoint(s, validateFunc),
+		SendInvitation:   MakeSendInvitationEndpoint(s, validateFunc),
+		ResendInvitation: MakeResendInvitationEndpoint(s),
+		RevokeInvitation: MakeRevokeInvitationEndpoint(s),
+		OpenInvitation:   MakeOpenInvitationEndpoint(s),
+		GetMyInvitations: MakeGetMyInvitationsEndpoint(s),
+		GetStats:         MakeGetStatsEndpoint(s),
 	}
 
 	// setup middlewares for each endpoints
+	// except OpenInvitation, which is called by not signed up users
 	if len(m) > 0 {
 		for _, mdw := range m {
 			e.SendInvitation = mdw(e.SendInvitation)
+			e.ResendInvitation = mdw(e.ResendInvitation)
+			e.RevokeInvitation = mdw(e.RevokeInvitation)
+			e.GetMyInvitations = mdw(e.GetMyInvitations)
+			e.GetStats = mdw(e.GetStats)
 		}
 	}
 
@@ -78,3 +98,112 @@ func MakeSendInvitationEndpoint(s service, v validator.ValidateFunc) endpoint.En
 		return true, nil
 	}
 }
+
+// MakeResendInvitationEndpoint ...
+func MakeResendInvitationEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w invitation id: %v", ErrInvalidParameter, err)
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+		username, err := jwt.UsernameFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get username: %w", err)
+		}
+
+		if err := s.ResendInvitation(ctx, uid, id, username); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeRevokeInvitationEndpoint ...
+func MakeRevokeInvitationEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w invitation id: %v", ErrInvalidParameter, err)
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		if err := s.RevokeInvitation(ctx, uid, id); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeOpenInvitationEndpoint is available without authorization,
+// since it's called from the invitation link before sign up.
+func MakeOpenInvitationEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		resp, err := s.OpenInvitation(ctx, request.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetMyInvitationsEndpoint ...
+func MakeGetMyInvitationsEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		resp, err := s.GetInvitationsByInviterID(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetStatsEndpoint ...
+func MakeGetStatsEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		resp, err := s.GetStats(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
@@ -4,5 +4,11 @@ import "errors"
 
 // Predefined package errors
 var (
-	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrInvalidParameter   = errors.New("invalid parameter")
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyInvited     = errors.New("email is already invited")
+	ErrAlreadyAccepted    = errors.New("invitation is already accepted")
+	ErrInvitationExpired  = errors.New("invitation is expired")
+	ErrInvitationRevoked  = errors.New("invitation is revoked")
+	ErrResendLimitReached = errors.New("invitation resend limit is reached")
 )
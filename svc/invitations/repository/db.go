@@ -25,15 +25,27 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.acceptInvitationByInviteeEmailStmt, err = db.PrepareContext(ctx, acceptInvitationByInviteeEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query AcceptInvitationByInviteeEmail: %w", err)
 	}
+	if q.anonymizeInvitationByInviteeIDStmt, err = db.PrepareContext(ctx, anonymizeInvitationByInviteeID); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymizeInvitationByInviteeID: %w", err)
+	}
 	if q.createInvitationStmt, err = db.PrepareContext(ctx, createInvitation); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateInvitation: %w", err)
 	}
+	if q.deleteNotAcceptedInvitationsByInviterIDStmt, err = db.PrepareContext(ctx, deleteNotAcceptedInvitationsByInviterID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteNotAcceptedInvitationsByInviterID: %w", err)
+	}
+	if q.getInvitationByIDStmt, err = db.PrepareContext(ctx, getInvitationByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetInvitationByID: %w", err)
+	}
 	if q.getInvitationByInviteeEmailStmt, err = db.PrepareContext(ctx, getInvitationByInviteeEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query GetInvitationByInviteeEmail: %w", err)
 	}
 	if q.getInvitationByInviteeIDStmt, err = db.PrepareContext(ctx, getInvitationByInviteeID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetInvitationByInviteeID: %w", err)
 	}
+	if q.getInvitationByTokenStmt, err = db.PrepareContext(ctx, getInvitationByToken); err != nil {
+		return nil, fmt.Errorf("error preparing query GetInvitationByToken: %w", err)
+	}
 	if q.getInvitationsStmt, err = db.PrepareContext(ctx, getInvitations); err != nil {
 		return nil, fmt.Errorf("error preparing query GetInvitations: %w", err)
 	}
@@ -43,6 +55,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getInvitationsPaginatedStmt, err = db.PrepareContext(ctx, getInvitationsPaginated); err != nil {
 		return nil, fmt.Errorf("error preparing query GetInvitationsPaginated: %w", err)
 	}
+	if q.getInvitationsStatsByInviterIDStmt, err = db.PrepareContext(ctx, getInvitationsStatsByInviterID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetInvitationsStatsByInviterID: %w", err)
+	}
+	if q.markInvitationOpenedStmt, err = db.PrepareContext(ctx, markInvitationOpened); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkInvitationOpened: %w", err)
+	}
+	if q.resendInvitationStmt, err = db.PrepareContext(ctx, resendInvitation); err != nil {
+		return nil, fmt.Errorf("error preparing query ResendInvitation: %w", err)
+	}
+	if q.revokeInvitationStmt, err = db.PrepareContext(ctx, revokeInvitation); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeInvitation: %w", err)
+	}
 	if q.setRewardReceivedStmt, err = db.PrepareContext(ctx, setRewardReceived); err != nil {
 		return nil, fmt.Errorf("error preparing query SetRewardReceived: %w", err)
 	}
@@ -56,11 +80,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing acceptInvitationByInviteeEmailStmt: %w", cerr)
 		}
 	}
+	if q.anonymizeInvitationByInviteeIDStmt != nil {
+		if cerr := q.anonymizeInvitationByInviteeIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymizeInvitationByInviteeIDStmt: %w", cerr)
+		}
+	}
 	if q.createInvitationStmt != nil {
 		if cerr := q.createInvitationStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createInvitationStmt: %w", cerr)
 		}
 	}
+	if q.deleteNotAcceptedInvitationsByInviterIDStmt != nil {
+		if cerr := q.deleteNotAcceptedInvitationsByInviterIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteNotAcceptedInvitationsByInviterIDStmt: %w", cerr)
+		}
+	}
+	if q.getInvitationByIDStmt != nil {
+		if cerr := q.getInvitationByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getInvitationByIDStmt: %w", cerr)
+		}
+	}
 	if q.getInvitationByInviteeEmailStmt != nil {
 		if cerr := q.getInvitationByInviteeEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getInvitationByInviteeEmailStmt: %w", cerr)
@@ -71,6 +110,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getInvitationByInviteeIDStmt: %w", cerr)
 		}
 	}
+	if q.getInvitationByTokenStmt != nil {
+		if cerr := q.getInvitationByTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getInvitationByTokenStmt: %w", cerr)
+		}
+	}
 	if q.getInvitationsStmt != nil {
 		if cerr := q.getInvitationsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getInvitationsStmt: %w", cerr)
@@ -86,6 +130,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getInvitationsPaginatedStmt: %w", cerr)
 		}
 	}
+	if q.getInvitationsStatsByInviterIDStmt != nil {
+		if cerr := q.getInvitationsStatsByInviterIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getInvitationsStatsByInviterIDStmt: %w", cerr)
+		}
+	}
+	if q.markInvitationOpenedStmt != nil {
+		if cerr := q.markInvitationOpenedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markInvitationOpenedStmt: %w", cerr)
+		}
+	}
+	if q.resendInvitationStmt != nil {
+		if cerr := q.resendInvitationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing resendInvitationStmt: %w", cerr)
+		}
+	}
+	if q.revokeInvitationStmt != nil {
+		if cerr := q.revokeInvitationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeInvitationStmt: %w", cerr)
+		}
+	}
 	if q.setRewardReceivedStmt != nil {
 		if cerr := q.setRewardReceivedStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setRewardReceivedStmt: %w", cerr)
@@ -128,16 +192,24 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                                 DBTX
-	tx                                 *sql.Tx
-	acceptInvitationByInviteeEmailStmt *sql.Stmt
-	createInvitationStmt               *sql.Stmt
-	getInvitationByInviteeEmailStmt    *sql.Stmt
-	getInvitationByInviteeIDStmt       *sql.Stmt
-	getInvitationsStmt                 *sql.Stmt
-	getInvitationsByInviterIDStmt      *sql.Stmt
-	getInvitationsPaginatedStmt        *sql.Stmt
-	setRewardReceivedStmt              *sql.Stmt
+	db                                          DBTX
+	tx                                          *sql.Tx
+	acceptInvitationByInviteeEmailStmt          *sql.Stmt
+	anonymizeInvitationByInviteeIDStmt          *sql.Stmt
+	createInvitationStmt                        *sql.Stmt
+	deleteNotAcceptedInvitationsByInviterIDStmt *sql.Stmt
+	getInvitationByIDStmt                       *sql.Stmt
+	getInvitationByInviteeEmailStmt             *sql.Stmt
+	getInvitationByInviteeIDStmt                *sql.Stmt
+	getInvitationByTokenStmt                    *sql.Stmt
+	getInvitationsStmt                          *sql.Stmt
+	getInvitationsByInviterIDStmt               *sql.Stmt
+	getInvitationsPaginatedStmt                 *sql.Stmt
+	getInvitationsStatsByInviterIDStmt          *sql.Stmt
+	markInvitationOpenedStmt                    *sql.Stmt
+	resendInvitationStmt                        *sql.Stmt
+	revokeInvitationStmt                        *sql.Stmt
+	setRewardReceivedStmt                       *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
@@ -145,12 +217,20 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		db:                                 tx,
 		tx:                                 tx,
 		acceptInvitationByInviteeEmailStmt: q.acceptInvitationByInviteeEmailStmt,
+		anonymizeInvitationByInviteeIDStmt: q.anonymizeInvitationByInviteeIDStmt,
 		createInvitationStmt:               q.createInvitationStmt,
-		getInvitationByInviteeEmailStmt:    q.getInvitationByInviteeEmailStmt,
-		getInvitationByInviteeIDStmt:       q.getInvitationByInviteeIDStmt,
-		getInvitationsStmt:                 q.getInvitationsStmt,
-		getInvitationsByInviterIDStmt:      q.getInvitationsByInviterIDStmt,
-		getInvitationsPaginatedStmt:        q.getInvitationsPaginatedStmt,
-		setRewardReceivedStmt:              q.setRewardReceivedStmt,
+		deleteNotAcceptedInvitationsByInviterIDStmt: q.deleteNotAcceptedInvitationsByInviterIDStmt,
+		getInvitationByIDStmt:                       q.getInvitationByIDStmt,
+		getInvitationByInviteeEmailStmt:             q.getInvitationByInviteeEmailStmt,
+		getInvitationByInviteeIDStmt:                q.getInvitationByInviteeIDStmt,
+		getInvitationByTokenStmt:                    q.getInvitationByTokenStmt,
+		getInvitationsStmt:                          q.getInvitationsStmt,
+		getInvitationsByInviterIDStmt:               q.getInvitationsByInviterIDStmt,
+		getInvitationsPaginatedStmt:                 q.getInvitationsPaginatedStmt,
+		getInvitationsStatsByInviterIDStmt:          q.getInvitationsStatsByInviterIDStmt,
+		markInvitationOpenedStmt:                    q.markInvitationOpenedStmt,
+		resendInvitationStmt:                        q.resendInvitationStmt,
+		revokeInvitationStmt:                        q.revokeInvitationStmt,
+		setRewardReceivedStmt:                       q.setRewardReceivedStmt,
 	}
 }
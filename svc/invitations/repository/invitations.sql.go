@@ -10,11 +10,13 @@ import (
 	"github.com/google/uuid"
 )
 
-const acceptInvitationByInviteeEmail = `-- name: AcceptInvitationByInviteeEmail :exec
+const acceptInvitationByInviteeEmail = `-- name: AcceptInvitationByInviteeEmail :execrows
 UPDATE invitations
 SET accepted_by = $1,
     accepted_at = $2
 WHERE id = $3
+    AND accepted_at IS NULL
+    AND revoked_at IS NULL
 `
 
 type AcceptInvitationByInviteeEmailParams struct {
@@ -23,23 +25,83 @@ type AcceptInvitationByInviteeEmailParams struct {
 	ID         uuid.UUID    `json:"id"`
 }
 
-func (q *Queries) AcceptInvitationByInviteeEmail(ctx context.Context, arg AcceptInvitationByInviteeEmailParams) error {
-	_, err := q.exec(ctx, q.acceptInvitationByInviteeEmailStmt, acceptInvitationByInviteeEmail, arg.AcceptedBy, arg.AcceptedAt, arg.ID)
+func (q *Queries) AcceptInvitationByInviteeEmail(ctx context.Context, arg AcceptInvitationByInviteeEmailParams) (int64, error) {
+	result, err := q.exec(ctx, q.acceptInvitationByInviteeEmailStmt, acceptInvitationByInviteeEmail, arg.AcceptedBy, arg.AcceptedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const anonymizeInvitationByInviteeID = `-- name: AnonymizeInvitationByInviteeID :exec
+UPDATE invitations
+SET email = '',
+    token = NULL
+WHERE accepted_by = $1
+`
+
+func (q *Queries) AnonymizeInvitationByInviteeID(ctx context.Context, acceptedBy uuid.UUID) error {
+	_, err := q.exec(ctx, q.anonymizeInvitationByInviteeIDStmt, anonymizeInvitationByInviteeID, acceptedBy)
 	return err
 }
 
 const createInvitation = `-- name: CreateInvitation :one
-INSERT INTO invitations (email, invited_by)
-VALUES ($1, $2) RETURNING id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received
+INSERT INTO invitations (email, invited_by, token, expires_at, last_sent_at)
+VALUES ($1, $2, $3, $4, now()) RETURNING id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received, token, expires_at, sends_count, last_sent_at, opened_at, revoked_at
 `
 
 type CreateInvitationParams struct {
-	Email     string    `json:"email"`
-	InvitedBy uuid.UUID `json:"invited_by"`
+	Email     string         `json:"email"`
+	InvitedBy uuid.UUID      `json:"invited_by"`
+	Token     sql.NullString `json:"token"`
+	ExpiresAt sql.NullTime   `json:"expires_at"`
 }
 
 func (q *Queries) CreateInvitation(ctx context.Context, arg CreateInvitationParams) (Invitation, error) {
-	row := q.queryRow(ctx, q.createInvitationStmt, createInvitation, arg.Email, arg.InvitedBy)
+	row := q.queryRow(ctx, q.createInvitationStmt, createInvitation,
+		arg.Email,
+		arg.InvitedBy,
+		arg.Token,
+		arg.ExpiresAt,
+	)
+	var i Invitation
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.InvitedBy,
+		&i.InvitedAt,
+		&i.AcceptedBy,
+		&i.AcceptedAt,
+		&i.RewardReceived,
+		&i.Token,
+		&i.ExpiresAt,
+		&i.SendsCount,
+		&i.LastSentAt,
+		&i.OpenedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const deleteNotAcceptedInvitationsByInviterID = `-- name: DeleteNotAcceptedInvitationsByInviterID :exec
+DELETE FROM invitations
+WHERE invited_by = $1
+    AND accepted_by IS NULL
+`
+
+func (q *Queries) DeleteNotAcceptedInvitationsByInviterID(ctx context.Context, invitedBy uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteNotAcceptedInvitationsByInviterIDStmt, deleteNotAcceptedInvitationsByInviterID, invitedBy)
+	return err
+}
+
+const getInvitationByID = `-- name: GetInvitationByID :one
+SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received, token, expires_at, sends_count, last_sent_at, opened_at, revoked_at
+FROM invitations
+WHERE id = $1
+`
+
+func (q *Queries) GetInvitationByID(ctx context.Context, id uuid.UUID) (Invitation, error) {
+	row := q.queryRow(ctx, q.getInvitationByIDStmt, getInvitationByID, id)
 	var i Invitation
 	err := row.Scan(
 		&i.ID,
@@ -49,14 +111,21 @@ func (q *Queries) CreateInvitation(ctx context.Context, arg CreateInvitationPara
 		&i.AcceptedBy,
 		&i.AcceptedAt,
 		&i.RewardReceived,
+		&i.Token,
+		&i.ExpiresAt,
+		&i.SendsCount,
+		&i.LastSentAt,
+		&i.OpenedAt,
+		&i.RevokedAt,
 	)
 	return i, err
 }
 
 const getInvitationByInviteeEmail = `-- name: GetInvitationByInviteeEmail :one
-SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received
+SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received, token, expires_at, sends_count, last_sent_at, opened_at, revoked_at
 FROM invitations
 WHERE email = $1
+ORDER BY invited_at DESC
     LIMIT 1
 `
 
@@ -71,12 +140,18 @@ func (q *Queries) GetInvitationByInviteeEmail(ctx context.Context, email string)
 		&i.AcceptedBy,
 		&i.AcceptedAt,
 		&i.RewardReceived,
+		&i.Token,
+		&i.ExpiresAt,
+		&i.SendsCount,
+		&i.LastSentAt,
+		&i.OpenedAt,
+		&i.RevokedAt,
 	)
 	return i, err
 }
 
 const getInvitationByInviteeID = `-- name: GetInvitationByInviteeID :one
-SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received
+SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received, token, expires_at, sends_count, last_sent_at, opened_at, revoked_at
 FROM invitations
 WHERE accepted_by = $1
     LIMIT 1
@@ -93,12 +168,45 @@ func (q *Queries) GetInvitationByInviteeID(ctx context.Context, acceptedBy uuid.
 		&i.AcceptedBy,
 		&i.AcceptedAt,
 		&i.RewardReceived,
+		&i.Token,
+		&i.ExpiresAt,
+		&i.SendsCount,
+		&i.LastSentAt,
+		&i.OpenedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getInvitationByToken = `-- name: GetInvitationByToken :one
+SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received, token, expires_at, sends_count, last_sent_at, opened_at, revoked_at
+FROM invitations
+WHERE token = $1
+`
+
+func (q *Queries) GetInvitationByToken(ctx context.Context, token sql.NullString) (Invitation, error) {
+	row := q.queryRow(ctx, q.getInvitationByTokenStmt, getInvitationByToken, token)
+	var i Invitation
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.InvitedBy,
+		&i.InvitedAt,
+		&i.AcceptedBy,
+		&i.AcceptedAt,
+		&i.RewardReceived,
+		&i.Token,
+		&i.ExpiresAt,
+		&i.SendsCount,
+		&i.LastSentAt,
+		&i.OpenedAt,
+		&i.RevokedAt,
 	)
 	return i, err
 }
 
 const getInvitations = `-- name: GetInvitations :many
-SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received
+SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received, token, expires_at, sends_count, last_sent_at, opened_at, revoked_at
 FROM invitations
 ORDER BY invited_at DESC
 `
@@ -120,6 +228,12 @@ func (q *Queries) GetInvitations(ctx context.Context) ([]Invitation, error) {
 			&i.AcceptedBy,
 			&i.AcceptedAt,
 			&i.RewardReceived,
+			&i.Token,
+			&i.ExpiresAt,
+			&i.SendsCount,
+			&i.LastSentAt,
+			&i.OpenedAt,
+			&i.RevokedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -135,7 +249,7 @@ func (q *Queries) GetInvitations(ctx context.Context) ([]Invitation, error) {
 }
 
 const getInvitationsByInviterID = `-- name: GetInvitationsByInviterID :many
-SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received
+SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received, token, expires_at, sends_count, last_sent_at, opened_at, revoked_at
 FROM invitations
 WHERE invited_by = $1
 ORDER BY invited_at DESC
@@ -158,6 +272,12 @@ func (q *Queries) GetInvitationsByInviterID(ctx context.Context, invitedBy uuid.
 			&i.AcceptedBy,
 			&i.AcceptedAt,
 			&i.RewardReceived,
+			&i.Token,
+			&i.ExpiresAt,
+			&i.SendsCount,
+			&i.LastSentAt,
+			&i.OpenedAt,
+			&i.RevokedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -173,7 +293,7 @@ func (q *Queries) GetInvitationsByInviterID(ctx context.Context, invitedBy uuid.
 }
 
 const getInvitationsPaginated = `-- name: GetInvitationsPaginated :many
-SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received
+SELECT id, email, invited_by, invited_at, accepted_by, accepted_at, reward_received, token, expires_at, sends_count, last_sent_at, opened_at, revoked_at
 FROM invitations
 ORDER BY invited_at DESC
 LIMIT $1 OFFSET $2
@@ -201,6 +321,12 @@ func (q *Queries) GetInvitationsPaginated(ctx context.Context, arg GetInvitation
 			&i.AcceptedBy,
 			&i.AcceptedAt,
 			&i.RewardReceived,
+			&i.Token,
+			&i.ExpiresAt,
+			&i.SendsCount,
+			&i.LastSentAt,
+			&i.OpenedAt,
+			&i.RevokedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -215,6 +341,77 @@ func (q *Queries) GetInvitationsPaginated(ctx context.Context, arg GetInvitation
 	return items, nil
 }
 
+const getInvitationsStatsByInviterID = `-- name: GetInvitationsStatsByInviterID :one
+SELECT COUNT(id) AS sent,
+    COUNT(opened_at) AS opened,
+    COUNT(accepted_at) AS accepted,
+    COUNT(id) FILTER (WHERE reward_received = TRUE) AS rewarded,
+    COUNT(revoked_at) AS revoked
+FROM invitations
+WHERE invited_by = $1
+`
+
+type GetInvitationsStatsByInviterIDRow struct {
+	Sent     int64 `json:"sent"`
+	Opened   int64 `json:"opened"`
+	Accepted int64 `json:"accepted"`
+	Rewarded int64 `json:"rewarded"`
+	Revoked  int64 `json:"revoked"`
+}
+
+func (q *Queries) GetInvitationsStatsByInviterID(ctx context.Context, invitedBy uuid.UUID) (GetInvitationsStatsByInviterIDRow, error) {
+	row := q.queryRow(ctx, q.getInvitationsStatsByInviterIDStmt, getInvitationsStatsByInviterID, invitedBy)
+	var i GetInvitationsStatsByInviterIDRow
+	err := row.Scan(
+		&i.Sent,
+		&i.Opened,
+		&i.Accepted,
+		&i.Rewarded,
+		&i.Revoked,
+	)
+	return i, err
+}
+
+const markInvitationOpened = `-- name: MarkInvitationOpened :exec
+UPDATE invitations
+SET opened_at = now()
+WHERE id = $1 AND opened_at IS NULL
+`
+
+func (q *Queries) MarkInvitationOpened(ctx context.Context, id uuid.UUID) error {
+	_, err := q.exec(ctx, q.markInvitationOpenedStmt, markInvitationOpened, id)
+	return err
+}
+
+const resendInvitation = `-- name: ResendInvitation :exec
+UPDATE invitations
+SET sends_count = sends_count + 1,
+    last_sent_at = now(),
+    expires_at = $1
+WHERE id = $2
+`
+
+type ResendInvitationParams struct {
+	ExpiresAt sql.NullTime `json:"expires_at"`
+	ID        uuid.UUID    `json:"id"`
+}
+
+func (q *Queries) ResendInvitation(ctx context.Context, arg ResendInvitationParams) error {
+	_, err := q.exec(ctx, q.resendInvitationStmt, resendInvitation, arg.ExpiresAt, arg.ID)
+	return err
+}
+
+const revokeInvitation = `-- name: RevokeInvitation :exec
+UPDATE invitations
+SET revoked_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) RevokeInvitation(ctx context.Context, id uuid.UUID) error {
+	_, err := q.exec(ctx, q.revokeInvitationStmt, revokeInvitation, id)
+	return err
+}
+
 const setRewardReceived = `-- name: SetRewardReceived :exec
 UPDATE invitations
 SET reward_received = $1
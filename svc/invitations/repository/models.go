@@ -10,11 +10,17 @@ import (
 )
 
 type Invitation struct {
-	ID             uuid.UUID    `json:"id"`
-	Email          string       `json:"email"`
-	InvitedBy      uuid.UUID    `json:"invited_by"`
-	InvitedAt      time.Time    `json:"invited_at"`
-	AcceptedBy     uuid.UUID    `json:"accepted_by"`
-	AcceptedAt     sql.NullTime `json:"accepted_at"`
-	RewardReceived sql.NullBool `json:"reward_received"`
+	ID             uuid.UUID      `json:"id"`
+	Email          string         `json:"email"`
+	InvitedBy      uuid.UUID      `json:"invited_by"`
+	InvitedAt      time.Time      `json:"invited_at"`
+	AcceptedBy     uuid.UUID      `json:"accepted_by"`
+	AcceptedAt     sql.NullTime   `json:"accepted_at"`
+	RewardReceived sql.NullBool   `json:"reward_received"`
+	Token          sql.NullString `json:"token"`
+	ExpiresAt      sql.NullTime   `json:"expires_at"`
+	SendsCount     int32          `json:"sends_count"`
+	LastSentAt     sql.NullTime   `json:"last_sent_at"`
+	OpenedAt       sql.NullTime   `json:"opened_at"`
+	RevokedAt      sql.NullTime   `json:"revoked_at"`
 }
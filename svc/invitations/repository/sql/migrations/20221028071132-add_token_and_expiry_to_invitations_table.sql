@@ -0,0 +1,20 @@
+-- +migrate Up
+ALTER TABLE invitations
+    ADD COLUMN token VARCHAR DEFAULT NULL,
+    ADD COLUMN expires_at TIMESTAMP DEFAULT NULL,
+    ADD COLUMN sends_count INT NOT NULL DEFAULT 1,
+    ADD COLUMN last_sent_at TIMESTAMP DEFAULT NULL,
+    ADD COLUMN opened_at TIMESTAMP DEFAULT NULL,
+    ADD COLUMN revoked_at TIMESTAMP DEFAULT NULL;
+CREATE UNIQUE INDEX invitations_token ON invitations USING BTREE (token);
+CREATE INDEX invitations_invited_by ON invitations USING BTREE (invited_by);
+-- +migrate Down
+DROP INDEX IF EXISTS invitations_invited_by;
+DROP INDEX IF EXISTS invitations_token;
+ALTER TABLE invitations
+    DROP COLUMN IF EXISTS token,
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS sends_count,
+    DROP COLUMN IF EXISTS last_sent_at,
+    DROP COLUMN IF EXISTS opened_at,
+    DROP COLUMN IF EXISTS revoked_at;
@@ -1,13 +1,22 @@
+-- name: GetInvitationByID :one
+SELECT *
+FROM invitations
+WHERE id = $1;
 -- name: GetInvitationByInviteeEmail :one
 SELECT *
 FROM invitations
 WHERE email = $1
+ORDER BY invited_at DESC
     LIMIT 1;
 -- name: GetInvitationByInviteeID :one
 SELECT *
 FROM invitations
 WHERE accepted_by = $1
     LIMIT 1;
+-- name: GetInvitationByToken :one
+SELECT *
+FROM invitations
+WHERE token = $1;
 -- name: GetInvitations :many
 SELECT *
 FROM invitations
@@ -22,15 +31,48 @@ SELECT *
 FROM invitations
 WHERE invited_by = $1
 ORDER BY invited_at DESC;
+-- name: GetInvitationsStatsByInviterID :one
+SELECT COUNT(id) AS sent,
+    COUNT(opened_at) AS opened,
+    COUNT(accepted_at) AS accepted,
+    COUNT(id) FILTER (WHERE reward_received = TRUE) AS rewarded,
+    COUNT(revoked_at) AS revoked
+FROM invitations
+WHERE invited_by = $1;
 -- name: CreateInvitation :one
-INSERT INTO invitations (email, invited_by)
-VALUES ($1, $2) RETURNING *;
--- name: AcceptInvitationByInviteeEmail :exec
+INSERT INTO invitations (email, invited_by, token, expires_at, last_sent_at)
+VALUES ($1, $2, $3, $4, now()) RETURNING *;
+-- name: AcceptInvitationByInviteeEmail :execrows
 UPDATE invitations
 SET accepted_by = @accepted_by,
     accepted_at = @accepted_at
+WHERE id = @id
+    AND accepted_at IS NULL
+    AND revoked_at IS NULL;
+-- name: MarkInvitationOpened :exec
+UPDATE invitations
+SET opened_at = now()
+WHERE id = @id AND opened_at IS NULL;
+-- name: ResendInvitation :exec
+UPDATE invitations
+SET sends_count = sends_count + 1,
+    last_sent_at = now(),
+    expires_at = @expires_at
+WHERE id = @id;
+-- name: RevokeInvitation :exec
+UPDATE invitations
+SET revoked_at = now()
 WHERE id = @id;
 -- name: SetRewardReceived :exec
 UPDATE invitations
 SET reward_received = @reward_received
 WHERE id = @id;
+-- name: DeleteNotAcceptedInvitationsByInviterID :exec
+DELETE FROM invitations
+WHERE invited_by = $1
+    AND accepted_by IS NULL;
+-- name: AnonymizeInvitationByInviteeID :exec
+UPDATE invitations
+SET email = '',
+    token = NULL
+WHERE accepted_by = $1;
@@ -32,6 +32,9 @@ type (
 	Config struct {
 		InvitationReward float64
 		InvitationURL    string
+		TokenSecret      string        // used to sign invitation tokens
+		TTL              time.Duration // invitation expires after this period since the last sending
+		MaxSends         int32         // max number of emails per invitation including resends
 	}
 
 	// Invitation struct
@@ -44,21 +47,43 @@ type (
 		AcceptedBy     uuid.UUID `json:"accepted_by"`
 		AcceptedAt     time.Time `json:"accepted_at"`
 		RewardReceived bool      `json:"reward_received"`
+		ExpiresAt      time.Time `json:"expires_at"`
+		SendsCount     int32     `json:"sends_count"`
+		OpenedAt       time.Time `json:"opened_at"`
+		RevokedAt      time.Time `json:"revoked_at"`
+		Status         string    `json:"status"`
+	}
+
+	// Stats struct
+	Stats struct {
+		Sent     int64 `json:"sent"`
+		Opened   int64 `json:"opened"`
+		Accepted int64 `json:"accepted"`
+		Rewarded int64 `json:"rewarded"`
+		Revoked  int64 `json:"revoked"`
 	}
 
 	invitationsRepository interface {
-		AcceptInvitationByInviteeEmail(ctx context.Context, arg repository.AcceptInvitationByInviteeEmailParams) error
+		AcceptInvitationByInviteeEmail(ctx context.Context, arg repository.AcceptInvitationByInviteeEmailParams) (int64, error)
 		CreateInvitation(ctx context.Context, arg repository.CreateInvitationParams) (repository.Invitation, error)
 		GetInvitations(ctx context.Context) ([]repository.Invitation, error)
 		GetInvitationsPaginated(ctx context.Context, arg repository.GetInvitationsPaginatedParams) ([]repository.Invitation, error)
+		GetInvitationByID(ctx context.Context, id uuid.UUID) (repository.Invitation, error)
 		GetInvitationByInviteeEmail(ctx context.Context, normalizedInviteeEmail string) (repository.Invitation, error)
 		GetInvitationByInviteeID(ctx context.Context, acceptedBy uuid.UUID) (repository.Invitation, error)
+		GetInvitationByToken(ctx context.Context, token sql.NullString) (repository.Invitation, error)
 		GetInvitationsByInviterID(ctx context.Context, invitedBy uuid.UUID) ([]repository.Invitation, error)
+		GetInvitationsStatsByInviterID(ctx context.Context, invitedBy uuid.UUID) (repository.GetInvitationsStatsByInviterIDRow, error)
+		DeleteNotAcceptedInvitationsByInviterID(ctx context.Context, invitedBy uuid.UUID) error
+		AnonymizeInvitationByInviteeID(ctx context.Context, acceptedBy uuid.UUID) error
+		MarkInvitationOpened(ctx context.Context, id uuid.UUID) error
+		ResendInvitation(ctx context.Context, arg repository.ResendInvitationParams) error
+		RevokeInvitation(ctx context.Context, id uuid.UUID) error
 		SetRewardReceived(ctx context.Context, arg repository.SetRewardReceivedParams) error
 	}
 
 	mailer interface {
-		SendInvitation(ctx context.Context, email, invitedBy string) error
+		SendInvitation(ctx context.Context, email, invitedBy, invitationLink string) error
 	}
 
 	rewardsClient interface {
@@ -78,6 +103,15 @@ func NewService(ir invitationsRepository, m mailer, rc rewardsClient, config Con
 	if rc == nil {
 		log.Fatalln("rewards client is not set")
 	}
+	if config.TokenSecret == "" {
+		log.Fatalln("invitation token secret is not set")
+	}
+	if config.TTL <= 0 {
+		config.TTL = 7 * 24 * time.Hour
+	}
+	if config.MaxSends <= 0 {
+		config.MaxSends = 3
+	}
 
 	return &Service{ir: ir, m: m, rc: rc, config: config}
 }
@@ -130,28 +164,189 @@ func (s *Service) SendReward(sendRewards func(ctx context.Context, uid, relation
 	}
 }
 
-// SendInvitation used to send invitation if person doesn't exist in invitation table.
+// SendInvitation used to send invitation if person doesn't have an active invitation yet.
+// Sending to the email invited by the same user again is counted as a resend.
 func (s *Service) SendInvitation(ctx context.Context, invitedByID uuid.UUID, invitedByUsername, inviteeEmail string) error {
-	if _, err := s.ir.GetInvitationByInviteeEmail(ctx, inviteeEmail); err != nil {
-		if db.IsNotFoundError(err) {
-			if _, err = s.ir.CreateInvitation(ctx, repository.CreateInvitationParams{
-				Email:     inviteeEmail,
-				InvitedBy: invitedByID,
-			}); err != nil {
-				return fmt.Errorf("could not create invitation: %w", err)
-			}
+	invitation, err := s.ir.GetInvitationByInviteeEmail(ctx, inviteeEmail)
+	if err != nil && !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get invitation by invitee email: %w", err)
+	}
 
-			if err = s.m.SendInvitation(ctx, inviteeEmail, invitedByUsername); err != nil {
-				return fmt.Errorf("could not send invitation: %w", err)
+	if err == nil {
+		switch invitationStatus(invitation, time.Now()) {
+		case StatusAccepted:
+			return fmt.Errorf("%w: %s", ErrAlreadyInvited, inviteeEmail)
+		case StatusPending:
+			if invitation.InvitedBy != invitedByID {
+				return fmt.Errorf("%w: %s", ErrAlreadyInvited, inviteeEmail)
 			}
+			return s.resend(ctx, invitation, invitedByUsername)
+		}
+	}
+
+	token, err := newInvitationToken(s.config.TokenSecret)
+	if err != nil {
+		return err
+	}
+	link, err := invitationLink(s.config.InvitationURL, token)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.ir.CreateInvitation(ctx, repository.CreateInvitationParams{
+		Email:     inviteeEmail,
+		InvitedBy: invitedByID,
+		Token:     sql.NullString{String: token, Valid: true},
+		ExpiresAt: sql.NullTime{Time: time.Now().Add(s.config.TTL), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("could not create invitation: %w", err)
+	}
+
+	if err = s.m.SendInvitation(ctx, inviteeEmail, invitedByUsername, link); err != nil {
+		return fmt.Errorf("could not send invitation: %w", err)
+	}
+
+	return nil
+}
 
-			return nil
+// ResendInvitation sends the invitation email again and extends the invitation expiry.
+func (s *Service) ResendInvitation(ctx context.Context, invitedByID, invitationID uuid.UUID, invitedByUsername string) error {
+	invitation, err := s.getInviterInvitation(ctx, invitedByID, invitationID)
+	if err != nil {
+		return err
+	}
+
+	switch invitationStatus(invitation, time.Now()) {
+	case StatusAccepted:
+		return ErrAlreadyAccepted
+	case StatusRevoked:
+		return ErrInvitationRevoked
+	}
+
+	return s.resend(ctx, invitation, invitedByUsername)
+}
+
+// RevokeInvitation makes the invitation link unusable.
+func (s *Service) RevokeInvitation(ctx context.Context, invitedByID, invitationID uuid.UUID) error {
+	invitation, err := s.getInviterInvitation(ctx, invitedByID, invitationID)
+	if err != nil {
+		return err
+	}
+
+	switch invitationStatus(invitation, time.Now()) {
+	case StatusAccepted:
+		return ErrAlreadyAccepted
+	case StatusRevoked:
+		return nil
+	}
+
+	if err := s.ir.RevokeInvitation(ctx, invitation.ID); err != nil {
+		return fmt.Errorf("could not revoke invitation: %w", err)
+	}
+
+	return nil
+}
+
+// OpenInvitation returns invitation by token from the invitation link and marks it as opened.
+func (s *Service) OpenInvitation(ctx context.Context, token string) (Invitation, error) {
+	invitation, err := s.getInvitationByToken(ctx, token)
+	if err != nil {
+		return Invitation{}, err
+	}
+
+	if !invitation.OpenedAt.Valid {
+		if err := s.ir.MarkInvitationOpened(ctx, invitation.ID); err != nil {
+			return Invitation{}, fmt.Errorf("could not mark invitation as opened: %w", err)
 		}
+		invitation.OpenedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
 
-		return fmt.Errorf("could not get invitation by invitee email: %w", err)
+	return castToInvitation(invitation), nil
+}
+
+// GetInvitationsByInviterID returns list of invitations sent by the user.
+func (s *Service) GetInvitationsByInviterID(ctx context.Context, invitedByID uuid.UUID) ([]Invitation, error) {
+	invitations, err := s.ir.GetInvitationsByInviterID(ctx, invitedByID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get invitations list: %w", err)
+	}
+
+	return castToListInvitations(invitations), nil
+}
+
+// GetStats returns numbers of sent, opened, accepted, rewarded and revoked invitations of the user.
+func (s *Service) GetStats(ctx context.Context, invitedByID uuid.UUID) (Stats, error) {
+	stats, err := s.ir.GetInvitationsStatsByInviterID(ctx, invitedByID)
+	if err != nil {
+		return Stats{}, fmt.Errorf("could not get invitations stats: %w", err)
+	}
+
+	return Stats{
+		Sent:     stats.Sent,
+		Opened:   stats.Opened,
+		Accepted: stats.Accepted,
+		Rewarded: stats.Rewarded,
+		Revoked:  stats.Revoked,
+	}, nil
+}
+
+func (s *Service) resend(ctx context.Context, invitation repository.Invitation, invitedByUsername string) error {
+	if invitation.SendsCount >= s.config.MaxSends {
+		return ErrResendLimitReached
+	}
+
+	if !invitation.Token.Valid {
+		// invitation was sent before tokens were introduced
+		return fmt.Errorf("%w: invitation has no token", ErrInvalidParameter)
+	}
+	link, err := invitationLink(s.config.InvitationURL, invitation.Token.String)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ir.ResendInvitation(ctx, repository.ResendInvitationParams{
+		ExpiresAt: sql.NullTime{Time: time.Now().Add(s.config.TTL), Valid: true},
+		ID:        invitation.ID,
+	}); err != nil {
+		return fmt.Errorf("could not update invitation: %w", err)
+	}
+
+	if err := s.m.SendInvitation(ctx, invitation.Email, invitedByUsername, link); err != nil {
+		return fmt.Errorf("could not send invitation: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) getInviterInvitation(ctx context.Context, invitedByID, invitationID uuid.UUID) (repository.Invitation, error) {
+	invitation, err := s.ir.GetInvitationByID(ctx, invitationID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return repository.Invitation{}, fmt.Errorf("%w invitation", ErrNotFound)
+		}
+		return repository.Invitation{}, fmt.Errorf("could not get invitation: %w", err)
+	}
+	if invitation.InvitedBy != invitedByID {
+		return repository.Invitation{}, fmt.Errorf("%w invitation", ErrNotFound)
 	}
 
-	return fmt.Errorf("user with email %s, alredy invited", inviteeEmail)
+	return invitation, nil
+}
+
+func (s *Service) getInvitationByToken(ctx context.Context, token string) (repository.Invitation, error) {
+	if !verifyInvitationToken(s.config.TokenSecret, token) {
+		return repository.Invitation{}, fmt.Errorf("%w: invitation token", ErrInvalidParameter)
+	}
+
+	invitation, err := s.ir.GetInvitationByToken(ctx, sql.NullString{String: token, Valid: true})
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return repository.Invitation{}, fmt.Errorf("%w invitation", ErrNotFound)
+		}
+		return repository.Invitation{}, fmt.Errorf("could not get invitation by token: %w", err)
+	}
+
+	return invitation, nil
 }
 
 // GetInvitationsPaginated returns list invitations with pagination.
@@ -167,24 +362,33 @@ func (s *Service) GetInvitationsPaginated(ctx context.Context, limit, offset int
 	return castToListInvitations(invitations), nil
 }
 
-// Cast repository.Show to service Show structure
+// Cast repository.Invitation to service Invitation structure
 func castToListInvitations(source []repository.Invitation) []Invitation {
 	result := make([]Invitation, 0, len(source))
 	for _, s := range source {
-		result = append(result, Invitation{
-			ID:             s.ID,
-			Email:          s.Email,
-			InvitedAt:      s.InvitedAt,
-			InvitedBy:      s.InvitedBy,
-			AcceptedAt:     s.AcceptedAt.Time,
-			AcceptedBy:     s.AcceptedBy,
-			RewardReceived: s.RewardReceived.Bool,
-		})
+		result = append(result, castToInvitation(s))
 	}
 
 	return result
 }
 
+func castToInvitation(source repository.Invitation) Invitation {
+	return Invitation{
+		ID:             source.ID,
+		Email:          source.Email,
+		InvitedAt:      source.InvitedAt,
+		InvitedBy:      source.InvitedBy,
+		AcceptedAt:     source.AcceptedAt.Time,
+		AcceptedBy:     source.AcceptedBy,
+		RewardReceived: source.RewardReceived.Bool,
+		ExpiresAt:      source.ExpiresAt.Time,
+		SendsCount:     source.SendsCount,
+		OpenedAt:       source.OpenedAt.Time,
+		RevokedAt:      source.RevokedAt.Time,
+		Status:         invitationStatus(source, time.Now()),
+	}
+}
+
 // GetInvitations returns list invitations.
 func (s *Service) GetInvitations(ctx context.Context) ([]Invitation, error) {
 	invitations, err := s.ir.GetInvitations(ctx)
@@ -205,20 +409,49 @@ func (s *Service) AcceptInvitation(ctx context.Context, inviteeID uuid.UUID, inv
 		return fmt.Errorf("could not get invitation by email %s: %w", inviteeEmail, err)
 	}
 
-	if invitation.AcceptedAt.Valid {
-		return fmt.Errorf("invitation is already accepted")
+	return s.accept(ctx, invitation, inviteeID)
+}
+
+// AcceptInvitationByToken used to accept invitation from the invitation link,
+// the invitee may sign up with any email address.
+func (s *Service) AcceptInvitationByToken(ctx context.Context, inviteeID uuid.UUID, token string) error {
+	invitation, err := s.getInvitationByToken(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return s.accept(ctx, invitation, inviteeID)
+}
+
+func (s *Service) accept(ctx context.Context, invitation repository.Invitation, inviteeID uuid.UUID) error {
+	switch invitationStatus(invitation, time.Now()) {
+	case StatusAccepted:
+		return ErrAlreadyAccepted
+	case StatusRevoked:
+		return ErrInvitationRevoked
+	case StatusExpired:
+		return ErrInvitationExpired
 	}
 
-	if err := s.ir.AcceptInvitationByInviteeEmail(ctx, repository.AcceptInvitationByInviteeEmailParams{
+	if invitation.InvitedBy == inviteeID {
+		return fmt.Errorf("%w: it's your own invitation", ErrInvalidParameter)
+	}
+
+	// invitation may be accepted or revoked concurrently, so the update is conditional
+	accepted, err := s.ir.AcceptInvitationByInviteeEmail(ctx, repository.AcceptInvitationByInviteeEmailParams{
 		ID:         invitation.ID,
 		AcceptedBy: inviteeID,
 		AcceptedAt: sql.NullTime{
 			Time:  time.Now().UTC(),
 			Valid: true,
 		},
-	}); err != nil {
+	})
+	if err != nil {
 		return fmt.Errorf("could not accept invitation for user id = %s: %w", inviteeID, err)
 	}
+	if accepted == 0 {
+		return ErrAlreadyAccepted
+	}
 
 	return nil
 }
@@ -0,0 +1,70 @@
+package invitations
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/lib/signedtoken"
+	"github.com/SatorNetwork/sator-api/svc/invitations/repository"
+)
+
+// Invitation statuses
+const (
+	StatusPending  = "pending"
+	StatusAccepted = "accepted"
+	StatusExpired  = "expired"
+	StatusRevoked  = "revoked"
+)
+
+// Invitation token settings
+const (
+	invitationTokenNonceSize = 18           // number of random bytes in the invitation token
+	invitationTokenPurpose   = "invitation" // signed along with the nonce of invitation tokens
+)
+
+// newInvitationToken returns a random nonce signed with the secret key
+func newInvitationToken(secret string) (string, error) {
+	b := make([]byte, invitationTokenNonceSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("could not generate invitation token: %w", err)
+	}
+
+	return signedtoken.New(secret, invitationTokenPurpose, b), nil
+}
+
+// verifyInvitationToken reports whether the token was issued with the secret key
+func verifyInvitationToken(secret, token string) bool {
+	_, ok := signedtoken.Verify(secret, invitationTokenPurpose, token)
+	return ok
+}
+
+// invitationLink appends the invitation token to the base invitation URL
+func invitationLink(baseURL, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("could not parse invitation url: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// invitationStatus returns the current status of the invitation.
+// Invitations sent before expiration was introduced never expire.
+func invitationStatus(inv repository.Invitation, now time.Time) string {
+	switch {
+	case inv.AcceptedAt.Valid:
+		return StatusAccepted
+	case inv.RevokedAt.Valid:
+		return StatusRevoked
+	case inv.ExpiresAt.Valid && !inv.ExpiresAt.Time.After(now):
+		return StatusExpired
+	}
+
+	return StatusPending
+}
@@ -0,0 +1,100 @@
+package invitations
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/invitations/repository"
+)
+
+func TestInvitationToken(t *testing.T) {
+	const secret = "secret"
+
+	token, err := newInvitationToken(secret)
+	if err != nil {
+		t.Fatalf("newInvitationToken() error = %v", err)
+	}
+
+	another, err := newInvitationToken(secret)
+	if err != nil {
+		t.Fatalf("newInvitationToken() error = %v", err)
+	}
+	if token == another {
+		t.Errorf("newInvitationToken() returned the same token twice: %s", token)
+	}
+
+	nonce := strings.SplitN(token, ".", 2)[0]
+
+	tests := []struct {
+		name   string
+		secret string
+		token  string
+		want   bool
+	}{
+		{name: "valid", secret: secret, token: token, want: true},
+		{name: "another secret", secret: "another", token: token, want: false},
+		{name: "tampered nonce", secret: secret, token: "x" + token, want: false},
+		{name: "missed signature", secret: secret, token: nonce, want: false},
+		{name: "empty signature", secret: secret, token: nonce + ".", want: false},
+		{name: "empty", secret: secret, token: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verifyInvitationToken(tt.secret, tt.token); got != tt.want {
+				t.Errorf("verifyInvitationToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvitationLink(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		want    string
+	}{
+		{name: "plain url", baseURL: "https://sator.io/invite", want: "https://sator.io/invite?token=abc.def"},
+		{name: "url with query", baseURL: "https://sator.io/invite?src=email", want: "https://sator.io/invite?src=email&token=abc.def"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := invitationLink(tt.baseURL, "abc.def")
+			if err != nil {
+				t.Fatalf("invitationLink() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("invitationLink() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvitationStatus(t *testing.T) {
+	now := time.Date(2022, 10, 28, 10, 0, 0, 0, time.UTC)
+	past := sql.NullTime{Time: now.Add(-time.Hour), Valid: true}
+	future := sql.NullTime{Time: now.Add(time.Hour), Valid: true}
+
+	tests := []struct {
+		name string
+		inv  repository.Invitation
+		want string
+	}{
+		{name: "legacy invitation without expiry", inv: repository.Invitation{}, want: StatusPending},
+		{name: "not expired yet", inv: repository.Invitation{ExpiresAt: future}, want: StatusPending},
+		{name: "expired", inv: repository.Invitation{ExpiresAt: past}, want: StatusExpired},
+		{name: "revoked", inv: repository.Invitation{ExpiresAt: future, RevokedAt: past}, want: StatusRevoked},
+		{name: "accepted before expiry", inv: repository.Invitation{ExpiresAt: past, AcceptedAt: past}, want: StatusAccepted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := invitationStatus(tt.inv, now); got != tt.want {
+				t.Errorf("invitationStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -38,6 +38,41 @@ func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
 		options...,
 	).ServeHTTP)
 
+	r.Get("/", httptransport.NewServer(
+		e.GetMyInvitations,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/stats", httptransport.NewServer(
+		e.GetStats,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/open/{token}", httptransport.NewServer(
+		e.OpenInvitation,
+		decodeOpenInvitationRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/{id}/resend", httptransport.NewServer(
+		e.ResendInvitation,
+		decodeInvitationIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/{id}/revoke", httptransport.NewServer(
+		e.RevokeInvitation,
+		decodeInvitationIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
 	return r
 }
 
@@ -49,10 +84,42 @@ func decodeSendInvitationRequest(_ context.Context, r *http.Request) (interface{
 	return req, nil
 }
 
+func decodeEmptyRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodeOpenInvitationRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	token := chi.URLParam(r, "token")
+	if token == "" {
+		return nil, fmt.Errorf("%w: missed invitation token", ErrInvalidParameter)
+	}
+	return token, nil
+}
+
+func decodeInvitationIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed invitation id", ErrInvalidParameter)
+	}
+	return id, nil
+}
+
 // returns http error code by error type
 func codeAndMessageFrom(err error) (int, interface{}) {
 	if errors.Is(err, ErrInvalidParameter) {
 		return http.StatusBadRequest, err.Error()
 	}
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+	if errors.Is(err, ErrAlreadyInvited) || errors.Is(err, ErrAlreadyAccepted) {
+		return http.StatusConflict, err.Error()
+	}
+	if errors.Is(err, ErrInvitationExpired) || errors.Is(err, ErrInvitationRevoked) {
+		return http.StatusGone, err.Error()
+	}
+	if errors.Is(err, ErrResendLimitReached) {
+		return http.StatusTooManyRequests, err.Error()
+	}
 	return httpencoder.CodeAndMessageFrom(err)
 }
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/svc/leaderboard"
+)
+
+type (
+	// Client struct
+	Client struct {
+		s service
+	}
+
+	service interface {
+		RecordScore(ctx context.Context, sc leaderboard.Score) error
+	}
+)
+
+// New leaderboard service client implementation
+func New(s service) *Client {
+	return &Client{s: s}
+}
+
+// RecordScore adds the user's score to leaderboards in background,
+// so the caller's flow is never blocked or failed by leaderboards.
+// Show and challenge ids are optional, pass uuid.Nil if the score is not related to them.
+func (c *Client) RecordScore(_ context.Context, userID uuid.UUID, points float64, wins int32, showID, challengeID uuid.UUID) {
+	go func() {
+		if err := c.s.RecordScore(context.Background(), leaderboard.Score{
+			UserID:      userID,
+			Points:      points,
+			Wins:        wins,
+			ShowID:      showID,
+			ChallengeID: challengeID,
+		}); err != nil {
+			log.Printf("could not record leaderboard score of user %s: %v", userID, err)
+		}
+	}()
+}
@@ -0,0 +1,176 @@
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+	"github.com/SatorNetwork/sator-api/lib/validator"
+)
+
+type (
+	// Endpoints collection of leaderboard service
+	Endpoints struct {
+		GetLeaderboard       endpoint.Endpoint
+		AddPayoutRule        endpoint.Endpoint
+		GetPayoutRules       endpoint.Endpoint
+		DeletePayoutRuleByID endpoint.Endpoint
+	}
+
+	service interface {
+		GetLeaderboard(ctx context.Context, userID uuid.UUID, b Board, limit, offset int32) (Leaderboard, error)
+		AddPayoutRule(ctx context.Context, r PayoutRule) (PayoutRule, error)
+		GetPayoutRules(ctx context.Context) ([]PayoutRule, error)
+		DeletePayoutRuleByID(ctx context.Context, id uuid.UUID) error
+	}
+
+	// GetLeaderboardRequest struct
+	GetLeaderboardRequest struct {
+		Scope   string `json:"scope" validate:"required,oneof=global show challenge"`
+		ScopeID string `json:"scope_id" validate:"required_unless=Scope global,omitempty,uuid"`
+		Period  string `json:"period" validate:"required,oneof=daily weekly monthly all_time"`
+
+		utils.PaginationRequest
+	}
+
+	// AddPayoutRuleRequest struct
+	AddPayoutRuleRequest struct {
+		Scope    string  `json:"scope" validate:"required,oneof=global show challenge"`
+		ScopeID  string  `json:"scope_id" validate:"required_unless=Scope global,omitempty,uuid"`
+		Period   string  `json:"period" validate:"required,oneof=daily weekly monthly"`
+		RankFrom int32   `json:"rank_from" validate:"required,gte=1"`
+		RankTo   int32   `json:"rank_to" validate:"required,gtefield=RankFrom"`
+		Amount   float64 `json:"amount" validate:"required,gt=0"`
+	}
+)
+
+// MakeEndpoints ...
+func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
+	validateFunc := validator.ValidateStruct()
+
+	e := Endpoints{
+		GetLeaderboard:       MakeGetLeaderboardEndpoint(s, validateFunc),
+		AddPayoutRule:        MakeAddPayoutRuleEndpoint(s, validateFunc),
+		GetPayoutRules:       MakeGetPayoutRulesEndpoint(s),
+		DeletePayoutRuleByID: MakeDeletePayoutRuleByIDEndpoint(s),
+	}
+
+	// setup middlewares for each endpoints
+	if len(m) > 0 {
+		for _, mdw := range m {
+			e.GetLeaderboard = mdw(e.GetLeaderboard)
+			e.AddPayoutRule = mdw(e.AddPayoutRule)
+			e.GetPayoutRules = mdw(e.GetPayoutRules)
+			e.DeletePayoutRuleByID = mdw(e.DeletePayoutRuleByID)
+		}
+	}
+
+	return e
+}
+
+// MakeGetLeaderboardEndpoint ...
+func MakeGetLeaderboardEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		req := request.(GetLeaderboardRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		scopeID := uuid.Nil
+		if req.ScopeID != "" {
+			scopeID, err = uuid.Parse(req.ScopeID)
+			if err != nil {
+				return nil, fmt.Errorf("%w scope id: %v", ErrInvalidParameter, err)
+			}
+		}
+
+		resp, err := s.GetLeaderboard(ctx, uid, Board{
+			ScopeType:  req.Scope,
+			ScopeID:    scopeID,
+			PeriodType: req.Period,
+		}, req.Limit(), req.Offset())
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeAddPayoutRuleEndpoint ...
+func MakeAddPayoutRuleEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(AddPayoutRuleRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.AddPayoutRule(ctx, PayoutRule{
+			ScopeType:  req.Scope,
+			ScopeID:    req.ScopeID,
+			PeriodType: req.Period,
+			RankFrom:   req.RankFrom,
+			RankTo:     req.RankTo,
+			Amount:     req.Amount,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetPayoutRulesEndpoint ...
+func MakeGetPayoutRulesEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.GetPayoutRules(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeDeletePayoutRuleByIDEndpoint ...
+func MakeDeletePayoutRuleByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w payout rule id: %v", ErrInvalidParameter, err)
+		}
+
+		if err := s.DeletePayoutRuleByID(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
@@ -0,0 +1,136 @@
+package leaderboard
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/svc/leaderboard/repository"
+)
+
+// Leaderboard periods
+const (
+	PeriodDaily   = "daily"
+	PeriodWeekly  = "weekly" // starts on Monday
+	PeriodMonthly = "monthly"
+	PeriodAllTime = "all_time"
+)
+
+// Leaderboard scopes
+const (
+	ScopeGlobal    = "global"
+	ScopeShow      = "show"
+	ScopeChallenge = "challenge"
+)
+
+// Periods returns list of supported leaderboard periods
+func Periods() []string {
+	return []string{PeriodDaily, PeriodWeekly, PeriodMonthly, PeriodAllTime}
+}
+
+// Board identifies a single leaderboard
+type Board struct {
+	ScopeType  string
+	ScopeID    uuid.UUID
+	PeriodType string
+}
+
+func validateBoard(b Board) error {
+	switch b.ScopeType {
+	case ScopeGlobal:
+		if b.ScopeID != uuid.Nil {
+			return fmt.Errorf("%w: global leaderboard has no scope id", ErrInvalidParameter)
+		}
+	case ScopeShow, ScopeChallenge:
+		if b.ScopeID == uuid.Nil {
+			return fmt.Errorf("%w: scope id is required for %s leaderboard", ErrInvalidParameter, b.ScopeType)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported scope %s", ErrInvalidParameter, b.ScopeType)
+	}
+
+	if _, err := periodStart(b.PeriodType, time.Now()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// periodStart returns start of the period which t belongs to, in UTC
+func periodStart(period string, t time.Time) (time.Time, error) {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch period {
+	case PeriodDaily:
+		return day, nil
+	case PeriodWeekly:
+		// time.Sunday is 0, shift it to the end of the week
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset), nil
+	case PeriodMonthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+	case PeriodAllTime:
+		return time.Unix(0, 0).UTC(), nil
+	}
+
+	return time.Time{}, fmt.Errorf("%w: unsupported period %s", ErrInvalidParameter, period)
+}
+
+// closedPeriodsRange returns range of period starts which are paid out by rules of the board:
+// from the period the earliest rule was added in, till the current period, which is not closed yet.
+// All-time leaderboard is never closed.
+func closedPeriodsRange(period string, rules []repository.LeaderboardPayoutRule, now time.Time) (since, before time.Time, ok bool) {
+	if period == PeriodAllTime || len(rules) == 0 {
+		return time.Time{}, time.Time{}, false
+	}
+
+	before, err := periodStart(period, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	addedAt := rules[0].CreatedAt
+	for _, r := range rules[1:] {
+		if r.CreatedAt.Before(addedAt) {
+			addedAt = r.CreatedAt
+		}
+	}
+	since, err = periodStart(period, addedAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	return since, before, true
+}
+
+// neighboursRange returns ranks around the given one, bounded by the first place
+func neighboursRange(rank, n int32) (from, to int32) {
+	from = rank - n
+	if from < 1 {
+		from = 1
+	}
+	return from, rank + n
+}
+
+// payoutForRank returns amount which the given rank is paid out by rules of the board
+func payoutForRank(rules []repository.LeaderboardPayoutRule, rank int32) float64 {
+	for _, r := range rules {
+		if rank >= r.RankFrom && rank <= r.RankTo {
+			return r.Amount
+		}
+	}
+	return 0
+}
+
+// maxPaidRank returns the lowest rank which is paid out by rules of the board
+func maxPaidRank(rules []repository.LeaderboardPayoutRule) int32 {
+	var max int32
+	for _, r := range rules {
+		if r.RankTo > max {
+			max = r.RankTo
+		}
+	}
+	return max
+}
@@ -0,0 +1,136 @@
+package leaderboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/leaderboard/repository"
+)
+
+func TestPeriodStart(t *testing.T) {
+	// Wednesday
+	now := time.Date(2022, 10, 26, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		period  string
+		want    time.Time
+		wantErr bool
+	}{
+		{period: PeriodDaily, want: time.Date(2022, 10, 26, 0, 0, 0, 0, time.UTC)},
+		{period: PeriodWeekly, want: time.Date(2022, 10, 24, 0, 0, 0, 0, time.UTC)},
+		{period: PeriodMonthly, want: time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{period: PeriodAllTime, want: time.Unix(0, 0).UTC()},
+		{period: "yearly", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			got, err := periodStart(tt.period, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("periodStart() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("periodStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodStartWeekOnSunday(t *testing.T) {
+	sunday := time.Date(2022, 10, 30, 23, 0, 0, 0, time.UTC)
+	want := time.Date(2022, 10, 24, 0, 0, 0, 0, time.UTC)
+
+	got, err := periodStart(PeriodWeekly, sunday)
+	if err != nil {
+		t.Fatalf("periodStart() error = %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("periodStart() = %v, want %v", got, want)
+	}
+}
+
+func TestClosedPeriodsRange(t *testing.T) {
+	now := time.Date(2022, 3, 1, 1, 0, 0, 0, time.UTC)
+	rules := []repository.LeaderboardPayoutRule{
+		{CreatedAt: time.Date(2022, 2, 20, 10, 0, 0, 0, time.UTC)},
+		{CreatedAt: time.Date(2022, 1, 15, 10, 0, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		period     string
+		wantSince  time.Time
+		wantBefore time.Time
+		wantOk     bool
+	}{
+		{
+			period:     PeriodDaily,
+			wantSince:  time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC),
+			wantBefore: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantOk:     true,
+		},
+		{
+			period:     PeriodWeekly,
+			wantSince:  time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC),
+			wantBefore: time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC),
+			wantOk:     true,
+		},
+		{
+			period:     PeriodMonthly,
+			wantSince:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantBefore: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantOk:     true,
+		},
+		{period: PeriodAllTime, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			since, before, ok := closedPeriodsRange(tt.period, rules, now)
+			if ok != tt.wantOk {
+				t.Fatalf("closedPeriodsRange() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !since.Equal(tt.wantSince) {
+				t.Errorf("closedPeriodsRange() since = %v, want %v", since, tt.wantSince)
+			}
+			if !before.Equal(tt.wantBefore) {
+				t.Errorf("closedPeriodsRange() before = %v, want %v", before, tt.wantBefore)
+			}
+		})
+	}
+}
+
+func TestNeighboursRange(t *testing.T) {
+	if from, to := neighboursRange(10, 2); from != 8 || to != 12 {
+		t.Errorf("neighboursRange() = %d..%d, want 8..12", from, to)
+	}
+	if from, to := neighboursRange(1, 2); from != 1 || to != 3 {
+		t.Errorf("neighboursRange() = %d..%d, want 1..3", from, to)
+	}
+}
+
+func TestPayoutForRank(t *testing.T) {
+	rules := []repository.LeaderboardPayoutRule{
+		{RankFrom: 1, RankTo: 1, Amount: 100},
+		{RankFrom: 2, RankTo: 10, Amount: 10},
+	}
+
+	tests := []struct {
+		rank int32
+		want float64
+	}{
+		{rank: 1, want: 100},
+		{rank: 5, want: 10},
+		{rank: 10, want: 10},
+		{rank: 11, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := payoutForRank(rules, tt.rank); got != tt.want {
+			t.Errorf("payoutForRank(%d) = %v, want %v", tt.rank, got, tt.want)
+		}
+	}
+
+	if got := maxPaidRank(rules); got != 10 {
+		t.Errorf("maxPaidRank() = %v, want 10", got)
+	}
+}
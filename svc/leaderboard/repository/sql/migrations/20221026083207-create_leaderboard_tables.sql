@@ -0,0 +1,52 @@
+-- +migrate Up
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+-- scores are accumulated incrementally for each board and period
+CREATE TABLE IF NOT EXISTS leaderboard_scores (
+    scope_type VARCHAR NOT NULL, -- global, show or challenge
+    scope_id uuid NOT NULL, -- nil uuid for global board
+    period_type VARCHAR NOT NULL, -- daily, weekly, monthly or all_time
+    period_start TIMESTAMP NOT NULL,
+    user_id uuid NOT NULL,
+    score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    wins INT NOT NULL DEFAULT 0,
+    reached_at TIMESTAMP NOT NULL DEFAULT now(), -- when current score was reached, earlier is ranked higher on tie
+    PRIMARY KEY (scope_type, scope_id, period_type, period_start, user_id)
+);
+CREATE INDEX leaderboard_scores_ranking ON leaderboard_scores USING BTREE (
+    scope_type,
+    scope_id,
+    period_type,
+    period_start,
+    score DESC,
+    wins DESC,
+    reached_at ASC,
+    user_id ASC
+);
+
+CREATE TABLE IF NOT EXISTS leaderboard_payout_rules (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    scope_type VARCHAR NOT NULL,
+    scope_id uuid NOT NULL,
+    period_type VARCHAR NOT NULL,
+    rank_from INT NOT NULL,
+    rank_to INT NOT NULL,
+    amount DOUBLE PRECISION NOT NULL, -- per user
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS leaderboard_payouts (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    scope_type VARCHAR NOT NULL,
+    scope_id uuid NOT NULL,
+    period_type VARCHAR NOT NULL,
+    period_start TIMESTAMP NOT NULL,
+    user_id uuid NOT NULL,
+    rank INT NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (scope_type, scope_id, period_type, period_start, user_id)
+);
+-- +migrate Down
+DROP TABLE IF EXISTS leaderboard_payouts;
+DROP TABLE IF EXISTS leaderboard_payout_rules;
+DROP TABLE IF EXISTS leaderboard_scores;
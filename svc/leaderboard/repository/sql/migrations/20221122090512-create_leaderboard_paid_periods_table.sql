@@ -0,0 +1,12 @@
+-- +migrate Up
+-- closed periods which all payouts are made for
+CREATE TABLE IF NOT EXISTS leaderboard_paid_periods (
+    scope_type VARCHAR NOT NULL,
+    scope_id uuid NOT NULL,
+    period_type VARCHAR NOT NULL,
+    period_start TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (scope_type, scope_id, period_type, period_start)
+);
+-- +migrate Down
+DROP TABLE IF EXISTS leaderboard_paid_periods;
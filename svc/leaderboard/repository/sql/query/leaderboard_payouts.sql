@@ -0,0 +1,79 @@
+-- name: AddLeaderboardPayoutRule :one
+INSERT INTO leaderboard_payout_rules (
+    scope_type,
+    scope_id,
+    period_type,
+    rank_from,
+    rank_to,
+    amount
+)
+VALUES (
+    @scope_type,
+    @scope_id,
+    @period_type,
+    @rank_from,
+    @rank_to,
+    @amount
+) RETURNING *;
+-- name: GetLeaderboardPayoutRules :many
+SELECT *
+FROM leaderboard_payout_rules
+ORDER BY scope_type, scope_id, period_type, rank_from;
+-- name: DeleteLeaderboardPayoutRuleByID :exec
+DELETE FROM leaderboard_payout_rules
+WHERE id = @id;
+-- name: AddLeaderboardPayout :exec
+INSERT INTO leaderboard_payouts (
+    scope_type,
+    scope_id,
+    period_type,
+    period_start,
+    user_id,
+    rank,
+    amount
+)
+VALUES (
+    @scope_type,
+    @scope_id,
+    @period_type,
+    @period_start,
+    @user_id,
+    @rank,
+    @amount
+);
+-- name: DeleteLeaderboardPayout :exec
+DELETE FROM leaderboard_payouts
+WHERE scope_type = @scope_type
+    AND scope_id = @scope_id
+    AND period_type = @period_type
+    AND period_start = @period_start
+    AND user_id = @user_id;
+-- name: AddLeaderboardPaidPeriod :exec
+INSERT INTO leaderboard_paid_periods (
+    scope_type,
+    scope_id,
+    period_type,
+    period_start
+)
+VALUES (
+    @scope_type,
+    @scope_id,
+    @period_type,
+    @period_start
+) ON CONFLICT DO NOTHING;
+-- name: GetUnpaidLeaderboardPeriods :many
+SELECT DISTINCT leaderboard_scores.period_start
+FROM leaderboard_scores
+WHERE leaderboard_scores.scope_type = @scope_type
+    AND leaderboard_scores.scope_id = @scope_id
+    AND leaderboard_scores.period_type = @period_type
+    AND leaderboard_scores.period_start >= @since
+    AND leaderboard_scores.period_start < @before
+    AND NOT EXISTS (
+        SELECT 1 FROM leaderboard_paid_periods
+        WHERE leaderboard_paid_periods.scope_type = leaderboard_scores.scope_type
+            AND leaderboard_paid_periods.scope_id = leaderboard_scores.scope_id
+            AND leaderboard_paid_periods.period_type = leaderboard_scores.period_type
+            AND leaderboard_paid_periods.period_start = leaderboard_scores.period_start
+    )
+ORDER BY leaderboard_scores.period_start ASC;
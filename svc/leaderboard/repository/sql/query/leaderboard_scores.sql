@@ -0,0 +1,68 @@
+-- name: AddLeaderboardScore :exec
+INSERT INTO leaderboard_scores (
+    scope_type,
+    scope_id,
+    period_type,
+    period_start,
+    user_id,
+    score,
+    wins,
+    reached_at
+)
+VALUES (
+    @scope_type,
+    @scope_id,
+    @period_type,
+    @period_start,
+    @user_id,
+    @score,
+    @wins,
+    @reached_at
+) ON CONFLICT (scope_type, scope_id, period_type, period_start, user_id) DO
+UPDATE
+SET score = leaderboard_scores.score + EXCLUDED.score,
+    wins = leaderboard_scores.wins + EXCLUDED.wins,
+    reached_at = EXCLUDED.reached_at;
+-- name: GetLeaderboardRanking :many
+SELECT user_id,
+    score,
+    wins,
+    reached_at,
+    ROW_NUMBER() OVER (
+        ORDER BY score DESC,
+            wins DESC,
+            reached_at ASC,
+            user_id ASC
+    )::INT AS rank
+FROM leaderboard_scores
+WHERE scope_type = @scope_type
+    AND scope_id = @scope_id
+    AND period_type = @period_type
+    AND period_start = @period_start
+ORDER BY rank ASC
+LIMIT @limit_val OFFSET @offset_val;
+-- name: GetLeaderboardUserRank :one
+WITH ranking AS (
+    SELECT user_id,
+        score,
+        wins,
+        reached_at,
+        ROW_NUMBER() OVER (
+            ORDER BY score DESC,
+                wins DESC,
+                reached_at ASC,
+                user_id ASC
+        )::INT AS rank
+    FROM leaderboard_scores
+    WHERE scope_type = @scope_type
+        AND scope_id = @scope_id
+        AND period_type = @period_type
+        AND period_start = @period_start
+)
+SELECT user_id,
+    score,
+    wins,
+    reached_at,
+    rank
+FROM ranking
+WHERE user_id = @user_id;
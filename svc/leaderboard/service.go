@@ -0,0 +1,534 @@
+package leaderboard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/leaderboard/repository"
+	"github.com/SatorNetwork/sator-api/svc/multipliers"
+)
+
+// RelationTypeLeaderboard indicates that reward is a leaderboard payout
+const RelationTypeLeaderboard = "leaderboard"
+
+type (
+	// Service struct
+	Service struct {
+		db         *sql.DB
+		repo       leaderboardRepository
+		ac         authClient
+		rc         rewardsClient
+		getLocker  db.GetLocker
+		neighbours int32
+
+		multiplierFn rewardMultiplierFunc // returns the user's bonus percent added to payouts
+	}
+
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
+	rewardMultiplierFunc func(ctx context.Context, userID uuid.UUID) (int32, error)
+
+	// Score is a result which user earned in the quiz, game, etc.
+	Score struct {
+		UserID      uuid.UUID
+		Points      float64
+		Wins        int32
+		ShowID      uuid.UUID // optional, nil uuid if the score is not related to a show
+		ChallengeID uuid.UUID // optional, nil uuid if the score is not related to a challenge
+	}
+
+	// Leaderboard struct
+	Leaderboard struct {
+		ScopeType   string    `json:"scope_type"`
+		ScopeID     string    `json:"scope_id,omitempty"`
+		PeriodType  string    `json:"period_type"`
+		PeriodStart time.Time `json:"period_start"`
+		Entries     []Entry   `json:"entries"`
+		Me          *Entry    `json:"me,omitempty"`
+		Neighbours  []Entry   `json:"neighbours,omitempty"`
+	}
+
+	// Entry is a user's position in leaderboard
+	Entry struct {
+		Rank     int32   `json:"rank"`
+		UserID   string  `json:"user_id"`
+		Username string  `json:"username"`
+		Score    float64 `json:"score"`
+		Wins     int32   `json:"wins"`
+	}
+
+	// PayoutRule defines amount paid to each user within ranks range at period close
+	PayoutRule struct {
+		ID         string  `json:"id"`
+		ScopeType  string  `json:"scope_type"`
+		ScopeID    string  `json:"scope_id,omitempty"`
+		PeriodType string  `json:"period_type"`
+		RankFrom   int32   `json:"rank_from"`
+		RankTo     int32   `json:"rank_to"`
+		Amount     float64 `json:"amount"`
+	}
+
+	leaderboardRepository interface {
+		AddLeaderboardScore(ctx context.Context, arg repository.AddLeaderboardScoreParams) error
+		GetLeaderboardRanking(ctx context.Context, arg repository.GetLeaderboardRankingParams) ([]repository.GetLeaderboardRankingRow, error)
+		GetLeaderboardUserRank(ctx context.Context, arg repository.GetLeaderboardUserRankParams) (repository.GetLeaderboardUserRankRow, error)
+
+		AddLeaderboardPayoutRule(ctx context.Context, arg repository.AddLeaderboardPayoutRuleParams) (repository.LeaderboardPayoutRule, error)
+		GetLeaderboardPayoutRules(ctx context.Context) ([]repository.LeaderboardPayoutRule, error)
+		DeleteLeaderboardPayoutRuleByID(ctx context.Context, id uuid.UUID) error
+		AddLeaderboardPayout(ctx context.Context, arg repository.AddLeaderboardPayoutParams) error
+		DeleteLeaderboardPayout(ctx context.Context, arg repository.DeleteLeaderboardPayoutParams) error
+		AddLeaderboardPaidPeriod(ctx context.Context, arg repository.AddLeaderboardPaidPeriodParams) error
+		GetUnpaidLeaderboardPeriods(ctx context.Context, arg repository.GetUnpaidLeaderboardPeriodsParams) ([]time.Time, error)
+
+		WithTx(tx *sql.Tx) *repository.Queries
+	}
+
+	authClient interface {
+		GetUsernameByID(ctx context.Context, id uuid.UUID) (string, error)
+	}
+
+	rewardsClient interface {
+		AddDepositTransaction(ctx context.Context, userID, relationID uuid.UUID, relationType string, amount float64) error
+	}
+)
+
+// NewService is a factory function,
+// returns a new instance of the Service interface implementation
+func NewService(dbConn *sql.DB, repo leaderboardRepository, ac authClient, rc rewardsClient, getLocker db.GetLocker, opt ...ServiceOption) *Service {
+	if repo == nil {
+		log.Fatalln("leaderboard repository is not set")
+	}
+	if ac == nil {
+		log.Fatalln("auth client is not set")
+	}
+	if rc == nil {
+		log.Fatalln("rewards client is not set")
+	}
+
+	s := &Service{
+		db:         dbConn,
+		repo:       repo,
+		ac:         ac,
+		rc:         rc,
+		getLocker:  getLocker,
+		neighbours: 2,
+	}
+
+	for _, fn := range opt {
+		fn(s)
+	}
+
+	if s.getLocker != nil {
+		s.startPayouts()
+	}
+
+	return s
+}
+
+// WithNeighboursNumber option
+// Sets number of users shown above and below the caller's rank
+func WithNeighboursNumber(n int32) ServiceOption {
+	return func(s *Service) {
+		if n > 0 {
+			s.neighbours = n
+		}
+	}
+}
+
+// WithRewardMultiplierFunc option
+// Adds the user's multiplier bonus to leaderboard payouts
+func WithRewardMultiplierFunc(fn rewardMultiplierFunc) ServiceOption {
+	return func(s *Service) {
+		s.multiplierFn = fn
+	}
+}
+
+// RecordScore adds the score to global leaderboards and leaderboards of the related show and challenge
+// for every period.
+func (s *Service) RecordScore(ctx context.Context, sc Score) error {
+	if sc.Points < 0 || sc.Wins < 0 {
+		return fmt.Errorf("%w: score must not be negative", ErrInvalidParameter)
+	}
+	if sc.Points == 0 && sc.Wins == 0 {
+		return nil
+	}
+
+	scopes := []Board{{ScopeType: ScopeGlobal}}
+	if sc.ShowID != uuid.Nil {
+		scopes = append(scopes, Board{ScopeType: ScopeShow, ScopeID: sc.ShowID})
+	}
+	if sc.ChallengeID != uuid.Nil {
+		scopes = append(scopes, Board{ScopeType: ScopeChallenge, ScopeID: sc.ChallengeID})
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+	now := time.Now().UTC()
+
+	for _, scope := range scopes {
+		for _, period := range Periods() {
+			start, err := periodStart(period, now)
+			if err != nil {
+				return err
+			}
+
+			if err := repo.AddLeaderboardScore(ctx, repository.AddLeaderboardScoreParams{
+				ScopeType:   scope.ScopeType,
+				ScopeID:     scope.ScopeID,
+				PeriodType:  period,
+				PeriodStart: start,
+				UserID:      sc.UserID,
+				Score:       sc.Points,
+				Wins:        sc.Wins,
+				ReachedAt:   now,
+			}); err != nil {
+				return fmt.Errorf("could not add %s %s score: %w", scope.ScopeType, period, err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetLeaderboard returns ranking of the current period,
+// the caller's own rank and users next to the caller.
+func (s *Service) GetLeaderboard(ctx context.Context, userID uuid.UUID, b Board, limit, offset int32) (Leaderboard, error) {
+	if err := validateBoard(b); err != nil {
+		return Leaderboard{}, err
+	}
+
+	start, err := periodStart(b.PeriodType, time.Now())
+	if err != nil {
+		return Leaderboard{}, err
+	}
+
+	result := Leaderboard{
+		ScopeType:   b.ScopeType,
+		PeriodType:  b.PeriodType,
+		PeriodStart: start,
+	}
+	if b.ScopeID != uuid.Nil {
+		result.ScopeID = b.ScopeID.String()
+	}
+
+	result.Entries, err = s.getRanking(ctx, b, start, limit, offset)
+	if err != nil {
+		return Leaderboard{}, err
+	}
+
+	me, err := s.repo.GetLeaderboardUserRank(ctx, repository.GetLeaderboardUserRankParams{
+		ScopeType:   b.ScopeType,
+		ScopeID:     b.ScopeID,
+		PeriodType:  b.PeriodType,
+		PeriodStart: start,
+		UserID:      userID,
+	})
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return result, nil
+		}
+		return Leaderboard{}, fmt.Errorf("could not get user rank: %w", err)
+	}
+
+	result.Me = &Entry{
+		Rank:     me.Rank,
+		UserID:   me.UserID.String(),
+		Username: s.getUsername(ctx, me.UserID),
+		Score:    me.Score,
+		Wins:     me.Wins,
+	}
+
+	from, to := neighboursRange(me.Rank, s.neighbours)
+	result.Neighbours, err = s.getRanking(ctx, b, start, to-from+1, from-1)
+	if err != nil {
+		return Leaderboard{}, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) getRanking(ctx context.Context, b Board, start time.Time, limit, offset int32) ([]Entry, error) {
+	rows, err := s.repo.GetLeaderboardRanking(ctx, repository.GetLeaderboardRankingParams{
+		ScopeType:   b.ScopeType,
+		ScopeID:     b.ScopeID,
+		PeriodType:  b.PeriodType,
+		PeriodStart: start,
+		LimitVal:    limit,
+		OffsetVal:   offset,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get leaderboard ranking: %w", err)
+	}
+
+	result := make([]Entry, 0, len(rows))
+	for _, r := range rows {
+		result = append(result, Entry{
+			Rank:     r.Rank,
+			UserID:   r.UserID.String(),
+			Username: s.getUsername(ctx, r.UserID),
+			Score:    r.Score,
+			Wins:     r.Wins,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *Service) getUsername(ctx context.Context, userID uuid.UUID) string {
+	username, err := s.ac.GetUsernameByID(ctx, userID)
+	if err != nil {
+		log.Printf("could not get username of user %s: %v", userID, err)
+		return ""
+	}
+	return username
+}
+
+// AddPayoutRule sets amount paid out to users within ranks range at period close
+func (s *Service) AddPayoutRule(ctx context.Context, r PayoutRule) (PayoutRule, error) {
+	scopeID := uuid.Nil
+	if r.ScopeID != "" {
+		id, err := uuid.Parse(r.ScopeID)
+		if err != nil {
+			return PayoutRule{}, fmt.Errorf("%w: scope id", ErrInvalidParameter)
+		}
+		scopeID = id
+	}
+
+	if err := validateBoard(Board{ScopeType: r.ScopeType, ScopeID: scopeID, PeriodType: r.PeriodType}); err != nil {
+		return PayoutRule{}, err
+	}
+	if r.PeriodType == PeriodAllTime {
+		return PayoutRule{}, fmt.Errorf("%w: all-time leaderboard is never closed", ErrInvalidParameter)
+	}
+	if r.RankFrom < 1 || r.RankTo < r.RankFrom {
+		return PayoutRule{}, fmt.Errorf("%w: invalid ranks range", ErrInvalidParameter)
+	}
+	if r.Amount <= 0 {
+		return PayoutRule{}, fmt.Errorf("%w: amount must be greater than zero", ErrInvalidParameter)
+	}
+
+	rule, err := s.repo.AddLeaderboardPayoutRule(ctx, repository.AddLeaderboardPayoutRuleParams{
+		ScopeType:  r.ScopeType,
+		ScopeID:    scopeID,
+		PeriodType: r.PeriodType,
+		RankFrom:   r.RankFrom,
+		RankTo:     r.RankTo,
+		Amount:     r.Amount,
+	})
+	if err != nil {
+		return PayoutRule{}, fmt.Errorf("could not add payout rule: %w", err)
+	}
+
+	return castToPayoutRule(rule), nil
+}
+
+// GetPayoutRules returns list of all payout rules
+func (s *Service) GetPayoutRules(ctx context.Context) ([]PayoutRule, error) {
+	rules, err := s.repo.GetLeaderboardPayoutRules(ctx)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get payout rules: %w", err)
+	}
+
+	result := make([]PayoutRule, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, castToPayoutRule(r))
+	}
+
+	return result, nil
+}
+
+// DeletePayoutRuleByID deletes payout rule
+func (s *Service) DeletePayoutRuleByID(ctx context.Context, id uuid.UUID) error {
+	if err := s.repo.DeleteLeaderboardPayoutRuleByID(ctx, id); err != nil {
+		return fmt.Errorf("could not delete payout rule: %w", err)
+	}
+
+	return nil
+}
+
+// PayOutClosedPeriods pays out rewards for all closed periods of each leaderboard with payout rules,
+// which are not paid out completely yet, e.g. because the job missed the period close or a deposit failed.
+// Every user is paid at most once per period, so it's safe to run it repeatedly.
+func (s *Service) PayOutClosedPeriods(ctx context.Context) error {
+	lock, err := s.getLocker.GetLock(ctx, "leaderboard-payouts")
+	if err != nil {
+		return fmt.Errorf("can't get lock: %w", err)
+	}
+	if ok, err := lock.Lock(ctx); err != nil || !ok {
+		// payouts are running on another instance
+		_ = lock.Unlock(context.Background())
+		return err
+	}
+	defer func() {
+		if err := lock.Unlock(context.Background()); err != nil {
+			log.Printf("can't release leaderboard payouts lock: %v", err)
+		}
+	}()
+
+	rules, err := s.repo.GetLeaderboardPayoutRules(ctx)
+	if err != nil && !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get payout rules: %w", err)
+	}
+
+	boards := make(map[Board][]repository.LeaderboardPayoutRule)
+	for _, r := range rules {
+		b := Board{ScopeType: r.ScopeType, ScopeID: r.ScopeID, PeriodType: r.PeriodType}
+		boards[b] = append(boards[b], r)
+	}
+
+	now := time.Now()
+	for b, rules := range boards {
+		since, before, ok := closedPeriodsRange(b.PeriodType, rules, now)
+		if !ok {
+			continue
+		}
+		periods, err := s.repo.GetUnpaidLeaderboardPeriods(ctx, repository.GetUnpaidLeaderboardPeriodsParams{
+			ScopeType:  b.ScopeType,
+			ScopeID:    b.ScopeID,
+			PeriodType: b.PeriodType,
+			Since:      since,
+			Before:     before,
+		})
+		if err != nil && !db.IsNotFoundError(err) {
+			log.Printf("can't get unpaid periods of %s %s leaderboard %s: %v", b.PeriodType, b.ScopeType, b.ScopeID, err)
+			continue
+		}
+		for _, start := range periods {
+			if err := s.payOutPeriod(ctx, b, start, rules); err != nil {
+				log.Printf("can't pay out %s %s leaderboard %s for period %s: %v", b.PeriodType, b.ScopeType, b.ScopeID, start, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) payOutPeriod(ctx context.Context, b Board, start time.Time, rules []repository.LeaderboardPayoutRule) error {
+	rows, err := s.repo.GetLeaderboardRanking(ctx, repository.GetLeaderboardRankingParams{
+		ScopeType:   b.ScopeType,
+		ScopeID:     b.ScopeID,
+		PeriodType:  b.PeriodType,
+		PeriodStart: start,
+		LimitVal:    maxPaidRank(rules),
+		OffsetVal:   0,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get leaderboard ranking: %w", err)
+	}
+
+	var failed int
+	for _, r := range rows {
+		amount := payoutForRank(rules, r.Rank)
+		if amount <= 0 {
+			continue
+		}
+		if err := s.payOut(ctx, b, start, r, amount); err != nil {
+			log.Printf("can't pay out leaderboard reward to user %s: %v", r.UserID, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		// the period is paid out again on the next run
+		return fmt.Errorf("could not pay out %d of %d users", failed, len(rows))
+	}
+
+	if err := s.repo.AddLeaderboardPaidPeriod(ctx, repository.AddLeaderboardPaidPeriodParams{
+		ScopeType:   b.ScopeType,
+		ScopeID:     b.ScopeID,
+		PeriodType:  b.PeriodType,
+		PeriodStart: start,
+	}); err != nil {
+		return fmt.Errorf("could not mark period as paid out: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) payOut(ctx context.Context, b Board, start time.Time, r repository.GetLeaderboardRankingRow, amount float64) error {
+	if s.multiplierFn != nil {
+		multiplier, err := s.multiplierFn(ctx, r.UserID)
+		if err != nil {
+			return fmt.Errorf("could not get user's multiplier: %w", err)
+		}
+		amount = multipliers.Apply(amount, multiplier)
+	}
+
+	// payout is stored before the deposit, so the user is never paid twice for the period
+	if err := s.repo.AddLeaderboardPayout(ctx, repository.AddLeaderboardPayoutParams{
+		ScopeType:   b.ScopeType,
+		ScopeID:     b.ScopeID,
+		PeriodType:  b.PeriodType,
+		PeriodStart: start,
+		UserID:      r.UserID,
+		Rank:        r.Rank,
+		Amount:      amount,
+	}); err != nil {
+		if db.IsDuplicateError(err) {
+			// already paid out
+			return nil
+		}
+		return fmt.Errorf("could not store payout: %w", err)
+	}
+
+	if err := s.rc.AddDepositTransaction(ctx, r.UserID, b.ScopeID, RelationTypeLeaderboard, amount); err != nil {
+		// payout is removed, so the user is paid out on the next run
+		if derr := s.repo.DeleteLeaderboardPayout(context.Background(), repository.DeleteLeaderboardPayoutParams{
+			ScopeType:   b.ScopeType,
+			ScopeID:     b.ScopeID,
+			PeriodType:  b.PeriodType,
+			PeriodStart: start,
+			UserID:      r.UserID,
+		}); derr != nil {
+			log.Printf("can't delete leaderboard payout of user %s: %v", r.UserID, derr)
+		}
+		return fmt.Errorf("could not add deposit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// startPayouts runs PayOutClosedPeriods every hour
+func (s *Service) startPayouts() {
+	c := cron.New()
+	if _, err := c.AddFunc("5 * * * *", func() {
+		if err := s.PayOutClosedPeriods(context.Background()); err != nil {
+			log.Printf("can't pay out leaderboard rewards: %v", err)
+		}
+	}); err != nil {
+		log.Printf("can't register leaderboard-payouts callback: %v", err)
+		return
+	}
+
+	c.Start()
+}
+
+func castToPayoutRule(r repository.LeaderboardPayoutRule) PayoutRule {
+	result := PayoutRule{
+		ID:         r.ID.String(),
+		ScopeType:  r.ScopeType,
+		PeriodType: r.PeriodType,
+		RankFrom:   r.RankFrom,
+		RankTo:     r.RankTo,
+		Amount:     r.Amount,
+	}
+	if r.ScopeID != uuid.Nil {
+		result.ScopeID = r.ScopeID.String()
+	}
+
+	return result
+}
@@ -0,0 +1,128 @@
+package leaderboard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	jwtkit "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+)
+
+// Predefined request query keys
+const (
+	pageParam         = "page"
+	itemsPerPageParam = "items_per_page"
+	scopeParam        = "scope"
+	scopeIDParam      = "scope_id"
+	periodParam       = "period"
+)
+
+type (
+	logger interface {
+		Log(keyvals ...interface{}) error
+	}
+)
+
+// MakeHTTPHandler ...
+func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
+	r := chi.NewRouter()
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
+		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
+		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+	}
+
+	r.Get("/", httptransport.NewServer(
+		e.GetLeaderboard,
+		decodeGetLeaderboardRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/payout-rules", httptransport.NewServer(
+		e.AddPayoutRule,
+		decodeAddPayoutRuleRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/payout-rules", httptransport.NewServer(
+		e.GetPayoutRules,
+		decodeGetPayoutRulesRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/payout-rules/{rule_id}", httptransport.NewServer(
+		e.DeletePayoutRuleByID,
+		decodePayoutRuleIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	return r
+}
+
+func decodeGetLeaderboardRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := GetLeaderboardRequest{
+		Scope:   r.URL.Query().Get(scopeParam),
+		ScopeID: r.URL.Query().Get(scopeIDParam),
+		Period:  r.URL.Query().Get(periodParam),
+		PaginationRequest: utils.PaginationRequest{
+			Page:         utils.StrToInt32(r.URL.Query().Get(pageParam)),
+			ItemsPerPage: utils.StrToInt32(r.URL.Query().Get(itemsPerPageParam)),
+		},
+	}
+	if req.Scope == "" {
+		req.Scope = ScopeGlobal
+	}
+	if req.Period == "" {
+		req.Period = PeriodWeekly
+	}
+
+	return req, nil
+}
+
+func decodeAddPayoutRuleRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req AddPayoutRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeGetPayoutRulesRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodePayoutRuleIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "rule_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed rule_id", ErrInvalidParameter)
+	}
+
+	return id, nil
+}
+
+// returns http error code by error type
+func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+
+	if errors.Is(err, ErrInvalidParameter) {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	return httpencoder.CodeAndMessageFrom(err)
+}
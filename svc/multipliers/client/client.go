@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Client struct
+	Client struct {
+		s service
+	}
+
+	service interface {
+		GetMultiplier(ctx context.Context, userID uuid.UUID) (int32, error)
+	}
+)
+
+// New multipliers service client implementation
+func New(s service) *Client {
+	return &Client{s: s}
+}
+
+// GetMultiplier returns the user's total bonus percent which is added to rewards
+func (c *Client) GetMultiplier(ctx context.Context, userID uuid.UUID) (int32, error) {
+	return c.s.GetMultiplier(ctx, userID)
+}
@@ -0,0 +1,282 @@
+package multipliers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+	"github.com/SatorNetwork/sator-api/lib/validator"
+)
+
+type (
+	// Endpoints collection of multipliers service
+	Endpoints struct {
+		GetMyMultiplier endpoint.Endpoint
+
+		GetRules   endpoint.Endpoint
+		UpdateRule endpoint.Endpoint
+
+		AddBoost        endpoint.Endpoint
+		GetBoostByID    endpoint.Endpoint
+		GetBoosts       endpoint.Endpoint
+		UpdateBoost     endpoint.Endpoint
+		DeleteBoostByID endpoint.Endpoint
+	}
+
+	service interface {
+		GetBreakdown(ctx context.Context, userID uuid.UUID) (Breakdown, error)
+
+		GetRules(ctx context.Context) ([]Rule, error)
+		UpdateRule(ctx context.Context, r Rule) error
+
+		AddBoost(ctx context.Context, b Boost) (Boost, error)
+		GetBoostByID(ctx context.Context, id uuid.UUID) (Boost, error)
+		GetBoosts(ctx context.Context, limit, offset int32) ([]Boost, error)
+		UpdateBoost(ctx context.Context, b Boost) error
+		DeleteBoostByID(ctx context.Context, id uuid.UUID) error
+	}
+
+	// UpdateRuleRequest struct
+	UpdateRuleRequest struct {
+		Factor         string  `json:"-" validate:"required"`
+		Enabled        bool    `json:"enabled"`
+		PercentPerUnit float64 `json:"percent_per_unit" validate:"gte=0"`
+		MaxPercent     float64 `json:"max_percent" validate:"gte=0"`
+	}
+
+	// AddBoostRequest struct
+	AddBoostRequest struct {
+		Title    string    `json:"title" validate:"required"`
+		Percent  float64   `json:"percent" validate:"required,gt=0"`
+		StartsAt time.Time `json:"starts_at" validate:"required"`
+		EndsAt   time.Time `json:"ends_at" validate:"required"`
+		Disabled bool      `json:"disabled"`
+	}
+
+	// UpdateBoostRequest struct
+	UpdateBoostRequest struct {
+		ID string `json:"-" validate:"required,uuid"`
+		AddBoostRequest
+	}
+
+	// GetBoostsRequest struct
+	GetBoostsRequest struct {
+		utils.PaginationRequest
+	}
+)
+
+// MakeEndpoints ...
+func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
+	validateFunc := validator.ValidateStruct()
+
+	e := Endpoints{
+		GetMyMultiplier: MakeGetMyMultiplierEndpoint(s),
+
+		GetRules:   MakeGetRulesEndpoint(s),
+		UpdateRule: MakeUpdateRuleEndpoint(s, validateFunc),
+
+		AddBoost:        MakeAddBoostEndpoint(s, validateFunc),
+		GetBoostByID:    MakeGetBoostByIDEndpoint(s),
+		GetBoosts:       MakeGetBoostsEndpoint(s, validateFunc),
+		UpdateBoost:     MakeUpdateBoostEndpoint(s, validateFunc),
+		DeleteBoostByID: MakeDeleteBoostByIDEndpoint(s),
+	}
+
+	// setup middlewares for each endpoints
+	if len(m) > 0 {
+		for _, mdw := range m {
+			e.GetMyMultiplier = mdw(e.GetMyMultiplier)
+			e.GetRules = mdw(e.GetRules)
+			e.UpdateRule = mdw(e.UpdateRule)
+			e.AddBoost = mdw(e.AddBoost)
+			e.GetBoostByID = mdw(e.GetBoostByID)
+			e.GetBoosts = mdw(e.GetBoosts)
+			e.UpdateBoost = mdw(e.UpdateBoost)
+			e.DeleteBoostByID = mdw(e.DeleteBoostByID)
+		}
+	}
+
+	return e
+}
+
+// MakeGetMyMultiplierEndpoint ...
+func MakeGetMyMultiplierEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		resp, err := s.GetBreakdown(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetRulesEndpoint ...
+func MakeGetRulesEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.GetRules(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeUpdateRuleEndpoint ...
+func MakeUpdateRuleEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(UpdateRuleRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := s.UpdateRule(ctx, Rule{
+			Factor:         req.Factor,
+			Enabled:        req.Enabled,
+			PercentPerUnit: req.PercentPerUnit,
+			MaxPercent:     req.MaxPercent,
+		}); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeAddBoostEndpoint ...
+func MakeAddBoostEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(AddBoostRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.AddBoost(ctx, req.toBoost(""))
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetBoostByIDEndpoint ...
+func MakeGetBoostByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w boost id: %v", ErrInvalidParameter, err)
+		}
+
+		resp, err := s.GetBoostByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetBoostsEndpoint ...
+func MakeGetBoostsEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(GetBoostsRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.GetBoosts(ctx, req.Limit(), req.Offset())
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeUpdateBoostEndpoint ...
+func MakeUpdateBoostEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(UpdateBoostRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := s.UpdateBoost(ctx, req.toBoost(req.ID)); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeDeleteBoostByIDEndpoint ...
+func MakeDeleteBoostByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w boost id: %v", ErrInvalidParameter, err)
+		}
+
+		if err := s.DeleteBoostByID(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+func (r AddBoostRequest) toBoost(id string) Boost {
+	return Boost{
+		ID:       id,
+		Title:    r.Title,
+		Percent:  r.Percent,
+		StartsAt: r.StartsAt,
+		EndsAt:   r.EndsAt,
+		Disabled: r.Disabled,
+	}
+}
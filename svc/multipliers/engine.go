@@ -0,0 +1,105 @@
+package multipliers
+
+import (
+	"math"
+
+	"github.com/SatorNetwork/sator-api/svc/multipliers/repository"
+)
+
+// Multiplier factors
+const (
+	FactorStakeLevel = "stake_level" // unit is a percent of the user's stake level
+	FactorNFT        = "nft"         // unit is an NFT held by the user
+	FactorStreak     = "streak"      // unit is a day of the user's login streak
+	FactorKYC        = "kyc"         // unit is a KYC tier passed by the user
+	FactorBoost      = "boost"       // event boost, percent is set by the boost itself
+)
+
+// Stacking modes define how factors are combined into the total multiplier
+const (
+	StackingAdditive       = "additive"       // percents are summed up
+	StackingMultiplicative = "multiplicative" // multipliers are multiplied by each other
+)
+
+// Factors returns list of the factors configured by rules
+func Factors() []string {
+	return []string{
+		FactorStakeLevel,
+		FactorNFT,
+		FactorStreak,
+		FactorKYC,
+	}
+}
+
+func isFactorSupported(factor string) bool {
+	for _, f := range Factors() {
+		if f == factor {
+			return true
+		}
+	}
+	return false
+}
+
+func isStackingModeSupported(mode string) bool {
+	return mode == StackingAdditive || mode == StackingMultiplicative
+}
+
+// factorPercent returns bonus percent of the factor according to the rule,
+// zero means the factor is disabled or the user has no units of it.
+func factorPercent(rule repository.MultiplierRule, units float64) float64 {
+	if !rule.Enabled || units <= 0 || rule.PercentPerUnit <= 0 {
+		return 0
+	}
+
+	percent := units * rule.PercentPerUnit
+	if rule.MaxPercent > 0 && percent > rule.MaxPercent {
+		return rule.MaxPercent
+	}
+
+	return percent
+}
+
+// combine stacks bonus percents of the factors according to the mode
+// and returns the total bonus percent before and after the cap is applied.
+func combine(mode string, maxPercent float64, percents ...float64) (uncapped, total float64) {
+	switch mode {
+	case StackingMultiplicative:
+		m := 1.0
+		for _, p := range percents {
+			m *= 1 + p/100
+		}
+		uncapped = (m - 1) * 100
+	default:
+		for _, p := range percents {
+			uncapped += p
+		}
+	}
+
+	// drop float noise of multiplication, percents are stored with two decimals precision
+	uncapped = math.Round(uncapped*100) / 100
+
+	total = uncapped
+	if total < 0 {
+		total = 0
+	}
+	if maxPercent > 0 && total > maxPercent {
+		total = maxPercent
+	}
+
+	return uncapped, total
+}
+
+// Bonus returns the bonus which the user's multiplier percent adds to the reward amount,
+// all rewarding services use it, so the bonus is the same for any kind of reward.
+func Bonus(amount float64, multiplier int32) float64 {
+	if amount <= 0 || multiplier <= 0 {
+		return 0
+	}
+
+	return amount / 100 * float64(multiplier)
+}
+
+// Apply returns the reward amount increased by the user's multiplier percent
+func Apply(amount float64, multiplier int32) float64 {
+	return amount + Bonus(amount, multiplier)
+}
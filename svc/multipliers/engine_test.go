@@ -0,0 +1,131 @@
+package multipliers
+
+import (
+	"testing"
+
+	"github.com/SatorNetwork/sator-api/svc/multipliers/repository"
+)
+
+func TestFactorPercent(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  repository.MultiplierRule
+		units float64
+		want  float64
+	}{
+		{
+			name:  "disabled",
+			rule:  repository.MultiplierRule{PercentPerUnit: 5},
+			units: 2,
+			want:  0,
+		},
+		{
+			name: "no units",
+			rule: repository.MultiplierRule{Enabled: true, PercentPerUnit: 5},
+			want: 0,
+		},
+		{
+			name:  "unlimited",
+			rule:  repository.MultiplierRule{Enabled: true, PercentPerUnit: 2},
+			units: 30,
+			want:  60,
+		},
+		{
+			name:  "capped",
+			rule:  repository.MultiplierRule{Enabled: true, PercentPerUnit: 2, MaxPercent: 10},
+			units: 30,
+			want:  10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := factorPercent(tt.rule, tt.units); got != tt.want {
+				t.Errorf("factorPercent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombine(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         string
+		maxPercent   float64
+		percents     []float64
+		wantUncapped float64
+		wantTotal    float64
+	}{
+		{
+			name: "no factors",
+			mode: StackingAdditive,
+		},
+		{
+			name:         "additive",
+			mode:         StackingAdditive,
+			percents:     []float64{10, 20, 5},
+			wantUncapped: 35,
+			wantTotal:    35,
+		},
+		{
+			name:         "multiplicative",
+			mode:         StackingMultiplicative,
+			percents:     []float64{10, 20},
+			wantUncapped: 32,
+			wantTotal:    32,
+		},
+		{
+			name:         "additive capped",
+			mode:         StackingAdditive,
+			maxPercent:   25,
+			percents:     []float64{10, 20},
+			wantUncapped: 30,
+			wantTotal:    25,
+		},
+		{
+			name:         "multiplicative capped",
+			mode:         StackingMultiplicative,
+			maxPercent:   50,
+			percents:     []float64{50, 50},
+			wantUncapped: 125,
+			wantTotal:    50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uncapped, total := combine(tt.mode, tt.maxPercent, tt.percents...)
+			if uncapped != tt.wantUncapped {
+				t.Errorf("combine() uncapped = %v, want %v", uncapped, tt.wantUncapped)
+			}
+			if total != tt.wantTotal {
+				t.Errorf("combine() total = %v, want %v", total, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestBonus(t *testing.T) {
+	tests := []struct {
+		name       string
+		amount     float64
+		multiplier int32
+		want       float64
+	}{
+		{name: "no multiplier", amount: 100},
+		{name: "negative multiplier", amount: 100, multiplier: -10},
+		{name: "no amount", multiplier: 10},
+		{name: "percent of amount", amount: 200, multiplier: 15, want: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bonus(tt.amount, tt.multiplier); got != tt.want {
+				t.Errorf("Bonus() = %v, want %v", got, tt.want)
+			}
+			if got := Apply(tt.amount, tt.multiplier); got != tt.amount+tt.want {
+				t.Errorf("Apply() = %v, want %v", got, tt.amount+tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+-- +migrate Up
+-- +migrate StatementBegin
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE
+OR REPLACE FUNCTION multipliers_update_updated_at_column() RETURNS TRIGGER AS $$
+BEGIN NEW .updated_at = NOW();
+RETURN NEW;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+-- rules of the user's reward multiplier factors, percents are added to the reward
+CREATE TABLE IF NOT EXISTS multiplier_rules (
+    factor VARCHAR PRIMARY KEY,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    percent_per_unit DOUBLE PRECISION NOT NULL DEFAULT 0,
+    max_percent DOUBLE PRECISION NOT NULL DEFAULT 0, -- 0 means unlimited
+    updated_at TIMESTAMP DEFAULT NULL
+);
+CREATE TRIGGER update_multiplier_rules_modtime BEFORE
+UPDATE ON multiplier_rules FOR EACH ROW EXECUTE PROCEDURE multipliers_update_updated_at_column();
+INSERT INTO multiplier_rules (factor, enabled, percent_per_unit, max_percent)
+VALUES ('stake_level', TRUE, 1, 0),
+    ('nft', FALSE, 1, 10),
+    ('streak', FALSE, 1, 10),
+    ('kyc', FALSE, 5, 0)
+ON CONFLICT DO NOTHING;
+
+-- time-limited boosts applied to all users, e.g. during events
+CREATE TABLE IF NOT EXISTS multiplier_boosts (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title VARCHAR NOT NULL,
+    percent DOUBLE PRECISION NOT NULL,
+    starts_at TIMESTAMP NOT NULL DEFAULT now(),
+    ends_at TIMESTAMP NOT NULL,
+    disabled BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX multiplier_boosts_period ON multiplier_boosts USING BTREE (starts_at, ends_at);
+CREATE TRIGGER update_multiplier_boosts_modtime BEFORE
+UPDATE ON multiplier_boosts FOR EACH ROW EXECUTE PROCEDURE multipliers_update_updated_at_column();
+-- +migrate Down
+DROP TRIGGER IF EXISTS update_multiplier_boosts_modtime ON multiplier_boosts;
+DROP TABLE IF EXISTS multiplier_boosts;
+DROP TRIGGER IF EXISTS update_multiplier_rules_modtime ON multiplier_rules;
+DROP TABLE IF EXISTS multiplier_rules;
+DROP FUNCTION IF EXISTS multipliers_update_updated_at_column();
@@ -0,0 +1,42 @@
+-- name: AddMultiplierBoost :one
+INSERT INTO multiplier_boosts (
+    title,
+    percent,
+    starts_at,
+    ends_at,
+    disabled
+)
+VALUES (
+    @title,
+    @percent,
+    @starts_at,
+    @ends_at,
+    @disabled
+) RETURNING *;
+-- name: GetMultiplierBoostByID :one
+SELECT *
+FROM multiplier_boosts
+WHERE id = @id;
+-- name: GetMultiplierBoostsPaginated :many
+SELECT *
+FROM multiplier_boosts
+ORDER BY starts_at DESC
+LIMIT @limit_val OFFSET @offset_val;
+-- name: GetActiveMultiplierBoosts :many
+SELECT *
+FROM multiplier_boosts
+WHERE disabled = FALSE
+    AND starts_at <= @now
+    AND ends_at > @now
+ORDER BY starts_at ASC;
+-- name: UpdateMultiplierBoost :exec
+UPDATE multiplier_boosts
+SET title = @title,
+    percent = @percent,
+    starts_at = @starts_at,
+    ends_at = @ends_at,
+    disabled = @disabled
+WHERE id = @id;
+-- name: DeleteMultiplierBoostByID :exec
+DELETE FROM multiplier_boosts
+WHERE id = @id;
@@ -0,0 +1,10 @@
+-- name: GetMultiplierRules :many
+SELECT *
+FROM multiplier_rules
+ORDER BY factor ASC;
+-- name: UpdateMultiplierRule :exec
+UPDATE multiplier_rules
+SET enabled = @enabled,
+    percent_per_unit = @percent_per_unit,
+    max_percent = @max_percent
+WHERE factor = @factor;
@@ -0,0 +1,388 @@
+package multipliers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/multipliers/repository"
+)
+
+type (
+	// Service struct
+	Service struct {
+		repo multipliersRepository
+		sl   stakeLevelsClient
+		nc   nftClient
+		cc   campaignsClient
+		ac   authClient
+
+		stackingMode string
+		maxPercent   float64
+	}
+
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
+	// Rule defines how much a factor adds to the user's multiplier
+	Rule struct {
+		Factor         string  `json:"factor"`
+		Enabled        bool    `json:"enabled"`
+		PercentPerUnit float64 `json:"percent_per_unit"`
+		MaxPercent     float64 `json:"max_percent"` // 0 means unlimited
+	}
+
+	// Boost is a time-limited bonus applied to all users
+	Boost struct {
+		ID       string    `json:"id"`
+		Title    string    `json:"title"`
+		Percent  float64   `json:"percent"`
+		StartsAt time.Time `json:"starts_at"`
+		EndsAt   time.Time `json:"ends_at"`
+		Disabled bool      `json:"disabled"`
+	}
+
+	// Factor is a part of the user's multiplier
+	Factor struct {
+		Factor  string  `json:"factor"`
+		Title   string  `json:"title,omitempty"`
+		Units   float64 `json:"units"`
+		Percent float64 `json:"percent"`
+	}
+
+	// Breakdown explains how the user's multiplier is calculated
+	Breakdown struct {
+		Factors         []Factor `json:"factors"`
+		StackingMode    string   `json:"stacking_mode"`
+		MaxPercent      float64  `json:"max_percent"` // 0 means unlimited
+		UncappedPercent float64  `json:"uncapped_percent"`
+		Percent         float64  `json:"percent"`    // bonus added to the reward
+		Multiplier      float64  `json:"multiplier"` // reward is multiplied by
+	}
+
+	multipliersRepository interface {
+		GetMultiplierRules(ctx context.Context) ([]repository.MultiplierRule, error)
+		UpdateMultiplierRule(ctx context.Context, arg repository.UpdateMultiplierRuleParams) error
+
+		AddMultiplierBoost(ctx context.Context, arg repository.AddMultiplierBoostParams) (repository.MultiplierBoost, error)
+		DeleteMultiplierBoostByID(ctx context.Context, id uuid.UUID) error
+		GetActiveMultiplierBoosts(ctx context.Context, now time.Time) ([]repository.MultiplierBoost, error)
+		GetMultiplierBoostByID(ctx context.Context, id uuid.UUID) (repository.MultiplierBoost, error)
+		GetMultiplierBoostsPaginated(ctx context.Context, arg repository.GetMultiplierBoostsPaginatedParams) ([]repository.MultiplierBoost, error)
+		UpdateMultiplierBoost(ctx context.Context, arg repository.UpdateMultiplierBoostParams) error
+	}
+
+	stakeLevelsClient interface {
+		GetMultiplier(ctx context.Context, userID uuid.UUID) (int32, error)
+	}
+
+	nftClient interface {
+		CountNFTsByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+	}
+
+	campaignsClient interface {
+		GetLoginStreak(ctx context.Context, userID uuid.UUID) (int32, error)
+	}
+
+	authClient interface {
+		IsKYCVerified(ctx context.Context, userID uuid.UUID) (bool, error)
+	}
+)
+
+// NewService is a factory function,
+// returns a new instance of the Service interface implementation
+func NewService(repo multipliersRepository, sl stakeLevelsClient, nc nftClient, cc campaignsClient, ac authClient, opt ...ServiceOption) *Service {
+	if repo == nil {
+		log.Fatalln("multipliers repository is not set")
+	}
+	if sl == nil {
+		log.Fatalln("stake levels client is not set")
+	}
+	if nc == nil {
+		log.Fatalln("nft client is not set")
+	}
+	if cc == nil {
+		log.Fatalln("campaigns client is not set")
+	}
+	if ac == nil {
+		log.Fatalln("auth client is not set")
+	}
+
+	s := &Service{
+		repo:         repo,
+		sl:           sl,
+		nc:           nc,
+		cc:           cc,
+		ac:           ac,
+		stackingMode: StackingAdditive,
+	}
+
+	// Set up options.
+	for _, o := range opt {
+		o(s)
+	}
+
+	return s
+}
+
+// WithStackingMode sets how factors are combined, additive by default
+func WithStackingMode(mode string) ServiceOption {
+	return func(s *Service) {
+		if !isStackingModeSupported(mode) {
+			log.Fatalf("unsupported multiplier stacking mode: %s", mode)
+		}
+		s.stackingMode = mode
+	}
+}
+
+// WithMaxPercent caps the total bonus percent, 0 means unlimited
+func WithMaxPercent(p float64) ServiceOption {
+	return func(s *Service) {
+		s.maxPercent = p
+	}
+}
+
+// GetMultiplier returns the user's total bonus percent which is added to rewards.
+func (s *Service) GetMultiplier(ctx context.Context, userID uuid.UUID) (int32, error) {
+	b, err := s.GetBreakdown(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(math.Round(b.Percent)), nil
+}
+
+// GetBreakdown returns the user's multiplier with all factors it consists of
+func (s *Service) GetBreakdown(ctx context.Context, userID uuid.UUID) (Breakdown, error) {
+	rules, err := s.repo.GetMultiplierRules(ctx)
+	if err != nil && !db.IsNotFoundError(err) {
+		return Breakdown{}, fmt.Errorf("could not get multiplier rules: %w", err)
+	}
+
+	factors := make([]Factor, 0, len(rules))
+	for _, r := range rules {
+		if !r.Enabled {
+			continue
+		}
+
+		units, err := s.factorUnits(ctx, r.Factor, userID)
+		if err != nil {
+			return Breakdown{}, fmt.Errorf("could not get %s factor: %w", r.Factor, err)
+		}
+
+		factors = append(factors, Factor{
+			Factor:  r.Factor,
+			Units:   units,
+			Percent: factorPercent(r, units),
+		})
+	}
+
+	boosts, err := s.repo.GetActiveMultiplierBoosts(ctx, time.Now())
+	if err != nil && !db.IsNotFoundError(err) {
+		return Breakdown{}, fmt.Errorf("could not get active boosts: %w", err)
+	}
+	for _, b := range boosts {
+		factors = append(factors, Factor{
+			Factor:  FactorBoost,
+			Title:   b.Title,
+			Units:   1,
+			Percent: b.Percent,
+		})
+	}
+
+	percents := make([]float64, 0, len(factors))
+	for _, f := range factors {
+		percents = append(percents, f.Percent)
+	}
+	uncapped, total := combine(s.stackingMode, s.maxPercent, percents...)
+
+	return Breakdown{
+		Factors:         factors,
+		StackingMode:    s.stackingMode,
+		MaxPercent:      s.maxPercent,
+		UncappedPercent: uncapped,
+		Percent:         total,
+		Multiplier:      1 + total/100,
+	}, nil
+}
+
+// factorUnits returns how many units of the factor the user has
+func (s *Service) factorUnits(ctx context.Context, factor string, userID uuid.UUID) (float64, error) {
+	switch factor {
+	case FactorStakeLevel:
+		m, err := s.sl.GetMultiplier(ctx, userID)
+		return float64(m), err
+	case FactorNFT:
+		n, err := s.nc.CountNFTsByUserID(ctx, userID)
+		return float64(n), err
+	case FactorStreak:
+		n, err := s.cc.GetLoginStreak(ctx, userID)
+		return float64(n), err
+	case FactorKYC:
+		ok, err := s.ac.IsKYCVerified(ctx, userID)
+		if err != nil || !ok {
+			return 0, err
+		}
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+// GetRules returns rules of all multiplier factors
+func (s *Service) GetRules(ctx context.Context) ([]Rule, error) {
+	rules, err := s.repo.GetMultiplierRules(ctx)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get multiplier rules: %w", err)
+	}
+
+	result := make([]Rule, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, Rule{
+			Factor:         r.Factor,
+			Enabled:        r.Enabled,
+			PercentPerUnit: r.PercentPerUnit,
+			MaxPercent:     r.MaxPercent,
+		})
+	}
+
+	return result, nil
+}
+
+// UpdateRule updates rule of the multiplier factor
+func (s *Service) UpdateRule(ctx context.Context, r Rule) error {
+	if !isFactorSupported(r.Factor) {
+		return fmt.Errorf("%w: unsupported factor %s", ErrInvalidParameter, r.Factor)
+	}
+	if r.PercentPerUnit < 0 || r.MaxPercent < 0 {
+		return fmt.Errorf("%w: percents must not be negative", ErrInvalidParameter)
+	}
+
+	if err := s.repo.UpdateMultiplierRule(ctx, repository.UpdateMultiplierRuleParams{
+		Enabled:        r.Enabled,
+		PercentPerUnit: r.PercentPerUnit,
+		MaxPercent:     r.MaxPercent,
+		Factor:         r.Factor,
+	}); err != nil {
+		return fmt.Errorf("could not update multiplier rule: %w", err)
+	}
+
+	return nil
+}
+
+// AddBoost creates a new event boost
+func (s *Service) AddBoost(ctx context.Context, b Boost) (Boost, error) {
+	if err := validateBoost(b); err != nil {
+		return Boost{}, err
+	}
+
+	boost, err := s.repo.AddMultiplierBoost(ctx, repository.AddMultiplierBoostParams{
+		Title:    b.Title,
+		Percent:  b.Percent,
+		StartsAt: b.StartsAt,
+		EndsAt:   b.EndsAt,
+		Disabled: b.Disabled,
+	})
+	if err != nil {
+		return Boost{}, fmt.Errorf("could not add boost: %w", err)
+	}
+
+	return castToBoost(boost), nil
+}
+
+// GetBoostByID returns boost details
+func (s *Service) GetBoostByID(ctx context.Context, id uuid.UUID) (Boost, error) {
+	boost, err := s.repo.GetMultiplierBoostByID(ctx, id)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Boost{}, fmt.Errorf("%w boost", ErrNotFound)
+		}
+		return Boost{}, fmt.Errorf("could not get boost: %w", err)
+	}
+
+	return castToBoost(boost), nil
+}
+
+// GetBoosts returns list of all boosts
+func (s *Service) GetBoosts(ctx context.Context, limit, offset int32) ([]Boost, error) {
+	list, err := s.repo.GetMultiplierBoostsPaginated(ctx, repository.GetMultiplierBoostsPaginatedParams{
+		LimitVal:  limit,
+		OffsetVal: offset,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get boosts list: %w", err)
+	}
+
+	result := make([]Boost, 0, len(list))
+	for _, b := range list {
+		result = append(result, castToBoost(b))
+	}
+
+	return result, nil
+}
+
+// UpdateBoost updates event boost
+func (s *Service) UpdateBoost(ctx context.Context, b Boost) error {
+	id, err := uuid.Parse(b.ID)
+	if err != nil {
+		return fmt.Errorf("%w: boost id", ErrInvalidParameter)
+	}
+
+	if err := validateBoost(b); err != nil {
+		return err
+	}
+
+	if _, err := s.GetBoostByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateMultiplierBoost(ctx, repository.UpdateMultiplierBoostParams{
+		Title:    b.Title,
+		Percent:  b.Percent,
+		StartsAt: b.StartsAt,
+		EndsAt:   b.EndsAt,
+		Disabled: b.Disabled,
+		ID:       id,
+	}); err != nil {
+		return fmt.Errorf("could not update boost: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteBoostByID deletes event boost
+func (s *Service) DeleteBoostByID(ctx context.Context, id uuid.UUID) error {
+	if err := s.repo.DeleteMultiplierBoostByID(ctx, id); err != nil {
+		return fmt.Errorf("could not delete boost: %w", err)
+	}
+
+	return nil
+}
+
+func validateBoost(b Boost) error {
+	if b.Percent <= 0 {
+		return fmt.Errorf("%w: boost percent must be greater than zero", ErrInvalidParameter)
+	}
+	if !b.EndsAt.After(b.StartsAt) {
+		return fmt.Errorf("%w: boost must end after it starts", ErrInvalidParameter)
+	}
+
+	return nil
+}
+
+func castToBoost(b repository.MultiplierBoost) Boost {
+	return Boost{
+		ID:       b.ID.String(),
+		Title:    b.Title,
+		Percent:  b.Percent,
+		StartsAt: b.StartsAt,
+		EndsAt:   b.EndsAt,
+		Disabled: b.Disabled,
+	}
+}
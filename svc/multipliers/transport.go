@@ -0,0 +1,172 @@
+package multipliers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	jwtkit "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+)
+
+// Predefined request query keys
+const (
+	pageParam         = "page"
+	itemsPerPageParam = "items_per_page"
+)
+
+type (
+	logger interface {
+		Log(keyvals ...interface{}) error
+	}
+)
+
+// MakeHTTPHandler ...
+func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
+	r := chi.NewRouter()
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
+		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
+		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+	}
+
+	r.Get("/", httptransport.NewServer(
+		e.GetMyMultiplier,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/rules", httptransport.NewServer(
+		e.GetRules,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Put("/rules/{factor}", httptransport.NewServer(
+		e.UpdateRule,
+		decodeUpdateRuleRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/boosts", httptransport.NewServer(
+		e.AddBoost,
+		decodeAddBoostRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/boosts", httptransport.NewServer(
+		e.GetBoosts,
+		decodeGetBoostsRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/boosts/{boost_id}", httptransport.NewServer(
+		e.GetBoostByID,
+		decodeBoostIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Put("/boosts/{boost_id}", httptransport.NewServer(
+		e.UpdateBoost,
+		decodeUpdateBoostRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/boosts/{boost_id}", httptransport.NewServer(
+		e.DeleteBoostByID,
+		decodeBoostIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	return r
+}
+
+func decodeEmptyRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodeUpdateRuleRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req UpdateRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	factor := chi.URLParam(r, "factor")
+	if factor == "" {
+		return nil, fmt.Errorf("%w: missed factor", ErrInvalidParameter)
+	}
+	req.Factor = factor
+
+	return req, nil
+}
+
+func decodeAddBoostRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req AddBoostRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeGetBoostsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return GetBoostsRequest{
+		PaginationRequest: utils.PaginationRequest{
+			Page:         utils.StrToInt32(r.URL.Query().Get(pageParam)),
+			ItemsPerPage: utils.StrToInt32(r.URL.Query().Get(itemsPerPageParam)),
+		},
+	}, nil
+}
+
+func decodeBoostIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "boost_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed boost_id", ErrInvalidParameter)
+	}
+
+	return id, nil
+}
+
+func decodeUpdateBoostRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req UpdateBoostRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	id := chi.URLParam(r, "boost_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed boost_id", ErrInvalidParameter)
+	}
+	req.ID = id
+
+	return req, nil
+}
+
+// returns http error code by error type
+func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+
+	if errors.Is(err, ErrInvalidParameter) {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	return httpencoder.CodeAndMessageFrom(err)
+}
@@ -14,6 +14,7 @@ type (
 
 	service interface {
 		DoesRelationIDHasNFT(ctx context.Context, relationID uuid.UUID) (bool, error)
+		CountNFTsByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 	}
 )
 
@@ -26,3 +27,8 @@ func New(s service) *Client {
 func (c *Client) DoesRelationIDHasNFT(ctx context.Context, relationID uuid.UUID) (bool, error) {
 	return c.s.DoesRelationIDHasNFT(ctx, relationID)
 }
+
+// CountNFTsByUserID returns number of NFTs owned by the user
+func (c *Client) CountNFTsByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return c.s.CountNFTsByUserID(ctx, userID)
+}
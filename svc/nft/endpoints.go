@@ -11,6 +11,7 @@ import (
 	"github.com/SatorNetwork/sator-api/lib/rbac"
 	"github.com/SatorNetwork/sator-api/lib/utils"
 	"github.com/SatorNetwork/sator-api/lib/validator"
+	"github.com/SatorNetwork/sator-api/svc/pricing"
 )
 
 type (
@@ -24,6 +25,7 @@ type (
 		GetNFTsByUserID        endpoint.Endpoint
 		GetNFTByID             endpoint.Endpoint
 		BuyNFT                 endpoint.Endpoint
+		GetNFTQuote            endpoint.Endpoint
 		BuyNFTViaMarketplace   endpoint.Endpoint
 		GetCategories          endpoint.Endpoint
 		GetMainScreenData      endpoint.Endpoint
@@ -40,7 +42,8 @@ type (
 		GetNFTsByEpisodeID(ctx context.Context, uid, episodeID uuid.UUID, limit, offset int32) ([]*NFT, error)
 		GetNFTsByUserID(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]*NFT, error)
 		GetNFTByID(ctx context.Context, nftID, userID uuid.UUID) (*NFT, error)
-		BuyNFT(ctx context.Context, userUid uuid.UUID, nftID uuid.UUID) error
+		BuyNFT(ctx context.Context, userUid uuid.UUID, nftID uuid.UUID, quoteID uuid.UUID) error
+		GetNFTQuote(ctx context.Context, userID, nftID uuid.UUID, asset string) (pricing.Quote, error)
 		BuyNFTViaMarketplace(ctx context.Context, userID uuid.UUID, mintAddress string) (*Empty, error)
 		GetCategories(ctx context.Context) ([]*Category, error)
 		GetMainScreenCategory(ctx context.Context) (*Category, error)
@@ -127,6 +130,16 @@ type (
 	GetNFTsByWalletAddressRequest struct {
 		WalletAddr string `json:"wallet_addr"`
 	}
+
+	BuyNFTRequest struct {
+		NFTID   string `json:"nft_id" validate:"required,uuid"`
+		QuoteID string `json:"quote_id,omitempty" validate:"omitempty,uuid"`
+	}
+
+	GetNFTQuoteRequest struct {
+		NFTID string `json:"nft_id" validate:"required,uuid"`
+		Asset string `json:"asset" validate:"required,oneof=SAO SOL USDC"`
+	}
 )
 
 func FromServiceNFTs(nfts []*NFT) []*TransportNFT {
@@ -225,7 +238,8 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 		GetNFTsByEpisodeID:     MakeGetNFTsByEpisodeIDEndpoint(s, validateFunc),
 		GetNFTsByUserID:        MakeGetNFTsByUserIDEndpoint(s, validateFunc),
 		GetNFTByID:             MakeGetNFTByIDEndpoint(s),
-		BuyNFT:                 MakeBuyNFTEndpoint(s),
+		BuyNFT:                 MakeBuyNFTEndpoint(s, validateFunc),
+		GetNFTQuote:            MakeGetNFTQuoteEndpoint(s, validateFunc),
 		BuyNFTViaMarketplace:   MakeBuyNFTViaMarketplaceEndpoint(s),
 		GetCategories:          MakeGetCategoriesEndpoint(s),
 		GetMainScreenData:      MakeGetMainScreenDataEndpoint(s),
@@ -245,6 +259,7 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 			e.GetNFTsByUserID = mdw(e.GetNFTsByUserID)
 			e.GetNFTByID = mdw(e.GetNFTByID)
 			e.BuyNFT = mdw(e.BuyNFT)
+			e.GetNFTQuote = mdw(e.GetNFTQuote)
 			e.BuyNFTViaMarketplace = mdw(e.BuyNFTViaMarketplace)
 			e.GetCategories = mdw(e.GetCategories)
 			e.GetMainScreenData = mdw(e.GetMainScreenData)
@@ -431,19 +446,27 @@ func MakeGetNFTByIDEndpoint(s service) endpoint.Endpoint {
 	}
 }
 
-func MakeBuyNFTEndpoint(s service) endpoint.Endpoint {
+func MakeBuyNFTEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		userUid, err := jwt.UserIDFromContext(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("could not get user profile id: %w", err)
 		}
 
-		nftID, ok := request.(string)
+		req, ok := request.(BuyNFTRequest)
 		if !ok {
-			return nil, fmt.Errorf("unexpected request type, want: string, got: %T", request)
+			return nil, fmt.Errorf("unexpected request type, want: BuyNFTRequest, got: %T", request)
+		}
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		quoteID := uuid.Nil
+		if req.QuoteID != "" {
+			quoteID = uuid.MustParse(req.QuoteID)
 		}
 
-		err = s.BuyNFT(ctx, userUid, uuid.MustParse(nftID))
+		err = s.BuyNFT(ctx, userUid, uuid.MustParse(req.NFTID), quoteID)
 		if err != nil {
 			return nil, err
 		}
@@ -452,6 +475,30 @@ func MakeBuyNFTEndpoint(s service) endpoint.Endpoint {
 	}
 }
 
+func MakeGetNFTQuoteEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		userUid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		req, ok := request.(GetNFTQuoteRequest)
+		if !ok {
+			return nil, fmt.Errorf("unexpected request type, want: GetNFTQuoteRequest, got: %T", request)
+		}
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.GetNFTQuote(ctx, userUid, uuid.MustParse(req.NFTID), req.Asset)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
 func MakeBuyNFTViaMarketplaceEndpoint(s service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		userUid, err := jwt.UserIDFromContext(ctx)
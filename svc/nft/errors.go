@@ -8,4 +8,6 @@ var (
 	ErrAlreadySold      = errors.New("this nft sold out")
 	ErrAlreadyBought    = errors.New("you already bought this NFT")
 	ErrAlreadyMinted    = errors.New("this nft minted")
+
+	ErrCreatorShareRequiresSAO = errors.New("this nft can be paid only with the list price in SAO")
 )
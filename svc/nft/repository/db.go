@@ -37,6 +37,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addNFTToCacheStmt, err = db.PrepareContext(ctx, addNFTToCache); err != nil {
 		return nil, fmt.Errorf("error preparing query AddNFTToCache: %w", err)
 	}
+	if q.countNFTItemsByOwnerIDStmt, err = db.PrepareContext(ctx, countNFTItemsByOwnerID); err != nil {
+		return nil, fmt.Errorf("error preparing query CountNFTItemsByOwnerID: %w", err)
+	}
 	if q.deleteNFTCategoryByIDStmt, err = db.PrepareContext(ctx, deleteNFTCategoryByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteNFTCategoryByID: %w", err)
 	}
@@ -118,6 +121,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addNFTToCacheStmt: %w", cerr)
 		}
 	}
+	if q.countNFTItemsByOwnerIDStmt != nil {
+		if cerr := q.countNFTItemsByOwnerIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countNFTItemsByOwnerIDStmt: %w", cerr)
+		}
+	}
 	if q.deleteNFTCategoryByIDStmt != nil {
 		if cerr := q.deleteNFTCategoryByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteNFTCategoryByIDStmt: %w", cerr)
@@ -247,6 +255,7 @@ type Queries struct {
 	addNFTItemOwnerStmt              *sql.Stmt
 	addNFTRelationStmt               *sql.Stmt
 	addNFTToCacheStmt                *sql.Stmt
+	countNFTItemsByOwnerIDStmt       *sql.Stmt
 	deleteNFTCategoryByIDStmt        *sql.Stmt
 	deleteNFTItemByIDStmt            *sql.Stmt
 	deleteNFTRelationStmt            *sql.Stmt
@@ -275,6 +284,7 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		addNFTItemOwnerStmt:              q.addNFTItemOwnerStmt,
 		addNFTRelationStmt:               q.addNFTRelationStmt,
 		addNFTToCacheStmt:                q.addNFTToCacheStmt,
+		countNFTItemsByOwnerIDStmt:       q.countNFTItemsByOwnerIDStmt,
 		deleteNFTCategoryByIDStmt:        q.deleteNFTCategoryByIDStmt,
 		deleteNFTItemByIDStmt:            q.deleteNFTItemByIDStmt,
 		deleteNFTRelationStmt:            q.deleteNFTRelationStmt,
@@ -68,6 +68,19 @@ func (q *Queries) AddNFTItemOwner(ctx context.Context, arg AddNFTItemOwnerParams
 	return err
 }
 
+const countNFTItemsByOwnerID = `-- name: CountNFTItemsByOwnerID :one
+SELECT count(DISTINCT nft_item_id)
+FROM nft_owners
+WHERE user_id = $1
+`
+
+func (q *Queries) CountNFTItemsByOwnerID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.queryRow(ctx, q.countNFTItemsByOwnerIDStmt, countNFTItemsByOwnerID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const deleteNFTItemByID = `-- name: DeleteNFTItemByID :exec
 DELETE FROM nft_items
 WHERE id = $1
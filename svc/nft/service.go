@@ -18,6 +18,7 @@ import (
 	lib_nft_marketplace "github.com/SatorNetwork/sator-api/lib/nft_marketplace"
 	lib_solana "github.com/SatorNetwork/sator-api/lib/solana"
 	"github.com/SatorNetwork/sator-api/svc/nft/repository"
+	"github.com/SatorNetwork/sator-api/svc/pricing"
 	"github.com/SatorNetwork/sator-api/svc/wallet"
 	wallet_repository "github.com/SatorNetwork/sator-api/svc/wallet/repository"
 )
@@ -30,6 +31,8 @@ type (
 		wr             walletRepository
 		sc             solanaClient
 		buyNFTFunc     buyNFTFunction
+		quoteFn        quoteFunc
+		payFn          payFunc
 
 		enableResourceIntensiveQueries bool
 	}
@@ -121,6 +124,7 @@ type (
 		GetAllNFTItems(ctx context.Context, arg repository.GetAllNFTItemsParams) ([]repository.GetAllNFTItemsRow, error)
 		GetNFTItemsListByRelationID(ctx context.Context, arg repository.GetNFTItemsListByRelationIDParams) ([]repository.GetNFTItemsListByRelationIDRow, error)
 		GetNFTItemsListByOwnerID(ctx context.Context, arg repository.GetNFTItemsListByOwnerIDParams) ([]repository.NFTItem, error)
+		CountNFTItemsByOwnerID(ctx context.Context, userID uuid.UUID) (int64, error)
 		GetNFTCategoriesList(ctx context.Context) ([]repository.NFTCategory, error)
 		GetMainNFTCategory(ctx context.Context) (repository.NFTCategory, error)
 		DoesUserOwnNFT(ctx context.Context, arg repository.DoesUserOwnNFTParams) (bool, error)
@@ -166,6 +170,8 @@ type (
 
 	// Simple function
 	buyNFTFunction func(ctx context.Context, uid uuid.UUID, amount float64, info string, creatorAddr string, creatorShare int32) error
+	quoteFunc      func(ctx context.Context, uid uuid.UUID, productType, productRef string, listPriceSAO float64, asset string) (pricing.Quote, error)
+	payFunc        func(ctx context.Context, uid, quoteID uuid.UUID, productType, productRef string, listPriceSAO float64, info string) (pricing.Purchase, error)
 )
 
 // NewService is a factory function,
@@ -221,7 +227,37 @@ func (s *Service) CreateNFT(ctx context.Context, userID uuid.UUID, nft *NFT) (st
 	return item.ID.String(), nil
 }
 
-func (s *Service) BuyNFT(ctx context.Context, userID uuid.UUID, nftID uuid.UUID) error {
+// WithPricingFunctions sets functions to quote and charge NFT price in SAO, SOL or USDC
+func WithPricingFunctions(quoteFn quoteFunc, payFn payFunc) Option {
+	return func(s *Service) {
+		s.quoteFn = quoteFn
+		s.payFn = payFn
+	}
+}
+
+// GetNFTQuote returns price quote to buy NFT with the given asset.
+// NFTs with creator share can be bought only for the list price in SAO,
+// since the creator's share is transferred directly to the creator.
+func (s *Service) GetNFTQuote(ctx context.Context, userID, nftID uuid.UUID, asset string) (pricing.Quote, error) {
+	if s.quoteFn == nil {
+		return pricing.Quote{}, fmt.Errorf("%w: payments in alternative assets are not supported", ErrInvalidParameter)
+	}
+
+	item, err := s.nftRepo.GetNFTItemByID(ctx, nftID)
+	if err != nil {
+		return pricing.Quote{}, fmt.Errorf("could not find NFT with id=%s: %w", nftID, err)
+	}
+	if item.Supply < int64(item.Minted) {
+		return pricing.Quote{}, ErrAlreadySold
+	}
+	if hasCreatorShare(item) {
+		return pricing.Quote{}, ErrCreatorShareRequiresSAO
+	}
+
+	return s.quoteFn(ctx, userID, pricing.ProductNFT, nftID.String(), item.BuyNowPrice, asset)
+}
+
+func (s *Service) BuyNFT(ctx context.Context, userID uuid.UUID, nftID uuid.UUID, quoteID uuid.UUID) error {
 	item, err := s.nftRepo.GetNFTItemByID(ctx, nftID)
 	if err != nil {
 		return fmt.Errorf("could not find NFT with id=%s: %w", nftID, err)
@@ -237,11 +273,20 @@ func (s *Service) BuyNFT(ctx context.Context, userID uuid.UUID, nftID uuid.UUID)
 		return ErrAlreadyBought
 	}
 
-	if err := s.buyNFTFunc(
+	if hasCreatorShare(item) && quoteID != uuid.Nil {
+		return ErrCreatorShareRequiresSAO
+	}
+
+	info := fmt.Sprintf("NFT purchase: %s", nftID)
+	if s.payFn != nil && !hasCreatorShare(item) {
+		if _, err := s.payFn(ctx, userID, quoteID, pricing.ProductNFT, nftID.String(), item.BuyNowPrice, info); err != nil {
+			return fmt.Errorf("NFT purchase error: %w", err)
+		}
+	} else if err := s.buyNFTFunc(
 		ctx,
 		userID,
 		item.BuyNowPrice,
-		fmt.Sprintf("NFT purchase: %s", nftID),
+		info,
 		item.CreatorAddress.String,
 		item.CreatorShare.Int32,
 	); err != nil {
@@ -260,6 +305,10 @@ func (s *Service) BuyNFT(ctx context.Context, userID uuid.UUID, nftID uuid.UUID)
 	return nil
 }
 
+func hasCreatorShare(item repository.GetNFTItemByIDRow) bool {
+	return item.CreatorShare.Int32 > 0 && item.CreatorAddress.String != ""
+}
+
 func (s *Service) BuyNFTViaMarketplace(ctx context.Context, userID uuid.UUID, mintAddress string) (*Empty, error) {
 	solanaAccount, err := s.wr.GetSolanaAccountByUserIDAndType(ctx, wallet_repository.GetSolanaAccountByUserIDAndTypeParams{
 		UserID:     userID,
@@ -426,6 +475,16 @@ func (s *Service) GetNFTsByUserID(ctx context.Context, userID uuid.UUID, limit,
 	return result, nil
 }
 
+// CountNFTsByUserID returns number of NFTs owned by the user
+func (s *Service) CountNFTsByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	count, err := s.nftRepo.CountNFTItemsByOwnerID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("could not count user's nfts: %w", err)
+	}
+
+	return count, nil
+}
+
 func (s *Service) GetNFTByID(ctx context.Context, nftID, userID uuid.UUID) (*NFT, error) {
 	item, err := s.nftRepo.GetNFTItemByID(ctx, nftID)
 	if err != nil {
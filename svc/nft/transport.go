@@ -3,7 +3,9 @@ package nft
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/SatorNetwork/sator-api/lib/httpencoder"
 	"github.com/SatorNetwork/sator-api/lib/utils"
+	"github.com/SatorNetwork/sator-api/svc/pricing"
 )
 
 // Predefined request query keys
@@ -94,6 +97,13 @@ func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
 		options...,
 	).ServeHTTP)
 
+	r.Post("/{nft_id}/quote", httptransport.NewServer(
+		e.GetNFTQuote,
+		decodeGetNFTQuoteRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
 	r.Post("/{mint_address}/buy/marketplace", httptransport.NewServer(
 		e.BuyNFTViaMarketplace,
 		decodeBuyNFTViaMarketplaceRequest,
@@ -230,7 +240,30 @@ func decodeBuyNFTRequest(_ context.Context, r *http.Request) (interface{}, error
 	if nftId == "" {
 		return nil, fmt.Errorf("%w: missed nft_id parameter", ErrInvalidParameter)
 	}
-	return nftId, nil
+
+	// request body is optional, it's needed only to pay with price quote
+	var req BuyNFTRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+	req.NFTID = nftId
+
+	return req, nil
+}
+
+func decodeGetNFTQuoteRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	nftId := chi.URLParam(r, "nft_id")
+	if nftId == "" {
+		return nil, fmt.Errorf("%w: missed nft_id parameter", ErrInvalidParameter)
+	}
+
+	var req GetNFTQuoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+	req.NFTID = nftId
+
+	return req, nil
 }
 
 func decodeBuyNFTViaMarketplaceRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -291,5 +324,9 @@ func decodeGetNFTsByWalletAddressRequest(_ context.Context, r *http.Request) (in
 
 // returns http error code by error type
 func codeAndMessageFrom(err error) (int, interface{}) {
-	return httpencoder.CodeAndMessageFrom(err)
+	if errors.Is(err, ErrInvalidParameter) || errors.Is(err, ErrCreatorShareRequiresSAO) {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	return pricing.CodeAndMessageFrom(err)
 }
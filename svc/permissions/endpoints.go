@@ -0,0 +1,254 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/lib/validator"
+)
+
+type (
+	// Endpoints collection of permissions service
+	Endpoints struct {
+		GetPermissions         endpoint.Endpoint
+		GetRoles               endpoint.Endpoint
+		GetRole                endpoint.Endpoint
+		AddRole                endpoint.Endpoint
+		UpdateRole             endpoint.Endpoint
+		DeleteRole             endpoint.Endpoint
+		GetUserAssignments     endpoint.Endpoint
+		GetResourceAssignments endpoint.Endpoint
+		AssignRole             endpoint.Endpoint
+		RevokeAssignment       endpoint.Endpoint
+	}
+
+	service interface {
+		rbac.PermissionChecker
+
+		GetPermissions(ctx context.Context) ([]string, error)
+		GetRoles(ctx context.Context) ([]Role, error)
+		GetRole(ctx context.Context, name string) (Role, error)
+		AddRole(ctx context.Context, r Role) (Role, error)
+		UpdateRole(ctx context.Context, r Role) error
+		DeleteRole(ctx context.Context, name string) error
+		GetUserAssignments(ctx context.Context, userID uuid.UUID) ([]Assignment, error)
+		GetResourceAssignments(ctx context.Context, resource rbac.Resource) ([]Assignment, error)
+		AssignRole(ctx context.Context, assignedBy, userID uuid.UUID, roleName string, resource rbac.Resource) (Assignment, error)
+		RevokeAssignment(ctx context.Context, id uuid.UUID) error
+	}
+
+	// AddRoleRequest struct
+	AddRoleRequest struct {
+		Name         string   `json:"name" validate:"required,max=64"`
+		Description  string   `json:"description"`
+		ResourceType string   `json:"resource_type,omitempty" validate:"omitempty,oneof=show"`
+		Permissions  []string `json:"permissions"`
+	}
+
+	// UpdateRoleRequest struct
+	UpdateRoleRequest struct {
+		Name        string   `json:"-" validate:"required"`
+		Description string   `json:"description"`
+		Permissions []string `json:"permissions"`
+	}
+
+	// AssignRoleRequest struct
+	AssignRoleRequest struct {
+		UserID       string `json:"-" validate:"required,uuid"`
+		Role         string `json:"role" validate:"required"`
+		ResourceType string `json:"resource_type,omitempty" validate:"omitempty,oneof=show"`
+		ResourceID   string `json:"resource_id,omitempty" validate:"omitempty,uuid"`
+	}
+
+	// GetResourceAssignmentsRequest struct
+	GetResourceAssignmentsRequest struct {
+		ResourceType string `json:"resource_type" validate:"required,oneof=show"`
+		ResourceID   string `json:"resource_id" validate:"required,uuid"`
+	}
+)
+
+// MakeEndpoints ...
+func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
+	validateFunc := validator.ValidateStruct()
+
+	e := Endpoints{
+		GetPermissions:         MakeGetPermissionsEndpoint(s),
+		GetRoles:               MakeGetRolesEndpoint(s),
+		GetRole:                MakeGetRoleEndpoint(s),
+		AddRole:                MakeAddRoleEndpoint(s, validateFunc),
+		UpdateRole:             MakeUpdateRoleEndpoint(s, validateFunc),
+		DeleteRole:             MakeDeleteRoleEndpoint(s),
+		GetUserAssignments:     MakeGetUserAssignmentsEndpoint(s),
+		GetResourceAssignments: MakeGetResourceAssignmentsEndpoint(s, validateFunc),
+		AssignRole:             MakeAssignRoleEndpoint(s, validateFunc),
+		RevokeAssignment:       MakeRevokeAssignmentEndpoint(s),
+	}
+
+	// all endpoints are available for users with permission to manage roles,
+	// it must be checked after the middlewares, which parse access token
+	m = append([]endpoint.Middleware{rbac.CheckPermissionMdw(s, rbac.PermissionRolesManage, nil)}, m...)
+
+	// setup middlewares for each endpoints
+	for _, mdw := range m {
+		e.GetPermissions = mdw(e.GetPermissions)
+		e.GetRoles = mdw(e.GetRoles)
+		e.GetRole = mdw(e.GetRole)
+		e.AddRole = mdw(e.AddRole)
+		e.UpdateRole = mdw(e.UpdateRole)
+		e.DeleteRole = mdw(e.DeleteRole)
+		e.GetUserAssignments = mdw(e.GetUserAssignments)
+		e.GetResourceAssignments = mdw(e.GetResourceAssignments)
+		e.AssignRole = mdw(e.AssignRole)
+		e.RevokeAssignment = mdw(e.RevokeAssignment)
+	}
+
+	return e
+}
+
+// MakeGetPermissionsEndpoint ...
+func MakeGetPermissionsEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return s.GetPermissions(ctx)
+	}
+}
+
+// MakeGetRolesEndpoint ...
+func MakeGetRolesEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return s.GetRoles(ctx)
+	}
+}
+
+// MakeGetRoleEndpoint ...
+func MakeGetRoleEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return s.GetRole(ctx, request.(string))
+	}
+}
+
+// MakeAddRoleEndpoint ...
+func MakeAddRoleEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(AddRoleRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		return s.AddRole(ctx, Role{
+			Name:         req.Name,
+			Description:  req.Description,
+			ResourceType: req.ResourceType,
+			Permissions:  req.Permissions,
+		})
+	}
+}
+
+// MakeUpdateRoleEndpoint ...
+func MakeUpdateRoleEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateRoleRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := s.UpdateRole(ctx, Role{
+			Name:        req.Name,
+			Description: req.Description,
+			Permissions: req.Permissions,
+		}); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeDeleteRoleEndpoint ...
+func MakeDeleteRoleEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := s.DeleteRole(ctx, request.(string)); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeGetUserAssignmentsEndpoint ...
+func MakeGetUserAssignmentsEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		userID, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w user id: %v", ErrInvalidParameter, err)
+		}
+
+		return s.GetUserAssignments(ctx, userID)
+	}
+}
+
+// MakeGetResourceAssignmentsEndpoint ...
+func MakeGetResourceAssignmentsEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetResourceAssignmentsRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resourceID, err := uuid.Parse(req.ResourceID)
+		if err != nil {
+			return nil, fmt.Errorf("%w resource id: %v", ErrInvalidParameter, err)
+		}
+
+		return s.GetResourceAssignments(ctx, rbac.Resource{Type: req.ResourceType, ID: resourceID})
+	}
+}
+
+// MakeAssignRoleEndpoint ...
+func MakeAssignRoleEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		req := request.(AssignRoleRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		userID, err := uuid.Parse(req.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("%w user id: %v", ErrInvalidParameter, err)
+		}
+
+		resource := rbac.Resource{Type: req.ResourceType}
+		if req.ResourceID != "" {
+			if resource.ID, err = uuid.Parse(req.ResourceID); err != nil {
+				return nil, fmt.Errorf("%w resource id: %v", ErrInvalidParameter, err)
+			}
+		}
+
+		return s.AssignRole(ctx, uid, userID, req.Role, resource)
+	}
+}
+
+// MakeRevokeAssignmentEndpoint ...
+func MakeRevokeAssignmentEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w assignment id: %v", ErrInvalidParameter, err)
+		}
+
+		if err := s.RevokeAssignment(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
@@ -0,0 +1,11 @@
+package permissions
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrNotFound         = errors.New("not found")
+	ErrAlreadyExists    = errors.New("already exists")
+	ErrPredefinedRole   = errors.New("predefined role can't be deleted")
+)
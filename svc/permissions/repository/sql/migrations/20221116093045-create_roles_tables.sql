@@ -0,0 +1,49 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR PRIMARY KEY,
+    description VARCHAR NOT NULL DEFAULT '',
+    resource_type VARCHAR NOT NULL DEFAULT '',
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role VARCHAR NOT NULL,
+    permission VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY(role, permission),
+    FOREIGN KEY(role) REFERENCES roles(name) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS role_assignments (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    role VARCHAR NOT NULL,
+    resource_type VARCHAR NOT NULL DEFAULT '',
+    resource_id uuid DEFAULT NULL,
+    assigned_by uuid DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY(role) REFERENCES roles(name) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX role_assignments_user_role_resource ON role_assignments USING BTREE (
+    user_id, role, resource_type, COALESCE(resource_id, '00000000-0000-0000-0000-000000000000')
+);
+CREATE INDEX role_assignments_resource ON role_assignments USING BTREE (resource_type, resource_id);
+INSERT INTO roles (name, description, resource_type) VALUES
+    ('admin', 'Full access to all resources', ''),
+    ('content_manager', 'Manages all shows, seasons and episodes', ''),
+    ('show_runner', 'Manages the show the role is granted on', 'show');
+INSERT INTO role_permissions (role, permission) VALUES
+    ('admin', 'shows.create'),
+    ('admin', 'shows.edit'),
+    ('admin', 'shows.delete'),
+    ('admin', 'episodes.edit'),
+    ('admin', 'roles.manage'),
+    ('content_manager', 'shows.create'),
+    ('content_manager', 'shows.edit'),
+    ('content_manager', 'shows.delete'),
+    ('content_manager', 'episodes.edit'),
+    ('show_runner', 'shows.edit'),
+    ('show_runner', 'episodes.edit');
+-- +migrate Down
+DROP TABLE IF EXISTS role_assignments;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,20 @@
+-- name: AddRoleAssignment :one
+INSERT INTO role_assignments (user_id, role, resource_type, resource_id, assigned_by)
+VALUES (@user_id, @role, @resource_type, @resource_id, @assigned_by) RETURNING *;
+-- name: GetRoleAssignmentsByUserID :many
+SELECT *
+FROM role_assignments
+WHERE user_id = @user_id
+ORDER BY created_at;
+-- name: GetRoleAssignmentsByResource :many
+SELECT *
+FROM role_assignments
+WHERE resource_type = @resource_type
+    AND resource_id = @resource_id
+ORDER BY created_at;
+-- name: DeleteRoleAssignment :execrows
+DELETE FROM role_assignments
+WHERE id = @id;
+-- name: DeleteRoleAssignmentsByUserID :exec
+DELETE FROM role_assignments
+WHERE user_id = @user_id;
@@ -0,0 +1,32 @@
+-- name: GetRolePermissions :many
+SELECT *
+FROM role_permissions
+ORDER BY role, permission;
+-- name: AddRolePermissions :exec
+INSERT INTO role_permissions (role, permission)
+SELECT @role, unnest(@permissions::VARCHAR[])
+ON CONFLICT DO NOTHING;
+-- name: DeleteRolePermissionsExcept :exec
+DELETE FROM role_permissions
+WHERE role = @role
+    AND NOT (permission = ANY(@permissions::VARCHAR[]));
+-- name: HasPermission :one
+SELECT EXISTS (
+    SELECT 1
+    FROM role_permissions
+    JOIN roles ON roles.name = role_permissions.role
+    WHERE role_permissions.permission = @permission
+        AND (
+            (roles.name = @user_role AND roles.resource_type = '')
+            OR EXISTS (
+                SELECT 1
+                FROM role_assignments
+                WHERE role_assignments.role = roles.name
+                    AND role_assignments.user_id = @user_id
+                    AND (
+                        role_assignments.resource_type = ''
+                        OR (role_assignments.resource_type = @resource_type AND role_assignments.resource_id = @resource_id)
+                    )
+            )
+        )
+)::BOOLEAN;
@@ -0,0 +1,19 @@
+-- name: GetRoles :many
+SELECT *
+FROM roles
+ORDER BY name;
+-- name: GetRoleByName :one
+SELECT *
+FROM roles
+WHERE name = @name;
+-- name: AddRole :one
+INSERT INTO roles (name, description, resource_type)
+VALUES (@name, @description, @resource_type) RETURNING *;
+-- name: UpdateRole :exec
+UPDATE roles
+SET description = @description,
+    updated_at = now()
+WHERE name = @name;
+-- name: DeleteRole :exec
+DELETE FROM roles
+WHERE name = @name;
@@ -0,0 +1,484 @@
+package permissions
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/svc/permissions/repository"
+)
+
+type (
+	// Service struct
+	Service struct {
+		db    *sql.DB
+		repo  permissionsRepository
+		audit auditFunc
+	}
+
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
+	auditFunc func(ctx context.Context, action, entityType, entityID string, before, after interface{}) error
+
+	// Role is an editable set of permissions.
+	// Role with resource type can be granted only on a resource of this type, e.g. a specific show.
+	Role struct {
+		Name         string   `json:"name"`
+		Description  string   `json:"description"`
+		ResourceType string   `json:"resource_type,omitempty"`
+		Permissions  []string `json:"permissions"`
+	}
+
+	// Assignment is the role granted to the user globally or on a resource
+	Assignment struct {
+		ID           uuid.UUID  `json:"id"`
+		UserID       uuid.UUID  `json:"user_id"`
+		Role         string     `json:"role"`
+		ResourceType string     `json:"resource_type,omitempty"`
+		ResourceID   *uuid.UUID `json:"resource_id,omitempty"`
+		AssignedBy   *uuid.UUID `json:"assigned_by,omitempty"`
+		CreatedAt    time.Time  `json:"created_at"`
+	}
+
+	permissionsRepository interface {
+		GetRoles(ctx context.Context) ([]repository.Role, error)
+		GetRoleByName(ctx context.Context, name string) (repository.Role, error)
+		AddRole(ctx context.Context, arg repository.AddRoleParams) (repository.Role, error)
+		UpdateRole(ctx context.Context, arg repository.UpdateRoleParams) error
+		DeleteRole(ctx context.Context, name string) error
+
+		GetRolePermissions(ctx context.Context) ([]repository.RolePermission, error)
+		AddRolePermissions(ctx context.Context, arg repository.AddRolePermissionsParams) error
+		DeleteRolePermissionsExcept(ctx context.Context, arg repository.DeleteRolePermissionsExceptParams) error
+		HasPermission(ctx context.Context, arg repository.HasPermissionParams) (bool, error)
+
+		AddRoleAssignment(ctx context.Context, arg repository.AddRoleAssignmentParams) (repository.RoleAssignment, error)
+		GetRoleAssignmentsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.RoleAssignment, error)
+		GetRoleAssignmentsByResource(ctx context.Context, arg repository.GetRoleAssignmentsByResourceParams) ([]repository.RoleAssignment, error)
+		DeleteRoleAssignment(ctx context.Context, id uuid.UUID) (int64, error)
+		DeleteRoleAssignmentsByUserID(ctx context.Context, userID uuid.UUID) error
+
+		WithTx(tx *sql.Tx) *repository.Queries
+	}
+)
+
+// roleNameRegexp limits role names to lower snake case, the same as predefined roles
+var roleNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// NewService is a factory function,
+// returns a new instance of the Service interface implementation.
+func NewService(dbConn *sql.DB, repo permissionsRepository, opt ...ServiceOption) *Service {
+	if repo == nil {
+		log.Fatalln("permissions repository is not set")
+	}
+
+	s := &Service{db: dbConn, repo: repo}
+
+	for _, fn := range opt {
+		fn(s)
+	}
+
+	return s
+}
+
+// WithAuditFunc option
+// Records changes of roles and role assignments to the audit log
+func WithAuditFunc(fn auditFunc) ServiceOption {
+	return func(s *Service) {
+		s.audit = fn
+	}
+}
+
+// HasPermission checks whether the user has the permission on the resource.
+// Permissions of the user's role are granted globally if the role isn't scoped to a resource type,
+// permissions of the assigned roles are granted globally or on the assigned resource only.
+func (s *Service) HasPermission(ctx context.Context, userID uuid.UUID, role rbac.Role, permission rbac.Permission, resource rbac.Resource) (bool, error) {
+	return s.repo.HasPermission(ctx, repository.HasPermissionParams{
+		Permission:   permission.String(),
+		UserRole:     role.String(),
+		UserID:       userID,
+		ResourceType: resource.Type,
+		ResourceID:   uuid.NullUUID{UUID: resource.ID, Valid: resource.ID != uuid.Nil},
+	})
+}
+
+// GetPermissions returns list of all permissions which can be granted to roles
+func (s *Service) GetPermissions(_ context.Context) ([]string, error) {
+	result := make([]string, 0, len(rbac.Permissions()))
+	for _, p := range rbac.Permissions() {
+		result = append(result, p.String())
+	}
+
+	return result, nil
+}
+
+// GetRoles returns list of roles with their permissions
+func (s *Service) GetRoles(ctx context.Context) ([]Role, error) {
+	roles, err := s.repo.GetRoles(ctx)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get roles: %w", err)
+	}
+
+	permissions, err := s.getPermissionsByRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Role, 0, len(roles))
+	for _, r := range roles {
+		result = append(result, castToRole(r, permissions[r.Name]))
+	}
+
+	return result, nil
+}
+
+// GetRole returns role by name with its permissions
+func (s *Service) GetRole(ctx context.Context, name string) (Role, error) {
+	role, err := s.repo.GetRoleByName(ctx, name)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Role{}, fmt.Errorf("%w: role %s", ErrNotFound, name)
+		}
+		return Role{}, fmt.Errorf("could not get role %s: %w", name, err)
+	}
+
+	permissions, err := s.getPermissionsByRole(ctx)
+	if err != nil {
+		return Role{}, err
+	}
+
+	return castToRole(role, permissions[role.Name]), nil
+}
+
+// AddRole creates a new role with the permissions
+func (s *Service) AddRole(ctx context.Context, r Role) (Role, error) {
+	if err := validateRoleName(r.Name); err != nil {
+		return Role{}, err
+	}
+	if !rbac.IsValidResourceType(r.ResourceType) {
+		return Role{}, fmt.Errorf("%w: unknown resource type %s", ErrInvalidParameter, r.ResourceType)
+	}
+	if err := validatePermissions(r.Permissions); err != nil {
+		return Role{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return Role{}, fmt.Errorf("could not begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	if _, err := repo.AddRole(ctx, repository.AddRoleParams{
+		Name:         r.Name,
+		Description:  r.Description,
+		ResourceType: r.ResourceType,
+	}); err != nil {
+		if db.IsDuplicateError(err) {
+			return Role{}, fmt.Errorf("%w: role %s", ErrAlreadyExists, r.Name)
+		}
+		return Role{}, fmt.Errorf("could not add role %s: %w", r.Name, err)
+	}
+
+	if err := setPermissions(ctx, repo, r.Name, r.Permissions); err != nil {
+		return Role{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Role{}, fmt.Errorf("could not add role %s: %w", r.Name, err)
+	}
+
+	role, err := s.GetRole(ctx, r.Name)
+	if err != nil {
+		return Role{}, err
+	}
+
+	if s.audit != nil {
+		if err := s.audit(ctx, "role.create", "role", role.Name, nil, role); err != nil {
+			return Role{}, fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return role, nil
+}
+
+// UpdateRole updates description and replaces permissions of the role.
+// Resource type can't be changed, since the role may be already granted on resources.
+func (s *Service) UpdateRole(ctx context.Context, r Role) error {
+	if err := validatePermissions(r.Permissions); err != nil {
+		return err
+	}
+
+	before, err := s.GetRole(ctx, r.Name)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	if err := repo.UpdateRole(ctx, repository.UpdateRoleParams{
+		Description: r.Description,
+		Name:        r.Name,
+	}); err != nil {
+		return fmt.Errorf("could not update role %s: %w", r.Name, err)
+	}
+
+	if err := setPermissions(ctx, repo, r.Name, r.Permissions); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not update role %s: %w", r.Name, err)
+	}
+
+	if s.audit != nil {
+		after, err := s.GetRole(ctx, r.Name)
+		if err != nil {
+			return err
+		}
+		if err := s.audit(ctx, "role.update", "role", r.Name, before, after); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteRole deletes the role with all its assignments.
+// Predefined roles can't be deleted, since users have them in the access token.
+func (s *Service) DeleteRole(ctx context.Context, name string) error {
+	if rbac.Role(name).IsPredefined() {
+		return ErrPredefinedRole
+	}
+
+	role, err := s.GetRole(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteRole(ctx, name); err != nil {
+		return fmt.Errorf("could not delete role %s: %w", name, err)
+	}
+
+	if s.audit != nil {
+		if err := s.audit(ctx, "role.delete", "role", name, role, nil); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetUserAssignments returns roles assigned to the user
+func (s *Service) GetUserAssignments(ctx context.Context, userID uuid.UUID) ([]Assignment, error) {
+	assignments, err := s.repo.GetRoleAssignmentsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get role assignments of user %s: %w", userID, err)
+	}
+
+	return castToAssignments(assignments), nil
+}
+
+// GetResourceAssignments returns roles assigned on the resource, e.g. who manages the show
+func (s *Service) GetResourceAssignments(ctx context.Context, resource rbac.Resource) ([]Assignment, error) {
+	assignments, err := s.repo.GetRoleAssignmentsByResource(ctx, repository.GetRoleAssignmentsByResourceParams{
+		ResourceType: resource.Type,
+		ResourceID:   uuid.NullUUID{UUID: resource.ID, Valid: true},
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get role assignments of %s %s: %w", resource.Type, resource.ID, err)
+	}
+
+	return castToAssignments(assignments), nil
+}
+
+// AssignRole grants the role to the user on the resource.
+// Role scoped to a resource type can be granted only on a resource of this type,
+// other roles can be granted only globally, i.e. with empty resource.
+func (s *Service) AssignRole(ctx context.Context, assignedBy, userID uuid.UUID, roleName string, resource rbac.Resource) (Assignment, error) {
+	role, err := s.GetRole(ctx, roleName)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	if role.ResourceType != resource.Type {
+		if role.ResourceType == "" {
+			return Assignment{}, fmt.Errorf("%w: role %s can be granted globally only", ErrInvalidParameter, role.Name)
+		}
+		return Assignment{}, fmt.Errorf("%w: role %s can be granted on %s only", ErrInvalidParameter, role.Name, role.ResourceType)
+	}
+	if resource.Type != "" && resource.ID == uuid.Nil {
+		return Assignment{}, fmt.Errorf("%w: missed resource id", ErrInvalidParameter)
+	}
+
+	assignment, err := s.repo.AddRoleAssignment(ctx, repository.AddRoleAssignmentParams{
+		UserID:       userID,
+		Role:         role.Name,
+		ResourceType: resource.Type,
+		ResourceID:   uuid.NullUUID{UUID: resource.ID, Valid: resource.Type != ""},
+		AssignedBy:   uuid.NullUUID{UUID: assignedBy, Valid: assignedBy != uuid.Nil},
+	})
+	if err != nil {
+		if db.IsDuplicateError(err) {
+			return Assignment{}, fmt.Errorf("%w: role %s is already assigned", ErrAlreadyExists, role.Name)
+		}
+		return Assignment{}, fmt.Errorf("could not assign role %s: %w", role.Name, err)
+	}
+
+	result := castToAssignment(assignment)
+	if s.audit != nil {
+		if err := s.audit(ctx, "role_assignment.create", "role_assignment", result.ID.String(), nil, result); err != nil {
+			return Assignment{}, fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// RevokeAssignment revokes the role assignment by id
+func (s *Service) RevokeAssignment(ctx context.Context, id uuid.UUID) error {
+	n, err := s.repo.DeleteRoleAssignment(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not revoke role assignment %s: %w", id, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: role assignment %s", ErrNotFound, id)
+	}
+
+	if s.audit != nil {
+		if err := s.audit(ctx, "role_assignment.delete", "role_assignment", id.String(), nil, nil); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// EraseUserData revokes all roles assigned to the user on account deletion
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	if err := s.repo.DeleteRoleAssignmentsByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not revoke role assignments: %w", err)
+	}
+
+	return nil
+}
+
+// setPermissions replaces permissions of the role with the given ones
+func setPermissions(ctx context.Context, repo *repository.Queries, role string, permissions []string) error {
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	if err := repo.DeleteRolePermissionsExcept(ctx, repository.DeleteRolePermissionsExceptParams{
+		Role:        role,
+		Permissions: permissions,
+	}); err != nil {
+		return fmt.Errorf("could not remove permissions of role %s: %w", role, err)
+	}
+
+	if len(permissions) > 0 {
+		if err := repo.AddRolePermissions(ctx, repository.AddRolePermissionsParams{
+			Role:        role,
+			Permissions: permissions,
+		}); err != nil {
+			return fmt.Errorf("could not add permissions to role %s: %w", role, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) getPermissionsByRole(ctx context.Context) (map[string][]string, error) {
+	permissions, err := s.repo.GetRolePermissions(ctx)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get role permissions: %w", err)
+	}
+
+	result := make(map[string][]string)
+	for _, p := range permissions {
+		result[p.Role] = append(result[p.Role], p.Permission)
+	}
+
+	return result, nil
+}
+
+// validateRoleName checks the name of a new role,
+// names of predefined roles and pseudo-roles are reserved.
+func validateRoleName(name string) error {
+	if !roleNameRegexp.MatchString(name) {
+		return fmt.Errorf("%w: role name must contain lowercase letters, digits and underscores and start with a letter", ErrInvalidParameter)
+	}
+
+	switch role := rbac.Role(name); {
+	case role.IsPredefined(), role == rbac.AvailableForAllRoles, role == rbac.AvailableForAuthorizedUsers:
+		return fmt.Errorf("%w: role name %s is reserved", ErrInvalidParameter, name)
+	}
+
+	return nil
+}
+
+func validatePermissions(permissions []string) error {
+	for _, p := range permissions {
+		if !rbac.Permission(p).IsValid() {
+			return fmt.Errorf("%w: unknown permission %s", ErrInvalidParameter, p)
+		}
+	}
+
+	return nil
+}
+
+func castToRole(source repository.Role, permissions []string) Role {
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	return Role{
+		Name:         source.Name,
+		Description:  source.Description,
+		ResourceType: source.ResourceType,
+		Permissions:  permissions,
+	}
+}
+
+func castToAssignment(source repository.RoleAssignment) Assignment {
+	a := Assignment{
+		ID:           source.ID,
+		UserID:       source.UserID,
+		Role:         source.Role,
+		ResourceType: source.ResourceType,
+		CreatedAt:    source.CreatedAt,
+	}
+	if source.ResourceID.Valid {
+		a.ResourceID = &source.ResourceID.UUID
+	}
+	if source.AssignedBy.Valid {
+		a.AssignedBy = &source.AssignedBy.UUID
+	}
+
+	return a
+}
+
+func castToAssignments(source []repository.RoleAssignment) []Assignment {
+	result := make([]Assignment, 0, len(source))
+	for _, a := range source {
+		result = append(result, castToAssignment(a))
+	}
+
+	return result
+}
@@ -0,0 +1,46 @@
+package permissions
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateRoleName(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    string
+		wantErr bool
+	}{
+		{name: "custom role", role: "show_editor"},
+		{name: "with digits", role: "editor2"},
+		{name: "empty", role: "", wantErr: true},
+		{name: "uppercase", role: "Editor", wantErr: true},
+		{name: "starts with digit", role: "2editor", wantErr: true},
+		{name: "whitespace", role: "show editor", wantErr: true},
+		{name: "dash", role: "show-editor", wantErr: true},
+		{name: "predefined admin", role: "admin", wantErr: true},
+		{name: "predefined user", role: "user", wantErr: true},
+		{name: "predefined test user", role: "test_user", wantErr: true},
+		{name: "pseudo-role for all", role: "available_for_all_roles", wantErr: true},
+		{name: "pseudo-role for authorized", role: "available_for_authorized_users", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRoleName(tt.role)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateRoleName(%q) error = %v, wantErr %v", tt.role, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidParameter) {
+				t.Errorf("validateRoleName(%q) error = %v, want %v", tt.role, err, ErrInvalidParameter)
+			}
+		})
+	}
+}
+
+func TestValidateRoleNameReservedMessage(t *testing.T) {
+	err := validateRoleName("moderator")
+	if err == nil || !strings.Contains(err.Error(), "reserved") {
+		t.Errorf("validateRoleName() error = %v, want reserved role name error", err)
+	}
+}
@@ -0,0 +1,189 @@
+package permissions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	jwtkit "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+)
+
+type (
+	logger interface {
+		Log(keyvals ...interface{}) error
+	}
+)
+
+// MakeHTTPHandler ...
+func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
+	r := chi.NewRouter()
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
+		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
+		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+	}
+
+	r.Get("/", httptransport.NewServer(
+		e.GetPermissions,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/roles", httptransport.NewServer(
+		e.GetRoles,
+		decodeEmptyRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/roles", httptransport.NewServer(
+		e.AddRole,
+		decodeAddRoleRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/roles/{role}", httptransport.NewServer(
+		e.GetRole,
+		decodeRoleNameRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Put("/roles/{role}", httptransport.NewServer(
+		e.UpdateRole,
+		decodeUpdateRoleRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/roles/{role}", httptransport.NewServer(
+		e.DeleteRole,
+		decodeRoleNameRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/assignments", httptransport.NewServer(
+		e.GetResourceAssignments,
+		decodeGetResourceAssignmentsRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/assignments/{assignment_id}", httptransport.NewServer(
+		e.RevokeAssignment,
+		decodeAssignmentIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/users/{user_id}/assignments", httptransport.NewServer(
+		e.GetUserAssignments,
+		decodeUserIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/users/{user_id}/assignments", httptransport.NewServer(
+		e.AssignRole,
+		decodeAssignRoleRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	return r
+}
+
+func decodeEmptyRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodeAddRoleRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req AddRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeRoleNameRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	name := chi.URLParam(r, "role")
+	if name == "" {
+		return nil, fmt.Errorf("%w: missed role", ErrInvalidParameter)
+	}
+
+	return name, nil
+}
+
+func decodeUpdateRoleRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req UpdateRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+	req.Name = chi.URLParam(r, "role")
+
+	return req, nil
+}
+
+func decodeGetResourceAssignmentsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return GetResourceAssignmentsRequest{
+		ResourceType: r.URL.Query().Get("resource_type"),
+		ResourceID:   r.URL.Query().Get("resource_id"),
+	}, nil
+}
+
+func decodeAssignmentIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "assignment_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed assignment_id", ErrInvalidParameter)
+	}
+
+	return id, nil
+}
+
+func decodeUserIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "user_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed user_id", ErrInvalidParameter)
+	}
+
+	return id, nil
+}
+
+func decodeAssignRoleRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req AssignRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+	req.UserID = chi.URLParam(r, "user_id")
+
+	return req, nil
+}
+
+// returns http error code by error type
+func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+
+	if errors.Is(err, ErrInvalidParameter) {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	if errors.Is(err, ErrAlreadyExists) || errors.Is(err, ErrPredefinedRole) {
+		return http.StatusConflict, err.Error()
+	}
+
+	return httpencoder.CodeAndMessageFrom(err)
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/svc/pricing"
+)
+
+type (
+	// Client struct
+	Client struct {
+		s service
+	}
+
+	service interface {
+		CreateQuote(ctx context.Context, uid uuid.UUID, productType, productRef string, listPriceSAO float64, asset string) (pricing.Quote, error)
+		Pay(ctx context.Context, uid, quoteID uuid.UUID, productType, productRef string, listPriceSAO float64, info string) (pricing.Purchase, error)
+	}
+)
+
+// New pricing service client implementation
+func New(s service) *Client {
+	return &Client{s: s}
+}
+
+// CreateQuote ...
+func (c *Client) CreateQuote(ctx context.Context, uid uuid.UUID, productType, productRef string, listPriceSAO float64, asset string) (pricing.Quote, error) {
+	return c.s.CreateQuote(ctx, uid, productType, productRef, listPriceSAO, asset)
+}
+
+// Pay ...
+func (c *Client) Pay(ctx context.Context, uid, quoteID uuid.UUID, productType, productRef string, listPriceSAO float64, info string) (pricing.Purchase, error) {
+	return c.s.Pay(ctx, uid, quoteID, productType, productRef, listPriceSAO, info)
+}
@@ -0,0 +1,16 @@
+package pricing
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrInvalidParameter  = errors.New("invalid parameter")
+	ErrUnsupportedAsset  = errors.New("unsupported payment asset")
+	ErrQuoteNotFound     = errors.New("price quote not found")
+	ErrQuoteExpired      = errors.New("price quote is expired, request a new one")
+	ErrQuoteAlreadyUsed  = errors.New("price quote has been already used")
+	ErrQuoteMismatch     = errors.New("price quote does not match the purchase")
+	ErrSlippageExceeded  = errors.New("price has changed more than allowed slippage, request a new quote")
+	ErrExchangeRateUnset = errors.New("exchange rate is not available")
+	ErrDiscountUsed      = errors.New("discount has been already used, request a new quote")
+)
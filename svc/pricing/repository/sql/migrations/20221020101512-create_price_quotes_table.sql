@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS price_quotes (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    product_type VARCHAR NOT NULL,
+    product_ref VARCHAR NOT NULL,
+    price_usd DOUBLE PRECISION NOT NULL,
+    asset VARCHAR NOT NULL,
+    asset_usd_rate DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX price_quotes_user_id ON price_quotes USING BTREE (user_id);
+-- +migrate Down
+DROP TABLE IF EXISTS price_quotes;
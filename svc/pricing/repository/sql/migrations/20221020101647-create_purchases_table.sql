@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS purchases (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    quote_id uuid DEFAULT NULL,
+    product_type VARCHAR NOT NULL,
+    product_ref VARCHAR NOT NULL,
+    price_usd DOUBLE PRECISION NOT NULL DEFAULT 0,
+    asset VARCHAR NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    tx_hash VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX purchases_user_id_created_at ON purchases USING BTREE (user_id, created_at);
+CREATE UNIQUE INDEX purchases_quote_id_uniq ON purchases (quote_id) WHERE quote_id IS NOT NULL;
+-- +migrate Down
+DROP TABLE IF EXISTS purchases;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- discount applied to the quoted price, it's claimed on the payment
+ALTER TABLE price_quotes ADD COLUMN discount_id uuid DEFAULT NULL;
+ALTER TABLE price_quotes ADD COLUMN discount_percent DOUBLE PRECISION NOT NULL DEFAULT 0;
+-- +migrate Down
+ALTER TABLE price_quotes DROP COLUMN IF EXISTS discount_percent;
+ALTER TABLE price_quotes DROP COLUMN IF EXISTS discount_id;
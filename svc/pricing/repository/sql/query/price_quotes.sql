@@ -0,0 +1,42 @@
+-- name: AddPriceQuote :one
+INSERT INTO price_quotes (
+    user_id,
+    product_type,
+    product_ref,
+    price_usd,
+    asset,
+    asset_usd_rate,
+    amount,
+    expires_at,
+    discount_id,
+    discount_percent
+)
+VALUES (
+    @user_id,
+    @product_type,
+    @product_ref,
+    @price_usd,
+    @asset,
+    @asset_usd_rate,
+    @amount,
+    @expires_at,
+    @discount_id,
+    @discount_percent
+) RETURNING *;
+
+-- name: GetPriceQuoteByID :one
+SELECT *
+FROM price_quotes
+WHERE id = @id
+LIMIT 1;
+
+-- name: MarkPriceQuoteAsUsed :one
+UPDATE price_quotes
+SET used_at = now()
+WHERE id = @id AND used_at IS NULL
+RETURNING *;
+
+-- name: ReleasePriceQuote :exec
+UPDATE price_quotes
+SET used_at = NULL
+WHERE id = @id;
@@ -0,0 +1,28 @@
+-- name: AddPurchase :one
+INSERT INTO purchases (
+    user_id,
+    quote_id,
+    product_type,
+    product_ref,
+    price_usd,
+    asset,
+    amount,
+    tx_hash
+)
+VALUES (
+    @user_id,
+    @quote_id,
+    @product_type,
+    @product_ref,
+    @price_usd,
+    @asset,
+    @amount,
+    @tx_hash
+) RETURNING *;
+
+-- name: GetPurchasesByUserID :many
+SELECT *
+FROM purchases
+WHERE user_id = @user_id
+ORDER BY created_at DESC
+LIMIT @limit_val OFFSET @offset_val;
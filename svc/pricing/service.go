@@ -0,0 +1,462 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/exchange_rates"
+	"github.com/SatorNetwork/sator-api/svc/pricing/repository"
+)
+
+// Supported payment assets
+const (
+	AssetSAO  = "SAO"
+	AssetSOL  = "SOL"
+	AssetUSDC = "USDC"
+)
+
+// Predefined product types
+const (
+	ProductEpisodeUnlock = "episode_unlock"
+	ProductPuzzleUnlock  = "puzzle_unlock"
+	ProductNFT           = "nft"
+)
+
+const (
+	defaultQuoteTTL    = time.Minute
+	defaultMaxSlippage = 0.02
+)
+
+type (
+	// Service struct
+	Service struct {
+		repo          pricingRepository
+		pay           payForServiceFunc
+		exchangeRates exchangeRatesClient
+
+		quoteTTL    time.Duration
+		maxSlippage float64
+
+		getDiscountFn     getDiscountFunc     // returns id and percent of the user's discount for the next purchase
+		useDiscountFn     useDiscountFunc     // marks the user's discount as used
+		releaseDiscountFn releaseDiscountFunc // makes the used discount available again
+	}
+
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
+	pricingRepository interface {
+		AddPriceQuote(ctx context.Context, arg repository.AddPriceQuoteParams) (repository.PriceQuote, error)
+		GetPriceQuoteByID(ctx context.Context, id uuid.UUID) (repository.PriceQuote, error)
+		MarkPriceQuoteAsUsed(ctx context.Context, id uuid.UUID) (repository.PriceQuote, error)
+		ReleasePriceQuote(ctx context.Context, id uuid.UUID) error
+
+		AddPurchase(ctx context.Context, arg repository.AddPurchaseParams) (repository.Purchase, error)
+		GetPurchasesByUserID(ctx context.Context, arg repository.GetPurchasesByUserIDParams) ([]repository.Purchase, error)
+	}
+
+	exchangeRatesClient interface {
+		GetAssetPrice(ctx context.Context, req *exchange_rates.Asset) (*exchange_rates.Price, error)
+	}
+
+	// payForServiceFunc charges user in the given asset and returns transaction hash
+	payForServiceFunc func(ctx context.Context, uid uuid.UUID, asset string, amount float64, info string) (string, error)
+
+	getDiscountFunc     func(ctx context.Context, uid uuid.UUID) (uuid.UUID, float64, error)
+	useDiscountFunc     func(ctx context.Context, uid, discountID uuid.UUID) error
+	releaseDiscountFunc func(ctx context.Context, discountID uuid.UUID) error
+
+	// Quote struct
+	Quote struct {
+		ID           string  `json:"id"`
+		ProductType  string  `json:"product_type"`
+		ProductRef   string  `json:"product_ref"`
+		PriceUSD     float64 `json:"price_usd"`
+		Asset        string  `json:"asset"`
+		AssetUSDRate float64 `json:"asset_usd_rate"`
+		Amount       float64 `json:"amount"`
+		MaxSlippage  float64 `json:"max_slippage"`
+		ExpiresAt    string  `json:"expires_at"`
+	}
+
+	// Purchase struct
+	Purchase struct {
+		ID          string  `json:"id"`
+		QuoteID     string  `json:"quote_id,omitempty"`
+		ProductType string  `json:"product_type"`
+		ProductRef  string  `json:"product_ref"`
+		PriceUSD    float64 `json:"price_usd"`
+		Asset       string  `json:"asset"`
+		Amount      float64 `json:"amount"`
+		TxHash      string  `json:"tx_hash"`
+		CreatedAt   string  `json:"created_at"`
+	}
+)
+
+// NewService is a factory function,
+// returns a new instance of the Service interface implementation
+func NewService(repo pricingRepository, pay payForServiceFunc, er exchangeRatesClient, opt ...ServiceOption) *Service {
+	s := &Service{
+		repo:          repo,
+		pay:           pay,
+		exchangeRates: er,
+		quoteTTL:      defaultQuoteTTL,
+		maxSlippage:   defaultMaxSlippage,
+	}
+
+	for _, o := range opt {
+		o(s)
+	}
+
+	return s
+}
+
+// CreateQuote converts product list price (in SAO) to USD and then to the asset chosen by user.
+// Returned quote locks the exchange rates and the user's discount until it expires.
+func (s *Service) CreateQuote(ctx context.Context, uid uuid.UUID, productType, productRef string, listPriceSAO float64, asset string) (Quote, error) {
+	if asset == "" {
+		asset = AssetSAO
+	}
+
+	discountID, discountPercent := s.userDiscount(ctx, uid)
+	listPriceSAO = applyDiscount(listPriceSAO, discountPercent)
+
+	priceUSD, rate, amount, err := s.convert(ctx, listPriceSAO, asset)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	q, err := s.repo.AddPriceQuote(ctx, repository.AddPriceQuoteParams{
+		UserID:          uid,
+		ProductType:     productType,
+		ProductRef:      productRef,
+		PriceUsd:        priceUSD,
+		Asset:           asset,
+		AssetUsdRate:    rate,
+		Amount:          amount,
+		ExpiresAt:       time.Now().Add(s.quoteTTL),
+		DiscountID:      uuid.NullUUID{UUID: discountID, Valid: discountID != uuid.Nil},
+		DiscountPercent: discountPercent,
+	})
+	if err != nil {
+		return Quote{}, fmt.Errorf("could not store price quote: %w", err)
+	}
+
+	return castToQuote(q, s.maxSlippage), nil
+}
+
+// Pay charges user for the product using previously locked quote.
+// If quoteID is uuid.Nil, the list price is charged in SAO.
+func (s *Service) Pay(ctx context.Context, uid, quoteID uuid.UUID, productType, productRef string, listPriceSAO float64, info string) (Purchase, error) {
+	if quoteID == uuid.Nil {
+		return s.payListPrice(ctx, uid, productType, productRef, listPriceSAO, info)
+	}
+
+	q, err := s.repo.GetPriceQuoteByID(ctx, quoteID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Purchase{}, ErrQuoteNotFound
+		}
+		return Purchase{}, fmt.Errorf("could not get price quote: %w", err)
+	}
+
+	if q.UserID != uid {
+		return Purchase{}, ErrQuoteNotFound
+	}
+	if q.UsedAt.Valid {
+		return Purchase{}, ErrQuoteAlreadyUsed
+	}
+	if q.ExpiresAt.Before(time.Now()) {
+		return Purchase{}, ErrQuoteExpired
+	}
+	if q.ProductType != productType || q.ProductRef != productRef {
+		return Purchase{}, ErrQuoteMismatch
+	}
+
+	// only the discount the quote is issued with is used
+	discountID := uuid.Nil
+	if q.DiscountID.Valid {
+		if err := s.useDiscount(ctx, uid, q.DiscountID.UUID); err != nil {
+			return Purchase{}, err
+		}
+		discountID = q.DiscountID.UUID
+		listPriceSAO = applyDiscount(listPriceSAO, q.DiscountPercent)
+	}
+
+	// Slippage guard: the product price or exchange rates could change after the quote was issued
+	_, _, currentAmount, err := s.convert(ctx, listPriceSAO, q.Asset)
+	if err != nil {
+		s.releaseDiscount(ctx, discountID)
+		return Purchase{}, err
+	}
+	if exceedsSlippage(q.Amount, currentAmount, s.maxSlippage) {
+		s.releaseDiscount(ctx, discountID)
+		return Purchase{}, ErrSlippageExceeded
+	}
+
+	if _, err := s.repo.MarkPriceQuoteAsUsed(ctx, q.ID); err != nil {
+		s.releaseDiscount(ctx, discountID)
+		if db.IsNotFoundError(err) {
+			return Purchase{}, ErrQuoteAlreadyUsed
+		}
+		return Purchase{}, fmt.Errorf("could not lock price quote: %w", err)
+	}
+
+	txHash, err := s.pay(ctx, uid, q.Asset, q.Amount, info)
+	if err != nil {
+		if rerr := s.repo.ReleasePriceQuote(ctx, q.ID); rerr != nil {
+			log.Printf("could not release price quote %s: %v", q.ID, rerr)
+		}
+		s.releaseDiscount(ctx, discountID)
+		return Purchase{}, err
+	}
+
+	return s.storePurchase(ctx, repository.AddPurchaseParams{
+		UserID:      uid,
+		QuoteID:     uuid.NullUUID{UUID: q.ID, Valid: true},
+		ProductType: productType,
+		ProductRef:  productRef,
+		PriceUsd:    q.PriceUsd,
+		Asset:       q.Asset,
+		Amount:      q.Amount,
+		TxHash:      txHash,
+	}), nil
+}
+
+// GetPurchases returns list of user's purchases
+func (s *Service) GetPurchases(ctx context.Context, uid uuid.UUID, limit, offset int32) ([]Purchase, error) {
+	list, err := s.repo.GetPurchasesByUserID(ctx, repository.GetPurchasesByUserIDParams{
+		UserID:    uid,
+		LimitVal:  limit,
+		OffsetVal: offset,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get purchases list: %w", err)
+	}
+
+	result := make([]Purchase, 0, len(list))
+	for _, p := range list {
+		result = append(result, castToPurchase(p))
+	}
+
+	return result, nil
+}
+
+func (s *Service) payListPrice(ctx context.Context, uid uuid.UUID, productType, productRef string, listPriceSAO float64, info string) (Purchase, error) {
+	discountID, discountPercent := s.userDiscount(ctx, uid)
+	if discountID != uuid.Nil {
+		if err := s.useDiscount(ctx, uid, discountID); err != nil {
+			return Purchase{}, err
+		}
+		listPriceSAO = applyDiscount(listPriceSAO, discountPercent)
+	}
+
+	txHash, err := s.pay(ctx, uid, AssetSAO, listPriceSAO, info)
+	if err != nil {
+		s.releaseDiscount(ctx, discountID)
+		return Purchase{}, err
+	}
+
+	// USD price is informational here, so missed exchange rate must not break the purchase
+	var priceUSD float64
+	if rate, err := s.getUSDRate(ctx, AssetSAO); err == nil {
+		priceUSD = listPriceSAO * rate
+	}
+
+	return s.storePurchase(ctx, repository.AddPurchaseParams{
+		UserID:      uid,
+		ProductType: productType,
+		ProductRef:  productRef,
+		PriceUsd:    priceUSD,
+		Asset:       AssetSAO,
+		Amount:      listPriceSAO,
+		TxHash:      txHash,
+	}), nil
+}
+
+// userDiscount returns id and percent of the user's discount for the next purchase,
+// uuid.Nil if the user has no discount.
+// Discount is optional, so its errors are logged and no discount is returned.
+func (s *Service) userDiscount(ctx context.Context, uid uuid.UUID) (uuid.UUID, float64) {
+	if s.getDiscountFn == nil || s.useDiscountFn == nil {
+		return uuid.Nil, 0
+	}
+
+	discountID, percent, err := s.getDiscountFn(ctx, uid)
+	if err != nil {
+		log.Printf("could not get discount of user %s: %v", uid, err)
+		return uuid.Nil, 0
+	}
+	if discountID == uuid.Nil || percent <= 0 {
+		return uuid.Nil, 0
+	}
+
+	return discountID, percent
+}
+
+// useDiscount claims the discount before the payment.
+// The purchase is rejected if the discount has been used concurrently.
+func (s *Service) useDiscount(ctx context.Context, uid, discountID uuid.UUID) error {
+	if err := s.useDiscountFn(ctx, uid, discountID); err != nil {
+		return fmt.Errorf("%w: %v", ErrDiscountUsed, err)
+	}
+
+	return nil
+}
+
+// releaseDiscount makes the claimed discount available again if the purchase has failed
+func (s *Service) releaseDiscount(ctx context.Context, discountID uuid.UUID) {
+	if discountID == uuid.Nil || s.releaseDiscountFn == nil {
+		return
+	}
+	if err := s.releaseDiscountFn(ctx, discountID); err != nil {
+		log.Printf("could not release discount %s: %v", discountID, err)
+	}
+}
+
+// storePurchase records the purchase. The user has been already charged at this point,
+// so the error is logged instead of being returned to avoid losing access to paid product.
+func (s *Service) storePurchase(ctx context.Context, arg repository.AddPurchaseParams) Purchase {
+	p, err := s.repo.AddPurchase(ctx, arg)
+	if err != nil {
+		log.Printf("could not store purchase of %s %s by user %s (tx: %s): %v",
+			arg.ProductType, arg.ProductRef, arg.UserID, arg.TxHash, err)
+		return castToPurchase(repository.Purchase{
+			UserID:      arg.UserID,
+			QuoteID:     arg.QuoteID,
+			ProductType: arg.ProductType,
+			ProductRef:  arg.ProductRef,
+			PriceUsd:    arg.PriceUsd,
+			Asset:       arg.Asset,
+			Amount:      arg.Amount,
+			TxHash:      arg.TxHash,
+			CreatedAt:   time.Now(),
+		})
+	}
+
+	return castToPurchase(p)
+}
+
+// convert returns USD price, asset rate and amount of asset to pay for the given SAO list price
+func (s *Service) convert(ctx context.Context, listPriceSAO float64, asset string) (priceUSD, rate, amount float64, err error) {
+	if listPriceSAO <= 0 {
+		return 0, 0, 0, fmt.Errorf("%w: price must be greater than 0", ErrInvalidParameter)
+	}
+
+	saoRate, err := s.getUSDRate(ctx, AssetSAO)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	priceUSD = listPriceSAO * saoRate
+
+	if asset == AssetSAO {
+		return priceUSD, saoRate, listPriceSAO, nil
+	}
+
+	rate, err = s.getUSDRate(ctx, asset)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return priceUSD, rate, convertAmount(priceUSD, rate, asset), nil
+}
+
+func (s *Service) getUSDRate(ctx context.Context, asset string) (float64, error) {
+	assetType, err := assetTypeFromName(asset)
+	if err != nil {
+		return 0, err
+	}
+
+	price, err := s.exchangeRates.GetAssetPrice(ctx, &exchange_rates.Asset{AssetType: assetType})
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s: %v", ErrExchangeRateUnset, asset, err)
+	}
+	if price == nil || price.Usd <= 0 {
+		return 0, fmt.Errorf("%w: %s", ErrExchangeRateUnset, asset)
+	}
+
+	return price.Usd, nil
+}
+
+func assetTypeFromName(asset string) (exchange_rates.AssetType, error) {
+	switch asset {
+	case AssetSAO:
+		return exchange_rates.AssetTypeSAO, nil
+	case AssetSOL:
+		return exchange_rates.AssetTypeSOL, nil
+	case AssetUSDC:
+		return exchange_rates.AssetTypeUSDC, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrUnsupportedAsset, asset)
+	}
+}
+
+// convertAmount converts USD price to the asset amount, rounded up to the asset precision
+func convertAmount(priceUSD, rate float64, asset string) float64 {
+	precision := 9
+	if asset == AssetUSDC {
+		precision = 6
+	}
+
+	m := math.Pow10(precision)
+	return math.Ceil(priceUSD/rate*m) / m
+}
+
+// applyDiscount reduces the price by the discount percent
+func applyDiscount(price, percent float64) float64 {
+	if percent <= 0 {
+		return price
+	}
+	if percent >= 100 {
+		return 0
+	}
+
+	return price * (100 - percent) / 100
+}
+
+// exceedsSlippage reports whether the current amount is bigger than the quoted one
+// by more than allowed slippage
+func exceedsSlippage(quoted, current, maxSlippage float64) bool {
+	return current > quoted*(1+maxSlippage)
+}
+
+func castToQuote(q repository.PriceQuote, maxSlippage float64) Quote {
+	return Quote{
+		ID:           q.ID.String(),
+		ProductType:  q.ProductType,
+		ProductRef:   q.ProductRef,
+		PriceUSD:     q.PriceUsd,
+		Asset:        q.Asset,
+		AssetUSDRate: q.AssetUsdRate,
+		Amount:       q.Amount,
+		MaxSlippage:  maxSlippage,
+		ExpiresAt:    q.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+func castToPurchase(p repository.Purchase) Purchase {
+	result := Purchase{
+		ProductType: p.ProductType,
+		ProductRef:  p.ProductRef,
+		PriceUSD:    p.PriceUsd,
+		Asset:       p.Asset,
+		Amount:      p.Amount,
+		TxHash:      p.TxHash,
+		CreatedAt:   p.CreatedAt.Format(time.RFC3339),
+	}
+	if p.ID != uuid.Nil {
+		result.ID = p.ID.String()
+	}
+	if p.QuoteID.Valid {
+		result.QuoteID = p.QuoteID.UUID.String()
+	}
+
+	return result
+}
@@ -0,0 +1,32 @@
+package pricing
+
+import "time"
+
+// WithQuoteTTL sets how long a locked quote stays valid
+func WithQuoteTTL(ttl time.Duration) ServiceOption {
+	return func(s *Service) {
+		if ttl > 0 {
+			s.quoteTTL = ttl
+		}
+	}
+}
+
+// WithMaxSlippage sets the maximum allowed price increase between quote and payment,
+// e.g. 0.02 means 2%
+func WithMaxSlippage(slippage float64) ServiceOption {
+	return func(s *Service) {
+		if slippage > 0 {
+			s.maxSlippage = slippage
+		}
+	}
+}
+
+// WithDiscountFunctions sets functions to apply the user's discount to the next purchase,
+// to claim it before the payment and to release it if the payment fails
+func WithDiscountFunctions(getFn getDiscountFunc, useFn useDiscountFunc, releaseFn releaseDiscountFunc) ServiceOption {
+	return func(s *Service) {
+		s.getDiscountFn = getFn
+		s.useDiscountFn = useFn
+		s.releaseDiscountFn = releaseFn
+	}
+}
@@ -0,0 +1,327 @@
+package pricing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/svc/exchange_rates"
+	"github.com/SatorNetwork/sator-api/svc/pricing/repository"
+)
+
+func TestConvertAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		priceUSD float64
+		rate     float64
+		asset    string
+		want     float64
+	}{
+		{name: "usdc is rounded up to 6 decimals", priceUSD: 1, rate: 3, asset: AssetUSDC, want: 0.333334},
+		{name: "sol is rounded up to 9 decimals", priceUSD: 1, rate: 3, asset: AssetSOL, want: 0.333333334},
+		{name: "exact amount", priceUSD: 10, rate: 2, asset: AssetSOL, want: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertAmount(tt.priceUSD, tt.rate, tt.asset); got != tt.want {
+				t.Errorf("convertAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExceedsSlippage(t *testing.T) {
+	tests := []struct {
+		name    string
+		quoted  float64
+		current float64
+		want    bool
+	}{
+		{name: "same price", quoted: 10, current: 10, want: false},
+		{name: "price decreased", quoted: 10, current: 5, want: false},
+		{name: "within slippage", quoted: 10, current: 10.2, want: false},
+		{name: "exceeds slippage", quoted: 10, current: 10.3, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exceedsSlippage(tt.quoted, tt.current, 0.02); got != tt.want {
+				t.Errorf("exceedsSlippage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyDiscount(t *testing.T) {
+	tests := []struct {
+		name    string
+		price   float64
+		percent float64
+		want    float64
+	}{
+		{name: "no discount", price: 50, percent: 0, want: 50},
+		{name: "partial discount", price: 50, percent: 20, want: 40},
+		{name: "full discount", price: 50, percent: 100, want: 0},
+		{name: "discount above 100%", price: 50, percent: 150, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyDiscount(tt.price, tt.percent); got != tt.want {
+				t.Errorf("applyDiscount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeRepo struct {
+	quotes    map[uuid.UUID]repository.PriceQuote
+	purchases []repository.AddPurchaseParams
+}
+
+func (r *fakeRepo) AddPriceQuote(_ context.Context, arg repository.AddPriceQuoteParams) (repository.PriceQuote, error) {
+	q := repository.PriceQuote{
+		ID:              uuid.New(),
+		UserID:          arg.UserID,
+		ProductType:     arg.ProductType,
+		ProductRef:      arg.ProductRef,
+		PriceUsd:        arg.PriceUsd,
+		Asset:           arg.Asset,
+		AssetUsdRate:    arg.AssetUsdRate,
+		Amount:          arg.Amount,
+		ExpiresAt:       arg.ExpiresAt,
+		CreatedAt:       time.Now(),
+		DiscountID:      arg.DiscountID,
+		DiscountPercent: arg.DiscountPercent,
+	}
+	r.quotes[q.ID] = q
+	return q, nil
+}
+
+func (r *fakeRepo) GetPriceQuoteByID(_ context.Context, id uuid.UUID) (repository.PriceQuote, error) {
+	q, ok := r.quotes[id]
+	if !ok {
+		return repository.PriceQuote{}, sql.ErrNoRows
+	}
+	return q, nil
+}
+
+func (r *fakeRepo) MarkPriceQuoteAsUsed(_ context.Context, id uuid.UUID) (repository.PriceQuote, error) {
+	q, ok := r.quotes[id]
+	if !ok || q.UsedAt.Valid {
+		return repository.PriceQuote{}, sql.ErrNoRows
+	}
+	q.UsedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	r.quotes[id] = q
+	return q, nil
+}
+
+func (r *fakeRepo) ReleasePriceQuote(_ context.Context, id uuid.UUID) error {
+	q := r.quotes[id]
+	q.UsedAt = sql.NullTime{}
+	r.quotes[id] = q
+	return nil
+}
+
+func (r *fakeRepo) AddPurchase(_ context.Context, arg repository.AddPurchaseParams) (repository.Purchase, error) {
+	r.purchases = append(r.purchases, arg)
+	return repository.Purchase{ID: uuid.New(), UserID: arg.UserID, TxHash: arg.TxHash, CreatedAt: time.Now()}, nil
+}
+
+func (r *fakeRepo) GetPurchasesByUserID(context.Context, repository.GetPurchasesByUserIDParams) ([]repository.Purchase, error) {
+	return nil, nil
+}
+
+type fakeRates map[exchange_rates.AssetType]float64
+
+func (r fakeRates) GetAssetPrice(_ context.Context, req *exchange_rates.Asset) (*exchange_rates.Price, error) {
+	return &exchange_rates.Price{Usd: r[req.AssetType]}, nil
+}
+
+func TestPay(t *testing.T) {
+	ctx := context.Background()
+	owner, stranger := uuid.New(), uuid.New()
+	rates := fakeRates{exchange_rates.AssetTypeSAO: 0.5, exchange_rates.AssetTypeUSDC: 1}
+	errPayment := errors.New("payment failed")
+
+	tests := []struct {
+		name         string
+		payer        uuid.UUID
+		expired      bool
+		payErr       error
+		wantErr      error
+		wantCharged  bool
+		wantReleased bool
+	}{
+		{name: "paid", payer: owner, wantCharged: true},
+		{name: "quote of another user", payer: stranger, wantErr: ErrQuoteNotFound},
+		{name: "expired quote", payer: owner, expired: true, wantErr: ErrQuoteExpired},
+		{name: "quote is released on failed payment", payer: owner, payErr: errPayment, wantErr: errPayment, wantCharged: true, wantReleased: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepo{quotes: make(map[uuid.UUID]repository.PriceQuote)}
+			charged := false
+			s := NewService(repo, func(_ context.Context, uid uuid.UUID, asset string, amount float64, _ string) (string, error) {
+				charged = true
+				if uid != owner || asset != AssetUSDC || amount != 5 {
+					t.Errorf("unexpected charge: %s %v %s", uid, amount, asset)
+				}
+				return "tx", tt.payErr
+			}, rates)
+
+			q, err := s.CreateQuote(ctx, owner, ProductNFT, "nft-1", 10, AssetUSDC)
+			if err != nil {
+				t.Fatalf("CreateQuote() error = %v", err)
+			}
+			quoteID := uuid.MustParse(q.ID)
+			if tt.expired {
+				stored := repo.quotes[quoteID]
+				stored.ExpiresAt = time.Now().Add(-time.Second)
+				repo.quotes[quoteID] = stored
+			}
+
+			_, err = s.Pay(ctx, tt.payer, quoteID, ProductNFT, "nft-1", 10, "nft")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Pay() error = %v, want %v", err, tt.wantErr)
+			}
+			if charged != tt.wantCharged {
+				t.Errorf("charged = %v, want %v", charged, tt.wantCharged)
+			}
+
+			used := repo.quotes[quoteID].UsedAt.Valid
+			switch {
+			case tt.wantErr == nil && (!used || len(repo.purchases) != 1):
+				t.Errorf("quote used = %v, purchases = %d, want used quote and 1 purchase", used, len(repo.purchases))
+			case tt.wantReleased && used:
+				t.Errorf("quote is not released after failed payment")
+			}
+		})
+	}
+}
+
+func TestPayWithUsedQuote(t *testing.T) {
+	ctx := context.Background()
+	uid := uuid.New()
+	repo := &fakeRepo{quotes: make(map[uuid.UUID]repository.PriceQuote)}
+	payments := 0
+	s := NewService(repo, func(context.Context, uuid.UUID, string, float64, string) (string, error) {
+		payments++
+		return "tx", nil
+	}, fakeRates{exchange_rates.AssetTypeSAO: 1})
+
+	q, err := s.CreateQuote(ctx, uid, ProductEpisodeUnlock, "ep-1", 10, AssetSAO)
+	if err != nil {
+		t.Fatalf("CreateQuote() error = %v", err)
+	}
+	quoteID := uuid.MustParse(q.ID)
+
+	if _, err := s.Pay(ctx, uid, quoteID, ProductEpisodeUnlock, "ep-1", 10, "unlock"); err != nil {
+		t.Fatalf("first Pay() error = %v", err)
+	}
+	if _, err := s.Pay(ctx, uid, quoteID, ProductEpisodeUnlock, "ep-1", 10, "unlock"); !errors.Is(err, ErrQuoteAlreadyUsed) {
+		t.Errorf("second Pay() error = %v, want %v", err, ErrQuoteAlreadyUsed)
+	}
+	if payments != 1 {
+		t.Errorf("payments = %d, want 1", payments)
+	}
+}
+
+func TestPayWithDiscount(t *testing.T) {
+	ctx := context.Background()
+	uid, discountID := uuid.New(), uuid.New()
+	errPayment := errors.New("payment failed")
+
+	tests := []struct {
+		name         string
+		claimErr     error
+		payErr       error
+		wantErr      error
+		wantAmount   float64
+		wantReleased bool
+	}{
+		{name: "discount is applied", wantAmount: 8},
+		{name: "discount used concurrently", claimErr: errors.New("not found"), wantErr: ErrDiscountUsed},
+		{name: "discount is released on failed payment", payErr: errPayment, wantErr: errPayment, wantAmount: 8, wantReleased: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var charged float64
+			released := false
+			s := NewService(&fakeRepo{quotes: make(map[uuid.UUID]repository.PriceQuote)},
+				func(_ context.Context, _ uuid.UUID, _ string, amount float64, _ string) (string, error) {
+					charged = amount
+					return "tx", tt.payErr
+				},
+				fakeRates{exchange_rates.AssetTypeSAO: 1},
+				WithDiscountFunctions(
+					func(context.Context, uuid.UUID) (uuid.UUID, float64, error) { return discountID, 20, nil },
+					func(_ context.Context, _, id uuid.UUID) error {
+						if id != discountID {
+							t.Errorf("used discount = %s, want %s", id, discountID)
+						}
+						return tt.claimErr
+					},
+					func(_ context.Context, id uuid.UUID) error {
+						released = id == discountID
+						return nil
+					},
+				),
+			)
+
+			_, err := s.Pay(ctx, uid, uuid.Nil, ProductEpisodeUnlock, "ep-1", 10, "unlock")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Pay() error = %v, want %v", err, tt.wantErr)
+			}
+			if charged != tt.wantAmount {
+				t.Errorf("charged = %v, want %v", charged, tt.wantAmount)
+			}
+			if released != tt.wantReleased {
+				t.Errorf("released = %v, want %v", released, tt.wantReleased)
+			}
+		})
+	}
+}
+
+func TestPayWithQuoteUsesQuotedDiscount(t *testing.T) {
+	ctx := context.Background()
+	uid, quotedID, laterID := uuid.New(), uuid.New(), uuid.New()
+
+	// the user gets a bigger discount after the quote is issued
+	currentID, currentPercent := quotedID, float64(20)
+	var used uuid.UUID
+	var charged float64
+	s := NewService(&fakeRepo{quotes: make(map[uuid.UUID]repository.PriceQuote)},
+		func(_ context.Context, _ uuid.UUID, _ string, amount float64, _ string) (string, error) {
+			charged = amount
+			return "tx", nil
+		},
+		fakeRates{exchange_rates.AssetTypeSAO: 1},
+		WithDiscountFunctions(
+			func(context.Context, uuid.UUID) (uuid.UUID, float64, error) { return currentID, currentPercent, nil },
+			func(_ context.Context, _, id uuid.UUID) error {
+				used = id
+				return nil
+			},
+			func(context.Context, uuid.UUID) error { return nil },
+		),
+	)
+
+	q, err := s.CreateQuote(ctx, uid, ProductEpisodeUnlock, "ep-1", 10, AssetSAO)
+	if err != nil {
+		t.Fatalf("CreateQuote() error = %v", err)
+	}
+	currentID, currentPercent = laterID, 50
+
+	if _, err := s.Pay(ctx, uid, uuid.MustParse(q.ID), ProductEpisodeUnlock, "ep-1", 10, "unlock"); err != nil {
+		t.Fatalf("Pay() error = %v", err)
+	}
+	if used != quotedID {
+		t.Errorf("used discount = %s, want %s", used, quotedID)
+	}
+	if charged != 8 {
+		t.Errorf("charged = %v, want 8", charged)
+	}
+}
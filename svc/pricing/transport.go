@@ -0,0 +1,80 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	jwtkit "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+)
+
+type (
+	logger interface {
+		Log(keyvals ...interface{}) error
+	}
+)
+
+const (
+	pageParam         = "page"
+	itemsPerPageParam = "items_per_page"
+)
+
+// MakeHTTPHandler ...
+func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
+	r := chi.NewRouter()
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
+		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, CodeAndMessageFrom)),
+		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+	}
+
+	r.Get("/purchases", httptransport.NewServer(
+		e.GetPurchases,
+		decodeGetPurchasesRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	return r
+}
+
+// CodeAndMessageFrom returns http error code by error type.
+// It's exported to be reused by services which accept payments via pricing service.
+func CodeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrInvalidParameter) ||
+		errors.Is(err, ErrUnsupportedAsset) ||
+		errors.Is(err, ErrQuoteMismatch) {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	if errors.Is(err, ErrQuoteNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+
+	if errors.Is(err, ErrQuoteExpired) ||
+		errors.Is(err, ErrQuoteAlreadyUsed) ||
+		errors.Is(err, ErrSlippageExceeded) ||
+		errors.Is(err, ErrDiscountUsed) {
+		return http.StatusConflict, err.Error()
+	}
+
+	if errors.Is(err, ErrExchangeRateUnset) {
+		return http.StatusServiceUnavailable, err.Error()
+	}
+
+	return httpencoder.CodeAndMessageFrom(err)
+}
+
+func decodeGetPurchasesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return utils.PaginationRequest{
+		Page:         utils.StrToInt32(r.URL.Query().Get(pageParam)),
+		ItemsPerPage: utils.StrToInt32(r.URL.Query().Get(itemsPerPageParam)),
+	}, nil
+}
@@ -25,6 +25,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createProfileStmt, err = db.PrepareContext(ctx, createProfile); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateProfile: %w", err)
 	}
+	if q.deleteProfileByUserIDStmt, err = db.PrepareContext(ctx, deleteProfileByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProfileByUserID: %w", err)
+	}
 	if q.getProfileByUserIDStmt, err = db.PrepareContext(ctx, getProfileByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetProfileByUserID: %w", err)
 	}
@@ -47,6 +50,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createProfileStmt: %w", cerr)
 		}
 	}
+	if q.deleteProfileByUserIDStmt != nil {
+		if cerr := q.deleteProfileByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProfileByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getProfileByUserIDStmt != nil {
 		if cerr := q.getProfileByUserIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getProfileByUserIDStmt: %w", cerr)
@@ -107,6 +115,7 @@ type Queries struct {
 	db                        DBTX
 	tx                        *sql.Tx
 	createProfileStmt         *sql.Stmt
+	deleteProfileByUserIDStmt *sql.Stmt
 	getProfileByUserIDStmt    *sql.Stmt
 	updateAvatarStmt          *sql.Stmt
 	updateProfileByIDStmt     *sql.Stmt
@@ -118,6 +127,7 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		db:                        tx,
 		tx:                        tx,
 		createProfileStmt:         q.createProfileStmt,
+		deleteProfileByUserIDStmt: q.deleteProfileByUserIDStmt,
 		getProfileByUserIDStmt:    q.getProfileByUserIDStmt,
 		updateAvatarStmt:          q.updateAvatarStmt,
 		updateProfileByIDStmt:     q.updateProfileByIDStmt,
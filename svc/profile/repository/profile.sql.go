@@ -36,6 +36,16 @@ func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (P
 	return i, err
 }
 
+const deleteProfileByUserID = `-- name: DeleteProfileByUserID :exec
+DELETE FROM profiles
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteProfileByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteProfileByUserIDStmt, deleteProfileByUserID, userID)
+	return err
+}
+
 const getProfileByUserID = `-- name: GetProfileByUserID :one
 SELECT id, user_id, first_name, last_name, updated_at, created_at, avatar
 FROM profiles
@@ -19,4 +19,7 @@ WHERE user_id = @user_id;
 -- name: UpdateAvatar :exec
 UPDATE profiles
 SET avatar = @avatar
+WHERE user_id = @user_id;
+-- name: DeleteProfileByUserID :exec
+DELETE FROM profiles
 WHERE user_id = @user_id;
\ No newline at end of file
@@ -14,13 +14,21 @@ import (
 type (
 	// Service struct
 	Service struct {
-		pr profileRepository
+		pr          profileRepository
+		eraseFileFn eraseFileFunc // removes the file uploaded by the user
 	}
 
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
+	eraseFileFunc func(ctx context.Context, userID uuid.UUID, fileURL string) error
+
 	profileRepository interface {
 		CreateProfile(ctx context.Context, arg repository.CreateProfileParams) (repository.Profile, error)
 		GetProfileByUserID(ctx context.Context, userID uuid.UUID) (repository.Profile, error)
 		UpdateAvatar(ctx context.Context, arg repository.UpdateAvatarParams) error
+		DeleteProfileByUserID(ctx context.Context, userID uuid.UUID) error
 	}
 
 	// Profile struct
@@ -34,11 +42,25 @@ type (
 )
 
 // NewService is a factory function, returns a new instance of the Service interface implementation
-func NewService(pr profileRepository) *Service {
+func NewService(pr profileRepository, opt ...ServiceOption) *Service {
 	if pr == nil {
 		log.Fatalln("profile repository is not set")
 	}
-	return &Service{pr: pr}
+
+	s := &Service{pr: pr}
+
+	for _, fn := range opt {
+		fn(s)
+	}
+
+	return s
+}
+
+// WithFileEraser sets function which removes the avatar image uploaded by the user on account deletion
+func WithFileEraser(fn eraseFileFunc) ServiceOption {
+	return func(s *Service) {
+		s.eraseFileFn = fn
+	}
 }
 
 // GetProfileByUserID returns user profile by user id
@@ -103,3 +125,46 @@ func (s *Service) UpdateAvatar(ctx context.Context, uid uuid.UUID, avatar string
 
 	return nil
 }
+
+// ExportUserData returns the profile of the user for the data export archive,
+// nil if the user has no profile yet.
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	p, err := s.pr.GetProfileByUserID(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not get user profile: %w", err)
+	}
+
+	profile := castToProfile(p, "")
+	if !p.Avatar.Valid {
+		profile.Avatar = ""
+	}
+
+	return profile, nil
+}
+
+// EraseUserData deletes the profile of the user with names and avatar on account deletion.
+// The avatar image is removed before the profile, so it's not lost if the removal fails.
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	p, err := s.pr.GetProfileByUserID(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get user profile: %w", err)
+	}
+
+	if p.Avatar.Valid && p.Avatar.String != "" && s.eraseFileFn != nil {
+		if err := s.eraseFileFn(ctx, userID, p.Avatar.String); err != nil {
+			return fmt.Errorf("could not erase avatar: %w", err)
+		}
+	}
+
+	if err := s.pr.DeleteProfileByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete user profile: %w", err)
+	}
+
+	return nil
+}
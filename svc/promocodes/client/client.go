@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Client struct
+	Client struct {
+		s service
+	}
+
+	service interface {
+		GetDiscount(ctx context.Context, userID uuid.UUID) (uuid.UUID, float64, error)
+		UseDiscount(ctx context.Context, userID, discountID uuid.UUID) error
+		ReleaseDiscount(ctx context.Context, discountID uuid.UUID) error
+	}
+)
+
+// New promo codes service client implementation
+func New(s service) *Client {
+	return &Client{s: s}
+}
+
+// GetDiscount returns id and percent of the user's discount for the next purchase, uuid.Nil if there is none
+func (c *Client) GetDiscount(ctx context.Context, userID uuid.UUID) (uuid.UUID, float64, error) {
+	return c.s.GetDiscount(ctx, userID)
+}
+
+// UseDiscount marks the user's discount as used
+func (c *Client) UseDiscount(ctx context.Context, userID, discountID uuid.UUID) error {
+	return c.s.UseDiscount(ctx, userID, discountID)
+}
+
+// ReleaseDiscount makes the used discount available again
+func (c *Client) ReleaseDiscount(ctx context.Context, discountID uuid.UUID) error {
+	return c.s.ReleaseDiscount(ctx, discountID)
+}
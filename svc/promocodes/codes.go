@@ -0,0 +1,127 @@
+package promocodes
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/promocodes/repository"
+)
+
+// Promo code reward types
+const (
+	RewardSAO           = "sao"            // reward amount of SAO tokens
+	RewardEpisodeUnlock = "episode_unlock" // free episode unlock, ref is episode id, option is unlock option
+	RewardPuzzleUnlock  = "puzzle_unlock"  // free puzzle game unlock, ref is puzzle game id, option is unlock option
+	RewardNFTPack       = "nft_pack"       // free NFT pack opening, ref is pack id
+	RewardDiscount      = "discount"       // discount percent on the next purchase
+)
+
+// codeAlphabet excludes characters which are easy to confuse: 0/O, 1/I/L
+const codeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// Code generation limits
+const (
+	defaultCodeLength = 10
+	minCodeLength     = 6
+	maxCodeLength     = 32
+	maxBatchSize      = 10000
+)
+
+// RewardTypes returns list of supported promo code reward types
+func RewardTypes() []string {
+	return []string{
+		RewardSAO,
+		RewardEpisodeUnlock,
+		RewardPuzzleUnlock,
+		RewardNFTPack,
+		RewardDiscount,
+	}
+}
+
+func isRewardTypeSupported(rewardType string) bool {
+	for _, t := range RewardTypes() {
+		if t == rewardType {
+			return true
+		}
+	}
+	return false
+}
+
+// generateCode returns a random code of the given length with optional prefix.
+func generateCode(prefix string, length int) (string, error) {
+	max := big.NewInt(int64(len(codeAlphabet)))
+
+	var b strings.Builder
+	b.WriteString(normalizeCode(prefix))
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(codeAlphabet[n.Int64()])
+	}
+
+	return b.String(), nil
+}
+
+// normalizeCode makes codes case-insensitive and tolerant to surrounding spaces.
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// checkRedeemable reports why the user can't redeem the code, if so.
+// Missing, disabled, not started and expired codes return the same error
+// to not let users enumerate existing codes.
+func checkRedeemable(c repository.PromoCode, userRedemptions int64, now time.Time) error {
+	if c.Disabled || now.Before(c.StartsAt) {
+		return ErrInvalidCode
+	}
+	if c.ExpiresAt.Valid && !now.Before(c.ExpiresAt.Time) {
+		return ErrInvalidCode
+	}
+	if c.MaxRedemptions > 0 && c.RedemptionsCount >= c.MaxRedemptions {
+		return ErrCodeExhausted
+	}
+	if c.PerUserLimit > 0 && userRedemptions >= int64(c.PerUserLimit) {
+		return ErrAlreadyRedeemed
+	}
+
+	return nil
+}
+
+func validatePromoCode(c PromoCode) error {
+	if !isRewardTypeSupported(c.RewardType) {
+		return fmt.Errorf("%w: unsupported reward type %s", ErrInvalidParameter, c.RewardType)
+	}
+	if c.MaxRedemptions < 0 || c.PerUserLimit < 0 {
+		return fmt.Errorf("%w: redemption limits must not be negative", ErrInvalidParameter)
+	}
+	if c.ExpiresAt != nil && !c.ExpiresAt.After(c.StartsAt) {
+		return fmt.Errorf("%w: expires_at must be after starts_at", ErrInvalidParameter)
+	}
+
+	switch c.RewardType {
+	case RewardSAO:
+		if c.RewardAmount <= 0 {
+			return fmt.Errorf("%w: reward amount must be positive", ErrInvalidParameter)
+		}
+	case RewardDiscount:
+		// Zero price can't be converted into SAO, so full discounts are not allowed.
+		if c.RewardAmount <= 0 || c.RewardAmount >= 100 {
+			return fmt.Errorf("%w: discount percent must be between 0 and 100", ErrInvalidParameter)
+		}
+	case RewardEpisodeUnlock, RewardPuzzleUnlock:
+		if c.RewardRef == "" || c.RewardOption == "" {
+			return fmt.Errorf("%w: reward ref and option are required", ErrInvalidParameter)
+		}
+	case RewardNFTPack:
+		if c.RewardRef == "" {
+			return fmt.Errorf("%w: reward ref is required", ErrInvalidParameter)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package promocodes
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/promocodes/repository"
+)
+
+func TestGenerateCode(t *testing.T) {
+	code, err := generateCode("promo", 12)
+	if err != nil {
+		t.Fatalf("generateCode() error = %v", err)
+	}
+	if !strings.HasPrefix(code, "PROMO") {
+		t.Errorf("generateCode() = %s, want PROMO prefix", code)
+	}
+	if len(code) != len("PROMO")+12 {
+		t.Errorf("generateCode() length = %d, want %d", len(code), len("PROMO")+12)
+	}
+	for _, r := range strings.TrimPrefix(code, "PROMO") {
+		if !strings.ContainsRune(codeAlphabet, r) {
+			t.Errorf("generateCode() = %s contains unexpected character %q", code, r)
+		}
+	}
+}
+
+func TestCheckRedeemable(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name            string
+		code            repository.PromoCode
+		userRedemptions int64
+		wantErr         error
+	}{
+		{
+			name: "redeemable",
+			code: repository.PromoCode{MaxRedemptions: 1, PerUserLimit: 1, StartsAt: now.Add(-time.Hour)},
+		},
+		{
+			name:    "disabled",
+			code:    repository.PromoCode{Disabled: true, StartsAt: now.Add(-time.Hour)},
+			wantErr: ErrInvalidCode,
+		},
+		{
+			name:    "not started",
+			code:    repository.PromoCode{StartsAt: now.Add(time.Hour)},
+			wantErr: ErrInvalidCode,
+		},
+		{
+			name: "expired",
+			code: repository.PromoCode{
+				StartsAt:  now.Add(-time.Hour),
+				ExpiresAt: sql.NullTime{Time: now.Add(-time.Minute), Valid: true},
+			},
+			wantErr: ErrInvalidCode,
+		},
+		{
+			name:    "single use redeemed",
+			code:    repository.PromoCode{MaxRedemptions: 1, RedemptionsCount: 1, StartsAt: now.Add(-time.Hour)},
+			wantErr: ErrCodeExhausted,
+		},
+		{
+			name:            "per user limit reached",
+			code:            repository.PromoCode{MaxRedemptions: 0, PerUserLimit: 2, RedemptionsCount: 10, StartsAt: now.Add(-time.Hour)},
+			userRedemptions: 2,
+			wantErr:         ErrAlreadyRedeemed,
+		},
+		{
+			name:            "unlimited",
+			code:            repository.PromoCode{RedemptionsCount: 100, StartsAt: now.Add(-time.Hour)},
+			userRedemptions: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkRedeemable(tt.code, tt.userRedemptions, now); !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkRedeemable() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePromoCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    PromoCode
+		wantErr bool
+	}{
+		{
+			name: "sao",
+			code: PromoCode{RewardType: RewardSAO, RewardAmount: 10},
+		},
+		{
+			name:    "sao without amount",
+			code:    PromoCode{RewardType: RewardSAO},
+			wantErr: true,
+		},
+		{
+			name: "discount",
+			code: PromoCode{RewardType: RewardDiscount, RewardAmount: 20},
+		},
+		{
+			name:    "full discount",
+			code:    PromoCode{RewardType: RewardDiscount, RewardAmount: 100},
+			wantErr: true,
+		},
+		{
+			name:    "episode unlock without option",
+			code:    PromoCode{RewardType: RewardEpisodeUnlock, RewardRef: "ref"},
+			wantErr: true,
+		},
+		{
+			name: "nft pack",
+			code: PromoCode{RewardType: RewardNFTPack, RewardRef: "ref"},
+		},
+		{
+			name:    "unsupported reward type",
+			code:    PromoCode{RewardType: "unknown"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validatePromoCode(tt.code); (err != nil) != tt.wantErr {
+				t.Errorf("validatePromoCode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+package promocodes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/jwt"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+	"github.com/SatorNetwork/sator-api/lib/validator"
+)
+
+type (
+	// Endpoints collection of promo codes service
+	Endpoints struct {
+		Redeem endpoint.Endpoint
+
+		AddPromoCode        endpoint.Endpoint
+		GetPromoCodeByID    endpoint.Endpoint
+		GetPromoCodes       endpoint.Endpoint
+		UpdatePromoCode     endpoint.Endpoint
+		DeletePromoCodeByID endpoint.Endpoint
+		GetStats            endpoint.Endpoint
+
+		GenerateBatch endpoint.Endpoint
+		GetBatchCodes endpoint.Endpoint
+		GetBatchStats endpoint.Endpoint
+	}
+
+	service interface {
+		Redeem(ctx context.Context, userID uuid.UUID, code string) (Redemption, error)
+
+		AddPromoCode(ctx context.Context, c PromoCode) (PromoCode, error)
+		GetPromoCodeByID(ctx context.Context, id uuid.UUID) (PromoCode, error)
+		GetPromoCodes(ctx context.Context, limit, offset int32) ([]PromoCode, error)
+		UpdatePromoCode(ctx context.Context, c PromoCode) error
+		DeletePromoCodeByID(ctx context.Context, id uuid.UUID) error
+		GetStats(ctx context.Context, id uuid.UUID) (Stats, error)
+
+		GenerateBatch(ctx context.Context, b Batch) (BatchResult, error)
+		GetBatchCodes(ctx context.Context, batchID uuid.UUID) ([]PromoCode, error)
+		GetBatchStats(ctx context.Context, batchID uuid.UUID) (BatchStats, error)
+	}
+
+	// RedeemRequest struct
+	RedeemRequest struct {
+		Code string `json:"code" validate:"required,max=64"`
+	}
+
+	// RewardParams struct
+	RewardParams struct {
+		Title          string     `json:"title" validate:"required"`
+		RewardType     string     `json:"reward_type" validate:"required"`
+		RewardAmount   float64    `json:"reward_amount" validate:"gte=0"`
+		RewardRef      string     `json:"reward_ref"`
+		RewardOption   string     `json:"reward_option"`
+		MaxRedemptions int32      `json:"max_redemptions" validate:"gte=0"`
+		PerUserLimit   int32      `json:"per_user_limit" validate:"gte=0"`
+		StartsAt       time.Time  `json:"starts_at"`
+		ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+		Disabled       bool       `json:"disabled"`
+	}
+
+	// AddPromoCodeRequest struct
+	AddPromoCodeRequest struct {
+		Code string `json:"code" validate:"required"`
+		RewardParams
+	}
+
+	// UpdatePromoCodeRequest struct
+	UpdatePromoCodeRequest struct {
+		ID             string     `json:"-" validate:"required,uuid"`
+		Title          string     `json:"title" validate:"required"`
+		MaxRedemptions int32      `json:"max_redemptions" validate:"gte=0"`
+		PerUserLimit   int32      `json:"per_user_limit" validate:"gte=0"`
+		StartsAt       time.Time  `json:"starts_at" validate:"required"`
+		ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+		Disabled       bool       `json:"disabled"`
+	}
+
+	// GetPromoCodesRequest struct
+	GetPromoCodesRequest struct {
+		utils.PaginationRequest
+	}
+
+	// GenerateBatchRequest struct
+	GenerateBatchRequest struct {
+		Prefix string `json:"prefix" validate:"omitempty,alphanum"`
+		Length int    `json:"length" validate:"gte=0"`
+		Count  int    `json:"count" validate:"required,gt=0"`
+		RewardParams
+	}
+)
+
+// MakeEndpoints ...
+func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
+	validateFunc := validator.ValidateStruct()
+
+	e := Endpoints{
+		Redeem: MakeRedeemEndpoint(s, validateFunc),
+
+		AddPromoCode:        MakeAddPromoCodeEndpoint(s, validateFunc),
+		GetPromoCodeByID:    MakeGetPromoCodeByIDEndpoint(s),
+		GetPromoCodes:       MakeGetPromoCodesEndpoint(s, validateFunc),
+		UpdatePromoCode:     MakeUpdatePromoCodeEndpoint(s, validateFunc),
+		DeletePromoCodeByID: MakeDeletePromoCodeByIDEndpoint(s),
+		GetStats:            MakeGetStatsEndpoint(s),
+
+		GenerateBatch: MakeGenerateBatchEndpoint(s, validateFunc),
+		GetBatchCodes: MakeGetBatchCodesEndpoint(s),
+		GetBatchStats: MakeGetBatchStatsEndpoint(s),
+	}
+
+	// setup middlewares for each endpoints
+	if len(m) > 0 {
+		for _, mdw := range m {
+			e.Redeem = mdw(e.Redeem)
+			e.AddPromoCode = mdw(e.AddPromoCode)
+			e.GetPromoCodeByID = mdw(e.GetPromoCodeByID)
+			e.GetPromoCodes = mdw(e.GetPromoCodes)
+			e.UpdatePromoCode = mdw(e.UpdatePromoCode)
+			e.DeletePromoCodeByID = mdw(e.DeletePromoCodeByID)
+			e.GetStats = mdw(e.GetStats)
+			e.GenerateBatch = mdw(e.GenerateBatch)
+			e.GetBatchCodes = mdw(e.GetBatchCodes)
+			e.GetBatchStats = mdw(e.GetBatchStats)
+		}
+	}
+
+	return e
+}
+
+// MakeRedeemEndpoint ...
+func MakeRedeemEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		req := request.(RedeemRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.Redeem(ctx, uid, req.Code)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeAddPromoCodeEndpoint ...
+func MakeAddPromoCodeEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(AddPromoCodeRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		c := req.toPromoCode()
+		c.Code = req.Code
+
+		resp, err := s.AddPromoCode(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetPromoCodeByIDEndpoint ...
+func MakeGetPromoCodeByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w promo code id: %v", ErrInvalidParameter, err)
+		}
+
+		resp, err := s.GetPromoCodeByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetPromoCodesEndpoint ...
+func MakeGetPromoCodesEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(GetPromoCodesRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.GetPromoCodes(ctx, req.Limit(), req.Offset())
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeUpdatePromoCodeEndpoint ...
+func MakeUpdatePromoCodeEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(UpdatePromoCodeRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := s.UpdatePromoCode(ctx, PromoCode{
+			ID:             req.ID,
+			Title:          req.Title,
+			MaxRedemptions: req.MaxRedemptions,
+			PerUserLimit:   req.PerUserLimit,
+			StartsAt:       req.StartsAt,
+			ExpiresAt:      req.ExpiresAt,
+			Disabled:       req.Disabled,
+		}); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeDeletePromoCodeByIDEndpoint ...
+func MakeDeletePromoCodeByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w promo code id: %v", ErrInvalidParameter, err)
+		}
+
+		if err := s.DeletePromoCodeByID(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeGetStatsEndpoint ...
+func MakeGetStatsEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w promo code id: %v", ErrInvalidParameter, err)
+		}
+
+		resp, err := s.GetStats(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGenerateBatchEndpoint ...
+func MakeGenerateBatchEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(GenerateBatchRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.GenerateBatch(ctx, Batch{
+			Prefix: req.Prefix,
+			Length: req.Length,
+			Count:  req.Count,
+			Params: req.toPromoCode(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetBatchCodesEndpoint ...
+func MakeGetBatchCodesEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w batch id: %v", ErrInvalidParameter, err)
+		}
+
+		resp, err := s.GetBatchCodes(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetBatchStatsEndpoint ...
+func MakeGetBatchStatsEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w batch id: %v", ErrInvalidParameter, err)
+		}
+
+		resp, err := s.GetBatchStats(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+func (r RewardParams) toPromoCode() PromoCode {
+	return PromoCode{
+		Title:          r.Title,
+		RewardType:     r.RewardType,
+		RewardAmount:   r.RewardAmount,
+		RewardRef:      r.RewardRef,
+		RewardOption:   r.RewardOption,
+		MaxRedemptions: r.MaxRedemptions,
+		PerUserLimit:   r.PerUserLimit,
+		StartsAt:       r.StartsAt,
+		ExpiresAt:      r.ExpiresAt,
+		Disabled:       r.Disabled,
+	}
+}
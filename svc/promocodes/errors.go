@@ -0,0 +1,13 @@
+package promocodes
+
+import "errors"
+
+// Predefined package errors
+var (
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrNotFound         = errors.New("not found")
+	ErrInvalidCode      = errors.New("invalid promo code")
+	ErrCodeExhausted    = errors.New("promo code has been fully redeemed")
+	ErrAlreadyRedeemed  = errors.New("promo code has already been redeemed")
+	ErrTooManyAttempts  = errors.New("too many attempts, try again later")
+)
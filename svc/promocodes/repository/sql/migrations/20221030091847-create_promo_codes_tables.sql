@@ -0,0 +1,60 @@
+-- +migrate Up
+-- +migrate StatementBegin
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE
+OR REPLACE FUNCTION promo_codes_update_updated_at_column() RETURNS TRIGGER AS $$
+BEGIN NEW .updated_at = NOW();
+RETURN NEW;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR NOT NULL,
+    batch_id uuid DEFAULT NULL, -- codes generated at once share the batch id
+    title VARCHAR NOT NULL DEFAULT '',
+    reward_type VARCHAR NOT NULL,
+    reward_amount DOUBLE PRECISION NOT NULL DEFAULT 0, -- SAO amount or discount percent
+    reward_ref VARCHAR NOT NULL DEFAULT '', -- episode, puzzle game or nft pack id
+    reward_option VARCHAR NOT NULL DEFAULT '', -- unlock option of episode or puzzle game
+    max_redemptions INT NOT NULL DEFAULT 1, -- 1 means single-use code, 0 means unlimited
+    per_user_limit INT NOT NULL DEFAULT 1,
+    redemptions_count INT NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP DEFAULT NULL,
+    disabled BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX promo_codes_code_uniq ON promo_codes (code);
+CREATE INDEX promo_codes_batch_id ON promo_codes USING BTREE (batch_id);
+CREATE TRIGGER update_promo_codes_modtime BEFORE
+UPDATE ON promo_codes FOR EACH ROW EXECUTE PROCEDURE promo_codes_update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS promo_code_redemptions (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    promo_code_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    reward_type VARCHAR NOT NULL,
+    reward_amount DOUBLE PRECISION NOT NULL DEFAULT 0,
+    discount_used_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY (promo_code_id) REFERENCES promo_codes(id) ON DELETE CASCADE
+);
+CREATE INDEX promo_code_redemptions_promo_code_id_user_id ON promo_code_redemptions USING BTREE (promo_code_id, user_id);
+CREATE INDEX promo_code_redemptions_user_id ON promo_code_redemptions USING BTREE (user_id);
+
+-- redemption attempts are used to throttle codes guessing
+CREATE TABLE IF NOT EXISTS promo_code_attempts (
+    user_id uuid NOT NULL,
+    code VARCHAR NOT NULL,
+    succeeded BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX promo_code_attempts_user_id_created_at ON promo_code_attempts USING BTREE (user_id, created_at);
+-- +migrate Down
+DROP TABLE IF EXISTS promo_code_attempts;
+DROP TABLE IF EXISTS promo_code_redemptions;
+DROP TRIGGER IF EXISTS update_promo_codes_modtime ON promo_codes;
+DROP TABLE IF EXISTS promo_codes;
+DROP FUNCTION IF EXISTS promo_codes_update_updated_at_column();
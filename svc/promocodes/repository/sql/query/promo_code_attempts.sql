@@ -0,0 +1,9 @@
+-- name: AddPromoCodeAttempt :exec
+INSERT INTO promo_code_attempts (user_id, code, succeeded)
+VALUES (@user_id, @code, @succeeded);
+-- name: CountFailedPromoCodeAttempts :one
+SELECT count(*)
+FROM promo_code_attempts
+WHERE user_id = @user_id
+    AND succeeded = FALSE
+    AND created_at > @since;
@@ -0,0 +1,48 @@
+-- name: AddPromoCodeRedemption :one
+INSERT INTO promo_code_redemptions (promo_code_id, user_id, reward_type, reward_amount)
+VALUES (@promo_code_id, @user_id, @reward_type, @reward_amount)
+RETURNING *;
+-- name: CountPromoCodeRedemptionsByUserID :one
+SELECT count(*)
+FROM promo_code_redemptions
+WHERE promo_code_id = @promo_code_id
+    AND user_id = @user_id;
+-- name: GetUnusedDiscountByUserID :one
+SELECT *
+FROM promo_code_redemptions
+WHERE user_id = @user_id
+    AND reward_type = 'discount'
+    AND discount_used_at IS NULL
+ORDER BY created_at ASC
+LIMIT 1;
+-- name: UseDiscountByID :one
+UPDATE promo_code_redemptions
+SET discount_used_at = now()
+WHERE id = @id
+    AND user_id = @user_id
+    AND reward_type = 'discount'
+    AND discount_used_at IS NULL
+RETURNING *;
+-- name: ReleaseDiscountByID :exec
+UPDATE promo_code_redemptions
+SET discount_used_at = NULL
+WHERE id = @id;
+-- name: DeletePromoCodeRedemptionByID :exec
+DELETE FROM promo_code_redemptions
+WHERE id = @id;
+-- name: GetPromoCodeStats :one
+SELECT count(*)::INT AS redemptions,
+    count(DISTINCT user_id)::INT AS unique_users,
+    coalesce(sum(reward_amount), 0)::DOUBLE PRECISION AS total_amount,
+    count(discount_used_at)::INT AS discounts_used
+FROM promo_code_redemptions
+WHERE promo_code_id = @promo_code_id;
+-- name: GetPromoCodeBatchStats :one
+SELECT count(DISTINCT promo_codes.id)::INT AS codes,
+    count(DISTINCT promo_code_redemptions.promo_code_id)::INT AS redeemed_codes,
+    count(promo_code_redemptions.id)::INT AS redemptions,
+    count(DISTINCT promo_code_redemptions.user_id)::INT AS unique_users,
+    coalesce(sum(promo_code_redemptions.reward_amount), 0)::DOUBLE PRECISION AS total_amount
+FROM promo_codes
+    LEFT JOIN promo_code_redemptions ON promo_code_redemptions.promo_code_id = promo_codes.id
+WHERE promo_codes.batch_id = @batch_id;
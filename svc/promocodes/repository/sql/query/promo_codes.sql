@@ -0,0 +1,68 @@
+-- name: AddPromoCode :one
+INSERT INTO promo_codes (
+    code,
+    batch_id,
+    title,
+    reward_type,
+    reward_amount,
+    reward_ref,
+    reward_option,
+    max_redemptions,
+    per_user_limit,
+    starts_at,
+    expires_at,
+    disabled
+)
+VALUES (
+    @code,
+    @batch_id,
+    @title,
+    @reward_type,
+    @reward_amount,
+    @reward_ref,
+    @reward_option,
+    @max_redemptions,
+    @per_user_limit,
+    @starts_at,
+    @expires_at,
+    @disabled
+) RETURNING *;
+-- name: GetPromoCodeByID :one
+SELECT *
+FROM promo_codes
+WHERE id = @id;
+-- name: GetPromoCodeByCodeForUpdate :one
+SELECT *
+FROM promo_codes
+WHERE code = @code
+FOR UPDATE;
+-- name: GetPromoCodesPaginated :many
+SELECT *
+FROM promo_codes
+ORDER BY created_at DESC
+LIMIT @limit_val OFFSET @offset_val;
+-- name: GetPromoCodesByBatchID :many
+SELECT *
+FROM promo_codes
+WHERE batch_id = @batch_id
+ORDER BY code ASC;
+-- name: UpdatePromoCode :exec
+UPDATE promo_codes
+SET title = @title,
+    max_redemptions = @max_redemptions,
+    per_user_limit = @per_user_limit,
+    starts_at = @starts_at,
+    expires_at = @expires_at,
+    disabled = @disabled
+WHERE id = @id;
+-- name: IncrementPromoCodeRedemptions :exec
+UPDATE promo_codes
+SET redemptions_count = redemptions_count + 1
+WHERE id = @id;
+-- name: DecrementPromoCodeRedemptions :exec
+UPDATE promo_codes
+SET redemptions_count = redemptions_count - 1
+WHERE id = @id;
+-- name: DeletePromoCodeByID :exec
+DELETE FROM promo_codes
+WHERE id = @id;
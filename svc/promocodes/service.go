@@ -0,0 +1,708 @@
+package promocodes
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/promocodes/repository"
+)
+
+// RelationTypePromoCode indicates that reward is issued by a promo code
+const RelationTypePromoCode = "promo_code"
+
+// Redeem throttling defaults
+const (
+	defaultMaxFailedAttempts = 5
+	defaultAttemptsWindow    = time.Hour
+)
+
+type (
+	// Service struct
+	Service struct {
+		db        *sql.DB
+		repo      promocodesRepository
+		rc        rewardsClient
+		getLocker db.GetLocker
+
+		episodeUnlockFn grantUnlockFunc  // unlocks episode for free
+		puzzleUnlockFn  grantUnlockFunc  // unlocks puzzle game for free
+		nftPackFn       grantNFTPackFunc // opens NFT pack for free
+
+		maxFailedAttempts int64
+		attemptsWindow    time.Duration
+	}
+
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
+	grantUnlockFunc  func(ctx context.Context, userID, refID uuid.UUID, option string) error
+	grantNFTPackFunc func(ctx context.Context, userID, packID uuid.UUID) (interface{}, error)
+
+	// PromoCode defines a code and the reward it gives
+	PromoCode struct {
+		ID               string     `json:"id"`
+		Code             string     `json:"code"`
+		BatchID          string     `json:"batch_id,omitempty"`
+		Title            string     `json:"title"`
+		RewardType       string     `json:"reward_type"`
+		RewardAmount     float64    `json:"reward_amount"` // SAO amount or discount percent, depends on reward type
+		RewardRef        string     `json:"reward_ref,omitempty"`
+		RewardOption     string     `json:"reward_option,omitempty"`
+		MaxRedemptions   int32      `json:"max_redemptions"` // 0 means unlimited
+		PerUserLimit     int32      `json:"per_user_limit"`  // 0 means unlimited
+		RedemptionsCount int32      `json:"redemptions_count"`
+		StartsAt         time.Time  `json:"starts_at"`
+		ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+		Disabled         bool       `json:"disabled"`
+	}
+
+	// Batch defines how to generate a set of codes with the same reward
+	Batch struct {
+		Prefix string    `json:"prefix"`
+		Length int       `json:"length"`
+		Count  int       `json:"count"`
+		Params PromoCode `json:"params"`
+	}
+
+	// BatchResult struct
+	BatchResult struct {
+		BatchID string   `json:"batch_id"`
+		Codes   []string `json:"codes"`
+	}
+
+	// Redemption describes the reward the user got
+	Redemption struct {
+		ID           string      `json:"id"`
+		Code         string      `json:"code"`
+		Title        string      `json:"title"`
+		RewardType   string      `json:"reward_type"`
+		RewardAmount float64     `json:"reward_amount"`
+		RewardRef    string      `json:"reward_ref,omitempty"`
+		NFT          interface{} `json:"nft,omitempty"`
+	}
+
+	// Stats of a single promo code
+	Stats struct {
+		Redemptions   int32   `json:"redemptions"`
+		UniqueUsers   int32   `json:"unique_users"`
+		TotalAmount   float64 `json:"total_amount"`
+		DiscountsUsed int32   `json:"discounts_used"`
+	}
+
+	// BatchStats of a set of generated codes
+	BatchStats struct {
+		Codes         int32   `json:"codes"`
+		RedeemedCodes int32   `json:"redeemed_codes"`
+		Redemptions   int32   `json:"redemptions"`
+		UniqueUsers   int32   `json:"unique_users"`
+		TotalAmount   float64 `json:"total_amount"`
+	}
+
+	promocodesRepository interface {
+		AddPromoCode(ctx context.Context, arg repository.AddPromoCodeParams) (repository.PromoCode, error)
+		DecrementPromoCodeRedemptions(ctx context.Context, id uuid.UUID) error
+		DeletePromoCodeByID(ctx context.Context, id uuid.UUID) error
+		GetPromoCodeByCodeForUpdate(ctx context.Context, code string) (repository.PromoCode, error)
+		GetPromoCodeByID(ctx context.Context, id uuid.UUID) (repository.PromoCode, error)
+		GetPromoCodesByBatchID(ctx context.Context, batchID uuid.NullUUID) ([]repository.PromoCode, error)
+		GetPromoCodesPaginated(ctx context.Context, arg repository.GetPromoCodesPaginatedParams) ([]repository.PromoCode, error)
+		IncrementPromoCodeRedemptions(ctx context.Context, id uuid.UUID) error
+		UpdatePromoCode(ctx context.Context, arg repository.UpdatePromoCodeParams) error
+
+		AddPromoCodeRedemption(ctx context.Context, arg repository.AddPromoCodeRedemptionParams) (repository.PromoCodeRedemption, error)
+		CountPromoCodeRedemptionsByUserID(ctx context.Context, arg repository.CountPromoCodeRedemptionsByUserIDParams) (int64, error)
+		DeletePromoCodeRedemptionByID(ctx context.Context, id uuid.UUID) error
+		GetPromoCodeBatchStats(ctx context.Context, batchID uuid.NullUUID) (repository.GetPromoCodeBatchStatsRow, error)
+		GetPromoCodeStats(ctx context.Context, promoCodeID uuid.UUID) (repository.GetPromoCodeStatsRow, error)
+		GetUnusedDiscountByUserID(ctx context.Context, userID uuid.UUID) (repository.PromoCodeRedemption, error)
+		ReleaseDiscountByID(ctx context.Context, id uuid.UUID) error
+		UseDiscountByID(ctx context.Context, arg repository.UseDiscountByIDParams) (repository.PromoCodeRedemption, error)
+
+		AddPromoCodeAttempt(ctx context.Context, arg repository.AddPromoCodeAttemptParams) error
+		CountFailedPromoCodeAttempts(ctx context.Context, arg repository.CountFailedPromoCodeAttemptsParams) (int64, error)
+
+		WithTx(tx *sql.Tx) *repository.Queries
+	}
+
+	rewardsClient interface {
+		AddDepositTransaction(ctx context.Context, userID, relationID uuid.UUID, relationType string, amount float64) error
+	}
+)
+
+// NewService is a factory function,
+// returns a new instance of the Service interface implementation
+func NewService(dbConn *sql.DB, repo promocodesRepository, rc rewardsClient, getLocker db.GetLocker, opt ...ServiceOption) *Service {
+	if repo == nil {
+		log.Fatalln("promo codes repository is not set")
+	}
+	if rc == nil {
+		log.Fatalln("rewards client is not set")
+	}
+	if getLocker == nil {
+		log.Fatalln("locker is not set")
+	}
+
+	s := &Service{
+		db:                dbConn,
+		repo:              repo,
+		rc:                rc,
+		getLocker:         getLocker,
+		maxFailedAttempts: defaultMaxFailedAttempts,
+		attemptsWindow:    defaultAttemptsWindow,
+	}
+
+	// Set up options.
+	for _, o := range opt {
+		o(s)
+	}
+
+	return s
+}
+
+// WithEpisodeUnlockFunc sets function which unlocks episode for free
+func WithEpisodeUnlockFunc(fn grantUnlockFunc) ServiceOption {
+	return func(s *Service) {
+		s.episodeUnlockFn = fn
+	}
+}
+
+// WithPuzzleUnlockFunc sets function which unlocks puzzle game for free
+func WithPuzzleUnlockFunc(fn grantUnlockFunc) ServiceOption {
+	return func(s *Service) {
+		s.puzzleUnlockFn = fn
+	}
+}
+
+// WithNFTPackFunc sets function which opens NFT pack for free
+func WithNFTPackFunc(fn grantNFTPackFunc) ServiceOption {
+	return func(s *Service) {
+		s.nftPackFn = fn
+	}
+}
+
+// WithThrottling limits number of failed redeem attempts per user within the window
+func WithThrottling(maxFailedAttempts int64, window time.Duration) ServiceOption {
+	return func(s *Service) {
+		if maxFailedAttempts > 0 {
+			s.maxFailedAttempts = maxFailedAttempts
+		}
+		if window > 0 {
+			s.attemptsWindow = window
+		}
+	}
+}
+
+// AddPromoCode creates a new promo code
+func (s *Service) AddPromoCode(ctx context.Context, c PromoCode) (PromoCode, error) {
+	c.Code = normalizeCode(c.Code)
+	if len(c.Code) < minCodeLength || len(c.Code) > maxCodeLength {
+		return PromoCode{}, fmt.Errorf("%w: code length must be between %d and %d", ErrInvalidParameter, minCodeLength, maxCodeLength)
+	}
+	if err := validatePromoCode(c); err != nil {
+		return PromoCode{}, err
+	}
+
+	code, err := s.repo.AddPromoCode(ctx, toAddPromoCodeParams(c, uuid.NullUUID{}))
+	if err != nil {
+		if db.IsDuplicateError(err) {
+			return PromoCode{}, fmt.Errorf("%w: code already exists", ErrInvalidParameter)
+		}
+		return PromoCode{}, fmt.Errorf("could not add promo code: %w", err)
+	}
+
+	return castToPromoCode(code), nil
+}
+
+// GenerateBatch creates a set of random codes with the same reward
+func (s *Service) GenerateBatch(ctx context.Context, b Batch) (BatchResult, error) {
+	if b.Length == 0 {
+		b.Length = defaultCodeLength
+	}
+	if b.Count <= 0 || b.Count > maxBatchSize {
+		return BatchResult{}, fmt.Errorf("%w: count must be between 1 and %d", ErrInvalidParameter, maxBatchSize)
+	}
+	if b.Length < minCodeLength || len(b.Prefix)+b.Length > maxCodeLength {
+		return BatchResult{}, fmt.Errorf("%w: code length must be between %d and %d", ErrInvalidParameter, minCodeLength, maxCodeLength)
+	}
+	if err := validatePromoCode(b.Params); err != nil {
+		return BatchResult{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return BatchResult{}, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+	batchID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+	codes := make([]string, 0, b.Count)
+	seen := make(map[string]bool, b.Count)
+	for len(codes) < b.Count {
+		code, err := generateCode(b.Prefix, b.Length)
+		if err != nil {
+			return BatchResult{}, fmt.Errorf("could not generate code: %w", err)
+		}
+		// Collisions within the batch are skipped here; collisions with existing codes fail the batch,
+		// since a failed statement aborts the whole transaction.
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+
+		params := b.Params
+		params.Code = code
+		if _, err := repo.AddPromoCode(ctx, toAddPromoCodeParams(params, batchID)); err != nil {
+			if db.IsDuplicateError(err) {
+				return BatchResult{}, fmt.Errorf("%w: generated code collides with existing one, try longer codes", ErrInvalidParameter)
+			}
+			return BatchResult{}, fmt.Errorf("could not add promo code: %w", err)
+		}
+		codes = append(codes, code)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return BatchResult{}, fmt.Errorf("could not commit batch: %w", err)
+	}
+
+	return BatchResult{BatchID: batchID.UUID.String(), Codes: codes}, nil
+}
+
+// GetPromoCodeByID returns promo code by id
+func (s *Service) GetPromoCodeByID(ctx context.Context, id uuid.UUID) (PromoCode, error) {
+	code, err := s.repo.GetPromoCodeByID(ctx, id)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return PromoCode{}, ErrNotFound
+		}
+		return PromoCode{}, fmt.Errorf("could not get promo code: %w", err)
+	}
+
+	return castToPromoCode(code), nil
+}
+
+// GetPromoCodes returns list of promo codes
+func (s *Service) GetPromoCodes(ctx context.Context, limit, offset int32) ([]PromoCode, error) {
+	codes, err := s.repo.GetPromoCodesPaginated(ctx, repository.GetPromoCodesPaginatedParams{
+		LimitVal:  limit,
+		OffsetVal: offset,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get promo codes: %w", err)
+	}
+
+	result := make([]PromoCode, 0, len(codes))
+	for _, c := range codes {
+		result = append(result, castToPromoCode(c))
+	}
+
+	return result, nil
+}
+
+// UpdatePromoCode updates limits and validity period of the promo code.
+// Code and reward are immutable to keep analytics consistent.
+func (s *Service) UpdatePromoCode(ctx context.Context, c PromoCode) error {
+	id, err := uuid.Parse(c.ID)
+	if err != nil {
+		return fmt.Errorf("%w promo code id: %v", ErrInvalidParameter, err)
+	}
+	if c.MaxRedemptions < 0 || c.PerUserLimit < 0 {
+		return fmt.Errorf("%w: redemption limits must not be negative", ErrInvalidParameter)
+	}
+	if c.ExpiresAt != nil && !c.ExpiresAt.After(c.StartsAt) {
+		return fmt.Errorf("%w: expires_at must be after starts_at", ErrInvalidParameter)
+	}
+
+	if _, err := s.GetPromoCodeByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdatePromoCode(ctx, repository.UpdatePromoCodeParams{
+		Title:          c.Title,
+		MaxRedemptions: c.MaxRedemptions,
+		PerUserLimit:   c.PerUserLimit,
+		StartsAt:       c.StartsAt,
+		ExpiresAt:      toNullTime(c.ExpiresAt),
+		Disabled:       c.Disabled,
+		ID:             id,
+	}); err != nil {
+		return fmt.Errorf("could not update promo code: %w", err)
+	}
+
+	return nil
+}
+
+// DeletePromoCodeByID deletes promo code with all its redemptions
+func (s *Service) DeletePromoCodeByID(ctx context.Context, id uuid.UUID) error {
+	if err := s.repo.DeletePromoCodeByID(ctx, id); err != nil {
+		return fmt.Errorf("could not delete promo code: %w", err)
+	}
+
+	return nil
+}
+
+// GetStats returns redemption stats of the promo code
+func (s *Service) GetStats(ctx context.Context, id uuid.UUID) (Stats, error) {
+	if _, err := s.GetPromoCodeByID(ctx, id); err != nil {
+		return Stats{}, err
+	}
+
+	st, err := s.repo.GetPromoCodeStats(ctx, id)
+	if err != nil {
+		return Stats{}, fmt.Errorf("could not get promo code stats: %w", err)
+	}
+
+	return Stats{
+		Redemptions:   st.Redemptions,
+		UniqueUsers:   st.UniqueUsers,
+		TotalAmount:   st.TotalAmount,
+		DiscountsUsed: st.DiscountsUsed,
+	}, nil
+}
+
+// GetBatchCodes returns all codes of the batch
+func (s *Service) GetBatchCodes(ctx context.Context, batchID uuid.UUID) ([]PromoCode, error) {
+	codes, err := s.repo.GetPromoCodesByBatchID(ctx, uuid.NullUUID{UUID: batchID, Valid: true})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get batch codes: %w", err)
+	}
+	if len(codes) == 0 {
+		return nil, ErrNotFound
+	}
+
+	result := make([]PromoCode, 0, len(codes))
+	for _, c := range codes {
+		result = append(result, castToPromoCode(c))
+	}
+
+	return result, nil
+}
+
+// GetBatchStats returns redemption stats of the batch
+func (s *Service) GetBatchStats(ctx context.Context, batchID uuid.UUID) (BatchStats, error) {
+	st, err := s.repo.GetPromoCodeBatchStats(ctx, uuid.NullUUID{UUID: batchID, Valid: true})
+	if err != nil {
+		return BatchStats{}, fmt.Errorf("could not get batch stats: %w", err)
+	}
+	if st.Codes == 0 {
+		return BatchStats{}, ErrNotFound
+	}
+
+	return BatchStats{
+		Codes:         st.Codes,
+		RedeemedCodes: st.RedeemedCodes,
+		Redemptions:   st.Redemptions,
+		UniqueUsers:   st.UniqueUsers,
+		TotalAmount:   st.TotalAmount,
+	}, nil
+}
+
+// Redeem gives the promo code reward to the user.
+// Every attempt is recorded, users with too many failed attempts are throttled.
+// Attempts of the same user are run one by one, so concurrent requests can't bypass the throttling.
+func (s *Service) Redeem(ctx context.Context, userID uuid.UUID, code string) (Redemption, error) {
+	code = normalizeCode(code)
+
+	id := fmt.Sprintf("redeem-promo-code-%v", userID.String())
+	lock, err := s.getLocker.GetLock(ctx, id)
+	if err != nil {
+		return Redemption{}, fmt.Errorf("can't get lock by id: %v, err: %w", id, err)
+	}
+	defer func() {
+		// We should release a lock in any case, even if context was cancelled
+		ctxt, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		if err := lock.Unlock(ctxt); err != nil {
+			log.Printf("can't release a lock with id: %v, err: %v", id, err)
+		}
+	}()
+
+	ok, err := lock.Lock(ctx)
+	if err != nil {
+		return Redemption{}, fmt.Errorf("can't acquire a lock with id: %v, err: %w", id, err)
+	}
+	if !ok {
+		// another attempt of the user is in progress
+		return Redemption{}, ErrTooManyAttempts
+	}
+
+	failed, err := s.repo.CountFailedPromoCodeAttempts(ctx, repository.CountFailedPromoCodeAttemptsParams{
+		UserID: userID,
+		Since:  time.Now().Add(-s.attemptsWindow),
+	})
+	if err != nil {
+		return Redemption{}, fmt.Errorf("could not count failed attempts: %w", err)
+	}
+	if failed >= s.maxFailedAttempts {
+		return Redemption{}, ErrTooManyAttempts
+	}
+
+	r, err := s.redeem(ctx, userID, code)
+	if err == nil || isRedeemError(err) {
+		if err := s.repo.AddPromoCodeAttempt(ctx, repository.AddPromoCodeAttemptParams{
+			UserID:    userID,
+			Code:      code,
+			Succeeded: err == nil,
+		}); err != nil {
+			log.Printf("could not store promo code attempt of user %s: %v", userID, err)
+		}
+	}
+	if err != nil {
+		return Redemption{}, err
+	}
+
+	return r, nil
+}
+
+// Promo code row is locked to keep redemption limits consistent.
+func (s *Service) redeem(ctx context.Context, userID uuid.UUID, code string) (Redemption, error) {
+	if code == "" {
+		return Redemption{}, ErrInvalidCode
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return Redemption{}, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	c, err := repo.GetPromoCodeByCodeForUpdate(ctx, code)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return Redemption{}, ErrInvalidCode
+		}
+		return Redemption{}, fmt.Errorf("could not get promo code: %w", err)
+	}
+
+	count, err := repo.CountPromoCodeRedemptionsByUserID(ctx, repository.CountPromoCodeRedemptionsByUserIDParams{
+		PromoCodeID: c.ID,
+		UserID:      userID,
+	})
+	if err != nil {
+		return Redemption{}, fmt.Errorf("could not count user redemptions: %w", err)
+	}
+
+	if err := checkRedeemable(c, count, time.Now()); err != nil {
+		return Redemption{}, err
+	}
+
+	redemption, err := repo.AddPromoCodeRedemption(ctx, repository.AddPromoCodeRedemptionParams{
+		PromoCodeID:  c.ID,
+		UserID:       userID,
+		RewardType:   c.RewardType,
+		RewardAmount: c.RewardAmount,
+	})
+	if err != nil {
+		return Redemption{}, fmt.Errorf("could not store redemption: %w", err)
+	}
+
+	if err := repo.IncrementPromoCodeRedemptions(ctx, c.ID); err != nil {
+		return Redemption{}, fmt.Errorf("could not update redemptions count: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Redemption{}, fmt.Errorf("could not commit redemption: %w", err)
+	}
+
+	// The reward is granted only after the redemption is committed
+	// and the redemption is reverted if the reward could not be granted.
+	nft, err := s.grantReward(ctx, userID, c)
+	if err != nil {
+		if rerr := s.revertRedemption(ctx, redemption.ID, c.ID); rerr != nil {
+			log.Printf("could not revert promo code redemption %s: %v", redemption.ID, rerr)
+		}
+		return Redemption{}, err
+	}
+
+	return Redemption{
+		ID:           redemption.ID.String(),
+		Code:         c.Code,
+		Title:        c.Title,
+		RewardType:   c.RewardType,
+		RewardAmount: c.RewardAmount,
+		RewardRef:    c.RewardRef,
+		NFT:          nft,
+	}, nil
+}
+
+// revertRedemption deletes the redemption which reward has not been granted,
+// so the code can be redeemed again.
+func (s *Service) revertRedemption(ctx context.Context, redemptionID, promoCodeID uuid.UUID) error {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	if err := repo.DeletePromoCodeRedemptionByID(ctx, redemptionID); err != nil {
+		return fmt.Errorf("could not delete redemption: %w", err)
+	}
+
+	if err := repo.DecrementPromoCodeRedemptions(ctx, promoCodeID); err != nil {
+		return fmt.Errorf("could not update redemptions count: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (s *Service) grantReward(ctx context.Context, userID uuid.UUID, c repository.PromoCode) (interface{}, error) {
+	switch c.RewardType {
+	case RewardSAO:
+		if err := s.rc.AddDepositTransaction(ctx, userID, c.ID, RelationTypePromoCode, c.RewardAmount); err != nil {
+			return nil, fmt.Errorf("could not add deposit transaction: %w", err)
+		}
+	case RewardEpisodeUnlock:
+		if err := grantUnlock(ctx, s.episodeUnlockFn, userID, c); err != nil {
+			return nil, fmt.Errorf("could not unlock episode: %w", err)
+		}
+	case RewardPuzzleUnlock:
+		if err := grantUnlock(ctx, s.puzzleUnlockFn, userID, c); err != nil {
+			return nil, fmt.Errorf("could not unlock puzzle game: %w", err)
+		}
+	case RewardNFTPack:
+		if s.nftPackFn == nil {
+			return nil, fmt.Errorf("nft pack reward is not supported")
+		}
+		packID, err := uuid.Parse(c.RewardRef)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nft pack id: %w", err)
+		}
+		nft, err := s.nftPackFn(ctx, userID, packID)
+		if err != nil {
+			return nil, fmt.Errorf("could not open nft pack: %w", err)
+		}
+		return nft, nil
+	case RewardDiscount:
+		// Discount is stored with the redemption and applied on the next purchase.
+	default:
+		return nil, fmt.Errorf("unsupported reward type %s", c.RewardType)
+	}
+
+	return nil, nil
+}
+
+func grantUnlock(ctx context.Context, fn grantUnlockFunc, userID uuid.UUID, c repository.PromoCode) error {
+	if fn == nil {
+		return fmt.Errorf("%s reward is not supported", c.RewardType)
+	}
+	refID, err := uuid.Parse(c.RewardRef)
+	if err != nil {
+		return fmt.Errorf("invalid reward ref: %w", err)
+	}
+
+	return fn(ctx, userID, refID, c.RewardOption)
+}
+
+// GetDiscount returns id and percent of the user's discount for the next purchase,
+// uuid.Nil if there is none
+func (s *Service) GetDiscount(ctx context.Context, userID uuid.UUID) (uuid.UUID, float64, error) {
+	r, err := s.repo.GetUnusedDiscountByUserID(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return uuid.Nil, 0, nil
+		}
+		return uuid.Nil, 0, fmt.Errorf("could not get user's discount: %w", err)
+	}
+
+	return r.ID, r.RewardAmount, nil
+}
+
+// UseDiscount marks the user's discount as used.
+// The discount is claimed by a single conditional update, so it can't be used twice.
+func (s *Service) UseDiscount(ctx context.Context, userID, discountID uuid.UUID) error {
+	if _, err := s.repo.UseDiscountByID(ctx, repository.UseDiscountByIDParams{
+		ID:     discountID,
+		UserID: userID,
+	}); err != nil {
+		if db.IsNotFoundError(err) {
+			return fmt.Errorf("%w discount", ErrNotFound)
+		}
+		return fmt.Errorf("could not use user's discount: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseDiscount makes the discount available again, e.g. if the payment has failed
+func (s *Service) ReleaseDiscount(ctx context.Context, discountID uuid.UUID) error {
+	if err := s.repo.ReleaseDiscountByID(ctx, discountID); err != nil {
+		return fmt.Errorf("could not release discount: %w", err)
+	}
+
+	return nil
+}
+
+// isRedeemError reports whether the redeem failed because of the code itself,
+// such attempts are counted for throttling.
+func isRedeemError(err error) bool {
+	return errors.Is(err, ErrInvalidCode) ||
+		errors.Is(err, ErrCodeExhausted) ||
+		errors.Is(err, ErrAlreadyRedeemed)
+}
+
+func toAddPromoCodeParams(c PromoCode, batchID uuid.NullUUID) repository.AddPromoCodeParams {
+	startsAt := c.StartsAt
+	if startsAt.IsZero() {
+		startsAt = time.Now()
+	}
+
+	return repository.AddPromoCodeParams{
+		Code:           c.Code,
+		BatchID:        batchID,
+		Title:          c.Title,
+		RewardType:     c.RewardType,
+		RewardAmount:   c.RewardAmount,
+		RewardRef:      c.RewardRef,
+		RewardOption:   c.RewardOption,
+		MaxRedemptions: c.MaxRedemptions,
+		PerUserLimit:   c.PerUserLimit,
+		StartsAt:       startsAt,
+		ExpiresAt:      toNullTime(c.ExpiresAt),
+		Disabled:       c.Disabled,
+	}
+}
+
+func castToPromoCode(c repository.PromoCode) PromoCode {
+	result := PromoCode{
+		ID:               c.ID.String(),
+		Code:             c.Code,
+		Title:            c.Title,
+		RewardType:       c.RewardType,
+		RewardAmount:     c.RewardAmount,
+		RewardRef:        c.RewardRef,
+		RewardOption:     c.RewardOption,
+		MaxRedemptions:   c.MaxRedemptions,
+		PerUserLimit:     c.PerUserLimit,
+		RedemptionsCount: c.RedemptionsCount,
+		StartsAt:         c.StartsAt,
+		Disabled:         c.Disabled,
+	}
+	if c.BatchID.Valid {
+		result.BatchID = c.BatchID.UUID.String()
+	}
+	if c.ExpiresAt.Valid {
+		result.ExpiresAt = &c.ExpiresAt.Time
+	}
+
+	return result
+}
+
+func toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
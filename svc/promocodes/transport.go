@@ -0,0 +1,201 @@
+package promocodes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	jwtkit "github.com/go-kit/kit/auth/jwt"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/utils"
+)
+
+// Predefined request query keys
+const (
+	pageParam         = "page"
+	itemsPerPageParam = "items_per_page"
+)
+
+type (
+	logger interface {
+		Log(keyvals ...interface{}) error
+	}
+)
+
+// MakeHTTPHandler ...
+func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
+	r := chi.NewRouter()
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
+		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
+		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+	}
+
+	r.Post("/redeem", httptransport.NewServer(
+		e.Redeem,
+		decodeRedeemRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/", httptransport.NewServer(
+		e.AddPromoCode,
+		decodeAddPromoCodeRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/", httptransport.NewServer(
+		e.GetPromoCodes,
+		decodeGetPromoCodesRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/{promo_code_id}", httptransport.NewServer(
+		e.GetPromoCodeByID,
+		decodePromoCodeIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Put("/{promo_code_id}", httptransport.NewServer(
+		e.UpdatePromoCode,
+		decodeUpdatePromoCodeRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/{promo_code_id}", httptransport.NewServer(
+		e.DeletePromoCodeByID,
+		decodePromoCodeIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/{promo_code_id}/stats", httptransport.NewServer(
+		e.GetStats,
+		decodePromoCodeIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/batches", httptransport.NewServer(
+		e.GenerateBatch,
+		decodeGenerateBatchRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/batches/{batch_id}", httptransport.NewServer(
+		e.GetBatchCodes,
+		decodeBatchIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/batches/{batch_id}/stats", httptransport.NewServer(
+		e.GetBatchStats,
+		decodeBatchIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	return r
+}
+
+func decodeRedeemRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req RedeemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeAddPromoCodeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req AddPromoCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeGetPromoCodesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return GetPromoCodesRequest{
+		PaginationRequest: utils.PaginationRequest{
+			Page:         utils.StrToInt32(r.URL.Query().Get(pageParam)),
+			ItemsPerPage: utils.StrToInt32(r.URL.Query().Get(itemsPerPageParam)),
+		},
+	}, nil
+}
+
+func decodePromoCodeIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "promo_code_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed promo_code_id", ErrInvalidParameter)
+	}
+
+	return id, nil
+}
+
+func decodeUpdatePromoCodeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req UpdatePromoCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	id := chi.URLParam(r, "promo_code_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed promo_code_id", ErrInvalidParameter)
+	}
+	req.ID = id
+
+	return req, nil
+}
+
+func decodeGenerateBatchRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req GenerateBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeBatchIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "batch_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed batch_id", ErrInvalidParameter)
+	}
+
+	return id, nil
+}
+
+// returns http error code by error type
+func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+
+	if errors.Is(err, ErrInvalidParameter) ||
+		errors.Is(err, ErrInvalidCode) ||
+		errors.Is(err, ErrCodeExhausted) ||
+		errors.Is(err, ErrAlreadyRedeemed) {
+		return http.StatusBadRequest, err.Error()
+	}
+
+	if errors.Is(err, ErrTooManyAttempts) {
+		return http.StatusTooManyRequests, err.Error()
+	}
+
+	return httpencoder.CodeAndMessageFrom(err)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/SatorNetwork/sator-api/lib/jwt"
 	"github.com/SatorNetwork/sator-api/lib/rbac"
 	"github.com/SatorNetwork/sator-api/lib/validator"
+	"github.com/SatorNetwork/sator-api/svc/pricing"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/google/uuid"
 )
@@ -26,6 +27,7 @@ type (
 
 		GetPuzzleGameForUser endpoint.Endpoint
 		UnlockPuzzleGame     endpoint.Endpoint
+		GetUnlockQuote       endpoint.Endpoint
 		StartPuzzleGame      endpoint.Endpoint
 
 		GetPuzzleGameUnlockOptions endpoint.Endpoint
@@ -42,7 +44,8 @@ type (
 		UnlinkImageFromPuzzleGame(ctx context.Context, gameID, fileID uuid.UUID) error
 
 		GetPuzzleGameForUser(ctx context.Context, userID, episodeID uuid.UUID, isTestUser bool) (PuzzleGame, error)
-		UnlockPuzzleGame(ctx context.Context, userID, puzzleGameID uuid.UUID, option string) (PuzzleGame, error)
+		UnlockPuzzleGame(ctx context.Context, userID, puzzleGameID uuid.UUID, option string, quoteID uuid.UUID) (PuzzleGame, error)
+		GetPuzzleGameUnlockQuote(ctx context.Context, userID, puzzleGameID uuid.UUID, option, asset string) (pricing.Quote, error)
 		StartPuzzleGame(ctx context.Context, userID, puzzleGameID uuid.UUID) (PuzzleGame, error)
 
 		GetPuzzleGameUnlockOptions(ctx context.Context) ([]PuzzleGameUnlockOption, error)
@@ -69,6 +72,13 @@ type (
 	UnlockPuzzleGameRequest struct {
 		PuzzleGameID string `json:"puzzle_game_id" validate:"required,uuid"`
 		UnlockOption string `json:"unlock_option" validate:"required"`
+		QuoteID      string `json:"quote_id,omitempty" validate:"omitempty,uuid"`
+	}
+
+	GetUnlockQuoteRequest struct {
+		PuzzleGameID string `json:"puzzle_game_id" validate:"required,uuid"`
+		UnlockOption string `json:"unlock_option" validate:"required"`
+		Asset        string `json:"asset" validate:"required,oneof=SAO SOL USDC"`
 	}
 
 	FinishPuzzleGameRequest struct {
@@ -98,6 +108,7 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 
 		GetPuzzleGameForUser: MakeGetPuzzleGameForUserEndpoint(s),
 		UnlockPuzzleGame:     MakeUnlockPuzzleGameEndpoint(s, validateFunc),
+		GetUnlockQuote:       MakeGetUnlockQuoteEndpoint(s, validateFunc),
 		StartPuzzleGame:      MakeStartPuzzleGameEndpoint(s),
 
 		GetPuzzleGameUnlockOptions: MakeGetPuzzleGameUnlockOptionsEndpoint(s),
@@ -117,6 +128,7 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 
 			e.GetPuzzleGameForUser = mdw(e.GetPuzzleGameForUser)
 			e.UnlockPuzzleGame = mdw(e.UnlockPuzzleGame)
+			e.GetUnlockQuote = mdw(e.GetUnlockQuote)
 			e.StartPuzzleGame = mdw(e.StartPuzzleGame)
 
 			e.GetPuzzleGameUnlockOptions = mdw(e.GetPuzzleGameUnlockOptions)
@@ -279,7 +291,37 @@ func MakeUnlockPuzzleGameEndpoint(s service, validateFunc validator.ValidateFunc
 			return nil, fmt.Errorf("could not get user id: %w", err)
 		}
 
-		res, err := s.UnlockPuzzleGame(ctx, uid, uuid.MustParse(req.PuzzleGameID), req.UnlockOption)
+		quoteID := uuid.Nil
+		if req.QuoteID != "" {
+			quoteID = uuid.MustParse(req.QuoteID)
+		}
+
+		res, err := s.UnlockPuzzleGame(ctx, uid, uuid.MustParse(req.PuzzleGameID), req.UnlockOption, quoteID)
+		if err != nil {
+			return nil, err
+		}
+
+		return res, nil
+	}
+}
+
+func MakeGetUnlockQuoteEndpoint(s service, validateFunc validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		req := request.(GetUnlockQuoteRequest)
+		if err := validateFunc(req); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user id: %w", err)
+		}
+
+		res, err := s.GetPuzzleGameUnlockQuote(ctx, uid, uuid.MustParse(req.PuzzleGameID), req.UnlockOption, req.Asset)
 		if err != nil {
 			return nil, err
 		}
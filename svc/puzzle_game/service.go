@@ -15,6 +15,8 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/SatorNetwork/sator-api/svc/files"
+	"github.com/SatorNetwork/sator-api/svc/multipliers"
+	"github.com/SatorNetwork/sator-api/svc/pricing"
 	"github.com/SatorNetwork/sator-api/svc/puzzle_game/repository"
 )
 
@@ -31,8 +33,13 @@ type (
 		pgr                        puzzleGameRepository
 		filesSvc                   filesService
 		chargeForUnlock            chargeForUnlockFunc          // function to charge user for unlocking puzzle game
+		quoteFn                    quoteFunc                    // function to quote unlock price in alternative assets
+		payFn                      payFunc                      // function to charge user for unlocking puzzle game using price quote
 		rewardsFn                  rewardsFunc                  // function to send rewards for puzzle game
 		getUserRewardsMultiplierFn getUserRewardsMultiplierFunc // function to get user rewards multiplier
+		spendBudgetFn              spendBudgetFunc              // function to consume rewards from the puzzle game budget
+		refundBudgetFn             refundBudgetFunc             // function to return undelivered rewards to the puzzle game budget
+		recordScoreFn              recordScoreFunc              // function to add finished game to leaderboards
 		puzzleGameShuffle          bool
 		rewardsEnabled             bool
 		paidStepsEnabled           bool
@@ -65,8 +72,13 @@ type (
 	}
 
 	chargeForUnlockFunc          func(ctx context.Context, uid uuid.UUID, amount float64, info string) error
+	quoteFunc                    func(ctx context.Context, uid uuid.UUID, productType, productRef string, listPriceSAO float64, asset string) (pricing.Quote, error)
+	payFunc                      func(ctx context.Context, uid, quoteID uuid.UUID, productType, productRef string, listPriceSAO float64, info string) (pricing.Purchase, error)
 	rewardsFunc                  func(ctx context.Context, userID, relationID uuid.UUID, relationType string, amount float64) error
 	getUserRewardsMultiplierFunc func(ctx context.Context, userID uuid.UUID) (int32, error)
+	spendBudgetFunc              func(ctx context.Context, puzzleGameID uuid.UUID, amount float64) (float64, error)
+	refundBudgetFunc             func(ctx context.Context, puzzleGameID uuid.UUID, amount float64) error
+	recordScoreFunc              func(ctx context.Context, userID uuid.UUID, points float64, wins int32, showID, challengeID uuid.UUID)
 
 	// ServiceOption function
 	// interface to extend service via options
@@ -299,8 +311,30 @@ func (s *Service) GetPuzzleGameForUser(ctx context.Context, userID, episodeID uu
 	return result, nil
 }
 
-func (s *Service) UnlockPuzzleGame(ctx context.Context, userID, puzzleGameID uuid.UUID, option string) (PuzzleGame, error) {
-	if s.chargeForUnlock == nil {
+func puzzleUnlockProductRef(puzzleGameID uuid.UUID, option string) string {
+	return fmt.Sprintf("%s:%s", puzzleGameID.String(), option)
+}
+
+// GetPuzzleGameUnlockQuote returns price quote to unlock puzzle game with the given asset.
+func (s *Service) GetPuzzleGameUnlockQuote(ctx context.Context, userID, puzzleGameID uuid.UUID, option, asset string) (pricing.Quote, error) {
+	if s.quoteFn == nil {
+		return pricing.Quote{}, errors.Wrap(ErrInvalidParameter, "payments in alternative assets are not supported")
+	}
+
+	opt, err := s.pgr.GetPuzzleGameUnlockOption(ctx, option)
+	if err != nil {
+		return pricing.Quote{}, errors.Wrap(err, "can't get puzzle game unlock option")
+	}
+
+	if opt.Locked {
+		return pricing.Quote{}, errors.New("this unlock option is not available")
+	}
+
+	return s.quoteFn(ctx, userID, pricing.ProductPuzzleUnlock, puzzleUnlockProductRef(puzzleGameID, option), opt.Amount, asset)
+}
+
+func (s *Service) UnlockPuzzleGame(ctx context.Context, userID, puzzleGameID uuid.UUID, option string, quoteID uuid.UUID) (PuzzleGame, error) {
+	if s.chargeForUnlock == nil && s.payFn == nil {
 		return PuzzleGame{}, errors.New("payment service is not set")
 	}
 
@@ -316,8 +350,13 @@ func (s *Service) UnlockPuzzleGame(ctx context.Context, userID, puzzleGameID uui
 		}
 
 		if opt.Amount > 0 {
-			if err := s.chargeForUnlock(ctx, userID, opt.Amount,
-				fmt.Sprintf("Unlock puzzle game #%s", puzzleGameID.String())); err != nil {
+			info := fmt.Sprintf("Unlock puzzle game #%s", puzzleGameID.String())
+			if s.payFn != nil {
+				if _, err := s.payFn(ctx, userID, quoteID, pricing.ProductPuzzleUnlock,
+					puzzleUnlockProductRef(puzzleGameID, option), opt.Amount, info); err != nil {
+					return PuzzleGame{}, errors.Wrap(err, "can't charge for unlock puzzle game")
+				}
+			} else if err := s.chargeForUnlock(ctx, userID, opt.Amount, info); err != nil {
 				return PuzzleGame{}, errors.Wrap(err, "can't charge for unlock puzzle game")
 			}
 		}
@@ -349,6 +388,28 @@ func (s *Service) UnlockPuzzleGame(ctx context.Context, userID, puzzleGameID uui
 	return result, nil
 }
 
+// GrantPuzzleGameUnlock unlocks puzzle game for free, e.g. as a promo code reward.
+func (s *Service) GrantPuzzleGameUnlock(ctx context.Context, userID, puzzleGameID uuid.UUID, option string) error {
+	steps := int32(math.MaxInt32)
+	if s.paidStepsEnabled {
+		opt, err := s.pgr.GetPuzzleGameUnlockOption(ctx, option)
+		if err != nil {
+			return errors.Wrap(err, "can't get puzzle game unlock option")
+		}
+		steps = opt.Steps
+	}
+
+	if _, err := s.pgr.UnlockPuzzleGame(ctx, repository.UnlockPuzzleGameParams{
+		PuzzleGameID: puzzleGameID,
+		UserID:       userID,
+		Steps:        steps,
+	}); err != nil {
+		return errors.Wrap(err, "can't unlock puzzle game")
+	}
+
+	return nil
+}
+
 func (s *Service) StartPuzzleGame(ctx context.Context, userID, puzzleGameID uuid.UUID) (PuzzleGame, error) {
 	if !s.paidStepsEnabled {
 		if _, err := s.pgr.UnlockPuzzleGame(ctx, repository.UnlockPuzzleGameParams{
@@ -550,31 +611,56 @@ func (s *Service) TapTile(ctx context.Context, userID, puzzleGameID uuid.UUID, p
 
 			if s.getUserRewardsMultiplierFn != nil {
 				mltp, _ := s.getUserRewardsMultiplierFn(ctx, userID)
-				if mltp > 0 {
-					lockRewardsAmount = (float64(mltp) / 100) * rewardsAmount
-					rewardsAmount = rewardsAmount + lockRewardsAmount
+				lockRewardsAmount = multipliers.Bonus(rewardsAmount, mltp)
+				rewardsAmount += lockRewardsAmount
+			}
+
+			budgetSpent := false
+			if s.spendBudgetFn != nil {
+				granted, err := s.spendBudgetFn(ctx, puzzleGameID, rewardsAmount)
+				if err != nil {
+					return PuzzleGame{}, errors.Wrap(err, "can't spend puzzle game budget")
+				}
+				if granted < rewardsAmount {
+					// budget is running out, so rewards are prorated
+					lockRewardsAmount = lockRewardsAmount * granted / rewardsAmount
+					rewardsAmount = granted
 				}
+				budgetSpent = true
 			}
 
-			if s.rewardsFn != nil {
+			if s.rewardsFn != nil && rewardsAmount > 0 {
 				att, err := s.pgr.GetPuzzleGameCurrentAttempt(ctx, repository.GetPuzzleGameCurrentAttemptParams{
 					PuzzleGameID: puzzleGameID,
 					UserID:       userID,
 					Status:       PuzzleGameStatusInProgress,
 				})
 				if err != nil {
+					if budgetSpent {
+						s.refundBudget(puzzleGameID, rewardsAmount)
+					}
 					return PuzzleGame{}, errors.Wrap(err, "can't get puzzle game current attempt")
 				}
 
+				delivered := false
 			retrySendReward:
 				for i := 0; i < 5; i++ {
 					if err := s.rewardsFn(ctx, userID, att.ID, "puzzle_games", rewardsAmount); err != nil {
 						log.Printf("can't add rewards for puzzle game: %v", err)
 						time.Sleep(time.Second * 3)
 					} else {
+						delivered = true
 						break retrySendReward
 					}
 				}
+
+				if !delivered {
+					// the game is finished without rewards, so the budget must not stay consumed
+					if budgetSpent {
+						s.refundBudget(puzzleGameID, rewardsAmount)
+					}
+					rewardsAmount, lockRewardsAmount = 0, 0
+				}
 			}
 		}
 
@@ -587,6 +673,10 @@ func (s *Service) TapTile(ctx context.Context, userID, puzzleGameID uuid.UUID, p
 		}); err != nil {
 			return PuzzleGame{}, errors.Wrap(err, "can't finish puzzle game")
 		}
+
+		if s.recordScoreFn != nil {
+			s.recordScoreFn(ctx, userID, rewardsAmount, 1, uuid.Nil, uuid.Nil)
+		}
 	} else {
 		_, err = s.pgr.UpdatePuzzleGameAttempt(ctx, repository.UpdatePuzzleGameAttemptParams{
 			Status:     att.Status,
@@ -617,3 +707,13 @@ func (s *Service) TapTile(ctx context.Context, userID, puzzleGameID uuid.UUID, p
 	}
 	return response.HideCorrectPositions(), nil
 }
+
+// refundBudget returns undelivered rewards to the puzzle game budget.
+func (s *Service) refundBudget(puzzleGameID uuid.UUID, amount float64) {
+	if s.refundBudgetFn == nil {
+		return
+	}
+	if err := s.refundBudgetFn(context.Background(), puzzleGameID, amount); err != nil {
+		log.Printf("can't refund puzzle game %s budget: %v", puzzleGameID, err)
+	}
+}
@@ -7,6 +7,15 @@ func WithChargeFunction(fn chargeForUnlockFunc) ServiceOption {
 	}
 }
 
+// WithPricingFunctions sets the functions to quote and charge unlock price in SAO, SOL or USDC.
+// If set, pay function is used instead of the charge function.
+func WithPricingFunctions(quoteFn quoteFunc, payFn payFunc) ServiceOption {
+	return func(s *Service) {
+		s.quoteFn = quoteFn
+		s.payFn = payFn
+	}
+}
+
 // WithFileServiceClient sets the file service client for the service.
 func WithFileServiceClient(fs filesService) ServiceOption {
 	return func(s *Service) {
@@ -28,6 +37,22 @@ func WithUserMultiplierFunction(fn getUserRewardsMultiplierFunc) ServiceOption {
 	}
 }
 
+// WithBudgetFunction sets the functions to consume rewards from the puzzle game budget
+// and to return them back if they could not be delivered.
+func WithBudgetFunction(spendFn spendBudgetFunc, refundFn refundBudgetFunc) ServiceOption {
+	return func(s *Service) {
+		s.spendBudgetFn = spendFn
+		s.refundBudgetFn = refundFn
+	}
+}
+
+// WithLeaderboardFunction sets the function to add finished games to leaderboards.
+func WithLeaderboardFunction(fn recordScoreFunc) ServiceOption {
+	return func(s *Service) {
+		s.recordScoreFn = fn
+	}
+}
+
 // IsRewardsEnabled returns true if rewards are enabled for the service.
 func IsRewardsEnabled(enabled bool) ServiceOption {
 	return func(s *Service) {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/svc/pricing"
 	"github.com/go-chi/chi/v5"
 	jwtkit "github.com/go-kit/kit/auth/jwt"
 	"github.com/go-kit/kit/transport"
@@ -97,6 +98,13 @@ func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
 		options...,
 	).ServeHTTP)
 
+	r.Post("/{puzzle_game_id}/unlock-quote", httptransport.NewServer(
+		e.GetUnlockQuote,
+		decodeGetUnlockQuoteRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
 	r.Post("/{puzzle_game_id}/start", httptransport.NewServer(
 		e.StartPuzzleGame,
 		decodeStartPuzzleGameRequest,
@@ -128,7 +136,7 @@ func codeAndMessageFrom(err error) (int, interface{}) {
 		return http.StatusBadRequest, err.Error()
 	}
 
-	return httpencoder.CodeAndMessageFrom(err)
+	return pricing.CodeAndMessageFrom(err)
 }
 
 func decodeGetPuzzleGameByEpisodeIDRequest(ctx context.Context, r *http.Request) (interface{}, error) {
@@ -234,6 +242,21 @@ func decodeUnlockPuzzleGameRequest(ctx context.Context, r *http.Request) (interf
 	return req, nil
 }
 
+func decodeGetUnlockQuoteRequest(ctx context.Context, r *http.Request) (interface{}, error) {
+	var req GetUnlockQuoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	id := chi.URLParam(r, "puzzle_game_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed puzzle game id", ErrInvalidParameter)
+	}
+	req.PuzzleGameID = id
+
+	return req, nil
+}
+
 func decodeStartPuzzleGameRequest(ctx context.Context, r *http.Request) (interface{}, error) {
 	id := chi.URLParam(r, "puzzle_game_id")
 	if id == "" {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/multipliers"
 	"github.com/SatorNetwork/sator-api/svc/qrcodes/repository"
 
 	"github.com/google/uuid"
@@ -21,8 +22,12 @@ const (
 type (
 	// Service struct
 	Service struct {
-		qr qrcodeRepository
-		rc rewardsClient
+		qr             qrcodeRepository
+		rc             rewardsClient
+		spendBudgetFn  spendBudgetFunc
+		refundBudgetFn refundBudgetFunc
+		multiplierFn   rewardMultiplierFunc
+		audit          auditFunc
 	}
 
 	qrcodeRepository interface {
@@ -37,6 +42,15 @@ type (
 		IsQRCodeScanned(ctx context.Context, userID, qrcodeID uuid.UUID) (bool, error)
 	}
 
+	spendBudgetFunc      func(ctx context.Context, qrcodeID, showID uuid.UUID, amount float64) (float64, error)
+	refundBudgetFunc     func(ctx context.Context, qrcodeID, showID uuid.UUID, amount float64) error
+	rewardMultiplierFunc func(ctx context.Context, userID uuid.UUID) (int32, error)
+	auditFunc            func(ctx context.Context, action, entityType, entityID string, before, after interface{}) error
+
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
 	// Qrcode struct
 	Qrcode struct {
 		ID           uuid.UUID `json:"id"`
@@ -49,7 +63,7 @@ type (
 )
 
 // NewService is a factory function, returns a new instance of the Service interface implementation
-func NewService(qr qrcodeRepository, rc rewardsClient) *Service {
+func NewService(qr qrcodeRepository, rc rewardsClient, opt ...ServiceOption) *Service {
 	if qr == nil {
 		log.Fatalln("qrcode service is not set")
 	}
@@ -57,7 +71,39 @@ func NewService(qr qrcodeRepository, rc rewardsClient) *Service {
 		log.Fatalln("rewards client is not set")
 	}
 
-	return &Service{qr: qr, rc: rc}
+	s := &Service{qr: qr, rc: rc}
+
+	for _, fn := range opt {
+		fn(s)
+	}
+
+	return s
+}
+
+// WithBudgetFunc option
+// Limits qrcode rewards by budgets of the qrcode and its show,
+// the spent amount is refunded if the reward could not be deposited
+func WithBudgetFunc(spendFn spendBudgetFunc, refundFn refundBudgetFunc) ServiceOption {
+	return func(s *Service) {
+		s.spendBudgetFn = spendFn
+		s.refundBudgetFn = refundFn
+	}
+}
+
+// WithRewardMultiplierFunc option
+// Adds the user's multiplier bonus to qrcode rewards
+func WithRewardMultiplierFunc(fn rewardMultiplierFunc) ServiceOption {
+	return func(s *Service) {
+		s.multiplierFn = fn
+	}
+}
+
+// WithAuditFunc option
+// Records changes of qrcodes and their rewards to the audit log
+func WithAuditFunc(fn auditFunc) ServiceOption {
+	return func(s *Service) {
+		s.audit = fn
+	}
 }
 
 // GetDataByQRCodeID returns show id and episode id by qrcode id
@@ -85,9 +131,30 @@ func (s *Service) GetDataByQRCodeID(ctx context.Context, id, userID uuid.UUID) (
 	if now.After(qrcodeData.ExpiresAt) {
 		return nil, ErrQRCodeExpired
 	}
-	if qrcodeData.RewardAmount.Float64 > 0 {
-		err := s.rc.AddDepositTransaction(ctx, userID, id, RelationTypeQRcodes, qrcodeData.RewardAmount.Float64)
+	rewardAmount := qrcodeData.RewardAmount.Float64
+	if rewardAmount > 0 && s.multiplierFn != nil {
+		multiplier, err := s.multiplierFn(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get multiplier of user_id=%s: %w", userID.String(), err)
+		}
+		rewardAmount = multipliers.Apply(rewardAmount, multiplier)
+	}
+	budgetSpent := false
+	if rewardAmount > 0 && s.spendBudgetFn != nil {
+		rewardAmount, err = s.spendBudgetFn(ctx, id, qrcodeData.ShowID, rewardAmount)
+		if err != nil {
+			return nil, fmt.Errorf("could not spend budget of qrcode_id=%s: %w", id.String(), err)
+		}
+		budgetSpent = true
+	}
+	if rewardAmount > 0 {
+		err := s.rc.AddDepositTransaction(ctx, userID, id, RelationTypeQRcodes, rewardAmount)
 		if err != nil {
+			if budgetSpent && s.refundBudgetFn != nil {
+				if rerr := s.refundBudgetFn(context.Background(), id, qrcodeData.ShowID, rewardAmount); rerr != nil {
+					log.Printf("could not refund budget of qrcode_id=%s: %v", id.String(), rerr)
+				}
+			}
 			return nil, fmt.Errorf("could not add transaction for user_id=%s and qrcode_id=%s: %w", userID.String(), id.String(), err)
 		}
 	}
@@ -96,7 +163,7 @@ func (s *Service) GetDataByQRCodeID(ctx context.Context, id, userID uuid.UUID) (
 		ID:           qrcodeData.ID,
 		ShowID:       qrcodeData.ShowID,
 		EpisodeID:    qrcodeData.EpisodeID,
-		RewardAmount: qrcodeData.RewardAmount.Float64,
+		RewardAmount: rewardAmount,
 	}
 
 	return qrcode, nil
@@ -118,7 +185,14 @@ func (s *Service) AddQRCode(ctx context.Context, qr Qrcode) (Qrcode, error) {
 		return Qrcode{}, fmt.Errorf("could not add qrcode with episode id=%s: %w", qr.EpisodeID, err)
 	}
 
-	return castToQrcode(qrCode), nil
+	result := castToQrcode(qrCode)
+	if s.audit != nil {
+		if err := s.audit(ctx, "qrcode.create", "qrcode", result.ID.String(), nil, result); err != nil {
+			return Qrcode{}, fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
+	return result, nil
 }
 
 func castToQrcode(qr repository.Qrcode) Qrcode {
@@ -134,15 +208,25 @@ func castToQrcode(qr repository.Qrcode) Qrcode {
 
 // DeleteQRCodeByID ...
 func (s *Service) DeleteQRCodeByID(ctx context.Context, id uuid.UUID) error {
+	before := s.qrcodeForAudit(ctx, id)
+
 	if err := s.qr.DeleteQRCodeByID(ctx, id); err != nil {
 		return fmt.Errorf("could not delete qrcode with id=%s:%w", id, err)
 	}
 
+	if s.audit != nil {
+		if err := s.audit(ctx, "qrcode.delete", "qrcode", id.String(), before, nil); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return nil
 }
 
 // UpdateQRCode ..
 func (s *Service) UpdateQRCode(ctx context.Context, qr Qrcode) error {
+	before := s.qrcodeForAudit(ctx, qr.ID)
+
 	if err := s.qr.UpdateQRCode(ctx, repository.UpdateQRCodeParams{
 		ShowID:    qr.ShowID,
 		EpisodeID: qr.EpisodeID,
@@ -161,5 +245,26 @@ func (s *Service) UpdateQRCode(ctx context.Context, qr Qrcode) error {
 		return fmt.Errorf("could not update qrcode with id=%s:%w", qr.ID, err)
 	}
 
+	if s.audit != nil {
+		if err := s.audit(ctx, "qrcode.update", "qrcode", qr.ID.String(), before, qr); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return nil
 }
+
+// qrcodeForAudit returns current state of the qrcode to be stored in the audit log,
+// nil if the audit log is not set or the qrcode is not found.
+func (s *Service) qrcodeForAudit(ctx context.Context, id uuid.UUID) interface{} {
+	if s.audit == nil {
+		return nil
+	}
+
+	qrCode, err := s.qr.GetDataByQRCodeID(ctx, id)
+	if err != nil {
+		return nil
+	}
+
+	return castToQrcode(qrCode)
+}
@@ -449,6 +449,8 @@ func (s *Service) getQuizWinners(ctx context.Context, quiz repository.Quiz, ques
 		totalRate += int(w.Rate)
 	}
 
+	// rewards multiplier is not applied: quiz v1 is not mounted in the API anymore,
+	// quizzes are served by quiz_v2 which adds the bonus with multipliers.Bonus.
 	for _, w := range result {
 		prize := calcPrize(quiz.PrizePool, totalWinnersNumber, int(questionsNumber), totalPts, totalRate, int(w.Pts), int(w.Rate))
 		if err := s.rewards.AddDepositTransaction(ctx, w.UserID, quiz.ID, RelationTypeQuizzes, prize); err != nil {
@@ -12,7 +12,9 @@ import (
 
 func GetCurrentPrizePool(
 	qr interfaces.QuizV2Repository,
+	budgets interfaces.BudgetsService,
 	challengeID uuid.UUID,
+	showID uuid.UUID,
 	prizePoolAmount float64,
 	minimumReward float64,
 	percentForQuiz float64,
@@ -30,7 +32,7 @@ func GetCurrentPrizePool(
 		return 0, errors.Wrap(err, "no money left in pool")
 	}
 	if leftInPool <= minimumReward {
-		return leftInPool, nil
+		return capByBudget(budgets, challengeID, showID, leftInPool)
 	}
 
 	currentPrizePool := leftInPool / 100 * percentForQuiz
@@ -38,5 +40,22 @@ func GetCurrentPrizePool(
 		currentPrizePool = minimumReward
 	}
 
-	return currentPrizePool, nil
+	return capByBudget(budgets, challengeID, showID, currentPrizePool)
+}
+
+// capByBudget limits prize pool by amount left in budgets of the challenge and its show
+func capByBudget(budgets interfaces.BudgetsService, challengeID, showID uuid.UUID, prizePool float64) (float64, error) {
+	if budgets == nil {
+		return prizePool, nil
+	}
+
+	remaining, limited, err := budgets.GetChallengeRemainingBudget(context.Background(), challengeID, showID)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't get remaining budget")
+	}
+	if limited && remaining < prizePool {
+		return remaining, nil
+	}
+
+	return prizePool, nil
 }
@@ -22,6 +22,9 @@ type Engine struct {
 	challenges         interfaces.ChallengesService
 	stakeLevels        interfaces.StakeLevels
 	rewards            interfaces.RewardsService
+	campaigns          interfaces.CampaignsService
+	budgets            interfaces.BudgetsService
+	leaderboard        interfaces.LeaderboardService
 	qr                 interfaces.QuizV2Repository
 	restrictionManager restriction_manager.RestrictionManager
 
@@ -36,6 +39,9 @@ func New(
 	challenges interfaces.ChallengesService,
 	stakeLevels interfaces.StakeLevels,
 	rewards interfaces.RewardsService,
+	campaigns interfaces.CampaignsService,
+	budgets interfaces.BudgetsService,
+	leaderboard interfaces.LeaderboardService,
 	qr interfaces.QuizV2Repository,
 	restrictionManager restriction_manager.RestrictionManager,
 	shuffleQuestions bool,
@@ -51,6 +57,9 @@ func New(
 		challenges:         challenges,
 		stakeLevels:        stakeLevels,
 		rewards:            rewards,
+		campaigns:          campaigns,
+		budgets:            budgets,
+		leaderboard:        leaderboard,
 		qr:                 qr,
 		restrictionManager: restrictionManager,
 
@@ -116,6 +125,9 @@ func (e *Engine) getOrCreateRoom(challengeID string, eventsChan chan engine_even
 			e.challenges,
 			e.stakeLevels,
 			e.rewards,
+			e.campaigns,
+			e.budgets,
+			e.leaderboard,
 			e.qr,
 			e.restrictionManager,
 			e.shuffleQuestions,
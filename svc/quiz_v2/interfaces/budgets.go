@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type BudgetsService interface {
+	GetChallengeRemainingBudget(ctx context.Context, challengeID, showID uuid.UUID) (float64, bool, error)
+	SpendChallengeBudget(ctx context.Context, challengeID, showID uuid.UUID, amount float64) (float64, error)
+	RefundChallengeBudget(ctx context.Context, challengeID, showID uuid.UUID, amount float64) error
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type CampaignsService interface {
+	TrackEvent(ctx context.Context, userID uuid.UUID, event string)
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type LeaderboardService interface {
+	RecordScore(ctx context.Context, userID uuid.UUID, points float64, wins int32, showID, challengeID uuid.UUID)
+}
@@ -11,6 +11,8 @@ type QuizV2Repository interface {
 	RegisterNewPlayer(ctx context.Context, arg quiz_v2_repository.RegisterNewPlayerParams) error
 	CountPlayersInRoom(ctx context.Context, challengeID uuid.UUID) (int64, error)
 	UnregisterPlayer(ctx context.Context, arg quiz_v2_repository.UnregisterPlayerParams) error
+	GetRoomsByPlayerID(ctx context.Context, userID uuid.UUID) ([]quiz_v2_repository.RoomPlayer, error)
+	DeleteRoomPlayersByUserID(ctx context.Context, userID uuid.UUID) error
 
 	RegisterNewQuiz(ctx context.Context, arg quiz_v2_repository.RegisterNewQuizParams) (quiz_v2_repository.QuizzesV2, error)
 	GetDistributedRewardsByChallengeID(ctx context.Context, challengeID uuid.UUID) (float64, error)
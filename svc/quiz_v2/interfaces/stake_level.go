@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 )
 
+// StakeLevels returns the user's reward multiplier as a bonus percent added to the prize
 type StakeLevels interface {
 	GetMultiplier(ctx context.Context, userID uuid.UUID) (_ int32, err error)
 }
@@ -28,9 +28,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.countPlayersInRoomStmt, err = db.PrepareContext(ctx, countPlayersInRoom); err != nil {
 		return nil, fmt.Errorf("error preparing query CountPlayersInRoom: %w", err)
 	}
+	if q.deleteRoomPlayersByUserIDStmt, err = db.PrepareContext(ctx, deleteRoomPlayersByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRoomPlayersByUserID: %w", err)
+	}
 	if q.getDistributedRewardsByChallengeIDStmt, err = db.PrepareContext(ctx, getDistributedRewardsByChallengeID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetDistributedRewardsByChallengeID: %w", err)
 	}
+	if q.getRoomsByPlayerIDStmt, err = db.PrepareContext(ctx, getRoomsByPlayerID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRoomsByPlayerID: %w", err)
+	}
 	if q.registerNewPlayerStmt, err = db.PrepareContext(ctx, registerNewPlayer); err != nil {
 		return nil, fmt.Errorf("error preparing query RegisterNewPlayer: %w", err)
 	}
@@ -55,11 +61,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing countPlayersInRoomStmt: %w", cerr)
 		}
 	}
+	if q.deleteRoomPlayersByUserIDStmt != nil {
+		if cerr := q.deleteRoomPlayersByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRoomPlayersByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getDistributedRewardsByChallengeIDStmt != nil {
 		if cerr := q.getDistributedRewardsByChallengeIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getDistributedRewardsByChallengeIDStmt: %w", cerr)
 		}
 	}
+	if q.getRoomsByPlayerIDStmt != nil {
+		if cerr := q.getRoomsByPlayerIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRoomsByPlayerIDStmt: %w", cerr)
+		}
+	}
 	if q.registerNewPlayerStmt != nil {
 		if cerr := q.registerNewPlayerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing registerNewPlayerStmt: %w", cerr)
@@ -116,7 +132,9 @@ type Queries struct {
 	tx                                     *sql.Tx
 	cleanUpStmt                            *sql.Stmt
 	countPlayersInRoomStmt                 *sql.Stmt
+	deleteRoomPlayersByUserIDStmt          *sql.Stmt
 	getDistributedRewardsByChallengeIDStmt *sql.Stmt
+	getRoomsByPlayerIDStmt                 *sql.Stmt
 	registerNewPlayerStmt                  *sql.Stmt
 	registerNewQuizStmt                    *sql.Stmt
 	unregisterPlayerStmt                   *sql.Stmt
@@ -128,7 +146,9 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		tx:                                     tx,
 		cleanUpStmt:                            q.cleanUpStmt,
 		countPlayersInRoomStmt:                 q.countPlayersInRoomStmt,
+		deleteRoomPlayersByUserIDStmt:          q.deleteRoomPlayersByUserIDStmt,
 		getDistributedRewardsByChallengeIDStmt: q.getDistributedRewardsByChallengeIDStmt,
+		getRoomsByPlayerIDStmt:                 q.getRoomsByPlayerIDStmt,
 		registerNewPlayerStmt:                  q.registerNewPlayerStmt,
 		registerNewQuizStmt:                    q.registerNewQuizStmt,
 		unregisterPlayerStmt:                   q.unregisterPlayerStmt,
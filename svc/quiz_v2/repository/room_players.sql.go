@@ -24,6 +24,51 @@ func (q *Queries) CountPlayersInRoom(ctx context.Context, challengeID uuid.UUID)
 	return players, err
 }
 
+const deleteRoomPlayersByUserID = `-- name: DeleteRoomPlayersByUserID :exec
+DELETE FROM room_players
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRoomPlayersByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteRoomPlayersByUserIDStmt, deleteRoomPlayersByUserID, userID)
+	return err
+}
+
+const getRoomsByPlayerID = `-- name: GetRoomsByPlayerID :many
+SELECT challenge_id, user_id, updated_at, created_at
+FROM room_players
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetRoomsByPlayerID(ctx context.Context, userID uuid.UUID) ([]RoomPlayer, error) {
+	rows, err := q.query(ctx, q.getRoomsByPlayerIDStmt, getRoomsByPlayerID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RoomPlayer
+	for rows.Next() {
+		var i RoomPlayer
+		if err := rows.Scan(
+			&i.ChallengeID,
+			&i.UserID,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const registerNewPlayer = `-- name: RegisterNewPlayer :exec
 INSERT INTO room_players (
     challenge_id,
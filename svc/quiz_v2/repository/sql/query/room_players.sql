@@ -19,3 +19,14 @@ FROM room_players
 WHERE challenge_id = @challenge_id
 GROUP BY challenge_id
 LIMIT 1;
+
+-- name: GetRoomsByPlayerID :many
+SELECT *
+FROM room_players
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+
+-- name: DeleteRoomPlayersByUserID :exec
+DELETE FROM room_players
+WHERE user_id = $1;
\ No newline at end of file
@@ -41,6 +41,7 @@ func New(
 	challengeID string,
 	challengesSvc interfaces.ChallengesService,
 	qr interfaces.QuizV2Repository,
+	budgets interfaces.BudgetsService,
 	stakeLevels interfaces.StakeLevels,
 	shuffleQuestions bool,
 ) (*quizEngine, error) {
@@ -50,7 +51,7 @@ func New(
 	}
 	challenge := qc.GetChallenge()
 
-	currentPrizePool, err := getCurrentPrizePool(qc, qr)
+	currentPrizePool, err := getCurrentPrizePool(qc, qr, budgets)
 	if err != nil {
 		return nil, err
 	}
@@ -71,11 +72,13 @@ func New(
 	}, nil
 }
 
-func getCurrentPrizePool(qc question_container.QuestionContainer, qr interfaces.QuizV2Repository) (float64, error) {
+func getCurrentPrizePool(qc question_container.QuestionContainer, qr interfaces.QuizV2Repository, budgets interfaces.BudgetsService) (float64, error) {
 	challenge := qc.GetChallenge()
 	return common.GetCurrentPrizePool(
 		qr,
+		budgets,
 		challenge.ID,
+		challenge.ShowID,
 		challenge.PrizePoolAmount,
 		challenge.MinimumReward,
 		challenge.PercentForQuiz,
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/SatorNetwork/sator-api/svc/multipliers"
 	"github.com/SatorNetwork/sator-api/svc/quiz_v2/interfaces"
 	"github.com/SatorNetwork/sator-api/svc/quiz_v2/room/default_room/quiz_engine/result_table/cell"
 )
@@ -161,7 +162,7 @@ func (rt *resultTable) applyStakeLevels(userIDToPrize map[uuid.UUID]float64) (ma
 			return nil, errors.Wrap(err, "could not get user's multiplier")
 		}
 		// TODO: add bonus to the winners message
-		bonus := (prize / 100) * float64(multiplier)
+		bonus := multipliers.Bonus(prize, multiplier)
 		prize = prize + bonus
 
 		prizeMapWithStakeLevels[userID] = UserReward{
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/SatorNetwork/sator-api/svc/campaigns"
 	"github.com/SatorNetwork/sator-api/svc/challenge"
 	engine_events "github.com/SatorNetwork/sator-api/svc/quiz_v2/engine/events"
 	"github.com/SatorNetwork/sator-api/svc/quiz_v2/interfaces"
@@ -58,6 +59,9 @@ type defaultRoom struct {
 	quizEngine         quiz_engine.QuizEngine
 	quizLobbyLatency   time.Duration
 	rewards            interfaces.RewardsService
+	campaigns          interfaces.CampaignsService
+	budgets            interfaces.BudgetsService
+	leaderboard        interfaces.LeaderboardService
 	restrictionManager restriction_manager.RestrictionManager
 
 	disableRewardsForQuiz bool
@@ -69,6 +73,9 @@ func New(
 	challenges interfaces.ChallengesService,
 	stakeLevels interfaces.StakeLevels,
 	rewards interfaces.RewardsService,
+	campaigns interfaces.CampaignsService,
+	budgets interfaces.BudgetsService,
+	leaderboard interfaces.LeaderboardService,
 	qr interfaces.QuizV2Repository,
 	restrictionManager restriction_manager.RestrictionManager,
 	shuffleQuestions bool,
@@ -84,7 +91,7 @@ func New(
 
 	statusIsUpdatedChan := make(chan struct{}, defaultChanBuffSize)
 
-	quizEngine, err := quiz_engine.New(challengeID, challenges, qr, stakeLevels, shuffleQuestions)
+	quizEngine, err := quiz_engine.New(challengeID, challenges, qr, budgets, stakeLevels, shuffleQuestions)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +114,9 @@ func New(
 		quizEngine:         quizEngine,
 		quizLobbyLatency:   quizLobbyLatency,
 		rewards:            rewards,
+		campaigns:          campaigns,
+		budgets:            budgets,
+		leaderboard:        leaderboard,
 		restrictionManager: restrictionManager,
 
 		disableRewardsForQuiz: disableRewardsForQuiz,
@@ -174,6 +184,7 @@ LOOP:
 
 			case status_transactor.WinnersTableAreSent:
 				go r.sendRewards()
+				go r.trackPlayedQuiz()
 
 			case status_transactor.RewardsAreSent:
 				r.st.SetStatus(status_transactor.RoomIsFinished)
@@ -466,6 +477,7 @@ func (r *defaultRoom) sendWinnersTable() {
 
 func (r *defaultRoom) sendRewards() {
 	if r.disableRewardsForQuiz {
+		r.recordQuizWins()
 		r.st.SetStatus(status_transactor.RewardsAreSent)
 		return
 	}
@@ -481,7 +493,23 @@ func (r *defaultRoom) sendRewards() {
 		return
 	}
 
+	ratio, err := r.spendBudget(challengeID, userIDToReward)
+	if err != nil {
+		log.Printf("can't spend challenge budget: %v\n", err)
+		return
+	}
 	for userID, reward := range userIDToReward {
+		reward.Prize *= ratio
+		reward.Bonus *= ratio
+		userIDToReward[userID] = reward
+	}
+
+	var undelivered float64
+	for userID, reward := range userIDToReward {
+		if reward.Prize <= 0 {
+			continue
+		}
+
 		err := r.rewards.AddDepositTransaction(
 			context.Background(),
 			userID,
@@ -491,9 +519,19 @@ func (r *defaultRoom) sendRewards() {
 		)
 		if err != nil {
 			log.Printf("can't add deposit transaction: %v\n", err)
+			undelivered += reward.Prize
+			reward.Prize, reward.Bonus = 0, 0
+			userIDToReward[userID] = reward
 			continue
 		}
 	}
+	r.refundBudget(challengeID, undelivered)
+
+	prizes := make(map[uuid.UUID]float64, len(userIDToReward))
+	for userID, reward := range userIDToReward {
+		prizes[userID] = reward.Prize
+	}
+	r.recordLeaderboardScores(challengeID, prizes)
 
 	for userID, reward := range userIDToReward {
 		err := r.restrictionManager.RegisterEarnedReward(context.Background(), challengeID, userID, reward.Prize)
@@ -503,7 +541,7 @@ func (r *defaultRoom) sendRewards() {
 		}
 	}
 
-	distributedPrizePool := r.quizEngine.DistributedPrizePool()
+	distributedPrizePool := r.quizEngine.DistributedPrizePool()*ratio - undelivered
 	_, err = r.qr.RegisterNewQuiz(context.Background(), quiz_v2_repository.RegisterNewQuizParams{
 		ChallengeID:        challengeID,
 		DistributedRewards: distributedPrizePool,
@@ -515,6 +553,106 @@ func (r *defaultRoom) sendRewards() {
 	r.st.SetStatus(status_transactor.RewardsAreSent)
 }
 
+// spendBudget consumes the whole prize pool distribution from budgets of the challenge and its show,
+// returns the ratio which rewards should be prorated by if budgets are running out.
+func (r *defaultRoom) spendBudget(challengeID uuid.UUID, userIDToReward map[uuid.UUID]result_table.UserReward) (float64, error) {
+	if r.budgets == nil {
+		return 1, nil
+	}
+
+	var total float64
+	for _, reward := range userIDToReward {
+		total += reward.Prize
+	}
+	if total <= 0 {
+		return 1, nil
+	}
+
+	challenge := r.quizEngine.GetChallenge()
+	granted, err := r.budgets.SpendChallengeBudget(context.Background(), challengeID, challenge.ShowID, total)
+	if err != nil {
+		return 0, err
+	}
+	if granted >= total {
+		return 1, nil
+	}
+
+	return granted / total, nil
+}
+
+// refundBudget returns rewards which could not be deposited to budgets of the challenge and its show
+func (r *defaultRoom) refundBudget(challengeID uuid.UUID, amount float64) {
+	if r.budgets == nil || amount <= 0 {
+		return
+	}
+
+	challenge := r.quizEngine.GetChallenge()
+	if err := r.budgets.RefundChallengeBudget(context.Background(), challengeID, challenge.ShowID, amount); err != nil {
+		log.Printf("can't refund challenge budget: %v\n", err)
+	}
+}
+
+// recordQuizWins adds wins of the quiz to leaderboards when rewards are disabled
+func (r *defaultRoom) recordQuizWins() {
+	challengeID, err := uuid.Parse(r.ChallengeID())
+	if err != nil {
+		log.Printf("can't parse challenge ID: %v\n", err)
+		return
+	}
+
+	winners, err := r.quizEngine.GetWinners()
+	if err != nil {
+		log.Printf("can't get winners: %v\n", err)
+		return
+	}
+
+	prizes := make(map[uuid.UUID]float64, len(winners))
+	for _, w := range winners {
+		userID, err := uuid.Parse(w.UserID)
+		if err != nil {
+			log.Printf("can't parse winner ID: %v\n", err)
+			continue
+		}
+		prizes[userID] = 0
+	}
+	r.recordLeaderboardScores(challengeID, prizes)
+}
+
+// recordLeaderboardScores adds the quiz win and earned prize of each winner to leaderboards
+func (r *defaultRoom) recordLeaderboardScores(challengeID uuid.UUID, prizes map[uuid.UUID]float64) {
+	if r.leaderboard == nil {
+		return
+	}
+
+	showID := r.quizEngine.GetChallenge().ShowID
+	for userID, prize := range prizes {
+		r.leaderboard.RecordScore(context.Background(), userID, prize, 1, showID, challengeID)
+	}
+}
+
+// trackPlayedQuiz reports the finished quiz to reward campaigns for each player
+func (r *defaultRoom) trackPlayedQuiz() {
+	if r.campaigns == nil {
+		return
+	}
+
+	players, err := r.quizEngine.GetPlayers()
+	if err != nil {
+		log.Printf("can't get players: %v\n", err)
+		return
+	}
+
+	for _, p := range players {
+		userID, err := uuid.Parse(p.UserID)
+		if err != nil {
+			log.Printf("can't parse player ID: %v\n", err)
+			continue
+		}
+
+		r.campaigns.TrackEvent(context.Background(), userID, campaigns.EventQuizPlayed)
+	}
+}
+
 func (r *defaultRoom) sendMessagesForNewPlayers(p player.Player) {
 	for _, msg := range r.messagesForNewPlayers {
 		if err := p.SendMessage(msg); err != nil {
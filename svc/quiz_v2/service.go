@@ -32,6 +32,7 @@ type (
 		natsURL    string
 		natsWSURL  string
 		challenges interfaces.ChallengesService
+		budgets    interfaces.BudgetsService
 		ac         authClient
 		pc         profileClient
 		dbClient   *sql.DB
@@ -68,6 +69,9 @@ func NewService(
 	challenges interfaces.ChallengesService,
 	stakeLevels interfaces.StakeLevels,
 	rewards interfaces.RewardsService,
+	campaigns interfaces.CampaignsService,
+	budgets interfaces.BudgetsService,
+	leaderboard interfaces.LeaderboardService,
 	ac authClient,
 	pc profileClient,
 	dbClient *sql.DB,
@@ -84,6 +88,9 @@ func NewService(
 		challenges,
 		stakeLevels,
 		rewards,
+		campaigns,
+		budgets,
+		leaderboard,
 		qr,
 		restrictionManager,
 		shuffleQuestions,
@@ -97,6 +104,7 @@ func NewService(
 		natsURL:               natsURL,
 		natsWSURL:             natsWSURL,
 		challenges:            challenges,
+		budgets:               budgets,
 		ac:                    ac,
 		pc:                    pc,
 		dbClient:              dbClient,
@@ -190,7 +198,9 @@ func (s *Service) GetChallengeByID(ctx context.Context, challengeID, userID uuid
 
 	currentPrizePool, err := common.GetCurrentPrizePool(
 		s.qr,
+		s.budgets,
 		challenge.ID,
+		challenge.ShowID,
 		challenge.PrizePoolAmount,
 		challenge.MinimumReward,
 		challenge.PercentForQuiz,
@@ -274,7 +284,9 @@ func (s *Service) NewChallengeFromSQL(ctx context.Context, c *sql_executor.Chall
 
 		currentPrizePool, err = common.GetCurrentPrizePool(
 			s.qr,
+			s.budgets,
 			challenge.ID,
+			challenge.ShowID,
 			challenge.PrizePoolAmount,
 			challenge.MinimumReward,
 			challenge.PercentForQuiz,
@@ -312,3 +324,36 @@ func (s *Service) NewChallengesFromSQL(ctx context.Context, sqlChallenges []*sql
 
 	return challenges, nil
 }
+
+// ExportUserData returns quiz rooms the user has joined, to be included into the data export archive.
+// Quiz rewards are exported by the rewards service.
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	rooms, err := s.qr.GetRoomsByPlayerID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get quiz rooms of user")
+	}
+
+	type room struct {
+		ChallengeID uuid.UUID `json:"challenge_id"`
+		JoinedAt    time.Time `json:"joined_at"`
+	}
+	result := make([]room, 0, len(rooms))
+	for _, r := range rooms {
+		result = append(result, room{
+			ChallengeID: r.ChallengeID,
+			JoinedAt:    r.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// EraseUserData deletes the user's participation in quiz rooms on account deletion.
+// Quiz rewards are financial records, so they are kept by the rewards service.
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	if err := s.qr.DeleteRoomPlayersByUserID(ctx, userID); err != nil {
+		return errors.Wrap(err, "can't delete quiz rooms of user")
+	}
+
+	return nil
+}
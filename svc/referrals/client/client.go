@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Client struct
+	Client struct {
+		s service
+	}
+
+	service interface {
+		TrackEvent(ctx context.Context, userID uuid.UUID, event string) error
+	}
+)
+
+// New referrals service client implementation
+func New(s service) *Client {
+	return &Client{s: s}
+}
+
+// TrackEvent records the referee's funnel stage and rewards the referrer for the milestone in background,
+// so the caller's flow is never blocked or failed by referral rewards.
+func (c *Client) TrackEvent(_ context.Context, userID uuid.UUID, event string) {
+	go func() {
+		if err := c.s.TrackEvent(context.Background(), userID, event); err != nil {
+			log.Printf("could not track referral event %s of user %s: %v", event, userID, err)
+		}
+	}()
+}
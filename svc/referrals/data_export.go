@@ -0,0 +1,71 @@
+package referrals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+)
+
+type (
+	// ReferralsData is the referral program data of the user included into the data export archive.
+	// Referees are identified by ids only, their personal data belongs to them.
+	ReferralsData struct {
+		ReferralCode *ReferralCode            `json:"referral_code,omitempty"`
+		UsedCodes    []Referral               `json:"used_codes"`
+		Rewards      []ExportedReferralReward `json:"rewards"`
+	}
+
+	// ExportedReferralReward struct
+	ExportedReferralReward struct {
+		ID        uuid.UUID `json:"id"`
+		RefereeID uuid.UUID `json:"referee_id"`
+		Milestone string    `json:"milestone"`
+		Amount    float64   `json:"amount"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+)
+
+// ExportUserData returns personal referral code of the user, referral codes used by the user
+// and rewards received for referees, to be included into the data export archive.
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	data := ReferralsData{
+		UsedCodes: []Referral{},
+		Rewards:   []ExportedReferralReward{},
+	}
+
+	rc, err := s.rr.GetReferralCodeDataByUserID(ctx, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		if !db.IsNotFoundError(err) {
+			return nil, fmt.Errorf("could not get referral code data by user id: %w", err)
+		}
+	} else {
+		code := castToReferralCode(rc, "")
+		data.ReferralCode = &code
+	}
+
+	referrals, err := s.rr.GetReferralsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get referrals list: %w", err)
+	}
+	data.UsedCodes = append(data.UsedCodes, castToListReferral(referrals)...)
+
+	rewards, err := s.rr.GetReferralRewardsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get referral rewards: %w", err)
+	}
+	for _, r := range rewards {
+		data.Rewards = append(data.Rewards, ExportedReferralReward{
+			ID:        r.ID,
+			RefereeID: r.RefereeID,
+			Milestone: r.Milestone,
+			Amount:    r.Amount,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+
+	return data, nil
+}
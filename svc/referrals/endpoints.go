@@ -3,6 +3,7 @@ package referrals
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/SatorNetwork/sator-api/lib/httpencoder"
 	"github.com/SatorNetwork/sator-api/lib/jwt"
@@ -25,6 +26,19 @@ type (
 
 		GetReferralsWithPaginationByUserID endpoint.Endpoint
 		StoreUserWithValidCode             endpoint.Endpoint
+
+		AddRewardProgram        endpoint.Endpoint
+		DeleteRewardProgramByID endpoint.Endpoint
+		GetRewardProgramByID    endpoint.Endpoint
+		GetRewardPrograms       endpoint.Endpoint
+		UpdateRewardProgram     endpoint.Endpoint
+		GetEarnings             endpoint.Endpoint
+
+		TrackLinkStage     endpoint.Endpoint
+		GetFunnel          endpoint.Endpoint
+		GetFunnelCohorts   endpoint.Endpoint
+		GetTopReferrers    endpoint.Endpoint
+		ExportTopReferrers endpoint.Endpoint
 	}
 
 	service interface {
@@ -38,6 +52,21 @@ type (
 		// Referrals
 		GetReferralsWithPaginationByUserID(ctx context.Context, uid uuid.UUID, limit, offset int32) ([]Referral, error)
 		StoreUserWithValidCode(ctx context.Context, uid uuid.UUID, code string) (bool, error)
+
+		// Reward programs
+		AddRewardProgram(ctx context.Context, p RewardProgram) (RewardProgram, error)
+		DeleteRewardProgramByID(ctx context.Context, id uuid.UUID) error
+		GetRewardProgramByID(ctx context.Context, id uuid.UUID) (RewardProgram, error)
+		GetRewardPrograms(ctx context.Context, limit, offset int32) ([]RewardProgram, error)
+		UpdateRewardProgram(ctx context.Context, p RewardProgram) error
+		GetEarnings(ctx context.Context, uid uuid.UUID) (Earnings, error)
+
+		// Attribution funnel
+		TrackLinkStage(ctx context.Context, referralCodeID uuid.UUID, stage, deviceID, ip string) error
+		GetFunnel(ctx context.Context, referralCodeID uuid.UUID) (Funnel, error)
+		GetFunnelCohorts(ctx context.Context, referralCodeID uuid.NullUUID, since time.Time) ([]Cohort, error)
+		GetTopReferrers(ctx context.Context, since time.Time, limit, offset int32) ([]TopReferrer, error)
+		ExportTopReferrers(ctx context.Context, since time.Time) ([][]string, error)
 	}
 
 	// AddReferralCodeRequest struct
@@ -57,6 +86,43 @@ type (
 		IsPersonal   bool   `json:"is_personal,omitempty"`
 		UserID       string `json:"user_id"`
 	}
+
+	// RewardProgramRequest struct
+	RewardProgramRequest struct {
+		ID           string              `json:"-"`
+		Title        string              `json:"title" validate:"required"`
+		WelcomeBonus float64             `json:"welcome_bonus" validate:"gte=0"`
+		IsActive     bool                `json:"is_active"`
+		Tiers        []RewardTierRequest `json:"tiers" validate:"dive"`
+	}
+
+	// RewardTierRequest struct
+	RewardTierRequest struct {
+		MinReferrals     int32   `json:"min_referrals" validate:"required,gte=1"`
+		KYCPassedReward  float64 `json:"kyc_passed_reward" validate:"gte=0"`
+		FirstQuizReward  float64 `json:"first_quiz_reward" validate:"gte=0"`
+		FirstStakeReward float64 `json:"first_stake_reward" validate:"gte=0"`
+	}
+
+	// TrackLinkStageRequest struct
+	TrackLinkStageRequest struct {
+		ReferralCodeID string `json:"referral_code_id" validate:"required,uuid"`
+		Stage          string `json:"stage" validate:"required,oneof=click install"`
+		DeviceID       string `json:"device_id" validate:"max=255"`
+		IP             string `json:"-"`
+	}
+
+	// GetFunnelCohortsRequest struct
+	GetFunnelCohortsRequest struct {
+		ReferralCodeID string `json:"referral_code_id" validate:"omitempty,uuid"`
+		Since          string `json:"since"`
+	}
+
+	// GetTopReferrersRequest struct
+	GetTopReferrersRequest struct {
+		Since string `json:"since"`
+		utils.PaginationRequest
+	}
 )
 
 func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
@@ -71,9 +137,23 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 
 		GetReferralsWithPaginationByUserID: MakeGetReferralsWithPaginationByUserIDEndpoint(s, validateFunc),
 		StoreUserWithValidCode:             MakeStoreUserWithValidCodeEndpoint(s, validateFunc),
+
+		AddRewardProgram:        MakeAddRewardProgramEndpoint(s, validateFunc),
+		DeleteRewardProgramByID: MakeDeleteRewardProgramByIDEndpoint(s),
+		GetRewardProgramByID:    MakeGetRewardProgramByIDEndpoint(s),
+		GetRewardPrograms:       MakeGetRewardProgramsEndpoint(s, validateFunc),
+		UpdateRewardProgram:     MakeUpdateRewardProgramEndpoint(s, validateFunc),
+		GetEarnings:             MakeGetEarningsEndpoint(s),
+
+		TrackLinkStage:     MakeTrackLinkStageEndpoint(s, validateFunc),
+		GetFunnel:          MakeGetFunnelEndpoint(s),
+		GetFunnelCohorts:   MakeGetFunnelCohortsEndpoint(s, validateFunc),
+		GetTopReferrers:    MakeGetTopReferrersEndpoint(s, validateFunc),
+		ExportTopReferrers: MakeExportTopReferrersEndpoint(s),
 	}
 
 	// setup middlewares for each endpoints
+	// except TrackLinkStage, which is called before sign up
 	if len(m) > 0 {
 		for _, mdw := range m {
 			e.AddReferralCodeData = mdw(e.AddReferralCodeData)
@@ -84,6 +164,18 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 
 			e.GetReferralsWithPaginationByUserID = mdw(e.GetReferralsWithPaginationByUserID)
 			e.StoreUserWithValidCode = mdw(e.StoreUserWithValidCode)
+
+			e.AddRewardProgram = mdw(e.AddRewardProgram)
+			e.DeleteRewardProgramByID = mdw(e.DeleteRewardProgramByID)
+			e.GetRewardProgramByID = mdw(e.GetRewardProgramByID)
+			e.GetRewardPrograms = mdw(e.GetRewardPrograms)
+			e.UpdateRewardProgram = mdw(e.UpdateRewardProgram)
+			e.GetEarnings = mdw(e.GetEarnings)
+
+			e.GetFunnel = mdw(e.GetFunnel)
+			e.GetFunnelCohorts = mdw(e.GetFunnelCohorts)
+			e.GetTopReferrers = mdw(e.GetTopReferrers)
+			e.ExportTopReferrers = mdw(e.ExportTopReferrers)
 		}
 	}
 
@@ -281,3 +373,268 @@ func MakeGetReferralsWithPaginationByUserIDEndpoint(s service, v validator.Valid
 		return resp, nil
 	}
 }
+
+// MakeAddRewardProgramEndpoint ...
+func MakeAddRewardProgramEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(RewardProgramRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.AddRewardProgram(ctx, castToRewardProgramFromRequest(req))
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeUpdateRewardProgramEndpoint ...
+func MakeUpdateRewardProgramEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(RewardProgramRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := s.UpdateRewardProgram(ctx, castToRewardProgramFromRequest(req)); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeDeleteRewardProgramByIDEndpoint ...
+func MakeDeleteRewardProgramByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w reward program id: %v", ErrInvalidParameter, err)
+		}
+
+		if err := s.DeleteRewardProgramByID(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeGetRewardProgramByIDEndpoint ...
+func MakeGetRewardProgramByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w reward program id: %v", ErrInvalidParameter, err)
+		}
+
+		resp, err := s.GetRewardProgramByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetRewardProgramsEndpoint ...
+func MakeGetRewardProgramsEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(utils.PaginationRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := s.GetRewardPrograms(ctx, req.Limit(), req.Offset())
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetEarningsEndpoint ...
+func MakeGetEarningsEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		resp, err := s.GetEarnings(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+func castToRewardProgramFromRequest(req RewardProgramRequest) RewardProgram {
+	tiers := make([]RewardTier, 0, len(req.Tiers))
+	for _, t := range req.Tiers {
+		tiers = append(tiers, RewardTier{
+			MinReferrals:     t.MinReferrals,
+			KYCPassedReward:  t.KYCPassedReward,
+			FirstQuizReward:  t.FirstQuizReward,
+			FirstStakeReward: t.FirstStakeReward,
+		})
+	}
+
+	return RewardProgram{
+		ID:           req.ID,
+		Title:        req.Title,
+		WelcomeBonus: req.WelcomeBonus,
+		IsActive:     req.IsActive,
+		Tiers:        tiers,
+	}
+}
+
+// MakeTrackLinkStageEndpoint is available without authorization,
+// since referral link is clicked and app is installed before sign up.
+func MakeTrackLinkStageEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(TrackLinkStageRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(req.ReferralCodeID)
+		if err != nil {
+			return nil, fmt.Errorf("%w referral code id: %v", ErrInvalidParameter, err)
+		}
+
+		if err := s.TrackLinkStage(ctx, id, req.Stage, req.DeviceID, req.IP); err != nil {
+			return nil, err
+		}
+
+		return true, nil
+	}
+}
+
+// MakeGetFunnelEndpoint ...
+func MakeGetFunnelEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w referral code id: %v", ErrInvalidParameter, err)
+		}
+
+		resp, err := s.GetFunnel(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetFunnelCohortsEndpoint ...
+func MakeGetFunnelCohortsEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(GetFunnelCohortsRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		since, err := parseSince(req.Since, time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		var codeID uuid.NullUUID
+		if req.ReferralCodeID != "" {
+			codeID.UUID, codeID.Valid = uuid.MustParse(req.ReferralCodeID), true
+		}
+
+		resp, err := s.GetFunnelCohorts(ctx, codeID, since)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeGetTopReferrersEndpoint ...
+func MakeGetTopReferrersEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		req := request.(GetTopReferrersRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		since, err := parseSince(req.Since, time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.GetTopReferrers(ctx, since, req.Limit(), req.Offset())
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
+
+// MakeExportTopReferrersEndpoint ...
+func MakeExportTopReferrersEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		since, err := parseSince(request.(string), time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.ExportTopReferrers(ctx, since)
+		if err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+}
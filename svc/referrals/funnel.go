@@ -0,0 +1,191 @@
+package referrals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/referrals/repository"
+)
+
+// maxExportedReferrers limits number of rows in CSV export of top referrers
+const maxExportedReferrers = 1000
+
+type (
+	// FunnelStage is a number of unique devices or users reached the stage
+	FunnelStage struct {
+		Stage          string  `json:"stage"`
+		Count          int64   `json:"count"`
+		ConversionRate float64 `json:"conversion_rate"`       // percent of the previous stage
+		SignupRate     float64 `json:"signup_rate,omitempty"` // percent of signups, for stages after signup only
+	}
+
+	// Funnel of a single referral code
+	Funnel struct {
+		ReferralCodeID string        `json:"referral_code_id"`
+		Stages         []FunnelStage `json:"stages"`
+	}
+
+	// Cohort is a funnel of users who confirmed referral codes within the week
+	Cohort struct {
+		Week   time.Time     `json:"week"`
+		Stages []FunnelStage `json:"stages"`
+	}
+
+	// TopReferrer is a referral code funnel with suspicious patterns detected
+	TopReferrer struct {
+		ReferralCodeID   string        `json:"referral_code_id"`
+		Code             string        `json:"code"`
+		ReferrerID       string        `json:"referrer_id,omitempty"`
+		Stages           []FunnelStage `json:"stages"`
+		MaxHourlySignups int64         `json:"max_hourly_signups"`
+		Flags            []string      `json:"flags"`
+	}
+)
+
+// TrackLinkStage records referral link click or app install before the user signs up
+func (s *Service) TrackLinkStage(ctx context.Context, referralCodeID uuid.UUID, stage, deviceID, ip string) error {
+	if stage != StageClick && stage != StageInstall {
+		return fmt.Errorf("%w: unsupported funnel stage %s", ErrInvalidParameter, stage)
+	}
+
+	if _, err := s.rr.GetReferralCodeDataByID(ctx, referralCodeID); err != nil {
+		if db.IsNotFoundError(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("could not get referral code: %w", err)
+	}
+
+	if err := s.rr.AddReferralFunnelEvent(ctx, repository.AddReferralFunnelEventParams{
+		ReferralCodeID: uuid.NullUUID{UUID: referralCodeID, Valid: true},
+		DeviceID:       deviceID,
+		Ip:             ip,
+		Stage:          stage,
+	}); err != nil {
+		return fmt.Errorf("could not store funnel event: %w", err)
+	}
+
+	return nil
+}
+
+// trackUserStage records the stage once per user.
+// Stages are recorded for every user, since the referral code could be confirmed after the stage was reached,
+// they are attributed to referral codes on read.
+func (s *Service) trackUserStage(ctx context.Context, userID uuid.UUID, stage string) error {
+	if err := s.rr.AddReferralFunnelEvent(ctx, repository.AddReferralFunnelEventParams{
+		UserID: uuid.NullUUID{UUID: userID, Valid: true},
+		Stage:  stage,
+	}); err != nil {
+		return fmt.Errorf("could not store funnel event: %w", err)
+	}
+
+	return nil
+}
+
+// GetFunnel returns attribution funnel of the referral code
+func (s *Service) GetFunnel(ctx context.Context, referralCodeID uuid.UUID) (Funnel, error) {
+	if _, err := s.rr.GetReferralCodeDataByID(ctx, referralCodeID); err != nil {
+		if db.IsNotFoundError(err) {
+			return Funnel{}, ErrNotFound
+		}
+		return Funnel{}, fmt.Errorf("could not get referral code: %w", err)
+	}
+
+	f, err := s.rr.GetReferralCodeFunnel(ctx, referralCodeID)
+	if err != nil {
+		return Funnel{}, fmt.Errorf("could not get referral code funnel: %w", err)
+	}
+
+	return Funnel{
+		ReferralCodeID: referralCodeID.String(),
+		Stages: funnelCounts{
+			clicks:     f.Clicks,
+			installs:   f.Installs,
+			signups:    f.Signups,
+			verified:   f.Verified,
+			kycPassed:  f.KycPassed,
+			firstQuiz:  f.FirstQuiz,
+			firstClaim: f.FirstClaim,
+		}.stages(false),
+	}, nil
+}
+
+// GetFunnelCohorts returns weekly cohorts of referees since the given time.
+// Referral code id is optional, cohorts of all codes are returned if it's not set.
+func (s *Service) GetFunnelCohorts(ctx context.Context, referralCodeID uuid.NullUUID, since time.Time) ([]Cohort, error) {
+	rows, err := s.rr.GetReferralFunnelCohorts(ctx, repository.GetReferralFunnelCohortsParams{
+		ReferralCodeID: referralCodeID,
+		Since:          since,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get referral funnel cohorts: %w", err)
+	}
+
+	result := make([]Cohort, 0, len(rows))
+	for _, c := range rows {
+		result = append(result, Cohort{
+			Week: c.CohortWeek,
+			Stages: funnelCounts{
+				signups:    c.Signups,
+				verified:   c.Verified,
+				kycPassed:  c.KycPassed,
+				firstQuiz:  c.FirstQuiz,
+				firstClaim: c.FirstClaim,
+			}.stages(true),
+		})
+	}
+
+	return result, nil
+}
+
+// GetTopReferrers returns referral codes with the most signups since the given time
+func (s *Service) GetTopReferrers(ctx context.Context, since time.Time, limit, offset int32) ([]TopReferrer, error) {
+	rows, err := s.rr.GetTopReferrers(ctx, repository.GetTopReferrersParams{
+		Since:     since,
+		LimitVal:  limit,
+		OffsetVal: offset,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get top referrers: %w", err)
+	}
+
+	result := make([]TopReferrer, 0, len(rows))
+	for _, r := range rows {
+		counts := funnelCounts{
+			clicks:     r.Clicks,
+			installs:   r.Installs,
+			signups:    r.Signups,
+			verified:   r.Verified,
+			kycPassed:  r.KycPassed,
+			firstQuiz:  r.FirstQuiz,
+			firstClaim: r.FirstClaim,
+		}
+
+		item := TopReferrer{
+			ReferralCodeID:   r.ReferralCodeID.String(),
+			Code:             r.Code,
+			Stages:           counts.stages(false),
+			MaxHourlySignups: r.MaxHourlySignups,
+			Flags:            suspiciousFlags(counts, r.InstallIps, r.MaxHourlySignups),
+		}
+		if r.ReferrerID.Valid {
+			item.ReferrerID = r.ReferrerID.UUID.String()
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// ExportTopReferrers returns top referrers since the given time as CSV records
+func (s *Service) ExportTopReferrers(ctx context.Context, since time.Time) ([][]string, error) {
+	items, err := s.GetTopReferrers(ctx, since, maxExportedReferrers, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return topReferrersCSV(items), nil
+}
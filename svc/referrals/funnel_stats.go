@@ -0,0 +1,175 @@
+package referrals
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/campaigns"
+)
+
+// Referral attribution funnel stages
+const (
+	StageClick        = "click"        // referral link was opened, recorded per device
+	StageInstall      = "install"      // app was opened for the first time from referral link, recorded per device
+	StageSignup       = "signup"       // user confirmed the referral code
+	StageVerification = "verification" // user verified email address
+	StageKYC          = "kyc"          // user passed identity verification
+	StageFirstQuiz    = "first_quiz"   // user played the first quiz
+	StageFirstClaim   = "first_claim"  // user claimed rewards for the first time
+)
+
+// Suspicious referral patterns
+const (
+	FlagSignupBurst     = "signup_burst"     // too many signups within an hour
+	FlagLowVerification = "low_verification" // most referees never verified email address
+	FlagNoActivity      = "no_activity"      // referees neither passed KYC nor played a quiz
+	FlagSharedIP        = "shared_ip"        // installs come from a few IP addresses
+)
+
+// Thresholds of suspicious referral patterns
+const (
+	suspiciousMinSample        = 10 // patterns are not evaluated on a smaller number of referees or installs
+	suspiciousHourlySignups    = 10
+	suspiciousVerificationRate = 20 // percent
+	suspiciousDistinctIPsRate  = 20 // percent
+)
+
+// defaultFunnelPeriod is used if analytics period start is not set
+const defaultFunnelPeriod = 90 * 24 * time.Hour
+
+// eventStages maps user's activity events to funnel stages
+var eventStages = map[string]string{
+	campaigns.EventAccountVerified: StageVerification,
+	campaigns.EventKYCPassed:       StageKYC,
+	campaigns.EventQuizPlayed:      StageFirstQuiz,
+	campaigns.EventRewardsClaimed:  StageFirstClaim,
+}
+
+// funnelCounts is a number of unique devices or users reached each funnel stage
+type funnelCounts struct {
+	clicks     int64
+	installs   int64
+	signups    int64
+	verified   int64
+	kycPassed  int64
+	firstQuiz  int64
+	firstClaim int64
+}
+
+// stages returns funnel stages with conversion rates.
+// Cohorts start from signup, since link clicks and installs are not bound to users.
+func (c funnelCounts) stages(fromSignup bool) []FunnelStage {
+	counts := []struct {
+		stage string
+		count int64
+	}{
+		{StageClick, c.clicks},
+		{StageInstall, c.installs},
+		{StageSignup, c.signups},
+		{StageVerification, c.verified},
+		{StageKYC, c.kycPassed},
+		{StageFirstQuiz, c.firstQuiz},
+		{StageFirstClaim, c.firstClaim},
+	}
+	if fromSignup {
+		counts = counts[2:]
+	}
+
+	result := make([]FunnelStage, 0, len(counts))
+	for i, sc := range counts {
+		st := FunnelStage{Stage: sc.stage, Count: sc.count}
+		if i > 0 {
+			st.ConversionRate = percentOf(sc.count, counts[i-1].count)
+		}
+		if sc.stage != StageClick && sc.stage != StageInstall && sc.stage != StageSignup {
+			st.SignupRate = percentOf(sc.count, c.signups)
+		}
+		result = append(result, st)
+	}
+
+	return result
+}
+
+// suspiciousFlags returns patterns which look like referral abuse
+func suspiciousFlags(c funnelCounts, installIPs, maxHourlySignups int64) []string {
+	flags := make([]string, 0)
+
+	if maxHourlySignups >= suspiciousHourlySignups {
+		flags = append(flags, FlagSignupBurst)
+	}
+	if c.signups >= suspiciousMinSample {
+		if percentOf(c.verified, c.signups) < suspiciousVerificationRate {
+			flags = append(flags, FlagLowVerification)
+		}
+		if c.kycPassed == 0 && c.firstQuiz == 0 {
+			flags = append(flags, FlagNoActivity)
+		}
+	}
+	if c.installs >= suspiciousMinSample && percentOf(installIPs, c.installs) <= suspiciousDistinctIPsRate {
+		flags = append(flags, FlagSharedIP)
+	}
+
+	return flags
+}
+
+// topReferrersCSV returns top referrers as CSV records with header
+func topReferrersCSV(items []TopReferrer) [][]string {
+	header := []string{"referral_code_id", "code", "referrer_id"}
+	if len(items) > 0 {
+		for _, st := range items[0].Stages {
+			header = append(header, st.Stage)
+		}
+	}
+	header = append(header, "kyc_rate", "first_claim_rate", "max_hourly_signups", "flags")
+
+	records := make([][]string, 0, len(items)+1)
+	records = append(records, header)
+	for _, item := range items {
+		record := []string{item.ReferralCodeID, item.Code, item.ReferrerID}
+		var kycRate, claimRate float64
+		for _, st := range item.Stages {
+			record = append(record, strconv.FormatInt(st.Count, 10))
+			switch st.Stage {
+			case StageKYC:
+				kycRate = st.SignupRate
+			case StageFirstClaim:
+				claimRate = st.SignupRate
+			}
+		}
+		record = append(record,
+			strconv.FormatFloat(kycRate, 'f', 2, 64),
+			strconv.FormatFloat(claimRate, 'f', 2, 64),
+			strconv.FormatInt(item.MaxHourlySignups, 10),
+			strings.Join(item.Flags, ";"),
+		)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// percentOf returns part of total in percents rounded to hundredths, 0 if total is 0
+func percentOf(part, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+
+	return math.Round(float64(part)/float64(total)*10000) / 100
+}
+
+// parseSince parses analytics period start in YYYY-MM-DD format, the last 90 days by default
+func parseSince(since string, now time.Time) (time.Time, error) {
+	if since == "" {
+		return now.Add(-defaultFunnelPeriod), nil
+	}
+
+	t, err := time.Parse("2006-01-02", since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w since: %v", ErrInvalidParameter, err)
+	}
+
+	return t, nil
+}
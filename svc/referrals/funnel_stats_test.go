@@ -0,0 +1,135 @@
+package referrals
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFunnelCountsStages(t *testing.T) {
+	c := funnelCounts{
+		clicks:     200,
+		installs:   100,
+		signups:    50,
+		verified:   40,
+		kycPassed:  10,
+		firstQuiz:  20,
+		firstClaim: 5,
+	}
+
+	stages := c.stages(false)
+	if len(stages) != 7 {
+		t.Fatalf("stages(false) length = %d, want 7", len(stages))
+	}
+	if stages[0].ConversionRate != 0 {
+		t.Errorf("click conversion rate = %v, want 0", stages[0].ConversionRate)
+	}
+	if stages[1].ConversionRate != 50 {
+		t.Errorf("install conversion rate = %v, want 50", stages[1].ConversionRate)
+	}
+	if stages[3].ConversionRate != 80 || stages[3].SignupRate != 80 {
+		t.Errorf("verification rates = %v/%v, want 80/80", stages[3].ConversionRate, stages[3].SignupRate)
+	}
+	if stages[5].ConversionRate != 200 || stages[5].SignupRate != 40 {
+		t.Errorf("first quiz rates = %v/%v, want 200/40", stages[5].ConversionRate, stages[5].SignupRate)
+	}
+
+	cohort := c.stages(true)
+	if len(cohort) != 5 || cohort[0].Stage != StageSignup {
+		t.Fatalf("stages(true) = %+v, want 5 stages starting from signup", cohort)
+	}
+	if cohort[0].ConversionRate != 0 {
+		t.Errorf("signup conversion rate = %v, want 0", cohort[0].ConversionRate)
+	}
+}
+
+func TestSuspiciousFlags(t *testing.T) {
+	tests := []struct {
+		name             string
+		counts           funnelCounts
+		installIPs       int64
+		maxHourlySignups int64
+		want             []string
+	}{
+		{
+			name:   "small sample",
+			counts: funnelCounts{installs: 5, signups: 5},
+			want:   []string{},
+		},
+		{
+			name:       "healthy",
+			counts:     funnelCounts{installs: 20, signups: 20, verified: 15, kycPassed: 5, firstQuiz: 10},
+			installIPs: 18,
+			want:       []string{},
+		},
+		{
+			name:             "signup burst",
+			counts:           funnelCounts{signups: 12, verified: 12, firstQuiz: 3},
+			maxHourlySignups: 12,
+			want:             []string{FlagSignupBurst},
+		},
+		{
+			name:       "farmed referees",
+			counts:     funnelCounts{installs: 30, signups: 30, verified: 3},
+			installIPs: 2,
+			want:       []string{FlagLowVerification, FlagNoActivity, FlagSharedIP},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := suspiciousFlags(tt.counts, tt.installIPs, tt.maxHourlySignups); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("suspiciousFlags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopReferrersCSV(t *testing.T) {
+	items := []TopReferrer{
+		{
+			ReferralCodeID:   "id",
+			Code:             "CODE",
+			ReferrerID:       "user",
+			Stages:           funnelCounts{clicks: 4, installs: 2, signups: 2, kycPassed: 1}.stages(false),
+			MaxHourlySignups: 1,
+			Flags:            []string{FlagSignupBurst, FlagSharedIP},
+		},
+	}
+
+	want := [][]string{
+		{"referral_code_id", "code", "referrer_id", "click", "install", "signup", "verification", "kyc", "first_quiz", "first_claim", "kyc_rate", "first_claim_rate", "max_hourly_signups", "flags"},
+		{"id", "CODE", "user", "4", "2", "2", "0", "1", "0", "0", "50.00", "0.00", "1", "signup_burst;shared_ip"},
+	}
+	if got := topReferrersCSV(items); !reflect.DeepEqual(got, want) {
+		t.Errorf("topReferrersCSV() = %v, want %v", got, want)
+	}
+}
+
+func TestPercentOf(t *testing.T) {
+	if got := percentOf(1, 3); got != 33.33 {
+		t.Errorf("percentOf(1, 3) = %v, want 33.33", got)
+	}
+	if got := percentOf(1, 0); got != 0 {
+		t.Errorf("percentOf(1, 0) = %v, want 0", got)
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2022, 10, 31, 12, 0, 0, 0, time.UTC)
+
+	got, err := parseSince("", now)
+	if err != nil || !got.Equal(now.Add(-defaultFunnelPeriod)) {
+		t.Errorf("parseSince(\"\") = %v, %v, want %v", got, err, now.Add(-defaultFunnelPeriod))
+	}
+
+	got, err = parseSince("2022-10-01", now)
+	if err != nil || !got.Equal(time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("parseSince(\"2022-10-01\") = %v, %v", got, err)
+	}
+
+	if _, err := parseSince("01.10.2022", now); !errors.Is(err, ErrInvalidParameter) {
+		t.Errorf("parseSince(\"01.10.2022\") error = %v, want %v", err, ErrInvalidParameter)
+	}
+}
@@ -28,9 +28,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addReferralCodeDataStmt, err = db.PrepareContext(ctx, addReferralCodeData); err != nil {
 		return nil, fmt.Errorf("error preparing query AddReferralCodeData: %w", err)
 	}
+	if q.addReferralFunnelEventStmt, err = db.PrepareContext(ctx, addReferralFunnelEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query AddReferralFunnelEvent: %w", err)
+	}
+	if q.addReferralRewardStmt, err = db.PrepareContext(ctx, addReferralReward); err != nil {
+		return nil, fmt.Errorf("error preparing query AddReferralReward: %w", err)
+	}
+	if q.addReferralRewardProgramStmt, err = db.PrepareContext(ctx, addReferralRewardProgram); err != nil {
+		return nil, fmt.Errorf("error preparing query AddReferralRewardProgram: %w", err)
+	}
+	if q.addReferralRewardTierStmt, err = db.PrepareContext(ctx, addReferralRewardTier); err != nil {
+		return nil, fmt.Errorf("error preparing query AddReferralRewardTier: %w", err)
+	}
+	if q.countReferralsByReferrerIDStmt, err = db.PrepareContext(ctx, countReferralsByReferrerID); err != nil {
+		return nil, fmt.Errorf("error preparing query CountReferralsByReferrerID: %w", err)
+	}
 	if q.deleteReferralCodeDataByIDStmt, err = db.PrepareContext(ctx, deleteReferralCodeDataByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteReferralCodeDataByID: %w", err)
 	}
+	if q.deleteReferralRewardByIDStmt, err = db.PrepareContext(ctx, deleteReferralRewardByID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteReferralRewardByID: %w", err)
+	}
+	if q.deleteReferralRewardProgramByIDStmt, err = db.PrepareContext(ctx, deleteReferralRewardProgramByID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteReferralRewardProgramByID: %w", err)
+	}
+	if q.deleteReferralRewardTiersByProgramIDStmt, err = db.PrepareContext(ctx, deleteReferralRewardTiersByProgramID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteReferralRewardTiersByProgramID: %w", err)
+	}
+	if q.doesReferralRewardExistStmt, err = db.PrepareContext(ctx, doesReferralRewardExist); err != nil {
+		return nil, fmt.Errorf("error preparing query DoesReferralRewardExist: %w", err)
+	}
+	if q.getActiveReferralRewardProgramStmt, err = db.PrepareContext(ctx, getActiveReferralRewardProgram); err != nil {
+		return nil, fmt.Errorf("error preparing query GetActiveReferralRewardProgram: %w", err)
+	}
 	if q.getNumberOfReferralCodesStmt, err = db.PrepareContext(ctx, getNumberOfReferralCodes); err != nil {
 		return nil, fmt.Errorf("error preparing query GetNumberOfReferralCodes: %w", err)
 	}
@@ -40,18 +70,54 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getReferralCodeDataByCodeStmt, err = db.PrepareContext(ctx, getReferralCodeDataByCode); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReferralCodeDataByCode: %w", err)
 	}
+	if q.getReferralCodeDataByIDStmt, err = db.PrepareContext(ctx, getReferralCodeDataByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReferralCodeDataByID: %w", err)
+	}
 	if q.getReferralCodeDataByUserIDStmt, err = db.PrepareContext(ctx, getReferralCodeDataByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReferralCodeDataByUserID: %w", err)
 	}
+	if q.getReferralCodeFunnelStmt, err = db.PrepareContext(ctx, getReferralCodeFunnel); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReferralCodeFunnel: %w", err)
+	}
 	if q.getReferralCodesDataListStmt, err = db.PrepareContext(ctx, getReferralCodesDataList); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReferralCodesDataList: %w", err)
 	}
+	if q.getReferralFunnelCohortsStmt, err = db.PrepareContext(ctx, getReferralFunnelCohorts); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReferralFunnelCohorts: %w", err)
+	}
+	if q.getReferralRewardProgramByIDStmt, err = db.PrepareContext(ctx, getReferralRewardProgramByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReferralRewardProgramByID: %w", err)
+	}
+	if q.getReferralRewardProgramsPaginatedStmt, err = db.PrepareContext(ctx, getReferralRewardProgramsPaginated); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReferralRewardProgramsPaginated: %w", err)
+	}
+	if q.getReferralRewardTiersByProgramIDStmt, err = db.PrepareContext(ctx, getReferralRewardTiersByProgramID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReferralRewardTiersByProgramID: %w", err)
+	}
+	if q.getReferralRewardsByUserIDStmt, err = db.PrepareContext(ctx, getReferralRewardsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReferralRewardsByUserID: %w", err)
+	}
+	if q.getReferralRewardsSummaryByUserIDStmt, err = db.PrepareContext(ctx, getReferralRewardsSummaryByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReferralRewardsSummaryByUserID: %w", err)
+	}
+	if q.getReferralsByUserIDStmt, err = db.PrepareContext(ctx, getReferralsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReferralsByUserID: %w", err)
+	}
 	if q.getReferralsWithPaginationByUserIDStmt, err = db.PrepareContext(ctx, getReferralsWithPaginationByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReferralsWithPaginationByUserID: %w", err)
 	}
+	if q.getReferrerByRefereeIDStmt, err = db.PrepareContext(ctx, getReferrerByRefereeID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReferrerByRefereeID: %w", err)
+	}
+	if q.getTopReferrersStmt, err = db.PrepareContext(ctx, getTopReferrers); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTopReferrers: %w", err)
+	}
 	if q.updateReferralCodeDataStmt, err = db.PrepareContext(ctx, updateReferralCodeData); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateReferralCodeData: %w", err)
 	}
+	if q.updateReferralRewardProgramStmt, err = db.PrepareContext(ctx, updateReferralRewardProgram); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateReferralRewardProgram: %w", err)
+	}
 	return &q, nil
 }
 
@@ -67,11 +133,61 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addReferralCodeDataStmt: %w", cerr)
 		}
 	}
+	if q.addReferralFunnelEventStmt != nil {
+		if cerr := q.addReferralFunnelEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addReferralFunnelEventStmt: %w", cerr)
+		}
+	}
+	if q.addReferralRewardStmt != nil {
+		if cerr := q.addReferralRewardStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addReferralRewardStmt: %w", cerr)
+		}
+	}
+	if q.addReferralRewardProgramStmt != nil {
+		if cerr := q.addReferralRewardProgramStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addReferralRewardProgramStmt: %w", cerr)
+		}
+	}
+	if q.addReferralRewardTierStmt != nil {
+		if cerr := q.addReferralRewardTierStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addReferralRewardTierStmt: %w", cerr)
+		}
+	}
+	if q.countReferralsByReferrerIDStmt != nil {
+		if cerr := q.countReferralsByReferrerIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countReferralsByReferrerIDStmt: %w", cerr)
+		}
+	}
 	if q.deleteReferralCodeDataByIDStmt != nil {
 		if cerr := q.deleteReferralCodeDataByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteReferralCodeDataByIDStmt: %w", cerr)
 		}
 	}
+	if q.deleteReferralRewardByIDStmt != nil {
+		if cerr := q.deleteReferralRewardByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteReferralRewardByIDStmt: %w", cerr)
+		}
+	}
+	if q.deleteReferralRewardProgramByIDStmt != nil {
+		if cerr := q.deleteReferralRewardProgramByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteReferralRewardProgramByIDStmt: %w", cerr)
+		}
+	}
+	if q.deleteReferralRewardTiersByProgramIDStmt != nil {
+		if cerr := q.deleteReferralRewardTiersByProgramIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteReferralRewardTiersByProgramIDStmt: %w", cerr)
+		}
+	}
+	if q.doesReferralRewardExistStmt != nil {
+		if cerr := q.doesReferralRewardExistStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing doesReferralRewardExistStmt: %w", cerr)
+		}
+	}
+	if q.getActiveReferralRewardProgramStmt != nil {
+		if cerr := q.getActiveReferralRewardProgramStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getActiveReferralRewardProgramStmt: %w", cerr)
+		}
+	}
 	if q.getNumberOfReferralCodesStmt != nil {
 		if cerr := q.getNumberOfReferralCodesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getNumberOfReferralCodesStmt: %w", cerr)
@@ -87,26 +203,86 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getReferralCodeDataByCodeStmt: %w", cerr)
 		}
 	}
+	if q.getReferralCodeDataByIDStmt != nil {
+		if cerr := q.getReferralCodeDataByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReferralCodeDataByIDStmt: %w", cerr)
+		}
+	}
 	if q.getReferralCodeDataByUserIDStmt != nil {
 		if cerr := q.getReferralCodeDataByUserIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getReferralCodeDataByUserIDStmt: %w", cerr)
 		}
 	}
+	if q.getReferralCodeFunnelStmt != nil {
+		if cerr := q.getReferralCodeFunnelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReferralCodeFunnelStmt: %w", cerr)
+		}
+	}
 	if q.getReferralCodesDataListStmt != nil {
 		if cerr := q.getReferralCodesDataListStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getReferralCodesDataListStmt: %w", cerr)
 		}
 	}
+	if q.getReferralFunnelCohortsStmt != nil {
+		if cerr := q.getReferralFunnelCohortsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReferralFunnelCohortsStmt: %w", cerr)
+		}
+	}
+	if q.getReferralRewardProgramByIDStmt != nil {
+		if cerr := q.getReferralRewardProgramByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReferralRewardProgramByIDStmt: %w", cerr)
+		}
+	}
+	if q.getReferralRewardProgramsPaginatedStmt != nil {
+		if cerr := q.getReferralRewardProgramsPaginatedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReferralRewardProgramsPaginatedStmt: %w", cerr)
+		}
+	}
+	if q.getReferralRewardTiersByProgramIDStmt != nil {
+		if cerr := q.getReferralRewardTiersByProgramIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReferralRewardTiersByProgramIDStmt: %w", cerr)
+		}
+	}
+	if q.getReferralRewardsByUserIDStmt != nil {
+		if cerr := q.getReferralRewardsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReferralRewardsByUserIDStmt: %w", cerr)
+		}
+	}
+	if q.getReferralRewardsSummaryByUserIDStmt != nil {
+		if cerr := q.getReferralRewardsSummaryByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReferralRewardsSummaryByUserIDStmt: %w", cerr)
+		}
+	}
+	if q.getReferralsByUserIDStmt != nil {
+		if cerr := q.getReferralsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReferralsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getReferralsWithPaginationByUserIDStmt != nil {
 		if cerr := q.getReferralsWithPaginationByUserIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getReferralsWithPaginationByUserIDStmt: %w", cerr)
 		}
 	}
+	if q.getReferrerByRefereeIDStmt != nil {
+		if cerr := q.getReferrerByRefereeIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReferrerByRefereeIDStmt: %w", cerr)
+		}
+	}
+	if q.getTopReferrersStmt != nil {
+		if cerr := q.getTopReferrersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTopReferrersStmt: %w", cerr)
+		}
+	}
 	if q.updateReferralCodeDataStmt != nil {
 		if cerr := q.updateReferralCodeDataStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateReferralCodeDataStmt: %w", cerr)
 		}
 	}
+	if q.updateReferralRewardProgramStmt != nil {
+		if cerr := q.updateReferralRewardProgramStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateReferralRewardProgramStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -144,33 +320,77 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                                     DBTX
-	tx                                     *sql.Tx
-	addReferralStmt                        *sql.Stmt
-	addReferralCodeDataStmt                *sql.Stmt
-	deleteReferralCodeDataByIDStmt         *sql.Stmt
-	getNumberOfReferralCodesStmt           *sql.Stmt
-	getReferralCodeByIDStmt                *sql.Stmt
-	getReferralCodeDataByCodeStmt          *sql.Stmt
-	getReferralCodeDataByUserIDStmt        *sql.Stmt
-	getReferralCodesDataListStmt           *sql.Stmt
-	getReferralsWithPaginationByUserIDStmt *sql.Stmt
-	updateReferralCodeDataStmt             *sql.Stmt
+	db                                       DBTX
+	tx                                       *sql.Tx
+	addReferralStmt                          *sql.Stmt
+	addReferralCodeDataStmt                  *sql.Stmt
+	addReferralFunnelEventStmt               *sql.Stmt
+	addReferralRewardStmt                    *sql.Stmt
+	addReferralRewardProgramStmt             *sql.Stmt
+	addReferralRewardTierStmt                *sql.Stmt
+	countReferralsByReferrerIDStmt           *sql.Stmt
+	deleteReferralCodeDataByIDStmt           *sql.Stmt
+	deleteReferralRewardByIDStmt             *sql.Stmt
+	deleteReferralRewardProgramByIDStmt      *sql.Stmt
+	deleteReferralRewardTiersByProgramIDStmt *sql.Stmt
+	doesReferralRewardExistStmt              *sql.Stmt
+	getActiveReferralRewardProgramStmt       *sql.Stmt
+	getNumberOfReferralCodesStmt             *sql.Stmt
+	getReferralCodeByIDStmt                  *sql.Stmt
+	getReferralCodeDataByCodeStmt            *sql.Stmt
+	getReferralCodeDataByIDStmt              *sql.Stmt
+	getReferralCodeDataByUserIDStmt          *sql.Stmt
+	getReferralCodeFunnelStmt                *sql.Stmt
+	getReferralCodesDataListStmt             *sql.Stmt
+	getReferralFunnelCohortsStmt             *sql.Stmt
+	getReferralRewardProgramByIDStmt         *sql.Stmt
+	getReferralRewardProgramsPaginatedStmt   *sql.Stmt
+	getReferralRewardTiersByProgramIDStmt    *sql.Stmt
+	getReferralRewardsByUserIDStmt           *sql.Stmt
+	getReferralRewardsSummaryByUserIDStmt    *sql.Stmt
+	getReferralsByUserIDStmt                 *sql.Stmt
+	getReferralsWithPaginationByUserIDStmt   *sql.Stmt
+	getReferrerByRefereeIDStmt               *sql.Stmt
+	getTopReferrersStmt                      *sql.Stmt
+	updateReferralCodeDataStmt               *sql.Stmt
+	updateReferralRewardProgramStmt          *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                                     tx,
-		tx:                                     tx,
-		addReferralStmt:                        q.addReferralStmt,
-		addReferralCodeDataStmt:                q.addReferralCodeDataStmt,
-		deleteReferralCodeDataByIDStmt:         q.deleteReferralCodeDataByIDStmt,
-		getNumberOfReferralCodesStmt:           q.getNumberOfReferralCodesStmt,
-		getReferralCodeByIDStmt:                q.getReferralCodeByIDStmt,
-		getReferralCodeDataByCodeStmt:          q.getReferralCodeDataByCodeStmt,
-		getReferralCodeDataByUserIDStmt:        q.getReferralCodeDataByUserIDStmt,
-		getReferralCodesDataListStmt:           q.getReferralCodesDataListStmt,
-		getReferralsWithPaginationByUserIDStmt: q.getReferralsWithPaginationByUserIDStmt,
-		updateReferralCodeDataStmt:             q.updateReferralCodeDataStmt,
+		db:                                       tx,
+		tx:                                       tx,
+		addReferralStmt:                          q.addReferralStmt,
+		addReferralCodeDataStmt:                  q.addReferralCodeDataStmt,
+		addReferralFunnelEventStmt:               q.addReferralFunnelEventStmt,
+		addReferralRewardStmt:                    q.addReferralRewardStmt,
+		addReferralRewardProgramStmt:             q.addReferralRewardProgramStmt,
+		addReferralRewardTierStmt:                q.addReferralRewardTierStmt,
+		countReferralsByReferrerIDStmt:           q.countReferralsByReferrerIDStmt,
+		deleteReferralCodeDataByIDStmt:           q.deleteReferralCodeDataByIDStmt,
+		deleteReferralRewardByIDStmt:             q.deleteReferralRewardByIDStmt,
+		deleteReferralRewardProgramByIDStmt:      q.deleteReferralRewardProgramByIDStmt,
+		deleteReferralRewardTiersByProgramIDStmt: q.deleteReferralRewardTiersByProgramIDStmt,
+		doesReferralRewardExistStmt:              q.doesReferralRewardExistStmt,
+		getActiveReferralRewardProgramStmt:       q.getActiveReferralRewardProgramStmt,
+		getNumberOfReferralCodesStmt:             q.getNumberOfReferralCodesStmt,
+		getReferralCodeByIDStmt:                  q.getReferralCodeByIDStmt,
+		getReferralCodeDataByCodeStmt:            q.getReferralCodeDataByCodeStmt,
+		getReferralCodeDataByIDStmt:              q.getReferralCodeDataByIDStmt,
+		getReferralCodeDataByUserIDStmt:          q.getReferralCodeDataByUserIDStmt,
+		getReferralCodeFunnelStmt:                q.getReferralCodeFunnelStmt,
+		getReferralCodesDataListStmt:             q.getReferralCodesDataListStmt,
+		getReferralFunnelCohortsStmt:             q.getReferralFunnelCohortsStmt,
+		getReferralRewardProgramByIDStmt:         q.getReferralRewardProgramByIDStmt,
+		getReferralRewardProgramsPaginatedStmt:   q.getReferralRewardProgramsPaginatedStmt,
+		getReferralRewardTiersByProgramIDStmt:    q.getReferralRewardTiersByProgramIDStmt,
+		getReferralRewardsByUserIDStmt:           q.getReferralRewardsByUserIDStmt,
+		getReferralRewardsSummaryByUserIDStmt:    q.getReferralRewardsSummaryByUserIDStmt,
+		getReferralsByUserIDStmt:                 q.getReferralsByUserIDStmt,
+		getReferralsWithPaginationByUserIDStmt:   q.getReferralsWithPaginationByUserIDStmt,
+		getReferrerByRefereeIDStmt:               q.getReferrerByRefereeIDStmt,
+		getTopReferrersStmt:                      q.getTopReferrersStmt,
+		updateReferralCodeDataStmt:               q.updateReferralCodeDataStmt,
+		updateReferralRewardProgramStmt:          q.updateReferralRewardProgramStmt,
 	}
 }
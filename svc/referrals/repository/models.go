@@ -24,3 +24,40 @@ type ReferralCode struct {
 	UserID       uuid.NullUUID  `json:"user_id"`
 	CreatedAt    time.Time      `json:"created_at"`
 }
+
+type ReferralFunnelEvent struct {
+	ID             uuid.UUID     `json:"id"`
+	ReferralCodeID uuid.NullUUID `json:"referral_code_id"`
+	UserID         uuid.NullUUID `json:"user_id"`
+	DeviceID       string        `json:"device_id"`
+	Ip             string        `json:"ip"`
+	Stage          string        `json:"stage"`
+	CreatedAt      time.Time     `json:"created_at"`
+}
+
+type ReferralReward struct {
+	ID        uuid.UUID `json:"id"`
+	ProgramID uuid.UUID `json:"program_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	RefereeID uuid.UUID `json:"referee_id"`
+	Milestone string    `json:"milestone"`
+	Amount    float64   `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ReferralRewardProgram struct {
+	ID           uuid.UUID    `json:"id"`
+	Title        string       `json:"title"`
+	WelcomeBonus float64      `json:"welcome_bonus"`
+	IsActive     bool         `json:"is_active"`
+	UpdatedAt    sql.NullTime `json:"updated_at"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+type ReferralRewardTier struct {
+	ProgramID        uuid.UUID `json:"program_id"`
+	MinReferrals     int32     `json:"min_referrals"`
+	KycPassedReward  float64   `json:"kyc_passed_reward"`
+	FirstQuizReward  float64   `json:"first_quiz_reward"`
+	FirstStakeReward float64   `json:"first_stake_reward"`
+}
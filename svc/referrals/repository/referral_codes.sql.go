@@ -104,6 +104,27 @@ func (q *Queries) GetReferralCodeDataByCode(ctx context.Context, code string) (R
 	return i, err
 }
 
+const getReferralCodeDataByID = `-- name: GetReferralCodeDataByID :one
+SELECT id, title, code, referral_link, is_personal, user_id, created_at
+FROM referral_codes
+WHERE id = $1
+`
+
+func (q *Queries) GetReferralCodeDataByID(ctx context.Context, id uuid.UUID) (ReferralCode, error) {
+	row := q.queryRow(ctx, q.getReferralCodeDataByIDStmt, getReferralCodeDataByID, id)
+	var i ReferralCode
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Code,
+		&i.ReferralLink,
+		&i.IsPersonal,
+		&i.UserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getReferralCodeDataByUserID = `-- name: GetReferralCodeDataByUserID :one
 SELECT id, title, code, referral_link, is_personal, user_id, created_at
 FROM referral_codes
@@ -5,6 +5,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -30,6 +31,26 @@ func (q *Queries) AddReferral(ctx context.Context, arg AddReferralParams) error
 	return err
 }
 
+const countReferralsByReferrerID = `-- name: CountReferralsByReferrerID :one
+SELECT COUNT(DISTINCT referrals.user_id)
+FROM referrals
+JOIN referral_codes ON referral_codes.id = referrals.referral_code_id
+WHERE referral_codes.user_id = $1
+    AND referrals.created_at <= $2::TIMESTAMP
+`
+
+type CountReferralsByReferrerIDParams struct {
+	ReferrerID    uuid.NullUUID `json:"referrer_id"`
+	CreatedBefore time.Time     `json:"created_before"`
+}
+
+func (q *Queries) CountReferralsByReferrerID(ctx context.Context, arg CountReferralsByReferrerIDParams) (int64, error) {
+	row := q.queryRow(ctx, q.countReferralsByReferrerIDStmt, countReferralsByReferrerID, arg.ReferrerID, arg.CreatedBefore)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getReferralCodeByID = `-- name: GetReferralCodeByID :one
 SELECT referral_code_id
 FROM referrals
@@ -43,6 +64,40 @@ func (q *Queries) GetReferralCodeByID(ctx context.Context, userID uuid.UUID) (uu
 	return referral_code_id, err
 }
 
+const getReferralsByUserID = `-- name: GetReferralsByUserID :many
+SELECT referral_code_id, user_id, created_at
+FROM referrals
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetReferralsByUserID(ctx context.Context, userID uuid.UUID) ([]Referral, error) {
+	rows, err := q.query(ctx, q.getReferralsByUserIDStmt, getReferralsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Referral
+	for rows.Next() {
+		var i Referral
+		if err := rows.Scan(
+			&i.ReferralCodeID,
+			&i.UserID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getReferralsWithPaginationByUserID = `-- name: GetReferralsWithPaginationByUserID :many
 SELECT referral_code_id, user_id, created_at
 FROM referrals
@@ -79,3 +134,26 @@ func (q *Queries) GetReferralsWithPaginationByUserID(ctx context.Context, arg Ge
 	}
 	return items, nil
 }
+
+const getReferrerByRefereeID = `-- name: GetReferrerByRefereeID :one
+SELECT referral_codes.user_id AS referrer_id, referrals.created_at
+FROM referrals
+JOIN referral_codes ON referral_codes.id = referrals.referral_code_id
+WHERE referrals.user_id = $1
+    AND referral_codes.is_personal = TRUE
+    AND referral_codes.user_id IS NOT NULL
+ORDER BY referrals.created_at ASC
+LIMIT 1
+`
+
+type GetReferrerByRefereeIDRow struct {
+	ReferrerID uuid.NullUUID `json:"referrer_id"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+func (q *Queries) GetReferrerByRefereeID(ctx context.Context, refereeID uuid.UUID) (GetReferrerByRefereeIDRow, error) {
+	row := q.queryRow(ctx, q.getReferrerByRefereeIDStmt, getReferrerByRefereeID, refereeID)
+	var i GetReferrerByRefereeIDRow
+	err := row.Scan(&i.ReferrerID, &i.CreatedAt)
+	return i, err
+}
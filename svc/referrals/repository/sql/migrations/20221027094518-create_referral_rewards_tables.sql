@@ -0,0 +1,36 @@
+-- +migrate Up
+-- +migrate StatementBegin
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+-- +migrate StatementEnd
+CREATE TABLE IF NOT EXISTS referral_reward_programs (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title VARCHAR NOT NULL,
+    welcome_bonus DOUBLE PRECISION NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE TABLE IF NOT EXISTS referral_reward_tiers (
+    program_id uuid NOT NULL,
+    min_referrals INT NOT NULL DEFAULT 1,
+    kyc_passed_reward DOUBLE PRECISION NOT NULL DEFAULT 0,
+    first_quiz_reward DOUBLE PRECISION NOT NULL DEFAULT 0,
+    first_stake_reward DOUBLE PRECISION NOT NULL DEFAULT 0,
+    PRIMARY KEY(program_id, min_referrals),
+    FOREIGN KEY(program_id) REFERENCES referral_reward_programs(id) ON DELETE CASCADE
+);
+CREATE TABLE IF NOT EXISTS referral_rewards (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    program_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    referee_id uuid NOT NULL,
+    milestone VARCHAR NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX referral_rewards_referee_milestone ON referral_rewards USING BTREE (referee_id, milestone);
+CREATE INDEX referral_rewards_user_id ON referral_rewards USING BTREE (user_id);
+-- +migrate Down
+DROP TABLE IF EXISTS referral_rewards;
+DROP TABLE IF EXISTS referral_reward_tiers;
+DROP TABLE IF EXISTS referral_reward_programs;
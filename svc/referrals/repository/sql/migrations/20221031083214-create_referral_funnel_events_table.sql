@@ -0,0 +1,21 @@
+-- +migrate Up
+-- +migrate StatementBegin
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+-- +migrate StatementEnd
+CREATE TABLE IF NOT EXISTS referral_funnel_events (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    referral_code_id uuid DEFAULT NULL,
+    user_id uuid DEFAULT NULL,
+    device_id VARCHAR NOT NULL DEFAULT '',
+    ip VARCHAR NOT NULL DEFAULT '',
+    stage VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    FOREIGN KEY(referral_code_id) REFERENCES referral_codes(id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX referral_funnel_events_user_stage ON referral_funnel_events USING BTREE (user_id, stage) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX referral_funnel_events_device_stage ON referral_funnel_events USING BTREE (referral_code_id, device_id, stage) WHERE user_id IS NULL AND device_id <> '' AND stage = 'install';
+CREATE INDEX referral_funnel_events_referral_code_id ON referral_funnel_events USING BTREE (referral_code_id, stage, created_at);
+CREATE INDEX referrals_referral_code_id_created_at ON referrals USING BTREE (referral_code_id, created_at);
+-- +migrate Down
+DROP INDEX IF EXISTS referrals_referral_code_id_created_at;
+DROP TABLE IF EXISTS referral_funnel_events;
@@ -43,3 +43,7 @@ WHERE id = @id;
 -- name: GetNumberOfReferralCodes :one
 SELECT COUNT (id)
 FROM referral_codes;
+-- name: GetReferralCodeDataByID :one
+SELECT *
+FROM referral_codes
+WHERE id = @id;
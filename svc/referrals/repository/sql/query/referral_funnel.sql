@@ -0,0 +1,96 @@
+-- name: AddReferralFunnelEvent :exec
+INSERT INTO referral_funnel_events (
+    referral_code_id,
+    user_id,
+    device_id,
+    ip,
+    stage
+)
+VALUES (
+    @referral_code_id,
+    @user_id,
+    @device_id,
+    @ip,
+    @stage
+) ON CONFLICT DO NOTHING;
+-- name: GetReferralCodeFunnel :one
+SELECT (
+        SELECT COUNT(DISTINCT COALESCE(NULLIF(clicks.device_id, ''), clicks.id::TEXT))
+        FROM referral_funnel_events clicks
+        WHERE clicks.referral_code_id = @referral_code_id
+            AND clicks.stage = 'click'
+    )::BIGINT AS clicks,
+    (
+        SELECT COUNT(DISTINCT COALESCE(NULLIF(installs.device_id, ''), installs.id::TEXT))
+        FROM referral_funnel_events installs
+        WHERE installs.referral_code_id = @referral_code_id
+            AND installs.stage = 'install'
+    )::BIGINT AS installs,
+    COUNT(DISTINCT referrals.user_id)::BIGINT AS signups,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'verification')::BIGINT AS verified,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'kyc')::BIGINT AS kyc_passed,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'first_quiz')::BIGINT AS first_quiz,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'first_claim')::BIGINT AS first_claim
+FROM referrals
+LEFT JOIN referral_funnel_events ON referral_funnel_events.user_id = referrals.user_id
+WHERE referrals.referral_code_id = @referral_code_id;
+-- name: GetReferralFunnelCohorts :many
+SELECT date_trunc('week', referrals.created_at)::TIMESTAMP AS cohort_week,
+    COUNT(DISTINCT referrals.user_id)::BIGINT AS signups,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'verification')::BIGINT AS verified,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'kyc')::BIGINT AS kyc_passed,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'first_quiz')::BIGINT AS first_quiz,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'first_claim')::BIGINT AS first_claim
+FROM referrals
+LEFT JOIN referral_funnel_events ON referral_funnel_events.user_id = referrals.user_id
+WHERE (@referral_code_id::uuid IS NULL OR referrals.referral_code_id = @referral_code_id::uuid)
+    AND referrals.created_at >= @since::TIMESTAMP
+GROUP BY cohort_week
+ORDER BY cohort_week ASC;
+-- name: GetTopReferrers :many
+SELECT referral_codes.id AS referral_code_id,
+    referral_codes.code,
+    referral_codes.user_id AS referrer_id,
+    (
+        SELECT COUNT(DISTINCT COALESCE(NULLIF(clicks.device_id, ''), clicks.id::TEXT))
+        FROM referral_funnel_events clicks
+        WHERE clicks.referral_code_id = referral_codes.id
+            AND clicks.stage = 'click'
+            AND clicks.created_at >= @since::TIMESTAMP
+    )::BIGINT AS clicks,
+    (
+        SELECT COUNT(DISTINCT COALESCE(NULLIF(installs.device_id, ''), installs.id::TEXT))
+        FROM referral_funnel_events installs
+        WHERE installs.referral_code_id = referral_codes.id
+            AND installs.stage = 'install'
+            AND installs.created_at >= @since::TIMESTAMP
+    )::BIGINT AS installs,
+    (
+        SELECT COUNT(DISTINCT install_ips.ip)
+        FROM referral_funnel_events install_ips
+        WHERE install_ips.referral_code_id = referral_codes.id
+            AND install_ips.stage = 'install'
+            AND install_ips.created_at >= @since::TIMESTAMP
+    )::BIGINT AS install_ips,
+    COUNT(DISTINCT referrals.user_id)::BIGINT AS signups,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'verification')::BIGINT AS verified,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'kyc')::BIGINT AS kyc_passed,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'first_quiz')::BIGINT AS first_quiz,
+    COUNT(DISTINCT referral_funnel_events.user_id) FILTER (WHERE referral_funnel_events.stage = 'first_claim')::BIGINT AS first_claim,
+    COALESCE((
+        SELECT MAX(hourly.signups)
+        FROM (
+            SELECT COUNT(*) AS signups
+            FROM referrals hourly_referrals
+            WHERE hourly_referrals.referral_code_id = referral_codes.id
+                AND hourly_referrals.created_at >= @since::TIMESTAMP
+            GROUP BY date_trunc('hour', hourly_referrals.created_at)
+        ) hourly
+    ), 0)::BIGINT AS max_hourly_signups
+FROM referral_codes
+JOIN referrals ON referrals.referral_code_id = referral_codes.id
+LEFT JOIN referral_funnel_events ON referral_funnel_events.user_id = referrals.user_id
+WHERE referrals.created_at >= @since::TIMESTAMP
+GROUP BY referral_codes.id
+ORDER BY signups DESC, referral_codes.created_at ASC
+LIMIT @limit_val OFFSET @offset_val;
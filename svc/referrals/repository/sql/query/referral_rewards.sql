@@ -0,0 +1,95 @@
+-- name: AddReferralRewardProgram :one
+INSERT INTO referral_reward_programs (
+    title,
+    welcome_bonus,
+    is_active
+)
+VALUES (
+    @title,
+    @welcome_bonus,
+    @is_active
+) RETURNING *;
+-- name: UpdateReferralRewardProgram :exec
+UPDATE referral_reward_programs
+SET title = @title,
+    welcome_bonus = @welcome_bonus,
+    is_active = @is_active,
+    updated_at = now()
+WHERE id = @id;
+-- name: DeleteReferralRewardProgramByID :exec
+DELETE FROM referral_reward_programs
+WHERE id = @id;
+-- name: GetReferralRewardProgramByID :one
+SELECT *
+FROM referral_reward_programs
+WHERE id = @id;
+-- name: GetReferralRewardProgramsPaginated :many
+SELECT *
+FROM referral_reward_programs
+ORDER BY created_at DESC
+LIMIT @limit_val OFFSET @offset_val;
+-- name: GetActiveReferralRewardProgram :one
+SELECT *
+FROM referral_reward_programs
+WHERE is_active = TRUE
+ORDER BY created_at DESC
+LIMIT 1;
+-- name: AddReferralRewardTier :exec
+INSERT INTO referral_reward_tiers (
+    program_id,
+    min_referrals,
+    kyc_passed_reward,
+    first_quiz_reward,
+    first_stake_reward
+)
+VALUES (
+    @program_id,
+    @min_referrals,
+    @kyc_passed_reward,
+    @first_quiz_reward,
+    @first_stake_reward
+);
+-- name: DeleteReferralRewardTiersByProgramID :exec
+DELETE FROM referral_reward_tiers
+WHERE program_id = @program_id;
+-- name: GetReferralRewardTiersByProgramID :many
+SELECT *
+FROM referral_reward_tiers
+WHERE program_id = @program_id
+ORDER BY min_referrals ASC;
+-- name: AddReferralReward :one
+INSERT INTO referral_rewards (
+    program_id,
+    user_id,
+    referee_id,
+    milestone,
+    amount
+)
+VALUES (
+    @program_id,
+    @user_id,
+    @referee_id,
+    @milestone,
+    @amount
+) RETURNING *;
+-- name: DeleteReferralRewardByID :exec
+DELETE FROM referral_rewards
+WHERE id = @id;
+-- name: DoesReferralRewardExist :one
+SELECT EXISTS(
+    SELECT 1
+    FROM referral_rewards
+    WHERE referee_id = @referee_id
+        AND milestone = @milestone
+);
+-- name: GetReferralRewardsSummaryByUserID :many
+SELECT milestone, COUNT(id) AS rewards_number, SUM(amount)::DOUBLE PRECISION AS total_amount
+FROM referral_rewards
+WHERE user_id = @user_id
+GROUP BY milestone
+ORDER BY milestone ASC;
+-- name: GetReferralRewardsByUserID :many
+SELECT *
+FROM referral_rewards
+WHERE user_id = $1
+ORDER BY created_at DESC;
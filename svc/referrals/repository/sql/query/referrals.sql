@@ -4,6 +4,11 @@ FROM referrals
 WHERE user_id = $1
 ORDER BY created_at DESC
     LIMIT $2 OFFSET $3;
+-- name: GetReferralsByUserID :many
+SELECT *
+FROM referrals
+WHERE user_id = $1
+ORDER BY created_at DESC;
 -- name: AddReferral :exec
 INSERT INTO referrals (
     referral_code_id,
@@ -17,3 +22,18 @@ VALUES (
 SELECT referral_code_id
 FROM referrals
 WHERE user_id = $1;
+-- name: GetReferrerByRefereeID :one
+SELECT referral_codes.user_id AS referrer_id, referrals.created_at
+FROM referrals
+JOIN referral_codes ON referral_codes.id = referrals.referral_code_id
+WHERE referrals.user_id = @referee_id
+    AND referral_codes.is_personal = TRUE
+    AND referral_codes.user_id IS NOT NULL
+ORDER BY referrals.created_at ASC
+LIMIT 1;
+-- name: CountReferralsByReferrerID :one
+SELECT COUNT(DISTINCT referrals.user_id)
+FROM referrals
+JOIN referral_codes ON referral_codes.id = referrals.referral_code_id
+WHERE referral_codes.user_id = @referrer_id
+    AND referrals.created_at <= @created_before::TIMESTAMP;
@@ -0,0 +1,194 @@
+package referrals
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/referrals/repository"
+)
+
+// AddRewardProgram creates a new referral reward program with its tiers.
+// The latest created active program is applied to new rewards.
+func (s *Service) AddRewardProgram(ctx context.Context, p RewardProgram) (RewardProgram, error) {
+	if err := validateRewardProgram(p); err != nil {
+		return RewardProgram{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return RewardProgram{}, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.rr.WithTx(tx)
+
+	program, err := repo.AddReferralRewardProgram(ctx, repository.AddReferralRewardProgramParams{
+		Title:        p.Title,
+		WelcomeBonus: p.WelcomeBonus,
+		IsActive:     p.IsActive,
+	})
+	if err != nil {
+		return RewardProgram{}, fmt.Errorf("could not add referral reward program: %w", err)
+	}
+
+	if err := addRewardTiers(ctx, repo, program.ID, p.Tiers); err != nil {
+		return RewardProgram{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return RewardProgram{}, fmt.Errorf("could not commit referral reward program: %w", err)
+	}
+
+	return castToRewardProgram(program, p.Tiers), nil
+}
+
+// GetRewardProgramByID returns referral reward program with its tiers
+func (s *Service) GetRewardProgramByID(ctx context.Context, id uuid.UUID) (RewardProgram, error) {
+	program, err := s.rr.GetReferralRewardProgramByID(ctx, id)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return RewardProgram{}, fmt.Errorf("%w referral reward program", ErrNotFound)
+		}
+		return RewardProgram{}, fmt.Errorf("could not get referral reward program: %w", err)
+	}
+
+	tiers, err := s.rr.GetReferralRewardTiersByProgramID(ctx, id)
+	if err != nil && !db.IsNotFoundError(err) {
+		return RewardProgram{}, fmt.Errorf("could not get referral reward tiers: %w", err)
+	}
+
+	return castToRewardProgram(program, castToRewardTiers(tiers)), nil
+}
+
+// GetRewardPrograms returns list of referral reward programs without tiers
+func (s *Service) GetRewardPrograms(ctx context.Context, limit, offset int32) ([]RewardProgram, error) {
+	list, err := s.rr.GetReferralRewardProgramsPaginated(ctx, repository.GetReferralRewardProgramsPaginatedParams{
+		LimitVal:  limit,
+		OffsetVal: offset,
+	})
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get referral reward programs list: %w", err)
+	}
+
+	result := make([]RewardProgram, 0, len(list))
+	for _, p := range list {
+		result = append(result, castToRewardProgram(p, nil))
+	}
+
+	return result, nil
+}
+
+// UpdateRewardProgram changes the program and replaces its tiers.
+// Rewards which have been already paid are not affected.
+func (s *Service) UpdateRewardProgram(ctx context.Context, p RewardProgram) error {
+	id, err := uuid.Parse(p.ID)
+	if err != nil {
+		return fmt.Errorf("%w: referral reward program id", ErrInvalidParameter)
+	}
+	if err := validateRewardProgram(p); err != nil {
+		return err
+	}
+
+	if _, err := s.rr.GetReferralRewardProgramByID(ctx, id); err != nil {
+		if db.IsNotFoundError(err) {
+			return fmt.Errorf("%w referral reward program", ErrNotFound)
+		}
+		return fmt.Errorf("could not get referral reward program: %w", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	repo := s.rr.WithTx(tx)
+
+	if err := repo.UpdateReferralRewardProgram(ctx, repository.UpdateReferralRewardProgramParams{
+		Title:        p.Title,
+		WelcomeBonus: p.WelcomeBonus,
+		IsActive:     p.IsActive,
+		ID:           id,
+	}); err != nil {
+		return fmt.Errorf("could not update referral reward program: %w", err)
+	}
+
+	if err := repo.DeleteReferralRewardTiersByProgramID(ctx, id); err != nil {
+		return fmt.Errorf("could not delete referral reward tiers: %w", err)
+	}
+
+	if err := addRewardTiers(ctx, repo, id, p.Tiers); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit referral reward program: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRewardProgramByID removes referral reward program with its tiers
+func (s *Service) DeleteRewardProgramByID(ctx context.Context, id uuid.UUID) error {
+	if err := s.rr.DeleteReferralRewardProgramByID(ctx, id); err != nil {
+		return fmt.Errorf("could not delete referral reward program: %w", err)
+	}
+
+	return nil
+}
+
+func addRewardTiers(ctx context.Context, repo *repository.Queries, programID uuid.UUID, tiers []RewardTier) error {
+	for _, t := range tiers {
+		if err := repo.AddReferralRewardTier(ctx, repository.AddReferralRewardTierParams{
+			ProgramID:        programID,
+			MinReferrals:     t.MinReferrals,
+			KycPassedReward:  t.KYCPassedReward,
+			FirstQuizReward:  t.FirstQuizReward,
+			FirstStakeReward: t.FirstStakeReward,
+		}); err != nil {
+			return fmt.Errorf("could not add referral reward tier: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateRewardProgram(p RewardProgram) error {
+	if p.Title == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalidParameter)
+	}
+	if p.WelcomeBonus < 0 {
+		return fmt.Errorf("%w: welcome bonus must not be negative", ErrInvalidParameter)
+	}
+
+	return validateTiers(p.Tiers)
+}
+
+func castToRewardProgram(p repository.ReferralRewardProgram, tiers []RewardTier) RewardProgram {
+	return RewardProgram{
+		ID:           p.ID.String(),
+		Title:        p.Title,
+		WelcomeBonus: p.WelcomeBonus,
+		IsActive:     p.IsActive,
+		Tiers:        tiers,
+		CreatedAt:    p.CreatedAt,
+	}
+}
+
+func castToRewardTiers(source []repository.ReferralRewardTier) []RewardTier {
+	result := make([]RewardTier, 0, len(source))
+	for _, t := range source {
+		result = append(result, RewardTier{
+			MinReferrals:     t.MinReferrals,
+			KYCPassedReward:  t.KycPassedReward,
+			FirstQuizReward:  t.FirstQuizReward,
+			FirstStakeReward: t.FirstStakeReward,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,204 @@
+package referrals
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/svc/multipliers"
+	"github.com/SatorNetwork/sator-api/svc/referrals/repository"
+)
+
+// TrackEvent records the user's attribution funnel stage and rewards the referrer of the user
+// if the event is the referee's milestone covered by the active referral reward program.
+// Every milestone is rewarded once per referee.
+func (s *Service) TrackEvent(ctx context.Context, userID uuid.UUID, event string) error {
+	if stage, ok := eventStages[event]; ok {
+		if err := s.trackUserStage(ctx, userID, stage); err != nil {
+			log.Printf("could not track funnel stage %s of user %s: %v", stage, userID, err)
+		}
+	}
+
+	milestone, ok := eventMilestones[event]
+	if !ok {
+		return nil
+	}
+
+	referrer, err := s.rr.GetReferrerByRefereeID(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get referrer of user %s: %w", userID, err)
+	}
+
+	paid, err := s.rr.DoesReferralRewardExist(ctx, repository.DoesReferralRewardExistParams{
+		RefereeID: userID,
+		Milestone: milestone,
+	})
+	if err != nil {
+		return fmt.Errorf("could not check referral reward: %w", err)
+	}
+	if paid {
+		return nil
+	}
+
+	program, ok, err := s.getActiveRewardProgram(ctx)
+	if err != nil || !ok {
+		return err
+	}
+
+	tiers, err := s.rr.GetReferralRewardTiersByProgramID(ctx, program.ID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get referral reward tiers: %w", err)
+	}
+
+	// referee's own referral is counted as well, so the first referral matches the tier from 1
+	referralsNumber, err := s.rr.CountReferralsByReferrerID(ctx, repository.CountReferralsByReferrerIDParams{
+		ReferrerID:    referrer.ReferrerID,
+		CreatedBefore: referrer.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("could not count referrals of user %s: %w", referrer.ReferrerID.UUID, err)
+	}
+
+	tier, ok := tierByReferralsNumber(tiers, referralsNumber)
+	if !ok {
+		return nil
+	}
+	amount := milestoneReward(tier, milestone)
+	if amount <= 0 {
+		return nil
+	}
+
+	if abusive, err := s.isAbusiveReferral(ctx, userID, referrer.ReferrerID.UUID); err != nil {
+		return err
+	} else if abusive {
+		log.Printf("referral reward for %s of user %s is skipped: accounts are linked", milestone, userID)
+		return nil
+	}
+
+	return s.payReward(ctx, program.ID, referrer.ReferrerID.UUID, userID, milestone, amount)
+}
+
+// GetEarnings returns summary of the user's referral rewards
+func (s *Service) GetEarnings(ctx context.Context, uid uuid.UUID) (Earnings, error) {
+	referralsNumber, err := s.rr.CountReferralsByReferrerID(ctx, repository.CountReferralsByReferrerIDParams{
+		ReferrerID:    uuid.NullUUID{UUID: uid, Valid: true},
+		CreatedBefore: time.Now(),
+	})
+	if err != nil {
+		return Earnings{}, fmt.Errorf("could not count referrals: %w", err)
+	}
+
+	summary, err := s.rr.GetReferralRewardsSummaryByUserID(ctx, uid)
+	if err != nil && !db.IsNotFoundError(err) {
+		return Earnings{}, fmt.Errorf("could not get referral rewards summary: %w", err)
+	}
+
+	result := Earnings{
+		ReferralsNumber: referralsNumber,
+		Milestones:      make([]MilestoneEarnings, 0, len(summary)),
+	}
+	for _, m := range summary {
+		result.TotalAmount += m.TotalAmount
+		result.Milestones = append(result.Milestones, MilestoneEarnings{
+			Milestone:     m.Milestone,
+			RewardsNumber: m.RewardsNumber,
+			Amount:        m.TotalAmount,
+		})
+	}
+
+	return result, nil
+}
+
+// payWelcomeBonus rewards the referee who has just confirmed a referral code.
+// Referrer ID is empty if the code is not a personal one.
+func (s *Service) payWelcomeBonus(ctx context.Context, refereeID, referrerID uuid.UUID) error {
+	program, ok, err := s.getActiveRewardProgram(ctx)
+	if err != nil || !ok || program.WelcomeBonus <= 0 {
+		return err
+	}
+
+	if abusive, err := s.isAbusiveReferral(ctx, refereeID, referrerID); err != nil {
+		return err
+	} else if abusive {
+		log.Printf("referral welcome bonus of user %s is skipped: accounts are linked", refereeID)
+		return nil
+	}
+
+	return s.payReward(ctx, program.ID, refereeID, refereeID, MilestoneWelcomeBonus, program.WelcomeBonus)
+}
+
+// getActiveRewardProgram returns the latest active referral reward program.
+// The second returned value is false if there is no active program.
+func (s *Service) getActiveRewardProgram(ctx context.Context) (repository.ReferralRewardProgram, bool, error) {
+	program, err := s.rr.GetActiveReferralRewardProgram(ctx)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return repository.ReferralRewardProgram{}, false, nil
+		}
+		return repository.ReferralRewardProgram{}, false, fmt.Errorf("could not get active referral reward program: %w", err)
+	}
+
+	return program, true, nil
+}
+
+// isAbusiveReferral reports whether the referee has several accounts on the same device
+// or seems to be the same person as the referrer.
+func (s *Service) isAbusiveReferral(ctx context.Context, refereeID, referrerID uuid.UUID) (bool, error) {
+	multiple, err := s.ac.HasMultipleAccounts(ctx, refereeID)
+	if err != nil {
+		return false, fmt.Errorf("could not check accounts of user %s: %w", refereeID, err)
+	}
+	if multiple || referrerID == uuid.Nil {
+		return multiple, nil
+	}
+
+	linked, err := s.ac.AreUsersLinked(ctx, refereeID, referrerID)
+	if err != nil {
+		return false, fmt.Errorf("could not check if users %s and %s are linked: %w", refereeID, referrerID, err)
+	}
+
+	return linked, nil
+}
+
+// payReward stores referral reward and deposits it to the user's rewards wallet.
+// The reward is skipped if the milestone of the referee has been already rewarded.
+// The stored reward is deleted if the deposit fails, so the milestone can be rewarded again.
+func (s *Service) payReward(ctx context.Context, programID, userID, refereeID uuid.UUID, milestone string, amount float64) error {
+	if s.multiplierFn != nil {
+		multiplier, err := s.multiplierFn(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("could not get user's multiplier: %w", err)
+		}
+		amount = multipliers.Apply(amount, multiplier)
+	}
+
+	reward, err := s.rr.AddReferralReward(ctx, repository.AddReferralRewardParams{
+		ProgramID: programID,
+		UserID:    userID,
+		RefereeID: refereeID,
+		Milestone: milestone,
+		Amount:    amount,
+	})
+	if err != nil {
+		if db.IsDuplicateError(err) {
+			return nil
+		}
+		return fmt.Errorf("could not store referral reward: %w", err)
+	}
+
+	if err := s.rc.AddDepositTransaction(ctx, userID, reward.ID, RelationTypeReferral, amount); err != nil {
+		if derr := s.rr.DeleteReferralRewardByID(ctx, reward.ID); derr != nil {
+			log.Printf("could not delete referral reward %s: %v", reward.ID, derr)
+		}
+		return fmt.Errorf("could not deposit referral reward: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package referrals
+
+import (
+	"fmt"
+
+	"github.com/SatorNetwork/sator-api/svc/campaigns"
+	"github.com/SatorNetwork/sator-api/svc/referrals/repository"
+)
+
+// Referral reward milestones
+const (
+	MilestoneWelcomeBonus = "welcome_bonus" // referee confirmed a referral code, paid to the referee
+	MilestoneKYCPassed    = "kyc_passed"    // referee passed identity verification, paid to the referrer
+	MilestoneFirstQuiz    = "first_quiz"    // referee played the first quiz, paid to the referrer
+	MilestoneFirstStake   = "first_stake"   // referee locked tokens for the first time, paid to the referrer
+)
+
+// eventMilestones maps referee's activity events to referrer's milestones
+var eventMilestones = map[string]string{
+	campaigns.EventKYCPassed:  MilestoneKYCPassed,
+	campaigns.EventQuizPlayed: MilestoneFirstQuiz,
+	campaigns.EventStake:      MilestoneFirstStake,
+}
+
+// tierByReferralsNumber returns the tier with the greatest threshold reached by the referrer.
+// The second returned value is false if the referrer has not reached any tier yet.
+func tierByReferralsNumber(tiers []repository.ReferralRewardTier, referralsNumber int64) (repository.ReferralRewardTier, bool) {
+	var (
+		result repository.ReferralRewardTier
+		found  bool
+	)
+
+	for _, t := range tiers {
+		if int64(t.MinReferrals) > referralsNumber {
+			continue
+		}
+		if !found || t.MinReferrals > result.MinReferrals {
+			result = t
+			found = true
+		}
+	}
+
+	return result, found
+}
+
+// milestoneReward returns amount the referrer gets for the milestone within the tier
+func milestoneReward(t repository.ReferralRewardTier, milestone string) float64 {
+	switch milestone {
+	case MilestoneKYCPassed:
+		return t.KycPassedReward
+	case MilestoneFirstQuiz:
+		return t.FirstQuizReward
+	case MilestoneFirstStake:
+		return t.FirstStakeReward
+	}
+
+	return 0
+}
+
+// validateTiers checks that every tier has a unique positive threshold and non-negative rewards
+func validateTiers(tiers []RewardTier) error {
+	seen := make(map[int32]bool, len(tiers))
+	for _, t := range tiers {
+		if t.MinReferrals < 1 {
+			return fmt.Errorf("%w: min referrals must be greater than zero", ErrInvalidParameter)
+		}
+		if seen[t.MinReferrals] {
+			return fmt.Errorf("%w: duplicated tier for %d referrals", ErrInvalidParameter, t.MinReferrals)
+		}
+		if t.KYCPassedReward < 0 || t.FirstQuizReward < 0 || t.FirstStakeReward < 0 {
+			return fmt.Errorf("%w: reward amount must not be negative", ErrInvalidParameter)
+		}
+		seen[t.MinReferrals] = true
+	}
+
+	return nil
+}
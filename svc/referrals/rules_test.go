@@ -0,0 +1,102 @@
+package referrals
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SatorNetwork/sator-api/svc/referrals/repository"
+)
+
+func TestTierByReferralsNumber(t *testing.T) {
+	tiers := []repository.ReferralRewardTier{
+		{MinReferrals: 1, KycPassedReward: 10},
+		{MinReferrals: 10, KycPassedReward: 15},
+		{MinReferrals: 50, KycPassedReward: 25},
+	}
+
+	tests := []struct {
+		name      string
+		tiers     []repository.ReferralRewardTier
+		number    int64
+		wantFound bool
+		wantMin   int32
+	}{
+		{name: "no tiers", number: 5, wantFound: false},
+		{name: "no referrals yet", tiers: tiers, number: 0, wantFound: false},
+		{name: "first tier", tiers: tiers, number: 1, wantFound: true, wantMin: 1},
+		{name: "between tiers", tiers: tiers, number: 49, wantFound: true, wantMin: 10},
+		{name: "top tier", tiers: tiers, number: 100, wantFound: true, wantMin: 50},
+		{
+			name:      "unsorted tiers",
+			tiers:     []repository.ReferralRewardTier{tiers[2], tiers[0], tiers[1]},
+			number:    12,
+			wantFound: true,
+			wantMin:   10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := tierByReferralsNumber(tt.tiers, tt.number)
+			if found != tt.wantFound {
+				t.Fatalf("tierByReferralsNumber() found = %v, want %v", found, tt.wantFound)
+			}
+			if found && got.MinReferrals != tt.wantMin {
+				t.Errorf("tierByReferralsNumber() min referrals = %v, want %v", got.MinReferrals, tt.wantMin)
+			}
+		})
+	}
+}
+
+func TestMilestoneReward(t *testing.T) {
+	tier := repository.ReferralRewardTier{
+		KycPassedReward:  10,
+		FirstQuizReward:  5,
+		FirstStakeReward: 20,
+	}
+
+	tests := []struct {
+		milestone string
+		want      float64
+	}{
+		{milestone: MilestoneKYCPassed, want: 10},
+		{milestone: MilestoneFirstQuiz, want: 5},
+		{milestone: MilestoneFirstStake, want: 20},
+		{milestone: MilestoneWelcomeBonus, want: 0},
+		{milestone: "unknown", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.milestone, func(t *testing.T) {
+			if got := milestoneReward(tier, tt.milestone); got != tt.want {
+				t.Errorf("milestoneReward() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTiers(t *testing.T) {
+	tests := []struct {
+		name    string
+		tiers   []RewardTier
+		wantErr bool
+	}{
+		{name: "empty", wantErr: false},
+		{name: "valid", tiers: []RewardTier{{MinReferrals: 1, KYCPassedReward: 10}, {MinReferrals: 10, KYCPassedReward: 20}}},
+		{name: "zero threshold", tiers: []RewardTier{{MinReferrals: 0}}, wantErr: true},
+		{name: "duplicated threshold", tiers: []RewardTier{{MinReferrals: 5}, {MinReferrals: 5}}, wantErr: true},
+		{name: "negative reward", tiers: []RewardTier{{MinReferrals: 1, FirstStakeReward: -1}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTiers(tt.tiers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateTiers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidParameter) {
+				t.Errorf("validateTiers() error = %v, want %v", err, ErrInvalidParameter)
+			}
+		})
+	}
+}
@@ -15,14 +15,28 @@ import (
 	"github.com/google/uuid"
 )
 
+// RelationTypeReferral indicates that reward is issued by a referral program
+const RelationTypeReferral = "referral"
+
 type (
 	// Service struct
 	Service struct {
+		db     *sql.DB
 		rr     referralsRepository
 		fb     *firebase.Interactor
 		config firebase.Config
+		rc     rewardsClient
+		ac     authClient
+
+		multiplierFn rewardMultiplierFunc // returns the user's bonus percent added to rewards
 	}
 
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
+
+	rewardMultiplierFunc func(ctx context.Context, userID uuid.UUID) (int32, error)
+
 	ReferralCode struct {
 		ID           uuid.UUID  `json:"id"`
 		Title        string     `json:"title"`
@@ -39,33 +53,121 @@ type (
 		CreatedAt      time.Time `json:"created_at"`
 	}
 
+	// RewardProgram defines the referee's welcome bonus and the referrer's rewards for referee's milestones
+	RewardProgram struct {
+		ID           string       `json:"id"`
+		Title        string       `json:"title"`
+		WelcomeBonus float64      `json:"welcome_bonus"`
+		IsActive     bool         `json:"is_active"`
+		Tiers        []RewardTier `json:"tiers"`
+		CreatedAt    time.Time    `json:"created_at"`
+	}
+
+	// RewardTier is applied to referrers who have made at least MinReferrals referrals
+	RewardTier struct {
+		MinReferrals     int32   `json:"min_referrals"`
+		KYCPassedReward  float64 `json:"kyc_passed_reward"`
+		FirstQuizReward  float64 `json:"first_quiz_reward"`
+		FirstStakeReward float64 `json:"first_stake_reward"`
+	}
+
+	// Earnings is a summary of the user's rewards received through referral programs
+	Earnings struct {
+		ReferralsNumber int64               `json:"referrals_number"`
+		TotalAmount     float64             `json:"total_amount"`
+		Milestones      []MilestoneEarnings `json:"milestones"`
+	}
+
+	// MilestoneEarnings is a summary of the user's rewards for the milestone
+	MilestoneEarnings struct {
+		Milestone     string  `json:"milestone"`
+		RewardsNumber int64   `json:"rewards_number"`
+		Amount        float64 `json:"amount"`
+	}
+
 	referralsRepository interface {
 		// Referral codes
 		AddReferralCodeData(ctx context.Context, arg repository.AddReferralCodeDataParams) (repository.ReferralCode, error)
 		DeleteReferralCodeDataByID(ctx context.Context, id uuid.UUID) error
 		GetReferralCodeDataByUserID(ctx context.Context, userID uuid.NullUUID) (repository.ReferralCode, error)
 		GetReferralCodeDataByCode(ctx context.Context, code string) (repository.ReferralCode, error)
+		GetReferralCodeDataByID(ctx context.Context, id uuid.UUID) (repository.ReferralCode, error)
 		GetReferralCodesDataList(ctx context.Context, arg repository.GetReferralCodesDataListParams) ([]repository.ReferralCode, error)
 		UpdateReferralCodeData(ctx context.Context, arg repository.UpdateReferralCodeDataParams) error
 		GetNumberOfReferralCodes(ctx context.Context) (int64, error)
 
 		// Referrals
 		AddReferral(ctx context.Context, arg repository.AddReferralParams) error
+		CountReferralsByReferrerID(ctx context.Context, arg repository.CountReferralsByReferrerIDParams) (int64, error)
+		GetReferralsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.Referral, error)
 		GetReferralsWithPaginationByUserID(ctx context.Context, arg repository.GetReferralsWithPaginationByUserIDParams) ([]repository.Referral, error)
+		GetReferrerByRefereeID(ctx context.Context, refereeID uuid.UUID) (repository.GetReferrerByRefereeIDRow, error)
+
+		// Reward programs
+		DeleteReferralRewardProgramByID(ctx context.Context, id uuid.UUID) error
+		GetActiveReferralRewardProgram(ctx context.Context) (repository.ReferralRewardProgram, error)
+		GetReferralRewardProgramByID(ctx context.Context, id uuid.UUID) (repository.ReferralRewardProgram, error)
+		GetReferralRewardProgramsPaginated(ctx context.Context, arg repository.GetReferralRewardProgramsPaginatedParams) ([]repository.ReferralRewardProgram, error)
+		GetReferralRewardTiersByProgramID(ctx context.Context, programID uuid.UUID) ([]repository.ReferralRewardTier, error)
+
+		// Reward payouts
+		AddReferralReward(ctx context.Context, arg repository.AddReferralRewardParams) (repository.ReferralReward, error)
+		DeleteReferralRewardByID(ctx context.Context, id uuid.UUID) error
+		DoesReferralRewardExist(ctx context.Context, arg repository.DoesReferralRewardExistParams) (bool, error)
+		GetReferralRewardsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.ReferralReward, error)
+		GetReferralRewardsSummaryByUserID(ctx context.Context, userID uuid.UUID) ([]repository.GetReferralRewardsSummaryByUserIDRow, error)
+
+		// Attribution funnel
+		AddReferralFunnelEvent(ctx context.Context, arg repository.AddReferralFunnelEventParams) error
+		GetReferralCodeFunnel(ctx context.Context, referralCodeID uuid.UUID) (repository.GetReferralCodeFunnelRow, error)
+		GetReferralFunnelCohorts(ctx context.Context, arg repository.GetReferralFunnelCohortsParams) ([]repository.GetReferralFunnelCohortsRow, error)
+		GetTopReferrers(ctx context.Context, arg repository.GetTopReferrersParams) ([]repository.GetTopReferrersRow, error)
+
+		WithTx(tx *sql.Tx) *repository.Queries
+	}
+
+	rewardsClient interface {
+		AddDepositTransaction(ctx context.Context, userID, relationID uuid.UUID, relationType string, amount float64) error
+	}
+
+	// authClient is used to detect referrals between accounts of the same person
+	authClient interface {
+		AreUsersLinked(ctx context.Context, userID, otherUserID uuid.UUID) (bool, error)
+		HasMultipleAccounts(ctx context.Context, userID uuid.UUID) (bool, error)
 	}
 )
 
 // NewService is a factory function,
 // returns a new instance of the Service interface implementation
-func NewService(rr referralsRepository, fb *firebase.Interactor, config firebase.Config) *Service {
+func NewService(dbConn *sql.DB, rr referralsRepository, fb *firebase.Interactor, config firebase.Config, rc rewardsClient, ac authClient, opt ...ServiceOption) *Service {
 	if rr == nil {
 		log.Fatalln("referrals repository is not set")
 	}
 	if fb == nil {
 		log.Fatalln("firebase client is not set")
 	}
+	if rc == nil {
+		log.Fatalln("rewards client is not set")
+	}
+	if ac == nil {
+		log.Fatalln("auth client is not set")
+	}
+
+	s := &Service{db: dbConn, rr: rr, fb: fb, config: config, rc: rc, ac: ac}
+
+	// Set up options.
+	for _, o := range opt {
+		o(s)
+	}
 
-	return &Service{rr: rr, fb: fb, config: config}
+	return s
+}
+
+// WithRewardMultiplierFunc adds the user's multiplier bonus to referral rewards
+func WithRewardMultiplierFunc(fn rewardMultiplierFunc) ServiceOption {
+	return func(s *Service) {
+		s.multiplierFn = fn
+	}
 }
 
 // GetMyReferralCode returns referral code if there is or generate new if not.
@@ -335,5 +437,13 @@ func (s *Service) StoreUserWithValidCode(ctx context.Context, uid uuid.UUID, cod
 		return false, fmt.Errorf("could not store referral with id = %v, and code = %s: %w", uid, code, err)
 	}
 
+	var referrerID uuid.UUID
+	if rc.IsPersonal.Bool && rc.UserID.Valid {
+		referrerID = rc.UserID.UUID
+	}
+	if err := s.payWelcomeBonus(ctx, uid, referrerID); err != nil {
+		log.Printf("could not pay referral welcome bonus to user %s: %v", uid, err)
+	}
+
 	return true, nil
 }
@@ -2,8 +2,11 @@ package referrals
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 
 	"github.com/SatorNetwork/sator-api/lib/httpencoder"
@@ -17,8 +20,10 @@ import (
 
 // Predefined request query keys
 const (
-	pageParam         = "page"
-	itemsPerPageParam = "items_per_page"
+	pageParam           = "page"
+	itemsPerPageParam   = "items_per_page"
+	sinceParam          = "since"
+	referralCodeIDParam = "referral_code_id"
 )
 
 type (
@@ -86,6 +91,83 @@ func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
 		options...,
 	).ServeHTTP)
 
+	r.Get("/earnings", httptransport.NewServer(
+		e.GetEarnings,
+		decodeGetEarningsRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/programs", httptransport.NewServer(
+		e.AddRewardProgram,
+		decodeAddRewardProgramRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/programs", httptransport.NewServer(
+		e.GetRewardPrograms,
+		decodeGetRewardProgramsRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/programs/{id}", httptransport.NewServer(
+		e.GetRewardProgramByID,
+		decodeRewardProgramIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Put("/programs/{id}", httptransport.NewServer(
+		e.UpdateRewardProgram,
+		decodeUpdateRewardProgramRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/programs/{id}", httptransport.NewServer(
+		e.DeleteRewardProgramByID,
+		decodeRewardProgramIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Post("/funnel", httptransport.NewServer(
+		e.TrackLinkStage,
+		decodeTrackLinkStageRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/codes/{id}/funnel", httptransport.NewServer(
+		e.GetFunnel,
+		decodeReferralCodeIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/funnel/cohorts", httptransport.NewServer(
+		e.GetFunnelCohorts,
+		decodeGetFunnelCohortsRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/funnel/top", httptransport.NewServer(
+		e.GetTopReferrers,
+		decodeGetTopReferrersRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/funnel/top/csv", httptransport.NewServer(
+		e.ExportTopReferrers,
+		decodeExportTopReferrersRequest,
+		encodeCSVResponse("top_referrers.csv"),
+		options...,
+	).ServeHTTP)
+
 	return r
 }
 
@@ -149,7 +231,120 @@ func decodeGetReferralsWithPaginationByUserIDRequest(_ context.Context, r *http.
 	}, nil
 }
 
+func decodeGetEarningsRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodeAddRewardProgramRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req RewardProgramRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeGetRewardProgramsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return utils.PaginationRequest{
+		Page:         utils.StrToInt32(r.URL.Query().Get(pageParam)),
+		ItemsPerPage: utils.StrToInt32(r.URL.Query().Get(itemsPerPageParam)),
+	}, nil
+}
+
+func decodeUpdateRewardProgramRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req RewardProgramRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed id", ErrInvalidParameter)
+	}
+	req.ID = id
+
+	return req, nil
+}
+
+func decodeRewardProgramIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed id", ErrInvalidParameter)
+	}
+
+	return id, nil
+}
+
+func decodeTrackLinkStageRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req TrackLinkStageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	// remote address is set to the real client ip by the router middleware
+	req.IP = r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		req.IP = host
+	}
+
+	return req, nil
+}
+
+func decodeReferralCodeIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed id", ErrInvalidParameter)
+	}
+
+	return id, nil
+}
+
+func decodeGetFunnelCohortsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return GetFunnelCohortsRequest{
+		ReferralCodeID: r.URL.Query().Get(referralCodeIDParam),
+		Since:          r.URL.Query().Get(sinceParam),
+	}, nil
+}
+
+func decodeGetTopReferrersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return GetTopReferrersRequest{
+		Since: r.URL.Query().Get(sinceParam),
+		PaginationRequest: utils.PaginationRequest{
+			Page:         utils.StrToInt32(r.URL.Query().Get(pageParam)),
+			ItemsPerPage: utils.StrToInt32(r.URL.Query().Get(itemsPerPageParam)),
+		},
+	}, nil
+}
+
+func decodeExportTopReferrersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return r.URL.Query().Get(sinceParam), nil
+}
+
+// encodeCSVResponse writes CSV records returned by endpoint as a file attachment
+func encodeCSVResponse(filename string) httptransport.EncodeResponseFunc {
+	return func(_ context.Context, w http.ResponseWriter, response interface{}) error {
+		records, ok := response.([][]string)
+		if !ok {
+			return fmt.Errorf("unexpected csv response type %T", response)
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(http.StatusOK)
+
+		return csv.NewWriter(w).WriteAll(records)
+	}
+}
+
 // returns http error code by error type
 func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound, err.Error()
+	}
+
+	if errors.Is(err, ErrInvalidParameter) {
+		return http.StatusBadRequest, err.Error()
+	}
+
 	return httpencoder.CodeAndMessageFrom(err)
 }
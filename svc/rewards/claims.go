@@ -0,0 +1,288 @@
+package rewards
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	lib_solana "github.com/SatorNetwork/sator-api/lib/solana"
+	"github.com/SatorNetwork/sator-api/svc/rewards/repository"
+)
+
+// Reward claim statuses
+const (
+	ClaimStatusCreated   = "created"   // rewards are reserved, transaction is not signed yet
+	ClaimStatusSubmitted = "submitted" // signed transaction is stored and may be sent to blockchain
+	ClaimStatusConfirmed = "confirmed" // transaction is confirmed, claim is finished
+	ClaimStatusFailed    = "failed"    // transaction is failed or can't be executed anymore
+	ClaimStatusReverted  = "reverted"  // reserved rewards are returned to the rewards wallet
+)
+
+// claimTransitions describes allowed reward claim state changes
+var claimTransitions = map[string][]string{
+	ClaimStatusCreated:   {ClaimStatusSubmitted, ClaimStatusFailed},
+	ClaimStatusSubmitted: {ClaimStatusConfirmed, ClaimStatusFailed},
+	ClaimStatusFailed:    {ClaimStatusReverted},
+}
+
+// canTransitClaim reports whether reward claim can be moved from one status to another
+func canTransitClaim(from, to string) bool {
+	for _, s := range claimTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// setClaimStatus moves claim to the next status,
+// it fails if the claim status was changed concurrently.
+func (s *Service) setClaimStatus(ctx context.Context, repo rewardsRepository, claim repository.RewardClaim, status string) (repository.RewardClaim, error) {
+	if !canTransitClaim(claim.Status, status) {
+		return claim, fmt.Errorf("%w: %s -> %s", ErrInvalidClaimTransition, claim.Status, status)
+	}
+
+	updated, err := repo.UpdateRewardClaimStatus(ctx, repository.UpdateRewardClaimStatusParams{
+		Status:        status,
+		ID:            claim.ID,
+		CurrentStatus: claim.Status,
+	})
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return claim, fmt.Errorf("%w: claim %s is not in status %s", ErrInvalidClaimTransition, claim.ID, claim.Status)
+		}
+		return claim, fmt.Errorf("could not update reward claim status: %w", err)
+	}
+
+	return updated, nil
+}
+
+// createClaim reserves rewards for the claim in a single db transaction
+func (s *Service) createClaim(ctx context.Context, uid uuid.UUID, amount float64, items map[uuid.UUID]float64) (claim repository.RewardClaim, err error) {
+	err = s.inTx(ctx, func(repo rewardsRepository) error {
+		claim, err = repo.CreateRewardClaim(ctx, repository.CreateRewardClaimParams{
+			UserID: uid,
+			Amount: amount,
+		})
+		if err != nil {
+			if db.IsDuplicateError(err) {
+				return ErrClaimInProgress
+			}
+			return fmt.Errorf("could not create reward claim: %w", err)
+		}
+
+		for rewardID, a := range items {
+			if err := repo.ClaimReward(ctx, repository.ClaimRewardParams{
+				Amount: a,
+				ID:     rewardID,
+			}); err != nil {
+				return fmt.Errorf("could not update rewards status: %w", err)
+			}
+			if err := repo.AddRewardClaimItem(ctx, repository.AddRewardClaimItemParams{
+				ClaimID:  claim.ID,
+				RewardID: rewardID,
+				Amount:   a,
+			}); err != nil {
+				return fmt.Errorf("could not store reward claim item: %w", err)
+			}
+		}
+
+		return nil
+	})
+
+	return claim, err
+}
+
+// confirmClaim finishes the claim and stores withdraw transaction in the rewards history
+func (s *Service) confirmClaim(ctx context.Context, claim repository.RewardClaim) error {
+	return s.inTx(ctx, func(repo rewardsRepository) error {
+		if _, err := s.setClaimStatus(ctx, repo, claim, ClaimStatusConfirmed); err != nil {
+			return err
+		}
+
+		if err := repo.AddTransaction(ctx, repository.AddTransactionParams{
+			UserID:          claim.UserID,
+			Amount:          claim.Amount,
+			TransactionType: TransactionTypeWithdraw,
+			HoldUntil:       time.Now(),
+		}); err != nil {
+			return fmt.Errorf("could not add withdraw transaction: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// failClaim marks claim as failed and returns reserved rewards to the rewards wallet
+func (s *Service) failClaim(ctx context.Context, claim repository.RewardClaim) error {
+	return s.inTx(ctx, func(repo rewardsRepository) error {
+		failed, err := s.setClaimStatus(ctx, repo, claim, ClaimStatusFailed)
+		if err != nil {
+			return err
+		}
+
+		items, err := repo.GetRewardClaimItems(ctx, claim.ID)
+		if err != nil && !db.IsNotFoundError(err) {
+			return fmt.Errorf("could not get reward claim items: %w", err)
+		}
+
+		for _, item := range items {
+			if err := repo.UnclaimReward(ctx, repository.UnclaimRewardParams{
+				Amount: item.Amount,
+				ID:     item.RewardID,
+			}); err != nil {
+				return fmt.Errorf("could not revert reward %s: %w", item.RewardID, err)
+			}
+		}
+
+		if _, err := s.setClaimStatus(ctx, repo, failed, ClaimStatusReverted); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// ReconcileClaims finishes or rolls back claims which are stuck,
+// e.g. because of restart between reserving rewards and sending transaction.
+// Submitted claims are looked up on chain by the stored signature,
+// they are reverted only when their transaction is failed or its blockhash is expired.
+func (s *Service) ReconcileClaims(ctx context.Context) error {
+	if s.txChecker == nil {
+		return nil
+	}
+
+	lock, err := s.getLocker.GetLock(ctx, "reconcile-reward-claims")
+	if err != nil {
+		return fmt.Errorf("can't get lock: %w", err)
+	}
+	if ok, err := lock.Lock(ctx); err != nil || !ok {
+		// reconciliation is running on another instance
+		_ = lock.Unlock(context.Background())
+		return err
+	}
+	defer func() {
+		if err := lock.Unlock(context.Background()); err != nil {
+			log.Printf("can't release reconcile reward claims lock: %v", err)
+		}
+	}()
+
+	submitted, err := s.repo.GetRewardClaimsByStatus(ctx, ClaimStatusSubmitted)
+	if err != nil && !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get submitted reward claims: %w", err)
+	}
+	for _, claim := range submitted {
+		status, err := s.checkClaimTransaction(ctx, claim)
+		if err != nil {
+			log.Printf("can't check reward claim %s transaction %s: %v", claim.ID, claim.TxHash.String, err)
+			continue
+		}
+
+		switch status {
+		case ClaimStatusConfirmed:
+			if err := s.confirmClaim(ctx, claim); err != nil {
+				log.Printf("can't confirm reward claim %s: %v", claim.ID, err)
+			}
+		case ClaimStatusFailed:
+			log.Printf("reward claim %s transaction %s is failed or expired, reverting", claim.ID, claim.TxHash.String)
+			if err := s.failClaim(ctx, claim); err != nil {
+				log.Printf("can't revert reward claim %s: %v", claim.ID, err)
+			}
+		}
+	}
+
+	// transaction of created claim is never sent, since it's sent only after the signature is stored
+	created, err := s.repo.GetRewardClaimsByStatus(ctx, ClaimStatusCreated)
+	if err != nil && !db.IsNotFoundError(err) {
+		return fmt.Errorf("could not get created reward claims: %w", err)
+	}
+	for _, claim := range created {
+		if time.Since(claim.CreatedAt) > s.claimConfirmationTimeout {
+			log.Printf("reward claim %s has not been submitted, reverting", claim.ID)
+			if err := s.failClaim(ctx, claim); err != nil {
+				log.Printf("can't revert reward claim %s: %v", claim.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkClaimTransaction looks up transaction of the submitted claim on chain
+// and returns the status the claim must be moved to, empty status means the transaction is still in progress.
+func (s *Service) checkClaimTransaction(ctx context.Context, claim repository.RewardClaim) (string, error) {
+	// blockhash is checked before the signature,
+	// so the transaction can't be executed after it's reported as not found
+	blockhashValid := true
+	if claim.TxBlockhash.Valid {
+		valid, err := s.txChecker.IsBlockhashValid(ctx, claim.TxBlockhash.String)
+		if err != nil {
+			return "", err
+		}
+		blockhashValid = valid
+	}
+
+	txStatus, err := s.txChecker.GetTransactionStatus(ctx, claim.TxHash.String)
+	if err != nil {
+		return "", err
+	}
+
+	return claimStatusByTransaction(txStatus, blockhashValid), nil
+}
+
+// claimStatusByTransaction returns the status claim must be moved to according to its transaction state.
+// Claim without transaction found on chain is failed only if the transaction can't be executed anymore.
+func claimStatusByTransaction(txStatus lib_solana.TransactionStatus, blockhashValid bool) string {
+	switch txStatus {
+	case lib_solana.TransactionStatusSucceeded:
+		return ClaimStatusConfirmed
+	case lib_solana.TransactionStatusFailed:
+		return ClaimStatusFailed
+	case lib_solana.TransactionStatusNotFound:
+		if !blockhashValid {
+			return ClaimStatusFailed
+		}
+	}
+
+	return ""
+}
+
+// startClaimsReconciliation runs ReconcileClaims every minute
+func (s *Service) startClaimsReconciliation() {
+	c := cron.New()
+	if _, err := c.AddFunc("* * * * *", func() {
+		if err := s.ReconcileClaims(context.Background()); err != nil {
+			log.Printf("can't reconcile reward claims: %v", err)
+		}
+	}); err != nil {
+		log.Printf("can't register reconcile-reward-claims callback: %v", err)
+		return
+	}
+
+	c.Start()
+}
+
+// inTx runs fn within db transaction if db is set
+func (s *Service) inTx(ctx context.Context, fn func(repo rewardsRepository) error) error {
+	if s.db == nil {
+		return fn(s.repo)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(s.repo.WithTx(tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,58 @@
+package rewards
+
+import (
+	"testing"
+
+	lib_solana "github.com/SatorNetwork/sator-api/lib/solana"
+)
+
+func TestCanTransitClaim(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{from: ClaimStatusCreated, to: ClaimStatusSubmitted, want: true},
+		{from: ClaimStatusCreated, to: ClaimStatusFailed, want: true},
+		{from: ClaimStatusCreated, to: ClaimStatusConfirmed, want: false},
+		{from: ClaimStatusCreated, to: ClaimStatusReverted, want: false},
+		{from: ClaimStatusSubmitted, to: ClaimStatusConfirmed, want: true},
+		{from: ClaimStatusSubmitted, to: ClaimStatusFailed, want: true},
+		{from: ClaimStatusSubmitted, to: ClaimStatusCreated, want: false},
+		{from: ClaimStatusFailed, to: ClaimStatusReverted, want: true},
+		{from: ClaimStatusFailed, to: ClaimStatusSubmitted, want: false},
+		{from: ClaimStatusConfirmed, to: ClaimStatusFailed, want: false},
+		{from: ClaimStatusReverted, to: ClaimStatusCreated, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			if got := canTransitClaim(tt.from, tt.to); got != tt.want {
+				t.Errorf("canTransitClaim() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClaimStatusByTransaction(t *testing.T) {
+	tests := []struct {
+		name           string
+		txStatus       lib_solana.TransactionStatus
+		blockhashValid bool
+		want           string
+	}{
+		{"finalized", lib_solana.TransactionStatusSucceeded, false, ClaimStatusConfirmed},
+		{"failed on chain", lib_solana.TransactionStatusFailed, true, ClaimStatusFailed},
+		{"not finalized yet", lib_solana.TransactionStatusPending, false, ""},
+		{"not found, may be executed", lib_solana.TransactionStatusNotFound, true, ""},
+		{"not found, blockhash expired", lib_solana.TransactionStatusNotFound, false, ClaimStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := claimStatusByTransaction(tt.txStatus, tt.blockhashValid); got != tt.want {
+				t.Errorf("claimStatusByTransaction() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
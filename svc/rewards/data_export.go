@@ -0,0 +1,93 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+)
+
+type (
+	// RewardsData is the rewards history of the user included into the data export archive
+	RewardsData struct {
+		Transactions []ExportedTransaction `json:"transactions"`
+		Claims       []ExportedClaim       `json:"claims"`
+	}
+
+	// ExportedTransaction struct
+	ExportedTransaction struct {
+		ID            uuid.UUID  `json:"id"`
+		Type          string     `json:"type"`
+		Amount        float64    `json:"amount"`
+		ClaimedAmount float64    `json:"claimed_amount"`
+		Withdrawn     bool       `json:"withdrawn"`
+		RelationType  string     `json:"relation_type,omitempty"`
+		RelationID    *uuid.UUID `json:"relation_id,omitempty"`
+		HoldUntil     time.Time  `json:"hold_until"`
+		VestingUntil  *time.Time `json:"vesting_until,omitempty"`
+		CreatedAt     time.Time  `json:"created_at"`
+	}
+
+	// ExportedClaim struct
+	ExportedClaim struct {
+		ID        uuid.UUID `json:"id"`
+		Amount    float64   `json:"amount"`
+		Status    string    `json:"status"`
+		TxHash    string    `json:"tx_hash,omitempty"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+)
+
+// ExportUserData returns all reward transactions and claims of the user for the data export archive
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	data := RewardsData{
+		Transactions: []ExportedTransaction{},
+		Claims:       []ExportedClaim{},
+	}
+
+	txs, err := s.repo.GetTransactionsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get rewards transactions: %w", err)
+	}
+	for _, tx := range txs {
+		etx := ExportedTransaction{
+			ID:            tx.ID,
+			Type:          "deposit",
+			Amount:        tx.Amount,
+			ClaimedAmount: tx.ClaimedAmount,
+			Withdrawn:     tx.Withdrawn,
+			RelationType:  tx.RelationType.String,
+			HoldUntil:     tx.HoldUntil,
+			CreatedAt:     tx.CreatedAt,
+		}
+		if tx.TransactionType == TransactionTypeWithdraw {
+			etx.Type = "withdraw"
+		}
+		if tx.RelationID.Valid {
+			etx.RelationID = &tx.RelationID.UUID
+		}
+		if tx.VestingUntil.Valid {
+			etx.VestingUntil = &tx.VestingUntil.Time
+		}
+		data.Transactions = append(data.Transactions, etx)
+	}
+
+	claims, err := s.repo.GetRewardClaimsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get reward claims: %w", err)
+	}
+	for _, c := range claims {
+		data.Claims = append(data.Claims, ExportedClaim{
+			ID:        c.ID,
+			Amount:    c.Amount,
+			Status:    c.Status,
+			TxHash:    c.TxHash.String,
+			CreatedAt: c.CreatedAt,
+		})
+	}
+
+	return data, nil
+}
@@ -10,4 +10,13 @@ var (
 	ErrInvalidParameter = errors.New("invalid parameter")
 
 	ErrNotEnoughBalance = errors.New("minimal amount to claim")
+
+	// ErrClaimInProgress indicates that the previous rewards claim of the user is not finished yet.
+	ErrClaimInProgress = errors.New("previous rewards claim is still in progress, try again later")
+
+	// ErrClaimPending indicates that the claim transaction is sent, but its result is unknown yet.
+	ErrClaimPending = errors.New("rewards claim is pending, the balance will be updated once the transaction is processed")
+
+	// ErrInvalidClaimTransition indicates that reward claim can't be moved to the requested status.
+	ErrInvalidClaimTransition = errors.New("invalid reward claim status transition")
 )
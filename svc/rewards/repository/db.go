@@ -22,11 +22,26 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.addRewardClaimItemStmt, err = db.PrepareContext(ctx, addRewardClaimItem); err != nil {
+		return nil, fmt.Errorf("error preparing query AddRewardClaimItem: %w", err)
+	}
 	if q.addTransactionStmt, err = db.PrepareContext(ctx, addTransaction); err != nil {
 		return nil, fmt.Errorf("error preparing query AddTransaction: %w", err)
 	}
-	if q.getAmountAvailableToWithdrawStmt, err = db.PrepareContext(ctx, getAmountAvailableToWithdraw); err != nil {
-		return nil, fmt.Errorf("error preparing query GetAmountAvailableToWithdraw: %w", err)
+	if q.claimRewardStmt, err = db.PrepareContext(ctx, claimReward); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimReward: %w", err)
+	}
+	if q.createRewardClaimStmt, err = db.PrepareContext(ctx, createRewardClaim); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRewardClaim: %w", err)
+	}
+	if q.getRewardClaimItemsStmt, err = db.PrepareContext(ctx, getRewardClaimItems); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRewardClaimItems: %w", err)
+	}
+	if q.getRewardClaimsByStatusStmt, err = db.PrepareContext(ctx, getRewardClaimsByStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRewardClaimsByStatus: %w", err)
+	}
+	if q.getRewardClaimsByUserIDStmt, err = db.PrepareContext(ctx, getRewardClaimsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRewardClaimsByUserID: %w", err)
 	}
 	if q.getScannedQRCodeByUserIDStmt, err = db.PrepareContext(ctx, getScannedQRCodeByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetScannedQRCodeByUserID: %w", err)
@@ -34,9 +49,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getTotalAmountStmt, err = db.PrepareContext(ctx, getTotalAmount); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTotalAmount: %w", err)
 	}
+	if q.getTransactionsByUserIDStmt, err = db.PrepareContext(ctx, getTransactionsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTransactionsByUserID: %w", err)
+	}
 	if q.getTransactionsByUserIDPaginatedStmt, err = db.PrepareContext(ctx, getTransactionsByUserIDPaginated); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTransactionsByUserIDPaginated: %w", err)
 	}
+	if q.getUnclaimedRewardsByUserIDStmt, err = db.PrepareContext(ctx, getUnclaimedRewardsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUnclaimedRewardsByUserID: %w", err)
+	}
+	if q.submitRewardClaimStmt, err = db.PrepareContext(ctx, submitRewardClaim); err != nil {
+		return nil, fmt.Errorf("error preparing query SubmitRewardClaim: %w", err)
+	}
+	if q.unclaimRewardStmt, err = db.PrepareContext(ctx, unclaimReward); err != nil {
+		return nil, fmt.Errorf("error preparing query UnclaimReward: %w", err)
+	}
+	if q.updateRewardClaimStatusStmt, err = db.PrepareContext(ctx, updateRewardClaimStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateRewardClaimStatus: %w", err)
+	}
 	if q.withdrawStmt, err = db.PrepareContext(ctx, withdraw); err != nil {
 		return nil, fmt.Errorf("error preparing query Withdraw: %w", err)
 	}
@@ -45,14 +75,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 
 func (q *Queries) Close() error {
 	var err error
+	if q.addRewardClaimItemStmt != nil {
+		if cerr := q.addRewardClaimItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addRewardClaimItemStmt: %w", cerr)
+		}
+	}
 	if q.addTransactionStmt != nil {
 		if cerr := q.addTransactionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addTransactionStmt: %w", cerr)
 		}
 	}
-	if q.getAmountAvailableToWithdrawStmt != nil {
-		if cerr := q.getAmountAvailableToWithdrawStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getAmountAvailableToWithdrawStmt: %w", cerr)
+	if q.claimRewardStmt != nil {
+		if cerr := q.claimRewardStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimRewardStmt: %w", cerr)
+		}
+	}
+	if q.createRewardClaimStmt != nil {
+		if cerr := q.createRewardClaimStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRewardClaimStmt: %w", cerr)
+		}
+	}
+	if q.getRewardClaimItemsStmt != nil {
+		if cerr := q.getRewardClaimItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRewardClaimItemsStmt: %w", cerr)
+		}
+	}
+	if q.getRewardClaimsByStatusStmt != nil {
+		if cerr := q.getRewardClaimsByStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRewardClaimsByStatusStmt: %w", cerr)
+		}
+	}
+	if q.getRewardClaimsByUserIDStmt != nil {
+		if cerr := q.getRewardClaimsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRewardClaimsByUserIDStmt: %w", cerr)
 		}
 	}
 	if q.getScannedQRCodeByUserIDStmt != nil {
@@ -65,11 +120,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getTotalAmountStmt: %w", cerr)
 		}
 	}
+	if q.getTransactionsByUserIDStmt != nil {
+		if cerr := q.getTransactionsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTransactionsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getTransactionsByUserIDPaginatedStmt != nil {
 		if cerr := q.getTransactionsByUserIDPaginatedStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTransactionsByUserIDPaginatedStmt: %w", cerr)
 		}
 	}
+	if q.getUnclaimedRewardsByUserIDStmt != nil {
+		if cerr := q.getUnclaimedRewardsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUnclaimedRewardsByUserIDStmt: %w", cerr)
+		}
+	}
+	if q.submitRewardClaimStmt != nil {
+		if cerr := q.submitRewardClaimStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing submitRewardClaimStmt: %w", cerr)
+		}
+	}
+	if q.unclaimRewardStmt != nil {
+		if cerr := q.unclaimRewardStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing unclaimRewardStmt: %w", cerr)
+		}
+	}
+	if q.updateRewardClaimStatusStmt != nil {
+		if cerr := q.updateRewardClaimStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateRewardClaimStatusStmt: %w", cerr)
+		}
+	}
 	if q.withdrawStmt != nil {
 		if cerr := q.withdrawStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing withdrawStmt: %w", cerr)
@@ -114,11 +194,21 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 type Queries struct {
 	db                                   DBTX
 	tx                                   *sql.Tx
+	addRewardClaimItemStmt               *sql.Stmt
 	addTransactionStmt                   *sql.Stmt
-	getAmountAvailableToWithdrawStmt     *sql.Stmt
+	claimRewardStmt                      *sql.Stmt
+	createRewardClaimStmt                *sql.Stmt
+	getRewardClaimItemsStmt              *sql.Stmt
+	getRewardClaimsByStatusStmt          *sql.Stmt
+	getRewardClaimsByUserIDStmt          *sql.Stmt
 	getScannedQRCodeByUserIDStmt         *sql.Stmt
 	getTotalAmountStmt                   *sql.Stmt
+	getTransactionsByUserIDStmt          *sql.Stmt
 	getTransactionsByUserIDPaginatedStmt *sql.Stmt
+	getUnclaimedRewardsByUserIDStmt      *sql.Stmt
+	submitRewardClaimStmt                *sql.Stmt
+	unclaimRewardStmt                    *sql.Stmt
+	updateRewardClaimStatusStmt          *sql.Stmt
 	withdrawStmt                         *sql.Stmt
 }
 
@@ -126,11 +216,21 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
 		db:                                   tx,
 		tx:                                   tx,
+		addRewardClaimItemStmt:               q.addRewardClaimItemStmt,
 		addTransactionStmt:                   q.addTransactionStmt,
-		getAmountAvailableToWithdrawStmt:     q.getAmountAvailableToWithdrawStmt,
+		claimRewardStmt:                      q.claimRewardStmt,
+		createRewardClaimStmt:                q.createRewardClaimStmt,
+		getRewardClaimItemsStmt:              q.getRewardClaimItemsStmt,
+		getRewardClaimsByStatusStmt:          q.getRewardClaimsByStatusStmt,
+		getRewardClaimsByUserIDStmt:          q.getRewardClaimsByUserIDStmt,
 		getScannedQRCodeByUserIDStmt:         q.getScannedQRCodeByUserIDStmt,
 		getTotalAmountStmt:                   q.getTotalAmountStmt,
+		getTransactionsByUserIDStmt:          q.getTransactionsByUserIDStmt,
 		getTransactionsByUserIDPaginatedStmt: q.getTransactionsByUserIDPaginatedStmt,
+		getUnclaimedRewardsByUserIDStmt:      q.getUnclaimedRewardsByUserIDStmt,
+		submitRewardClaimStmt:                q.submitRewardClaimStmt,
+		unclaimRewardStmt:                    q.unclaimRewardStmt,
+		updateRewardClaimStatusStmt:          q.updateRewardClaimStatusStmt,
 		withdrawStmt:                         q.withdrawStmt,
 	}
 }
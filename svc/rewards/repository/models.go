@@ -19,4 +19,24 @@ type Reward struct {
 	CreatedAt       time.Time      `json:"created_at"`
 	TransactionType int32          `json:"transaction_type"`
 	RelationType    sql.NullString `json:"relation_type"`
+	HoldUntil       time.Time      `json:"hold_until"`
+	VestingUntil    sql.NullTime   `json:"vesting_until"`
+	ClaimedAmount   float64        `json:"claimed_amount"`
+}
+
+type RewardClaim struct {
+	ID          uuid.UUID      `json:"id"`
+	UserID      uuid.UUID      `json:"user_id"`
+	Amount      float64        `json:"amount"`
+	Status      string         `json:"status"`
+	TxHash      sql.NullString `json:"tx_hash"`
+	UpdatedAt   sql.NullTime   `json:"updated_at"`
+	CreatedAt   time.Time      `json:"created_at"`
+	TxBlockhash sql.NullString `json:"tx_blockhash"`
+}
+
+type RewardClaimItem struct {
+	ClaimID  uuid.UUID `json:"claim_id"`
+	RewardID uuid.UUID `json:"reward_id"`
+	Amount   float64   `json:"amount"`
 }
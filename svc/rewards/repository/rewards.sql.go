@@ -17,14 +17,18 @@ INSERT INTO rewards (
         relation_id,
         relation_type,
         transaction_type,
-        amount
+        amount,
+        hold_until,
+        vesting_until
     )
 VALUES (
         $1,
         $2,
         $3,
         $4,
-        $5
+        $5,
+        $6,
+        $7
     )
 `
 
@@ -34,6 +38,8 @@ type AddTransactionParams struct {
 	RelationType    sql.NullString `json:"relation_type"`
 	TransactionType int32          `json:"transaction_type"`
 	Amount          float64        `json:"amount"`
+	HoldUntil       time.Time      `json:"hold_until"`
+	VestingUntil    sql.NullTime   `json:"vesting_until"`
 }
 
 func (q *Queries) AddTransaction(ctx context.Context, arg AddTransactionParams) error {
@@ -43,34 +49,32 @@ func (q *Queries) AddTransaction(ctx context.Context, arg AddTransactionParams)
 		arg.RelationType,
 		arg.TransactionType,
 		arg.Amount,
+		arg.HoldUntil,
+		arg.VestingUntil,
 	)
 	return err
 }
 
-const getAmountAvailableToWithdraw = `-- name: GetAmountAvailableToWithdraw :one
-SELECT SUM(amount)::DOUBLE PRECISION
-FROM rewards
-WHERE user_id = $1
-AND withdrawn = FALSE
+const claimReward = `-- name: ClaimReward :exec
+UPDATE rewards
+SET claimed_amount = claimed_amount + $1,
+    withdrawn = (claimed_amount + $1 >= amount)
+WHERE id = $2
 AND transaction_type = 1
-AND created_at < $2
-GROUP BY user_id
 `
 
-type GetAmountAvailableToWithdrawParams struct {
-	UserID       uuid.UUID `json:"user_id"`
-	NotAfterDate time.Time `json:"not_after_date"`
+type ClaimRewardParams struct {
+	Amount float64   `json:"amount"`
+	ID     uuid.UUID `json:"id"`
 }
 
-func (q *Queries) GetAmountAvailableToWithdraw(ctx context.Context, arg GetAmountAvailableToWithdrawParams) (float64, error) {
-	row := q.queryRow(ctx, q.getAmountAvailableToWithdrawStmt, getAmountAvailableToWithdraw, arg.UserID, arg.NotAfterDate)
-	var column_1 float64
-	err := row.Scan(&column_1)
-	return column_1, err
+func (q *Queries) ClaimReward(ctx context.Context, arg ClaimRewardParams) error {
+	_, err := q.exec(ctx, q.claimRewardStmt, claimReward, arg.Amount, arg.ID)
+	return err
 }
 
 const getScannedQRCodeByUserID = `-- name: GetScannedQRCodeByUserID :one
-SELECT id, user_id, relation_id, amount, withdrawn, updated_at, created_at, transaction_type, relation_type
+SELECT id, user_id, relation_id, amount, withdrawn, updated_at, created_at, transaction_type, relation_type, hold_until, vesting_until, claimed_amount
 FROM rewards
 WHERE user_id = $1 AND relation_id = $2 AND relation_type =$3
     LIMIT 1
@@ -95,12 +99,15 @@ func (q *Queries) GetScannedQRCodeByUserID(ctx context.Context, arg GetScannedQR
 		&i.CreatedAt,
 		&i.TransactionType,
 		&i.RelationType,
+		&i.HoldUntil,
+		&i.VestingUntil,
+		&i.ClaimedAmount,
 	)
 	return i, err
 }
 
 const getTotalAmount = `-- name: GetTotalAmount :one
-SELECT SUM(amount)::DOUBLE PRECISION
+SELECT SUM(amount - claimed_amount)::DOUBLE PRECISION
 FROM rewards
 WHERE user_id = $1
 AND withdrawn = FALSE
@@ -115,8 +122,51 @@ func (q *Queries) GetTotalAmount(ctx context.Context, userID uuid.UUID) (float64
 	return column_1, err
 }
 
+const getTransactionsByUserID = `-- name: GetTransactionsByUserID :many
+SELECT id, user_id, relation_id, amount, withdrawn, updated_at, created_at, transaction_type, relation_type, hold_until, vesting_until, claimed_amount
+FROM rewards
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetTransactionsByUserID(ctx context.Context, userID uuid.UUID) ([]Reward, error) {
+	rows, err := q.query(ctx, q.getTransactionsByUserIDStmt, getTransactionsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Reward
+	for rows.Next() {
+		var i Reward
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.RelationID,
+			&i.Amount,
+			&i.Withdrawn,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.TransactionType,
+			&i.RelationType,
+			&i.HoldUntil,
+			&i.VestingUntil,
+			&i.ClaimedAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTransactionsByUserIDPaginated = `-- name: GetTransactionsByUserIDPaginated :many
-SELECT id, user_id, relation_id, amount, withdrawn, updated_at, created_at, transaction_type, relation_type
+SELECT id, user_id, relation_id, amount, withdrawn, updated_at, created_at, transaction_type, relation_type, hold_until, vesting_until, claimed_amount
 FROM rewards
 WHERE user_id = $1
 ORDER BY created_at DESC
@@ -148,6 +198,9 @@ func (q *Queries) GetTransactionsByUserIDPaginated(ctx context.Context, arg GetT
 			&i.CreatedAt,
 			&i.TransactionType,
 			&i.RelationType,
+			&i.HoldUntil,
+			&i.VestingUntil,
+			&i.ClaimedAmount,
 		); err != nil {
 			return nil, err
 		}
@@ -162,9 +215,73 @@ func (q *Queries) GetTransactionsByUserIDPaginated(ctx context.Context, arg GetT
 	return items, nil
 }
 
+const getUnclaimedRewardsByUserID = `-- name: GetUnclaimedRewardsByUserID :many
+SELECT id, user_id, relation_id, amount, withdrawn, updated_at, created_at, transaction_type, relation_type, hold_until, vesting_until, claimed_amount
+FROM rewards
+WHERE user_id = $1
+AND withdrawn = FALSE
+AND transaction_type = 1
+ORDER BY hold_until ASC
+`
+
+func (q *Queries) GetUnclaimedRewardsByUserID(ctx context.Context, userID uuid.UUID) ([]Reward, error) {
+	rows, err := q.query(ctx, q.getUnclaimedRewardsByUserIDStmt, getUnclaimedRewardsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Reward
+	for rows.Next() {
+		var i Reward
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.RelationID,
+			&i.Amount,
+			&i.Withdrawn,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.TransactionType,
+			&i.RelationType,
+			&i.HoldUntil,
+			&i.VestingUntil,
+			&i.ClaimedAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unclaimReward = `-- name: UnclaimReward :exec
+UPDATE rewards
+SET claimed_amount = GREATEST(claimed_amount - $1, 0),
+    withdrawn = FALSE
+WHERE id = $2
+AND transaction_type = 1
+`
+
+type UnclaimRewardParams struct {
+	Amount float64   `json:"amount"`
+	ID     uuid.UUID `json:"id"`
+}
+
+func (q *Queries) UnclaimReward(ctx context.Context, arg UnclaimRewardParams) error {
+	_, err := q.exec(ctx, q.unclaimRewardStmt, unclaimReward, arg.Amount, arg.ID)
+	return err
+}
+
 const withdraw = `-- name: Withdraw :exec
 UPDATE rewards
-SET withdrawn = TRUE
+SET withdrawn = TRUE,
+    claimed_amount = amount
 WHERE user_id = $1
 AND transaction_type = 1
 `
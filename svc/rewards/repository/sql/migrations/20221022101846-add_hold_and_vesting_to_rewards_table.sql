@@ -0,0 +1,12 @@
+-- +migrate Up
+ALTER TABLE rewards
+    ADD COLUMN hold_until TIMESTAMP NOT NULL DEFAULT now(),
+    ADD COLUMN vesting_until TIMESTAMP DEFAULT NULL,
+    ADD COLUMN claimed_amount DOUBLE PRECISION NOT NULL DEFAULT 0;
+UPDATE rewards SET hold_until = created_at;
+UPDATE rewards SET claimed_amount = amount WHERE withdrawn = TRUE AND transaction_type = 1;
+-- +migrate Down
+ALTER TABLE rewards
+    DROP COLUMN hold_until,
+    DROP COLUMN vesting_until,
+    DROP COLUMN claimed_amount;
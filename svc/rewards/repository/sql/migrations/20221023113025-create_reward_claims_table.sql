@@ -0,0 +1,28 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS reward_claims (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'created',
+    tx_hash VARCHAR DEFAULT NULL,
+    updated_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+-- only one claim per user can be in progress
+CREATE UNIQUE INDEX reward_claims_user_id_in_progress ON reward_claims USING BTREE (user_id) WHERE (status IN ('created', 'submitted'));
+CREATE INDEX reward_claims_status ON reward_claims USING BTREE (status);
+CREATE TRIGGER update_reward_claims_modtime BEFORE
+UPDATE ON reward_claims FOR EACH ROW EXECUTE PROCEDURE rewards_update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS reward_claim_items (
+    claim_id uuid NOT NULL,
+    reward_id uuid NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (claim_id, reward_id),
+    FOREIGN KEY (claim_id) REFERENCES reward_claims(id) ON DELETE CASCADE,
+    FOREIGN KEY (reward_id) REFERENCES rewards(id) ON DELETE CASCADE
+);
+-- +migrate Down
+DROP TABLE IF EXISTS reward_claim_items;
+DROP TRIGGER IF EXISTS update_reward_claims_modtime ON reward_claims;
+DROP TABLE IF EXISTS reward_claims;
@@ -0,0 +1,6 @@
+-- +migrate Up
+ALTER TABLE reward_claims
+    ADD COLUMN tx_blockhash VARCHAR DEFAULT NULL;
+
+-- +migrate Down
+ALTER TABLE reward_claims DROP COLUMN tx_blockhash;
@@ -0,0 +1,38 @@
+-- name: CreateRewardClaim :one
+INSERT INTO reward_claims (user_id, amount)
+VALUES (@user_id, @amount) RETURNING *;
+
+-- name: AddRewardClaimItem :exec
+INSERT INTO reward_claim_items (claim_id, reward_id, amount)
+VALUES (@claim_id, @reward_id, @amount);
+
+-- name: GetRewardClaimItems :many
+SELECT *
+FROM reward_claim_items
+WHERE claim_id = @claim_id;
+
+-- name: GetRewardClaimsByStatus :many
+SELECT *
+FROM reward_claims
+WHERE status = @status
+ORDER BY created_at ASC;
+
+-- name: GetRewardClaimsByUserID :many
+SELECT *
+FROM reward_claims
+WHERE user_id = @user_id
+ORDER BY created_at DESC;
+
+-- name: SubmitRewardClaim :one
+UPDATE reward_claims
+SET status = 'submitted',
+    tx_hash = @tx_hash,
+    tx_blockhash = @tx_blockhash
+WHERE id = @id
+AND status = 'created' RETURNING *;
+
+-- name: UpdateRewardClaimStatus :one
+UPDATE reward_claims
+SET status = @status
+WHERE id = @id
+AND status = @current_status RETURNING *;
@@ -4,39 +4,63 @@ INSERT INTO rewards (
         relation_id,
         relation_type,
         transaction_type,
-        amount
+        amount,
+        hold_until,
+        vesting_until
     )
 VALUES (
         @user_id,
         @relation_id,
         @relation_type,
         @transaction_type,
-        @amount
+        @amount,
+        @hold_until,
+        @vesting_until
     );
 
 -- name: Withdraw :exec
 UPDATE rewards
-SET withdrawn = TRUE
+SET withdrawn = TRUE,
+    claimed_amount = amount
 WHERE user_id = @user_id
 AND transaction_type = 1;
 
--- name: GetTotalAmount :one
-SELECT SUM(amount)::DOUBLE PRECISION
+-- name: ClaimReward :exec
+UPDATE rewards
+SET claimed_amount = claimed_amount + @amount,
+    withdrawn = (claimed_amount + @amount >= amount)
+WHERE id = @id
+AND transaction_type = 1;
+
+-- name: UnclaimReward :exec
+UPDATE rewards
+SET claimed_amount = GREATEST(claimed_amount - @amount, 0),
+    withdrawn = FALSE
+WHERE id = @id
+AND transaction_type = 1;
+
+-- name: GetUnclaimedRewardsByUserID :many
+SELECT *
 FROM rewards
 WHERE user_id = @user_id
 AND withdrawn = FALSE
 AND transaction_type = 1
-GROUP BY user_id;
+ORDER BY hold_until ASC;
 
--- name: GetAmountAvailableToWithdraw :one
-SELECT SUM(amount)::DOUBLE PRECISION
+-- name: GetTotalAmount :one
+SELECT SUM(amount - claimed_amount)::DOUBLE PRECISION
 FROM rewards
 WHERE user_id = @user_id
 AND withdrawn = FALSE
 AND transaction_type = 1
-AND created_at < @not_after_date
 GROUP BY user_id;
 
+-- name: GetTransactionsByUserID :many
+SELECT *
+FROM rewards
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
 -- name: GetTransactionsByUserIDPaginated :many
 SELECT *
 FROM rewards
@@ -4,11 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/SatorNetwork/sator-api/svc/qrcodes"
 
 	"github.com/SatorNetwork/sator-api/lib/db"
+	lib_solana "github.com/SatorNetwork/sator-api/lib/solana"
+	"github.com/SatorNetwork/sator-api/lib/totp"
+	"github.com/SatorNetwork/sator-api/svc/campaigns"
 	"github.com/SatorNetwork/sator-api/svc/rewards/repository"
 	"github.com/SatorNetwork/sator-api/svc/wallet"
 
@@ -25,6 +29,7 @@ const (
 type (
 	// Service struct
 	Service struct {
+		db                *sql.DB
 		repo              rewardsRepository
 		ws                walletService
 		getLocker         db.GetLocker
@@ -32,6 +37,16 @@ type (
 		explorerURLTmpl   string
 		holdRewardsPeriod time.Duration
 		minAmountToClaim  float64 // minimum amount to claim rewards
+
+		holdPeriods      map[string]time.Duration // hold period per relation type, overrides holdRewardsPeriod
+		vestingThreshold float64                  // min reward amount to be vested
+		vestingPeriod    time.Duration            // period of linear vesting after hold, vesting is disabled if zero
+
+		txChecker                txStatusChecker // reward claims reconciliation is disabled if nil
+		claimConfirmationTimeout time.Duration   // claim is reverted if its transaction is not signed in time
+
+		trackEvent      trackEventFunc     // reports user's activity to reward campaigns
+		verifyTwoFactor twoFactorCheckFunc // checks step-up code of sensitive actions, skipped if nil
 	}
 
 	Winner struct {
@@ -41,13 +56,36 @@ type (
 
 	rewardsRepository interface {
 		AddTransaction(ctx context.Context, arg repository.AddTransactionParams) error
-		Withdraw(ctx context.Context, uid uuid.UUID) error
+		ClaimReward(ctx context.Context, arg repository.ClaimRewardParams) error
 		GetTotalAmount(ctx context.Context, userID uuid.UUID) (float64, error)
+		GetUnclaimedRewardsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.Reward, error)
+		GetTransactionsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.Reward, error)
 		GetTransactionsByUserIDPaginated(ctx context.Context, arg repository.GetTransactionsByUserIDPaginatedParams) ([]repository.Reward, error)
-		// GetAmountAvailableToWithdraw(ctx context.Context, arg repository.GetAmountAvailableToWithdrawParams) (float64, error)
 		GetScannedQRCodeByUserID(ctx context.Context, arg repository.GetScannedQRCodeByUserIDParams) (repository.Reward, error)
+		UnclaimReward(ctx context.Context, arg repository.UnclaimRewardParams) error
+
+		AddRewardClaimItem(ctx context.Context, arg repository.AddRewardClaimItemParams) error
+		CreateRewardClaim(ctx context.Context, arg repository.CreateRewardClaimParams) (repository.RewardClaim, error)
+		GetRewardClaimItems(ctx context.Context, claimID uuid.UUID) ([]repository.RewardClaimItem, error)
+		GetRewardClaimsByStatus(ctx context.Context, status string) ([]repository.RewardClaim, error)
+		GetRewardClaimsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.RewardClaim, error)
+		SubmitRewardClaim(ctx context.Context, arg repository.SubmitRewardClaimParams) (repository.RewardClaim, error)
+		UpdateRewardClaimStatus(ctx context.Context, arg repository.UpdateRewardClaimStatusParams) (repository.RewardClaim, error)
+
+		WithTx(tx *sql.Tx) *repository.Queries
+	}
+
+	// txStatusChecker looks up blockchain transactions by signature
+	txStatusChecker interface {
+		GetTransactionStatus(ctx context.Context, txhash string) (lib_solana.TransactionStatus, error)
+		IsBlockhashValid(ctx context.Context, blockhash string) (bool, error)
 	}
 
+	trackEventFunc func(ctx context.Context, userID uuid.UUID, event string)
+
+	// twoFactorCheckFunc returns error if the action requires step-up code and the code is missed or invalid
+	twoFactorCheckFunc func(ctx context.Context, userID uuid.UUID, action string) error
+
 	ClaimRewardsResult struct {
 		DisplayAmount   string  `json:"amount"`
 		TransactionURL  string  `json:"transaction_url"`
@@ -56,7 +94,7 @@ type (
 	}
 
 	walletService interface {
-		WithdrawRewards(ctx context.Context, userID uuid.UUID, amount float64) (string, error)
+		WithdrawRewards(ctx context.Context, userID uuid.UUID, amount float64, onSigned wallet.SignedTxFunc) (string, error)
 	}
 
 	// Option func to set custom service options
@@ -65,8 +103,9 @@ type (
 
 // NewService is a factory function,
 // returns a new instance of the Service interface implementation
-func NewService(repo rewardsRepository, ws walletService, getLocker db.GetLocker, opt ...Option) *Service {
+func NewService(dbConn *sql.DB, repo rewardsRepository, ws walletService, getLocker db.GetLocker, opt ...Option) *Service {
 	s := &Service{
+		db:                dbConn,
 		repo:              repo,
 		ws:                ws,
 		getLocker:         getLocker,
@@ -74,12 +113,19 @@ func NewService(repo rewardsRepository, ws walletService, getLocker db.GetLocker
 		explorerURLTmpl:   "https://explorer.solana.com/tx/%s?cluster=devnet",
 		holdRewardsPeriod: time.Hour * 24 * 30,
 		minAmountToClaim:  0,
+		holdPeriods:       make(map[string]time.Duration),
+
+		claimConfirmationTimeout: time.Minute * 30,
 	}
 
 	for _, fn := range opt {
 		fn(s)
 	}
 
+	if s.txChecker != nil {
+		s.startClaimsReconciliation()
+	}
+
 	return s
 }
 
@@ -88,7 +134,7 @@ func (s *Service) GetMinAmountToClaim() float64 {
 }
 
 func (s *Service) GetRewardsWallet(ctx context.Context, userID, walletID uuid.UUID) (wallet.Wallet, error) {
-	totalRewards, _, err := s.GetUserRewards(ctx, userID)
+	balance, err := s.GetRewardsBalance(ctx, userID)
 	if err != nil {
 		return wallet.Wallet{}, fmt.Errorf("could  not get rewards wallet: %w", err)
 	}
@@ -99,12 +145,22 @@ func (s *Service) GetRewardsWallet(ctx context.Context, userID, walletID uuid.UU
 		Balance: []wallet.Balance{
 			{
 				Currency: "UNCLAIMED",
-				Amount:   totalRewards,
+				Amount:   balance.Total(),
+			},
+			{
+				Currency: "AVAILABLE",
+				Amount:   balance.Available,
+			},
+			{
+				Currency: "ON_HOLD",
+				Amount:   balance.OnHold,
+				UnlockAt: formatUnlockDate(balance.OnHoldUntil),
+			},
+			{
+				Currency: "VESTING",
+				Amount:   balance.Vesting,
+				UnlockAt: formatUnlockDate(balance.VestingUntil),
 			},
-			// {
-			// 	Currency: "Available to claim",
-			// 	Amount:   availableRewards,
-			// },
 		},
 		Actions: []wallet.Action{{
 			Type: wallet.ActionClaimRewards.String(),
@@ -116,71 +172,126 @@ func (s *Service) GetRewardsWallet(ctx context.Context, userID, walletID uuid.UU
 
 // AddTransaction ...
 func (s *Service) AddTransaction(ctx context.Context, uid, relationID uuid.UUID, relationType string, amount float64, trType int32) error {
-	if err := s.repo.AddTransaction(ctx, repository.AddTransactionParams{
+	arg := repository.AddTransactionParams{
 		UserID:          uid,
 		RelationID:      uuid.NullUUID{UUID: relationID, Valid: true},
 		Amount:          amount,
 		TransactionType: trType,
 		RelationType:    sql.NullString{String: relationType, Valid: true},
-	}); err != nil {
+		HoldUntil:       time.Now(),
+	}
+	if trType == TransactionTypeDeposit {
+		arg.HoldUntil, arg.VestingUntil = s.rewardSchedule(relationType, amount, arg.HoldUntil)
+	}
+
+	if err := s.repo.AddTransaction(ctx, arg); err != nil {
 		return fmt.Errorf("could not add transaction for user_id=%s, relation_id=%s, relation_type=%s: %w", uid.String(), relationID.String(), relationType, err)
 	}
 
 	return nil
 }
 
-// ClaimRewards send rewards to user by it and sets them to withdrawn.
+// ClaimRewards sends available rewards to user and marks them as claimed.
+// Rewards on hold and not vested part of rewards stay in the rewards wallet.
+// Claimed rewards are reserved before sending transaction and returned back
+// if the transaction failed, see ReconcileClaims.
 func (s *Service) ClaimRewards(ctx context.Context, uid uuid.UUID) (ClaimRewardsResult, error) {
-	// id := fmt.Sprintf("claim-rewards-%v", uid.String())
-	// lock, err := s.getLocker.GetLock(ctx, id)
-	// if err != nil {
-	// 	return ClaimRewardsResult{}, fmt.Errorf("can't get lock by id: %v, err: %v", id, err)
-	// }
-
-	// ok, err := lock.Lock(ctx)
-	// if err != nil {
-	// 	return ClaimRewardsResult{}, fmt.Errorf("can't acquire a lock with id: %v, err: %v", id, err)
-	// }
-	// if !ok {
-	// 	return ClaimRewardsResult{}, fmt.Errorf("lock %v is already acquired", id)
-	// }
-
-	amount, err := s.repo.GetTotalAmount(ctx, uid)
+	if s.verifyTwoFactor != nil {
+		if err := s.verifyTwoFactor(ctx, uid, totp.ActionClaimRewards); err != nil {
+			return ClaimRewardsResult{}, err
+		}
+	}
+
+	id := fmt.Sprintf("claim-rewards-%v", uid.String())
+	lock, err := s.getLocker.GetLock(ctx, id)
 	if err != nil {
-		if db.IsNotFoundError(err) {
-			return ClaimRewardsResult{}, ErrRewardsAlreadyClaimed
+		return ClaimRewardsResult{}, fmt.Errorf("can't get lock by id: %v, err: %w", id, err)
+	}
+	defer func() {
+		// We should release a lock in any case, even if context was cancelled
+		ctxt, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		if err := lock.Unlock(ctxt); err != nil {
+			log.Printf("can't release a lock with id: %v, err: %v", id, err)
+		}
+	}()
+
+	ok, err := lock.Lock(ctx)
+	if err != nil {
+		return ClaimRewardsResult{}, fmt.Errorf("can't acquire a lock with id: %v, err: %w", id, err)
+	}
+	if !ok {
+		return ClaimRewardsResult{}, ErrClaimInProgress
+	}
+
+	unclaimed, err := s.repo.GetUnclaimedRewardsByUserID(ctx, uid)
+	if err != nil && !db.IsNotFoundError(err) {
+		return ClaimRewardsResult{}, fmt.Errorf("could not get unclaimed rewards: %w", err)
+	}
+
+	now := time.Now()
+	claims := make(map[uuid.UUID]float64, len(unclaimed))
+	var amount float64
+	for _, r := range unclaimed {
+		if a := claimableAmount(r, now); a > 0 {
+			claims[r.ID] = a
+			amount += a
 		}
+	}
+	amount = roundDown(amount)
 
-		return ClaimRewardsResult{}, fmt.Errorf("could not get total amount of rewards: %w", err)
+	if amount <= 0 {
+		return ClaimRewardsResult{}, ErrRewardsAlreadyClaimed
 	}
 
 	if amount < s.minAmountToClaim {
 		return ClaimRewardsResult{}, fmt.Errorf("%w: %.2f", ErrNotEnoughBalance, s.minAmountToClaim)
 	}
 
-	txHash, err := s.ws.WithdrawRewards(ctx, uid, amount)
+	claim, err := s.createClaim(ctx, uid, amount, claims)
 	if err != nil {
-		return ClaimRewardsResult{}, fmt.Errorf("could not create blockchain transaction: %w", err)
+		return ClaimRewardsResult{}, err
 	}
 
-	if err = s.repo.Withdraw(ctx, uid); err != nil {
-		return ClaimRewardsResult{}, fmt.Errorf("could not update rewards status: %w", err)
+	// signature of the transaction is stored before it's sent,
+	// so the claim is never reverted while its transaction still can be executed
+	var submitted bool
+	txHash, err := s.ws.WithdrawRewards(ctx, uid, amount, func(txHash, blockhash string) error {
+		c, err := s.repo.SubmitRewardClaim(ctx, repository.SubmitRewardClaimParams{
+			TxHash:      sql.NullString{String: txHash, Valid: true},
+			TxBlockhash: sql.NullString{String: blockhash, Valid: blockhash != ""},
+			ID:          claim.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("could not store transaction of reward claim %s: %w", claim.ID, err)
+		}
+		claim, submitted = c, true
+		return nil
+	})
+	if err != nil {
+		if !submitted {
+			// transaction has not been sent, so rewards are returned right away
+			if rerr := s.failClaim(context.Background(), claim); rerr != nil {
+				log.Printf("can't revert reward claim %s: %v", claim.ID, rerr)
+			}
+			return ClaimRewardsResult{}, fmt.Errorf("could not create blockchain transaction: %w", err)
+		}
+
+		// transaction may have reached blockchain, the claim is finished or reverted by reconciliation
+		log.Printf("reward claim %s: %v", claim.ID, err)
+		return ClaimRewardsResult{}, ErrClaimPending
 	}
 
-	if err := s.repo.AddTransaction(ctx, repository.AddTransactionParams{
-		UserID:          uid,
-		Amount:          amount,
-		TransactionType: TransactionTypeWithdraw,
-	}); err != nil {
-		return ClaimRewardsResult{}, fmt.Errorf("could not add reward: %w", err)
+	if s.txChecker == nil {
+		// transaction status can't be checked, so the claim is confirmed right away
+		if err := s.confirmClaim(context.Background(), claim); err != nil {
+			log.Printf("can't confirm reward claim %s: %v", claim.ID, err)
+		}
 	}
 
-	// We should release a lock in any case, even if context was cancelled
-	// TODO(evg): get timeout from config
-	// ctxt, _ := context.WithTimeout(context.Background(), 15 * time.Second)
-	// if err := lock.Unlock(ctxt); err != nil {
-	// 	return ClaimRewardsResult{}, fmt.Errorf("can't release a lock with id: %v, err: %v", id, err)
-	// }
+	if s.trackEvent != nil {
+		s.trackEvent(ctx, uid, campaigns.EventRewardsClaimed)
+	}
 
 	return ClaimRewardsResult{
 		Amount:          amount,
@@ -199,17 +310,29 @@ func (s *Service) GetUserRewards(ctx context.Context, uid uuid.UUID) (total floa
 		}
 	}
 
-	// available, err = s.repo.GetAmountAvailableToWithdraw(ctx, repository.GetAmountAvailableToWithdrawParams{
-	// 	UserID:       uid,
-	// 	NotAfterDate: time.Now().Add(-s.holdRewardsPeriod),
-	// })
-	// if err != nil {
-	// 	if !db.IsNotFoundError(err) {
-	// 		return 0, 0, fmt.Errorf("could not get available amount of rewards: %w", err)
-	// 	}
-	// }
+	balance, err := s.GetRewardsBalance(ctx, uid)
+	if err != nil {
+		return 0, 0, err
+	}
 
-	return total, 0, nil
+	return total, balance.Available, nil
+}
+
+// GetRewardsBalance returns user's unclaimed rewards split into available, on hold and vesting parts.
+func (s *Service) GetRewardsBalance(ctx context.Context, uid uuid.UUID) (RewardsBalance, error) {
+	unclaimed, err := s.repo.GetUnclaimedRewardsByUserID(ctx, uid)
+	if err != nil && !db.IsNotFoundError(err) {
+		return RewardsBalance{}, fmt.Errorf("could not get unclaimed rewards: %w", err)
+	}
+
+	return calculateRewardsBalance(unclaimed, time.Now()), nil
+}
+
+func formatUnlockDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
 }
 
 // GetTransactions returns list of transactions from rewards wallet.
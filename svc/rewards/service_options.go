@@ -1,6 +1,8 @@
 package rewards
 
-import "time"
+import (
+	"time"
+)
 
 // WithAssetName option
 // Default value: SAO
@@ -32,3 +34,56 @@ func WithMinAmountToClaim(amount float64) Option {
 		s.minAmountToClaim = amount
 	}
 }
+
+// WithHoldPeriods sets hold period per reward relation type,
+// rewards of other relation types are held for the period set by WithHoldRewardsPeriod.
+func WithHoldPeriods(periods map[string]time.Duration) Option {
+	return func(s *Service) {
+		for relationType, period := range periods {
+			s.holdPeriods[relationType] = period
+		}
+	}
+}
+
+// WithVesting enables linear vesting after hold period for rewards
+// with amount greater or equal to threshold.
+// Default value: disabled
+func WithVesting(threshold float64, period time.Duration) Option {
+	return func(s *Service) {
+		s.vestingThreshold = threshold
+		s.vestingPeriod = period
+	}
+}
+
+// WithTransactionStatusChecker enables reconciliation of reward claims:
+// submitted claims are confirmed or reverted according to their transaction status on chain.
+// Default value: disabled, claims are confirmed right after transaction is sent
+func WithTransactionStatusChecker(c txStatusChecker) Option {
+	return func(s *Service) {
+		s.txChecker = c
+	}
+}
+
+// WithClaimConfirmationTimeout sets period after which reward claim without signed transaction is reverted
+// Default value: 30 minutes
+func WithClaimConfirmationTimeout(timeout time.Duration) Option {
+	return func(s *Service) {
+		if timeout > 0 {
+			s.claimConfirmationTimeout = timeout
+		}
+	}
+}
+
+// WithCampaignEventFunc sets function to report claimed rewards to reward campaigns
+func WithCampaignEventFunc(fn trackEventFunc) Option {
+	return func(s *Service) {
+		s.trackEvent = fn
+	}
+}
+
+// WithTwoFactorCheckFunc sets function to check step-up code of rewards claims
+func WithTwoFactorCheckFunc(fn twoFactorCheckFunc) Option {
+	return func(s *Service) {
+		s.verifyTwoFactor = fn
+	}
+}
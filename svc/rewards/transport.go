@@ -2,10 +2,12 @@ package rewards
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/totp"
 	"github.com/SatorNetwork/sator-api/lib/utils"
 	"github.com/go-chi/chi/v5"
 	jwtkit "github.com/go-kit/kit/auth/jwt"
@@ -32,7 +34,7 @@ func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
 	options := []httptransport.ServerOption{
 		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
 		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
-		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+		httptransport.ServerBefore(jwtkit.HTTPToContext(), totp.ToContext()),
 	}
 
 	r.Get("/claim", httptransport.NewServer(
@@ -83,5 +85,13 @@ func decodeGetTransactionsRequest(_ context.Context, r *http.Request) (interface
 
 // returns http error code by error type
 func codeAndMessageFrom(err error) (int, interface{}) {
+	if errors.Is(err, ErrClaimInProgress) {
+		return http.StatusConflict, err.Error()
+	}
+
+	if errors.Is(err, ErrClaimPending) {
+		return http.StatusAccepted, err.Error()
+	}
+
 	return httpencoder.CodeAndMessageFrom(err)
 }
@@ -0,0 +1,129 @@
+package rewards
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/rewards/repository"
+)
+
+// RewardsBalance is unclaimed rewards amount split by availability
+type RewardsBalance struct {
+	Available    float64   `json:"available"`
+	OnHold       float64   `json:"on_hold"`
+	OnHoldUntil  time.Time `json:"on_hold_until,omitempty"` // the nearest date when a part of rewards on hold becomes available
+	Vesting      float64   `json:"vesting"`
+	VestingUntil time.Time `json:"vesting_until,omitempty"` // the date when all vesting rewards become available
+}
+
+// Total returns amount of all unclaimed rewards
+func (b RewardsBalance) Total() float64 {
+	return b.Available + b.OnHold + b.Vesting
+}
+
+// ParseHoldPeriods parses hold periods per relation type
+// from string like "quizzes:24h,invitation:720h".
+func ParseHoldPeriods(s string) (map[string]time.Duration, error) {
+	result := make(map[string]time.Duration)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		parts := strings.SplitN(item, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("%w: hold period must be in format relation_type:duration, got %s", ErrInvalidParameter, item)
+		}
+
+		d, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: hold period of %s: %v", ErrInvalidParameter, parts[0], err)
+		}
+		result[strings.TrimSpace(parts[0])] = d
+	}
+
+	return result, nil
+}
+
+// rewardSchedule returns the date when reward becomes available
+// and the date when it's fully vested, if reward is vested.
+func (s *Service) rewardSchedule(relationType string, amount float64, now time.Time) (holdUntil time.Time, vestingUntil sql.NullTime) {
+	holdPeriod, ok := s.holdPeriods[relationType]
+	if !ok {
+		holdPeriod = s.holdRewardsPeriod
+	}
+	holdUntil = now.Add(holdPeriod)
+
+	if s.vestingPeriod > 0 && s.vestingThreshold > 0 && amount >= s.vestingThreshold {
+		vestingUntil = sql.NullTime{Time: holdUntil.Add(s.vestingPeriod), Valid: true}
+	}
+
+	return holdUntil, vestingUntil
+}
+
+// unlockedAmount returns amount of reward available at the moment including already claimed part.
+// Vested rewards are unlocked linearly from the end of hold period until vesting end date.
+func unlockedAmount(r repository.Reward, now time.Time) float64 {
+	if now.Before(r.HoldUntil) {
+		return 0
+	}
+
+	if !r.VestingUntil.Valid || !now.Before(r.VestingUntil.Time) || !r.VestingUntil.Time.After(r.HoldUntil) {
+		return r.Amount
+	}
+
+	share := float64(now.Sub(r.HoldUntil)) / float64(r.VestingUntil.Time.Sub(r.HoldUntil))
+	return roundDown(r.Amount * share)
+}
+
+// claimableAmount returns amount of reward which can be claimed at the moment
+func claimableAmount(r repository.Reward, now time.Time) float64 {
+	amount := roundDown(unlockedAmount(r, now) - r.ClaimedAmount)
+	if amount < 0 {
+		return 0
+	}
+	return amount
+}
+
+// calculateRewardsBalance splits unclaimed rewards into available, on hold and vesting parts
+func calculateRewardsBalance(list []repository.Reward, now time.Time) RewardsBalance {
+	var b RewardsBalance
+	for _, r := range list {
+		rest := r.Amount - r.ClaimedAmount
+		if rest <= 0 {
+			continue
+		}
+
+		if now.Before(r.HoldUntil) {
+			b.OnHold += rest
+			if b.OnHoldUntil.IsZero() || r.HoldUntil.Before(b.OnHoldUntil) {
+				b.OnHoldUntil = r.HoldUntil
+			}
+			continue
+		}
+
+		claimable := claimableAmount(r, now)
+		b.Available += claimable
+		if locked := rest - claimable; locked > 0 && r.VestingUntil.Valid {
+			b.Vesting += locked
+			if r.VestingUntil.Time.After(b.VestingUntil) {
+				b.VestingUntil = r.VestingUntil.Time
+			}
+		}
+	}
+
+	b.Available = roundDown(b.Available)
+	b.OnHold = roundDown(b.OnHold)
+	b.Vesting = roundDown(b.Vesting)
+
+	return b
+}
+
+// roundDown rounds amount down to SAO precision
+func roundDown(amount float64) float64 {
+	return math.Floor(amount*1e9+1e-6) / 1e9
+}
@@ -0,0 +1,110 @@
+package rewards
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/svc/rewards/repository"
+)
+
+func TestUnlockedAmount(t *testing.T) {
+	now := time.Date(2022, time.October, 22, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		reward repository.Reward
+		want   float64
+	}{
+		{
+			name:   "on hold",
+			reward: repository.Reward{Amount: 100, HoldUntil: now.Add(time.Hour)},
+			want:   0,
+		},
+		{
+			name:   "hold period passed",
+			reward: repository.Reward{Amount: 100, HoldUntil: now.Add(-time.Hour)},
+			want:   100,
+		},
+		{
+			name: "half vested",
+			reward: repository.Reward{
+				Amount:       100,
+				HoldUntil:    now.Add(-5 * 24 * time.Hour),
+				VestingUntil: sql.NullTime{Time: now.Add(5 * 24 * time.Hour), Valid: true},
+			},
+			want: 50,
+		},
+		{
+			name: "fully vested",
+			reward: repository.Reward{
+				Amount:       100,
+				HoldUntil:    now.Add(-10 * 24 * time.Hour),
+				VestingUntil: sql.NullTime{Time: now.Add(-time.Hour), Valid: true},
+			},
+			want: 100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unlockedAmount(tt.reward, now); got != tt.want {
+				t.Errorf("unlockedAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateRewardsBalance(t *testing.T) {
+	now := time.Date(2022, time.October, 22, 12, 0, 0, 0, time.UTC)
+	holdUntil := now.Add(24 * time.Hour)
+	vestingUntil := now.Add(5 * 24 * time.Hour)
+
+	list := []repository.Reward{
+		// available, partially claimed
+		{Amount: 10, ClaimedAmount: 4, HoldUntil: now.Add(-time.Hour)},
+		// on hold
+		{Amount: 20, HoldUntil: holdUntil},
+		{Amount: 5, HoldUntil: holdUntil.Add(time.Hour)},
+		// half vested, 20 already claimed
+		{
+			Amount:        100,
+			ClaimedAmount: 20,
+			HoldUntil:     now.Add(-5 * 24 * time.Hour),
+			VestingUntil:  sql.NullTime{Time: vestingUntil, Valid: true},
+		},
+	}
+
+	got := calculateRewardsBalance(list, now)
+	if got.Available != 36 {
+		t.Errorf("Available = %v, want 36", got.Available)
+	}
+	if got.OnHold != 25 || !got.OnHoldUntil.Equal(holdUntil) {
+		t.Errorf("OnHold = %v until %v, want 25 until %v", got.OnHold, got.OnHoldUntil, holdUntil)
+	}
+	if got.Vesting != 50 || !got.VestingUntil.Equal(vestingUntil) {
+		t.Errorf("Vesting = %v until %v, want 50 until %v", got.Vesting, got.VestingUntil, vestingUntil)
+	}
+	if got.Total() != 111 {
+		t.Errorf("Total() = %v, want 111", got.Total())
+	}
+}
+
+func TestParseHoldPeriods(t *testing.T) {
+	got, err := ParseHoldPeriods("quizzes:24h, invitation:720h")
+	if err != nil {
+		t.Fatalf("ParseHoldPeriods() error = %v", err)
+	}
+	if got["quizzes"] != 24*time.Hour || got["invitation"] != 720*time.Hour || len(got) != 2 {
+		t.Errorf("ParseHoldPeriods() = %v", got)
+	}
+
+	if got, err := ParseHoldPeriods(""); err != nil || len(got) != 0 {
+		t.Errorf("ParseHoldPeriods(\"\") = %v, %v", got, err)
+	}
+
+	for _, s := range []string{"quizzes", "quizzes:1day", ":24h"} {
+		if _, err := ParseHoldPeriods(s); err == nil {
+			t.Errorf("ParseHoldPeriods(%q) expected error", s)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package shows
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// EraseUserData removes username and review texts from the user's episode ratings
+// and deletes the user's likes and dislikes of reviews on account deletion.
+// Numeric ratings are kept, so average ratings of episodes don't change.
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	if err := s.sr.AnonymizeRatingsByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not anonymize episode ratings: %w", err)
+	}
+	if err := s.sr.DeleteReviewsRatingsByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete review reactions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package shows
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+)
+
+type (
+	// ReviewsData is the episode ratings, reviews and review reactions of the user
+	// included into the data export archive.
+	ReviewsData struct {
+		Reviews   []ExportedReview         `json:"reviews"`
+		Reactions []ExportedReviewReaction `json:"reactions"`
+	}
+
+	// ExportedReview is a rating of the episode with optional review
+	ExportedReview struct {
+		ID        uuid.UUID `json:"id"`
+		EpisodeID uuid.UUID `json:"episode_id"`
+		Rating    int32     `json:"rating"`
+		Title     string    `json:"title,omitempty"`
+		Review    string    `json:"review,omitempty"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	// ExportedReviewReaction is a like or dislike of another user's review
+	ExportedReviewReaction struct {
+		ReviewID  uuid.UUID `json:"review_id"`
+		Reaction  string    `json:"reaction"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+)
+
+// ExportUserData returns episode ratings and reviews of the user
+// and their reactions to other reviews for the data export archive.
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	data := ReviewsData{
+		Reviews:   []ExportedReview{},
+		Reactions: []ExportedReviewReaction{},
+	}
+
+	ratings, err := s.sr.GetRatingsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get episode ratings: %w", err)
+	}
+	for _, r := range ratings {
+		data.Reviews = append(data.Reviews, ExportedReview{
+			ID:        r.ID,
+			EpisodeID: r.EpisodeID,
+			Rating:    r.Rating,
+			Title:     r.Title.String,
+			Review:    r.Review.String,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+
+	reactions, err := s.sr.GetReviewsRatingsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get review reactions: %w", err)
+	}
+	for _, r := range reactions {
+		reaction := "like"
+		if ReviewRatingType(r.RatingType.Int32) == DislikeReview {
+			reaction = "dislike"
+		}
+		data.Reactions = append(data.Reactions, ExportedReviewReaction{
+			ReviewID:  r.ReviewID,
+			Reaction:  reaction,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+
+	return data, nil
+}
@@ -283,21 +283,26 @@ type (
 )
 
 // MakeEndpoints ...
-func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
+// Content management endpoints are available for users with the permission globally
+// or on the show of the request, e.g. show runners of the show.
+func MakeEndpoints(s service, pc rbac.PermissionChecker, m ...endpoint.Middleware) Endpoints {
 	validateFunc := validator.ValidateStruct()
+	can := func(permission rbac.Permission, rf rbac.ResourceFunc) endpoint.Middleware {
+		return rbac.CheckPermissionMdw(pc, permission, rf)
+	}
 
 	e := Endpoints{
-		AddShow:              MakeAddShowEndpoint(s, validateFunc),
-		DeleteShowByID:       MakeDeleteShowByIDEndpoint(s),
+		AddShow:              can(rbac.PermissionShowsCreate, nil)(MakeAddShowEndpoint(s, validateFunc)),
+		DeleteShowByID:       can(rbac.PermissionShowsDelete, showResource)(MakeDeleteShowByIDEndpoint(s)),
 		GetShows:             MakeGetShowsEndpoint(s, validateFunc),
 		GetAllShows:          MakeGetAllShowsEndpoint(s, validateFunc),
 		GetShowsWithNFT:      MakeGetShowsEndpoint(s, validateFunc),
 		GetShowChallenges:    MakeGetShowChallengesEndpoint(s, validateFunc),
-		GetShowByID:          MakeGetShowByIDEndpoint(s),
+		GetShowByID:          can(rbac.PermissionShowsEdit, showResource)(MakeGetShowByIDEndpoint(s)),
 		GetPublishedShowByID: MakeGetPublishedShowByIDEndpoint(s),
 		GetShowsByCategory:   MakeGetShowsByCategoryEndpoint(s, validateFunc),
 		GetShowsByStatus:     MakeGetShowsByStatusEndpoint(s, validateFunc),
-		UpdateShow:           MakeUpdateShowEndpoint(s),
+		UpdateShow:           can(rbac.PermissionShowsEdit, showResource)(MakeUpdateShowEndpoint(s)),
 
 		AddShowCategory:        MakeAddShowCategoryEndpoint(s, validateFunc),
 		DeleteShowCategoryByID: MakeDeleteShowCategoryByIDEndpoint(s),
@@ -305,20 +310,20 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 		GetShowCategoryByID:    MakeGetShowCategoryByIDEndpoint(s),
 		GetShowCategories:      MakeGetShowCategoriesEndpoint(s, validateFunc),
 
-		AddSeason:        MakeAddSeasonEndpoint(s, validateFunc),
+		AddSeason:        can(rbac.PermissionEpisodesEdit, showResource)(MakeAddSeasonEndpoint(s, validateFunc)),
 		GetSeasonByID:    MakeGetSeasonByIDEndpoint(s, validateFunc),
-		DeleteSeasonByID: MakeDeleteSeasonByIDEndpoint(s, validateFunc),
+		DeleteSeasonByID: can(rbac.PermissionEpisodesEdit, showResource)(MakeDeleteSeasonByIDEndpoint(s, validateFunc)),
 
-		AddEpisode:                      MakeAddEpisodeEndpoint(s, validateFunc),
-		DeleteEpisodeByID:               MakeDeleteEpisodeByIDEndpoint(s, validateFunc),
+		AddEpisode:                      can(rbac.PermissionEpisodesEdit, showResource)(MakeAddEpisodeEndpoint(s, validateFunc)),
+		DeleteEpisodeByID:               can(rbac.PermissionEpisodesEdit, showResource)(MakeDeleteEpisodeByIDEndpoint(s, validateFunc)),
 		GetActivatedUserEpisodes:        MakeGetActivatedUserEpisodesEndpoint(s, validateFunc),
 		GetEpisodesByStatus:             MakeGetEpisodesByStatusEndpoint(s, validateFunc),
 		GetEpisodeByID:                  MakeGetEpisodeByIDEndpoint(s, validateFunc),
 		GetPublishedEpisodeByID:         MakeGetPublishedEpisodeByIDEndpoint(s, validateFunc),
 		GetEpisodesByShowID:             MakeGetEpisodesByShowIDEndpoint(s, validateFunc),
-		GetAllEpisodesByShowID:          MakeGetAllEpisodesByShowIDEndpoint(s, validateFunc),
+		GetAllEpisodesByShowID:          can(rbac.PermissionEpisodesEdit, showResource)(MakeGetAllEpisodesByShowIDEndpoint(s, validateFunc)),
 		GetEpisodeByIDWithShowAndSeason: MakeGetEpisodeByIDWithShowAndSeasonEndpoint(s),
-		UpdateEpisode:                   MakeUpdateEpisodeEndpoint(s, validateFunc),
+		UpdateEpisode:                   can(rbac.PermissionEpisodesEdit, showResource)(MakeUpdateEpisodeEndpoint(s, validateFunc)),
 
 		RateEpisode:            MakeRateEpisodeEndpoint(s, validateFunc),
 		ReviewEpisode:          MakeReviewEpisodeEndpoint(s, validateFunc),
@@ -382,6 +387,38 @@ func MakeEndpoints(s service, m ...endpoint.Middleware) Endpoints {
 	return e
 }
 
+// showResource returns the show of the content management request
+func showResource(_ context.Context, request interface{}) (rbac.Resource, error) {
+	var showID string
+	switch req := request.(type) {
+	case string:
+		showID = req
+	case UpdateShowRequest:
+		showID = req.ID
+	case AddSeasonRequest:
+		showID = req.ShowID
+	case DeleteSeasonByIDRequest:
+		showID = req.ShowID
+	case AddEpisodeRequest:
+		showID = req.ShowID
+	case UpdateEpisodeRequest:
+		showID = req.ShowID
+	case DeleteEpisodeByIDRequest:
+		showID = req.ShowID
+	case GetEpisodesByShowIDRequest:
+		showID = req.ShowID
+	default:
+		return rbac.Resource{}, fmt.Errorf("unexpected request type %T", request)
+	}
+
+	id, err := uuid.Parse(showID)
+	if err != nil {
+		return rbac.Resource{}, fmt.Errorf("%w show id: %v", ErrInvalidParameter, err)
+	}
+
+	return rbac.ShowResource(id), nil
+}
+
 // MakeGetShowsEndpoint ...
 func MakeGetShowsEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
@@ -467,10 +504,6 @@ func MakeGetShowChallengesEndpoint(s service, v validator.ValidateFunc) endpoint
 // MakeGetShowByIDEndpoint ...
 func MakeGetShowByIDEndpoint(s service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin, rbac.RoleContentManager); err != nil {
-			return nil, err
-		}
-
 		id, err := uuid.Parse(request.(string))
 		if err != nil {
 			return nil, fmt.Errorf("%w show id: %v", ErrInvalidParameter, err)
@@ -561,10 +594,6 @@ func MakeGetShowsByStatusEndpoint(s service, v validator.ValidateFunc) endpoint.
 // MakeAddShowEndpoint ...
 func MakeAddShowEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin, rbac.RoleContentManager); err != nil {
-			return nil, err
-		}
-
 		req := request.(AddShowRequest)
 		if err := v(req); err != nil {
 			return nil, err
@@ -601,10 +630,6 @@ func MakeAddShowEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint
 // MakeUpdateShowEndpoint ...
 func MakeUpdateShowEndpoint(s service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin, rbac.RoleContentManager); err != nil {
-			return nil, err
-		}
-
 		req := request.(UpdateShowRequest)
 
 		id, err := uuid.Parse(req.ID)
@@ -644,10 +669,6 @@ func MakeUpdateShowEndpoint(s service) endpoint.Endpoint {
 // MakeDeleteShowByIDEndpoint ...
 func MakeDeleteShowByIDEndpoint(s service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin, rbac.RoleContentManager); err != nil {
-			return nil, err
-		}
-
 		id, err := uuid.Parse(request.(string))
 		if err != nil {
 			return nil, fmt.Errorf("could not get show id: %w", err)
@@ -665,10 +686,6 @@ func MakeDeleteShowByIDEndpoint(s service) endpoint.Endpoint {
 // MakeAddEpisodeEndpoint ...
 func MakeAddEpisodeEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin, rbac.RoleContentManager); err != nil {
-			return nil, err
-		}
-
 		req := request.(AddEpisodeRequest)
 		if err := v(req); err != nil {
 			return nil, err
@@ -729,10 +746,6 @@ func MakeAddEpisodeEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoi
 // MakeUpdateEpisodeEndpoint ...
 func MakeUpdateEpisodeEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin, rbac.RoleContentManager); err != nil {
-			return nil, err
-		}
-
 		req := request.(UpdateEpisodeRequest)
 		if err := v(req); err != nil {
 			return nil, err
@@ -798,10 +811,6 @@ func MakeUpdateEpisodeEndpoint(s service, v validator.ValidateFunc) endpoint.End
 // MakeDeleteEpisodeByIDEndpoint ...
 func MakeDeleteEpisodeByIDEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin, rbac.RoleContentManager); err != nil {
-			return nil, err
-		}
-
 		req := request.(DeleteEpisodeByIDRequest)
 		if err := v(req); err != nil {
 			return nil, err
@@ -962,10 +971,6 @@ func MakeGetEpisodesByShowIDEndpoint(s service, v validator.ValidateFunc) endpoi
 // MakeGetAllEpisodesByShowIDEndpoint ...
 func MakeGetAllEpisodesByShowIDEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleContentManager, rbac.RoleAdmin); err != nil {
-			return nil, err
-		}
-
 		req := request.(GetEpisodesByShowIDRequest)
 		if err := v(req); err != nil {
 			return nil, err
@@ -988,10 +993,6 @@ func MakeGetAllEpisodesByShowIDEndpoint(s service, v validator.ValidateFunc) end
 // MakeAddSeasonEndpoint ...
 func MakeAddSeasonEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin, rbac.RoleContentManager); err != nil {
-			return nil, err
-		}
-
 		req := request.(AddSeasonRequest)
 		if err := v(req); err != nil {
 			return nil, err
@@ -1047,10 +1048,6 @@ func MakeGetSeasonByIDEndpoint(s service, v validator.ValidateFunc) endpoint.End
 // MakeDeleteSeasonByIDEndpoint ...
 func MakeDeleteSeasonByIDEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		if err := rbac.CheckRoleFromContext(ctx, rbac.RoleAdmin, rbac.RoleContentManager); err != nil {
-			return nil, err
-		}
-
 		req := request.(DeleteSeasonByIDRequest)
 		if err := v(req); err != nil {
 			return nil, err
@@ -45,6 +45,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.allReviewsListStmt, err = db.PrepareContext(ctx, allReviewsList); err != nil {
 		return nil, fmt.Errorf("error preparing query AllReviewsList: %w", err)
 	}
+	if q.anonymizeRatingsByUserIDStmt, err = db.PrepareContext(ctx, anonymizeRatingsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query AnonymizeRatingsByUserID: %w", err)
+	}
 	if q.countUserClapsStmt, err = db.PrepareContext(ctx, countUserClaps); err != nil {
 		return nil, fmt.Errorf("error preparing query CountUserClaps: %w", err)
 	}
@@ -60,6 +63,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteReviewStmt, err = db.PrepareContext(ctx, deleteReview); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteReview: %w", err)
 	}
+	if q.deleteReviewsRatingsByUserIDStmt, err = db.PrepareContext(ctx, deleteReviewsRatingsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteReviewsRatingsByUserID: %w", err)
+	}
 	if q.deleteSeasonByIDStmt, err = db.PrepareContext(ctx, deleteSeasonByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteSeasonByID: %w", err)
 	}
@@ -126,6 +132,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getPublishedShowsStmt, err = db.PrepareContext(ctx, getPublishedShows); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPublishedShows: %w", err)
 	}
+	if q.getRatingsByUserIDStmt, err = db.PrepareContext(ctx, getRatingsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRatingsByUserID: %w", err)
+	}
 	if q.getRawEpisodeByIDStmt, err = db.PrepareContext(ctx, getRawEpisodeByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRawEpisodeByID: %w", err)
 	}
@@ -135,6 +144,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getReviewRatingStmt, err = db.PrepareContext(ctx, getReviewRating); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReviewRating: %w", err)
 	}
+	if q.getReviewsRatingsByUserIDStmt, err = db.PrepareContext(ctx, getReviewsRatingsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReviewsRatingsByUserID: %w", err)
+	}
 	if q.getSeasonByIDStmt, err = db.PrepareContext(ctx, getSeasonByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSeasonByID: %w", err)
 	}
@@ -241,6 +253,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing allReviewsListStmt: %w", cerr)
 		}
 	}
+	if q.anonymizeRatingsByUserIDStmt != nil {
+		if cerr := q.anonymizeRatingsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing anonymizeRatingsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.countUserClapsStmt != nil {
 		if cerr := q.countUserClapsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countUserClapsStmt: %w", cerr)
@@ -266,6 +283,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteReviewStmt: %w", cerr)
 		}
 	}
+	if q.deleteReviewsRatingsByUserIDStmt != nil {
+		if cerr := q.deleteReviewsRatingsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteReviewsRatingsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.deleteSeasonByIDStmt != nil {
 		if cerr := q.deleteSeasonByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteSeasonByIDStmt: %w", cerr)
@@ -376,6 +398,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getPublishedShowsStmt: %w", cerr)
 		}
 	}
+	if q.getRatingsByUserIDStmt != nil {
+		if cerr := q.getRatingsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRatingsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getRawEpisodeByIDStmt != nil {
 		if cerr := q.getRawEpisodeByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getRawEpisodeByIDStmt: %w", cerr)
@@ -391,6 +418,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getReviewRatingStmt: %w", cerr)
 		}
 	}
+	if q.getReviewsRatingsByUserIDStmt != nil {
+		if cerr := q.getReviewsRatingsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReviewsRatingsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.getSeasonByIDStmt != nil {
 		if cerr := q.getSeasonByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getSeasonByIDStmt: %w", cerr)
@@ -547,11 +579,13 @@ type Queries struct {
 	addShowCategoryStmt                       *sql.Stmt
 	addShowToCategoryStmt                     *sql.Stmt
 	allReviewsListStmt                        *sql.Stmt
+	anonymizeRatingsByUserIDStmt              *sql.Stmt
 	countUserClapsStmt                        *sql.Stmt
 	deleteEpisodeByIDStmt                     *sql.Stmt
 	deleteEpisodeBySeasonIDStmt               *sql.Stmt
 	deleteEpisodeByShowIDStmt                 *sql.Stmt
 	deleteReviewStmt                          *sql.Stmt
+	deleteReviewsRatingsByUserIDStmt          *sql.Stmt
 	deleteSeasonByIDStmt                      *sql.Stmt
 	deleteSeasonByShowIDStmt                  *sql.Stmt
 	deleteShowByIDStmt                        *sql.Stmt
@@ -574,9 +608,11 @@ type Queries struct {
 	getPublishedRawEpisodeByIDStmt            *sql.Stmt
 	getPublishedShowByIDStmt                  *sql.Stmt
 	getPublishedShowsStmt                     *sql.Stmt
+	getRatingsByUserIDStmt                    *sql.Stmt
 	getRawEpisodeByIDStmt                     *sql.Stmt
 	getReviewByIDStmt                         *sql.Stmt
 	getReviewRatingStmt                       *sql.Stmt
+	getReviewsRatingsByUserIDStmt             *sql.Stmt
 	getSeasonByIDStmt                         *sql.Stmt
 	getSeasonsByShowIDStmt                    *sql.Stmt
 	getShowByIDStmt                           *sql.Stmt
@@ -612,11 +648,13 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		addShowCategoryStmt:                       q.addShowCategoryStmt,
 		addShowToCategoryStmt:                     q.addShowToCategoryStmt,
 		allReviewsListStmt:                        q.allReviewsListStmt,
+		anonymizeRatingsByUserIDStmt:              q.anonymizeRatingsByUserIDStmt,
 		countUserClapsStmt:                        q.countUserClapsStmt,
 		deleteEpisodeByIDStmt:                     q.deleteEpisodeByIDStmt,
 		deleteEpisodeBySeasonIDStmt:               q.deleteEpisodeBySeasonIDStmt,
 		deleteEpisodeByShowIDStmt:                 q.deleteEpisodeByShowIDStmt,
 		deleteReviewStmt:                          q.deleteReviewStmt,
+		deleteReviewsRatingsByUserIDStmt:          q.deleteReviewsRatingsByUserIDStmt,
 		deleteSeasonByIDStmt:                      q.deleteSeasonByIDStmt,
 		deleteSeasonByShowIDStmt:                  q.deleteSeasonByShowIDStmt,
 		deleteShowByIDStmt:                        q.deleteShowByIDStmt,
@@ -639,9 +677,11 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getPublishedRawEpisodeByIDStmt:            q.getPublishedRawEpisodeByIDStmt,
 		getPublishedShowByIDStmt:                  q.getPublishedShowByIDStmt,
 		getPublishedShowsStmt:                     q.getPublishedShowsStmt,
+		getRatingsByUserIDStmt:                    q.getRatingsByUserIDStmt,
 		getRawEpisodeByIDStmt:                     q.getRawEpisodeByIDStmt,
 		getReviewByIDStmt:                         q.getReviewByIDStmt,
 		getReviewRatingStmt:                       q.getReviewRatingStmt,
+		getReviewsRatingsByUserIDStmt:             q.getReviewsRatingsByUserIDStmt,
 		getSeasonByIDStmt:                         q.getSeasonByIDStmt,
 		getSeasonsByShowIDStmt:                    q.getSeasonsByShowIDStmt,
 		getShowByIDStmt:                           q.getShowByIDStmt,
@@ -55,6 +55,19 @@ func (q *Queries) AllReviewsList(ctx context.Context, arg AllReviewsListParams)
 	return items, nil
 }
 
+const anonymizeRatingsByUserID = `-- name: AnonymizeRatingsByUserID :exec
+UPDATE ratings
+SET username = NULL,
+    title = NULL,
+    review = NULL
+WHERE user_id = $1
+`
+
+func (q *Queries) AnonymizeRatingsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.anonymizeRatingsByUserIDStmt, anonymizeRatingsByUserID, userID)
+	return err
+}
+
 const deleteReview = `-- name: DeleteReview :exec
 DELETE FROM ratings
 WHERE id = $1
@@ -127,6 +140,45 @@ func (q *Queries) GetEpisodeRatingByID(ctx context.Context, episodeID uuid.UUID)
 	return i, err
 }
 
+const getRatingsByUserID = `-- name: GetRatingsByUserID :many
+SELECT episode_id, user_id, rating, created_at, id, title, review, username
+FROM ratings
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetRatingsByUserID(ctx context.Context, userID uuid.UUID) ([]Rating, error) {
+	rows, err := q.query(ctx, q.getRatingsByUserIDStmt, getRatingsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Rating
+	for rows.Next() {
+		var i Rating
+		if err := rows.Scan(
+			&i.EpisodeID,
+			&i.UserID,
+			&i.Rating,
+			&i.CreatedAt,
+			&i.ID,
+			&i.Title,
+			&i.Review,
+			&i.Username,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getReviewByID = `-- name: GetReviewByID :one
 SELECT episode_id, user_id, rating, created_at, id, title, review, username FROM ratings
 WHERE id = $1
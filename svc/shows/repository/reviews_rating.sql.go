@@ -12,6 +12,16 @@ import (
 	"github.com/google/uuid"
 )
 
+const deleteReviewsRatingsByUserID = `-- name: DeleteReviewsRatingsByUserID :exec
+DELETE FROM reviews_rating
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteReviewsRatingsByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteReviewsRatingsByUserIDStmt, deleteReviewsRatingsByUserID, userID)
+	return err
+}
+
 const deleteUserEpisodeReview = `-- name: DeleteUserEpisodeReview :exec
 DELETE FROM reviews_rating
 WHERE user_id = $1 
@@ -47,6 +57,41 @@ func (q *Queries) GetReviewRating(ctx context.Context, arg GetReviewRatingParams
 	return count, err
 }
 
+const getReviewsRatingsByUserID = `-- name: GetReviewsRatingsByUserID :many
+SELECT review_id, user_id, rating_type, created_at
+FROM reviews_rating
+WHERE user_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetReviewsRatingsByUserID(ctx context.Context, userID uuid.UUID) ([]ReviewsRating, error) {
+	rows, err := q.query(ctx, q.getReviewsRatingsByUserIDStmt, getReviewsRatingsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReviewsRating
+	for rows.Next() {
+		var i ReviewsRating
+		if err := rows.Scan(
+			&i.ReviewID,
+			&i.UserID,
+			&i.RatingType,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserEpisodeReview = `-- name: GetUserEpisodeReview :one
 SELECT review_id, user_id, rating_type, created_at FROM reviews_rating
 WHERE user_id = $1 
@@ -24,6 +24,12 @@ SELECT rating FROM ratings
 WHERE episode_id = $1
   AND user_id = $2;
 
+-- name: GetRatingsByUserID :many
+SELECT *
+FROM ratings
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
 -- name: ReviewEpisode :one
 INSERT INTO ratings (
     episode_id,
@@ -119,6 +125,13 @@ WHERE ratings.user_id = $1
 ORDER BY likes_number DESC
     LIMIT $2 OFFSET $3;
 
+-- name: AnonymizeRatingsByUserID :exec
+UPDATE ratings
+SET username = NULL,
+    title = NULL,
+    review = NULL
+WHERE user_id = $1;
+
 -- name: DeleteReview :exec
 DELETE FROM ratings
 WHERE id = @id;
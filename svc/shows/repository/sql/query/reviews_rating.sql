@@ -33,3 +33,14 @@ AND review_id = @review_id;
 DELETE FROM reviews_rating
 WHERE user_id = @user_id 
 AND review_id = @review_id;
+
+-- name: GetReviewsRatingsByUserID :many
+SELECT *
+FROM reviews_rating
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+
+-- name: DeleteReviewsRatingsByUserID :exec
+DELETE FROM reviews_rating
+WHERE user_id = $1;
\ No newline at end of file
@@ -14,6 +14,7 @@ import (
 	"github.com/SatorNetwork/sator-api/lib/db"
 	lib_solana "github.com/SatorNetwork/sator-api/lib/solana"
 	"github.com/SatorNetwork/sator-api/lib/utils"
+	"github.com/SatorNetwork/sator-api/svc/campaigns"
 	"github.com/SatorNetwork/sator-api/svc/profile"
 	"github.com/SatorNetwork/sator-api/svc/shows/repository"
 )
@@ -39,6 +40,8 @@ type (
 		nc           nftClient
 		firebaseSvc  firebaseService
 		tipsPercent  float64
+		trackEvent   trackEventFunc
+		audit        auditFunc
 	}
 
 	// Show struct
@@ -152,6 +155,8 @@ type (
 		RateEpisode(ctx context.Context, arg repository.RateEpisodeParams) error
 		DidUserRateEpisode(ctx context.Context, arg repository.DidUserRateEpisodeParams) (bool, error)
 		GetUsersEpisodeRatingByID(ctx context.Context, arg repository.GetUsersEpisodeRatingByIDParams) (int32, error)
+		GetRatingsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.Rating, error)
+		AnonymizeRatingsByUserID(ctx context.Context, userID uuid.UUID) error
 
 		// Episode reviews
 		DidUserReviewEpisode(ctx context.Context, arg repository.DidUserReviewEpisodeParams) (bool, error)
@@ -165,6 +170,8 @@ type (
 		GetReviewByID(ctx context.Context, id uuid.UUID) (repository.Rating, error)
 		GetUserEpisodeReview(ctx context.Context, arg repository.GetUserEpisodeReviewParams) (repository.ReviewsRating, error)
 		DeleteUserEpisodeReview(ctx context.Context, arg repository.DeleteUserEpisodeReviewParams) error
+		GetReviewsRatingsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.ReviewsRating, error)
+		DeleteReviewsRatingsByUserID(ctx context.Context, userID uuid.UUID) error
 
 		// Show claps
 		AddClapForShow(ctx context.Context, arg repository.AddClapForShowParams) error
@@ -216,6 +223,12 @@ type (
 
 	// Simple function
 	sentTipsFunction func(ctx context.Context, uid, recipientID uuid.UUID, amount float64, cfg *lib_solana.SendAssetsConfig, info string) error
+	trackEventFunc   func(ctx context.Context, userID uuid.UUID, event string)
+	auditFunc        func(ctx context.Context, action, entityType, entityID string, before, after interface{}) error
+
+	// ServiceOption function
+	// interface to extend service via options
+	ServiceOption func(*Service)
 )
 
 // NewService is a factory function,
@@ -229,6 +242,7 @@ func NewService(
 	nc nftClient,
 	firebaseSvc firebaseService,
 	tipsPercent float64,
+	opt ...ServiceOption,
 ) *Service {
 	if sr == nil {
 		log.Fatalln("shows repository is not set")
@@ -249,7 +263,7 @@ func NewService(
 		log.Fatalln("nft client is not set")
 	}
 
-	return &Service{
+	s := &Service{
 		sr:           sr,
 		chc:          chc,
 		pc:           pc,
@@ -259,6 +273,28 @@ func NewService(
 		tipsPercent:  tipsPercent,
 		firebaseSvc:  firebaseSvc,
 	}
+
+	for _, fn := range opt {
+		fn(s)
+	}
+
+	return s
+}
+
+// WithCampaignEventFunc option
+// Reports reviews to reward campaigns
+func WithCampaignEventFunc(fn trackEventFunc) ServiceOption {
+	return func(s *Service) {
+		s.trackEvent = fn
+	}
+}
+
+// WithAuditFunc option
+// Records changes of shows to the audit log
+func WithAuditFunc(fn auditFunc) ServiceOption {
+	return func(s *Service) {
+		s.audit = fn
+	}
 }
 
 // GetShows returns shows.
@@ -973,6 +1009,12 @@ func (s *Service) AddShow(ctx context.Context, sh Show) (Show, error) {
 		}
 	}
 
+	if s.audit != nil {
+		if err := s.audit(ctx, "show.create", "show", show.ID.String(), nil, show); err != nil {
+			return Show{}, fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return s.GetShowByID(ctx, show.ID)
 }
 
@@ -1029,11 +1071,28 @@ func (s *Service) UpdateShow(ctx context.Context, sh Show) error {
 		}
 	}
 
+	if s.audit != nil {
+		newShow, err := s.sr.GetShowByID(ctx, sh.ID)
+		if err != nil {
+			return fmt.Errorf("could not get updated show with id=%s: %w", sh.ID, err)
+		}
+		if err := s.audit(ctx, "show.update", "show", sh.ID.String(), oldShow, newShow); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return nil
 }
 
 // DeleteShowByID ..
 func (s *Service) DeleteShowByID(ctx context.Context, id uuid.UUID) error {
+	var show interface{}
+	if s.audit != nil {
+		if sh, err := s.sr.GetShowByID(ctx, id); err == nil {
+			show = sh
+		}
+	}
+
 	if err := s.sr.DeleteShowByID(ctx, id); err != nil {
 		return fmt.Errorf("could not delete show with id=%s:%w", id, err)
 	}
@@ -1046,6 +1105,12 @@ func (s *Service) DeleteShowByID(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("could not delete episodes with show id=%s:%w", id, err)
 	}
 
+	if s.audit != nil {
+		if err := s.audit(ctx, "show.delete", "show", id.String(), show, nil); err != nil {
+			return fmt.Errorf("could not record audit log: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1056,6 +1121,10 @@ func (s *Service) AddEpisode(ctx context.Context, ep Episode) (Episode, error) {
 		return Episode{}, fmt.Errorf("could not add parse date from string: %w", err)
 	}
 
+	if err := s.checkSeasonOfShow(ctx, ep.ShowID, ep.SeasonID); err != nil {
+		return Episode{}, err
+	}
+
 	params := repository.AddEpisodeParams{
 		ShowID: ep.ShowID,
 		SeasonID: uuid.NullUUID{
@@ -1130,6 +1199,13 @@ func (s *Service) UpdateEpisode(ctx context.Context, ep Episode) error {
 	if err != nil {
 		return fmt.Errorf("could not get episode with id=%s:%w", ep.ID, err)
 	}
+	// permissions may be granted on the show only, so episode can't be moved to another show
+	if oldEpisode.ShowID != ep.ShowID {
+		return fmt.Errorf("%w: episode with id=%s in show with id=%s", ErrNotFound, ep.ID, ep.ShowID)
+	}
+	if err := s.checkSeasonOfShow(ctx, ep.ShowID, ep.SeasonID); err != nil {
+		return err
+	}
 
 	params := repository.UpdateEpisodeParams{
 		ID:            ep.ID,
@@ -1191,6 +1267,14 @@ func (s *Service) UpdateEpisode(ctx context.Context, ep Episode) error {
 
 // DeleteEpisodeByID ...
 func (s *Service) DeleteEpisodeByID(ctx context.Context, showID, episodeID uuid.UUID) error {
+	episode, err := s.sr.GetEpisodeByID(ctx, episodeID)
+	if err != nil {
+		return fmt.Errorf("could not get episode with id=%s:%w", episodeID, err)
+	}
+	if episode.ShowID != showID {
+		return fmt.Errorf("%w: episode with id=%s in show with id=%s", ErrNotFound, episodeID, showID)
+	}
+
 	if err := s.sr.DeleteEpisodeByID(ctx, episodeID); err != nil {
 		return fmt.Errorf("could not delete episode with id=%s:%w", episodeID, err)
 	}
@@ -1238,7 +1322,11 @@ func (s *Service) GetSeasonByID(ctx context.Context, showID, seasonID uuid.UUID)
 }
 
 // DeleteSeasonByID ...
-func (s *Service) DeleteSeasonByID(ctx context.Context, _, seasonID uuid.UUID) error {
+func (s *Service) DeleteSeasonByID(ctx context.Context, showID, seasonID uuid.UUID) error {
+	if err := s.checkSeasonOfShow(ctx, showID, seasonID); err != nil {
+		return err
+	}
+
 	if err := s.sr.DeleteSeasonByID(ctx, seasonID); err != nil {
 		return fmt.Errorf("could not delete season with id=%s:%w", seasonID, err)
 	}
@@ -1250,6 +1338,26 @@ func (s *Service) DeleteSeasonByID(ctx context.Context, _, seasonID uuid.UUID) e
 	return nil
 }
 
+// checkSeasonOfShow returns error if the season belongs to another show
+func (s *Service) checkSeasonOfShow(ctx context.Context, showID, seasonID uuid.UUID) error {
+	if seasonID == uuid.Nil {
+		return nil
+	}
+
+	season, err := s.sr.GetSeasonByID(ctx, seasonID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return fmt.Errorf("%w: season with id=%s", ErrNotFound, seasonID)
+		}
+		return fmt.Errorf("could not get season with id=%s: %w", seasonID, err)
+	}
+	if season.ShowID != showID {
+		return fmt.Errorf("%w: season with id=%s in show with id=%s", ErrNotFound, seasonID, showID)
+	}
+
+	return nil
+}
+
 // getAverageEpisodesRatingByID returns average episode rating.
 func (s *Service) getAverageEpisodesRatingByID(ctx context.Context, episodeID uuid.UUID) (float64, int64, error) {
 	rating, err := s.sr.GetEpisodeRatingByID(ctx, episodeID)
@@ -1290,6 +1398,10 @@ func (s *Service) ReviewEpisode(ctx context.Context, episodeID, userID uuid.UUID
 		return fmt.Errorf("could not review episode with episodeID=%s: %w", episodeID, err)
 	}
 
+	if s.trackEvent != nil {
+		s.trackEvent(ctx, userID, campaigns.EventReview)
+	}
+
 	return nil
 }
 
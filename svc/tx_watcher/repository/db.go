@@ -1,136 +0,0 @@
-// Code generated by sqlc. DO NOT EDIT.
-
-package repository
-
-import (
-	"context"
-	"database/sql"
-	"fmt"
-)
-
-type DBTX interface {
-	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
-	PrepareContext(context.Context, string) (*sql.Stmt, error)
-	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
-	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
-}
-
-func New(db DBTX) *Queries {
-	return &Queries{db: db}
-}
-
-func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
-	q := Queries{db: db}
-	var err error
-	if q.cleanTransactionsStmt, err = db.PrepareContext(ctx, cleanTransactions); err != nil {
-		return nil, fmt.Errorf("error preparing query CleanTransactions: %w", err)
-	}
-	if q.getAllTransactionsStmt, err = db.PrepareContext(ctx, getAllTransactions); err != nil {
-		return nil, fmt.Errorf("error preparing query GetAllTransactions: %w", err)
-	}
-	if q.getTransactionsByStatusStmt, err = db.PrepareContext(ctx, getTransactionsByStatus); err != nil {
-		return nil, fmt.Errorf("error preparing query GetTransactionsByStatus: %w", err)
-	}
-	if q.registerTransactionStmt, err = db.PrepareContext(ctx, registerTransaction); err != nil {
-		return nil, fmt.Errorf("error preparing query RegisterTransaction: %w", err)
-	}
-	if q.registerTxRetryStmt, err = db.PrepareContext(ctx, registerTxRetry); err != nil {
-		return nil, fmt.Errorf("error preparing query RegisterTxRetry: %w", err)
-	}
-	if q.updateTransactionStatusStmt, err = db.PrepareContext(ctx, updateTransactionStatus); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateTransactionStatus: %w", err)
-	}
-	return &q, nil
-}
-
-func (q *Queries) Close() error {
-	var err error
-	if q.cleanTransactionsStmt != nil {
-		if cerr := q.cleanTransactionsStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing cleanTransactionsStmt: %w", cerr)
-		}
-	}
-	if q.getAllTransactionsStmt != nil {
-		if cerr := q.getAllTransactionsStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getAllTransactionsStmt: %w", cerr)
-		}
-	}
-	if q.getTransactionsByStatusStmt != nil {
-		if cerr := q.getTransactionsByStatusStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getTransactionsByStatusStmt: %w", cerr)
-		}
-	}
-	if q.registerTransactionStmt != nil {
-		if cerr := q.registerTransactionStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing registerTransactionStmt: %w", cerr)
-		}
-	}
-	if q.registerTxRetryStmt != nil {
-		if cerr := q.registerTxRetryStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing registerTxRetryStmt: %w", cerr)
-		}
-	}
-	if q.updateTransactionStatusStmt != nil {
-		if cerr := q.updateTransactionStatusStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateTransactionStatusStmt: %w", cerr)
-		}
-	}
-	return err
-}
-
-func (q *Queries) exec(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (sql.Result, error) {
-	switch {
-	case stmt != nil && q.tx != nil:
-		return q.tx.StmtContext(ctx, stmt).ExecContext(ctx, args...)
-	case stmt != nil:
-		return stmt.ExecContext(ctx, args...)
-	default:
-		return q.db.ExecContext(ctx, query, args...)
-	}
-}
-
-func (q *Queries) query(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (*sql.Rows, error) {
-	switch {
-	case stmt != nil && q.tx != nil:
-		return q.tx.StmtContext(ctx, stmt).QueryContext(ctx, args...)
-	case stmt != nil:
-		return stmt.QueryContext(ctx, args...)
-	default:
-		return q.db.QueryContext(ctx, query, args...)
-	}
-}
-
-func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) *sql.Row {
-	switch {
-	case stmt != nil && q.tx != nil:
-		return q.tx.StmtContext(ctx, stmt).QueryRowContext(ctx, args...)
-	case stmt != nil:
-		return stmt.QueryRowContext(ctx, args...)
-	default:
-		return q.db.QueryRowContext(ctx, query, args...)
-	}
-}
-
-type Queries struct {
-	db                          DBTX
-	tx                          *sql.Tx
-	cleanTransactionsStmt       *sql.Stmt
-	getAllTransactionsStmt      *sql.Stmt
-	getTransactionsByStatusStmt *sql.Stmt
-	registerTransactionStmt     *sql.Stmt
-	registerTxRetryStmt         *sql.Stmt
-	updateTransactionStatusStmt *sql.Stmt
-}
-
-func (q *Queries) WithTx(tx *sql.Tx) *Queries {
-	return &Queries{
-		db:                          tx,
-		tx:                          tx,
-		cleanTransactionsStmt:       q.cleanTransactionsStmt,
-		getAllTransactionsStmt:      q.getAllTransactionsStmt,
-		getTransactionsByStatusStmt: q.getTransactionsByStatusStmt,
-		registerTransactionStmt:     q.registerTransactionStmt,
-		registerTxRetryStmt:         q.registerTxRetryStmt,
-		updateTransactionStatusStmt: q.updateTransactionStatusStmt,
-	}
-}
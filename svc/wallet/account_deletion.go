@@ -0,0 +1,19 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// EraseUserData deletes external wallets watched by the user on account deletion.
+// Custodial wallets, stakes and transfers are financial records, so they are kept
+// under the user id which is no longer linked to personal data.
+func (s *Service) EraseUserData(ctx context.Context, userID uuid.UUID) error {
+	if err := s.wr.DeleteWatchedWalletsByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("could not delete watched wallets: %w", err)
+	}
+
+	return nil
+}
@@ -19,9 +19,10 @@ type (
 		GetWallets(ctx context.Context, userID uuid.UUID) (wallet.Wallets, error)
 		GetWalletByID(ctx context.Context, userID, walletID uuid.UUID) (wallet.Wallet, error)
 		CreateWallet(ctx context.Context, userID uuid.UUID) error
-		WithdrawRewards(ctx context.Context, userID uuid.UUID, amount float64) (tx string, err error)
+		WithdrawRewards(ctx context.Context, userID uuid.UUID, amount float64, onSigned wallet.SignedTxFunc) (tx string, err error)
 		GetListTransactionsByWalletID(ctx context.Context, userID, walletID uuid.UUID, limit, offset int32) (_ wallet.Transactions, err error)
 		PayForService(ctx context.Context, uid uuid.UUID, amount float64, info string) error
+		PayForServiceWithAsset(ctx context.Context, uid uuid.UUID, asset string, amount float64, info string) (string, error)
 		PayForNFT(ctx context.Context, uid uuid.UUID, amount float64, info string, creatorAddr string, creatorShare int32) error
 		P2PTransfer(ctx context.Context, uid, recipientID uuid.UUID, amount float64, cfg *lib_solana.SendAssetsConfig, info string) error
 		GetMultiplier(ctx context.Context, userID uuid.UUID) (_ int32, err error)
@@ -51,8 +52,8 @@ func (c *Client) CreateWallet(ctx context.Context, userID uuid.UUID) error {
 }
 
 // WithdrawRewards ...
-func (c *Client) WithdrawRewards(ctx context.Context, userID uuid.UUID, amount float64) (tx string, err error) {
-	return c.s.WithdrawRewards(ctx, userID, amount)
+func (c *Client) WithdrawRewards(ctx context.Context, userID uuid.UUID, amount float64, onSigned wallet.SignedTxFunc) (tx string, err error) {
+	return c.s.WithdrawRewards(ctx, userID, amount, onSigned)
 }
 
 // GetListTransactionsByWalletID ...
@@ -65,6 +66,11 @@ func (c *Client) PayForService(ctx context.Context, uid uuid.UUID, amount float6
 	return c.s.PayForService(ctx, uid, amount, info)
 }
 
+// PayForServiceWithAsset ...
+func (c *Client) PayForServiceWithAsset(ctx context.Context, uid uuid.UUID, asset string, amount float64, info string) (string, error) {
+	return c.s.PayForServiceWithAsset(ctx, uid, asset, amount, info)
+}
+
 // PayForNFT ...
 func (c *Client) PayForNFT(ctx context.Context, uid uuid.UUID, amount float64, info string, creatorAddr string, creatorShare int32) error {
 	return c.s.PayForNFT(ctx, uid, amount, info, creatorAddr, creatorShare)
@@ -0,0 +1,113 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+)
+
+type (
+	// WalletsData is the wallets data of the user included into the data export archive.
+	// Private keys of the custodial accounts are never exported.
+	WalletsData struct {
+		Wallets        []ExportedWallet        `json:"wallets"`
+		WatchedWallets []ExportedWatchedWallet `json:"watched_wallets"`
+		Stake          *ExportedStake          `json:"stake,omitempty"`
+	}
+
+	// ExportedWallet struct
+	ExportedWallet struct {
+		ID        uuid.UUID `json:"id"`
+		Type      string    `json:"type"`
+		Address   string    `json:"address,omitempty"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	// ExportedWatchedWallet struct
+	ExportedWatchedWallet struct {
+		ID        uuid.UUID `json:"id"`
+		Title     string    `json:"title"`
+		Address   string    `json:"address"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	// ExportedStake struct
+	ExportedStake struct {
+		WalletID    uuid.UUID `json:"wallet_id"`
+		Amount      float64   `json:"amount"`
+		Duration    int32     `json:"duration"`
+		UnstakeDate time.Time `json:"unstake_date"`
+		CreatedAt   time.Time `json:"created_at"`
+	}
+)
+
+// ExportUserData returns wallets, watch-only wallets and locked tokens of the user
+// to be included into the data export archive.
+func (s *Service) ExportUserData(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	data := WalletsData{
+		Wallets:        []ExportedWallet{},
+		WatchedWallets: []ExportedWatchedWallet{},
+	}
+
+	wallets, err := s.wr.GetWalletsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get wallets list: %w", err)
+	}
+	for _, w := range wallets {
+		ew := ExportedWallet{
+			ID:        w.ID,
+			Type:      w.WalletType,
+			CreatedAt: w.CreatedAt,
+		}
+		if w.WalletType == WalletTypeEthereum {
+			if w.EthereumAccountID.Valid {
+				acc, err := s.wr.GetEthereumAccountByID(ctx, w.EthereumAccountID.UUID)
+				if err != nil {
+					return nil, fmt.Errorf("could not get ethereum account: %w", err)
+				}
+				ew.Address = acc.Address
+			}
+		} else {
+			acc, err := s.wr.GetSolanaAccountByID(ctx, w.SolanaAccountID)
+			if err != nil {
+				return nil, fmt.Errorf("could not get solana account: %w", err)
+			}
+			ew.Address = acc.PublicKey
+		}
+		data.Wallets = append(data.Wallets, ew)
+	}
+
+	watched, err := s.wr.GetWatchedWalletsByUserID(ctx, userID)
+	if err != nil && !db.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not get watch-only wallets: %w", err)
+	}
+	for _, ww := range watched {
+		data.WatchedWallets = append(data.WatchedWallets, ExportedWatchedWallet{
+			ID:        ww.ID,
+			Title:     ww.Title,
+			Address:   ww.Address,
+			CreatedAt: ww.CreatedAt,
+		})
+	}
+
+	stake, err := s.wr.GetStakeByUserID(ctx, userID)
+	if err != nil {
+		if !db.IsNotFoundError(err) {
+			return nil, fmt.Errorf("could not get locked tokens by user id: %w", err)
+		}
+	} else {
+		data.Stake = &ExportedStake{
+			WalletID:    stake.WalletID,
+			Amount:      stake.StakeAmount,
+			Duration:    stake.StakeDuration.Int32,
+			UnstakeDate: stake.UnstakeDate,
+			CreatedAt:   stake.CreatedAt,
+		}
+	}
+
+	return data, nil
+}
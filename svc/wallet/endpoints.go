@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/url"
 
+	"github.com/SatorNetwork/sator-api/lib/httpencoder"
 	"github.com/SatorNetwork/sator-api/lib/jwt"
 	"github.com/SatorNetwork/sator-api/lib/rbac"
 	"github.com/SatorNetwork/sator-api/lib/solana/client"
@@ -30,6 +31,9 @@ type (
 		Unstake                       endpoint.Endpoint
 		PossibleMultiplier            endpoint.Endpoint
 		GetStakeLevels                endpoint.Endpoint
+		AddWatchedWallet              endpoint.Endpoint
+		GetWatchedWalletByID          endpoint.Endpoint
+		DeleteWatchedWallet           endpoint.Endpoint
 	}
 
 	service interface {
@@ -44,6 +48,9 @@ type (
 		PossibleMultiplier(ctx context.Context, additionalAmount float64, userID, walletID uuid.UUID) (int32, error)
 		GetEnabledStakeLevelsList(ctx context.Context, userID uuid.UUID) ([]StakeLevel, error)
 		GetSaoWalletByUserID(ctx context.Context, userID uuid.UUID) (UserWallet, error)
+		AddWatchedWallet(ctx context.Context, userID uuid.UUID, address, title string) (WatchedWallet, error)
+		GetWatchedWalletByID(ctx context.Context, userID, id uuid.UUID) (WatchedWallet, error)
+		DeleteWatchedWallet(ctx context.Context, userID, id uuid.UUID) error
 	}
 
 	CreateTransferRequest struct {
@@ -81,6 +88,12 @@ type (
 		Amount   float64 `json:"amount" validate:"required,number,gt=0"`
 		WalletID string  `json:"wallet_id" validate:"required,uuid"`
 	}
+
+	// AddWatchedWalletRequest struct
+	AddWatchedWalletRequest struct {
+		Address string `json:"address" validate:"required"`
+		Title   string `json:"title" validate:"max=64"`
+	}
 )
 
 var StakeDuration = env.GetInt("SMART_CONTRACT_STAKE_DURATION", 0)
@@ -100,6 +113,9 @@ func MakeEndpoints(s service, kycMdw endpoint.Middleware, m ...endpoint.Middlewa
 		Unstake:                       MakeUnstakeEndpoint(s, validateFunc),
 		PossibleMultiplier:            MakePossibleMultiplierEndpoint(s, validateFunc),
 		GetStakeLevels:                MakeGetStakeLevelsEndpoint(s),
+		AddWatchedWallet:              MakeAddWatchedWalletEndpoint(s, validateFunc),
+		GetWatchedWalletByID:          MakeGetWatchedWalletByIDEndpoint(s),
+		DeleteWatchedWallet:           MakeDeleteWatchedWalletEndpoint(s),
 	}
 
 	// setup middlewares for each endpoints
@@ -116,6 +132,9 @@ func MakeEndpoints(s service, kycMdw endpoint.Middleware, m ...endpoint.Middlewa
 			e.Unstake = mdw(e.Unstake)
 			e.PossibleMultiplier = mdw(e.PossibleMultiplier)
 			e.GetStakeLevels = mdw(e.GetStakeLevels)
+			e.AddWatchedWallet = mdw(e.AddWatchedWallet)
+			e.GetWatchedWalletByID = mdw(e.GetWatchedWalletByID)
+			e.DeleteWatchedWallet = mdw(e.DeleteWatchedWallet)
 		}
 	}
 
@@ -184,7 +203,12 @@ func MakeGetWalletsEndpoint(s service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		return wallets, nil
+		return httpencoder.Response{
+			Data: wallets,
+			Meta: map[string]interface{}{
+				"watched_total_usd": wallets.WatchedTotalUSD(),
+			},
+		}, nil
 	}
 }
 
@@ -396,6 +420,84 @@ func MakeGetStakeLevelsEndpoint(s service) endpoint.Endpoint {
 	}
 }
 
+func MakeAddWatchedWalletEndpoint(s service, v validator.ValidateFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		req := request.(AddWatchedWalletRequest)
+		if err := v(req); err != nil {
+			return nil, err
+		}
+
+		if err := validateSolanaWalletAddr("address", req.Address); err != nil {
+			return nil, err
+		}
+
+		w, err := s.AddWatchedWallet(ctx, uid, req.Address, req.Title)
+		if err != nil {
+			return nil, err
+		}
+
+		return w, nil
+	}
+}
+
+func MakeGetWatchedWalletByIDEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return nil, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid watch-only wallet id", ErrInvalidParameter)
+		}
+
+		w, err := s.GetWatchedWalletByID(ctx, uid, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return w, nil
+	}
+}
+
+func MakeDeleteWatchedWalletEndpoint(s service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := rbac.CheckRoleFromContext(ctx, rbac.AvailableForAuthorizedUsers); err != nil {
+			return false, err
+		}
+
+		uid, err := jwt.UserIDFromContext(ctx)
+		if err != nil {
+			return false, fmt.Errorf("could not get user profile id: %w", err)
+		}
+
+		id, err := uuid.Parse(request.(string))
+		if err != nil {
+			return false, fmt.Errorf("%w: invalid watch-only wallet id", ErrInvalidParameter)
+		}
+
+		if err := s.DeleteWatchedWallet(ctx, uid, id); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+}
+
 func validateSolanaWalletAddr(fieldName, addr string) error {
 	if err := client.ValidateSolanaWalletAddr(addr); err != nil {
 		log.Printf("invalid solana wallet address=%s, error: %v", addr, err)
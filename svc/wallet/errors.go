@@ -14,4 +14,7 @@ var (
 	ErrTransactionFailed   = errors.New("transaction failed")
 	ErrFraudDetection      = errors.New("fraud detection")
 	ErrTooManyRequests     = errors.New("too many requests, please try again later")
+	ErrUnsupportedAsset    = errors.New("unsupported payment asset")
+	ErrAlreadyWatched      = errors.New("this address is already in your wallets list")
+	ErrWatchedWalletsLimit = errors.New("watch-only wallets limit is reached")
 )
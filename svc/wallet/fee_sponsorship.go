@@ -0,0 +1,238 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	lib_solana "github.com/SatorNetwork/sator-api/lib/solana"
+	"github.com/SatorNetwork/sator-api/svc/wallet/repository"
+)
+
+type (
+	// FeeSponsorshipTier defines how many transactions per day and per month
+	// are paid by the fee payer for users matching the tier.
+	FeeSponsorshipTier struct {
+		Role           rbac.Role `json:"role,omitempty"`             // empty role matches any user
+		MinStakeAmount float64   `json:"min_stake_amount,omitempty"` // minimal amount of locked SAO
+		DailyLimit     int32     `json:"daily_limit"`
+		MonthlyLimit   int32     `json:"monthly_limit"`
+	}
+
+	// SponsoredTransactions shows how many transactions of the user
+	// will still be paid by the fee payer.
+	SponsoredTransactions struct {
+		DailyLimit       int32 `json:"daily_limit"`
+		DailyRemaining   int32 `json:"daily_remaining"`
+		MonthlyLimit     int32 `json:"monthly_limit"`
+		MonthlyRemaining int32 `json:"monthly_remaining"`
+	}
+)
+
+// defaultFeeSponsorshipTiers is used unless tiers are set via WithFeeSponsorshipTiers
+var defaultFeeSponsorshipTiers = []FeeSponsorshipTier{
+	{DailyLimit: 3, MonthlyLimit: 30},
+	{MinStakeAmount: 1000, DailyLimit: 10, MonthlyLimit: 100},
+	{MinStakeAmount: 10000, DailyLimit: 30, MonthlyLimit: 300},
+	{Role: rbac.RoleTestUser, DailyLimit: 100, MonthlyLimit: 1000},
+}
+
+// Available reports whether the next transaction will be sponsored.
+func (st SponsoredTransactions) Available() bool {
+	return st.DailyRemaining > 0 && st.MonthlyRemaining > 0
+}
+
+// GetSponsoredTransactions returns the sponsorship quota of the user for the current day and month.
+func (s *Service) GetSponsoredTransactions(ctx context.Context, userID uuid.UUID) (SponsoredTransactions, error) {
+	return s.getSponsoredTransactions(ctx, s.wr, userID)
+}
+
+func (s *Service) getSponsoredTransactions(ctx context.Context, repo walletRepository, userID uuid.UUID) (SponsoredTransactions, error) {
+	var stakeAmount float64
+	stake, err := repo.GetStakeByUserID(ctx, userID)
+	if err != nil {
+		if !db.IsNotFoundError(err) {
+			return SponsoredTransactions{}, err
+		}
+	} else {
+		stakeAmount = stake.StakeAmount
+	}
+
+	tier := matchFeeSponsorshipTier(s.feeSponsorshipTiers, rbac.GetRoleFromContext(ctx), stakeAmount)
+
+	dayStart, monthStart := sponsorshipPeriodStarts(time.Now())
+	used, err := repo.CountSponsoredTransactions(ctx, repository.CountSponsoredTransactionsParams{
+		DayStart:   dayStart,
+		UserID:     userID,
+		MonthStart: monthStart,
+	})
+	if err != nil {
+		return SponsoredTransactions{}, err
+	}
+
+	return remainingSponsoredTransactions(tier, used), nil
+}
+
+// sponsorFee switches off charging of the solana fee from the sender
+// if a sponsored transaction is reserved for the user, and returns the reservation id.
+// The fee is charged from the sender if the quota is exhausted or can't be fetched.
+func (s *Service) sponsorFee(ctx context.Context, userID uuid.UUID, action ActionType, cfg *lib_solana.SendAssetsConfig) uuid.UUID {
+	reservationID := s.reserveSponsoredTransaction(ctx, userID, action)
+	cfg.ChargeSolanaFeeFromSender = reservationID == uuid.Nil
+	return reservationID
+}
+
+// isTransactionSponsored reports whether the next transaction of the user is going to be sponsored,
+// it doesn't reserve the quota and must be used for previews only.
+func (s *Service) isTransactionSponsored(ctx context.Context, userID uuid.UUID) bool {
+	st, err := s.GetSponsoredTransactions(ctx, userID)
+	if err != nil {
+		log.Printf("could not get sponsored transactions of user %s: %v", userID.String(), err)
+		return false
+	}
+
+	return st.Available()
+}
+
+// reserveSponsoredTransaction counts the transaction against the user's sponsorship quota before it is sent.
+// The quota check and the insert are done under a per-user lock, so parallel requests can't exceed the quota.
+// Returns uuid.Nil if the quota is exhausted or can't be fetched.
+func (s *Service) reserveSponsoredTransaction(ctx context.Context, userID uuid.UUID, action ActionType) uuid.UUID {
+	reservationID, err := func() (uuid.UUID, error) {
+		tx, err := s.dbConn.BeginTx(ctx, &sql.TxOptions{})
+		if err != nil {
+			return uuid.Nil, err
+		}
+		defer tx.Rollback()
+
+		repo := s.wr.WithTx(tx)
+
+		if err := repo.LockSponsoredTransactions(ctx, userID); err != nil {
+			return uuid.Nil, err
+		}
+
+		st, err := s.getSponsoredTransactions(ctx, repo, userID)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if !st.Available() {
+			return uuid.Nil, nil
+		}
+
+		id, err := repo.AddSponsoredTransaction(ctx, repository.AddSponsoredTransactionParams{
+			UserID:     userID,
+			ActionType: action.String(),
+		})
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		return id, tx.Commit()
+	}()
+	if err != nil {
+		log.Printf("could not reserve sponsored transaction of user %s: %v", userID.String(), err)
+		return uuid.Nil
+	}
+
+	return reservationID
+}
+
+// confirmSponsoredTransaction stores hash of the sent transaction in the reservation
+func (s *Service) confirmSponsoredTransaction(ctx context.Context, reservationID uuid.UUID, txHash string) {
+	if reservationID == uuid.Nil {
+		return
+	}
+
+	if err := s.wr.UpdateSponsoredTransactionTxHash(ctx, repository.UpdateSponsoredTransactionTxHashParams{
+		TxHash: txHash,
+		ID:     reservationID,
+	}); err != nil {
+		log.Printf("could not store hash of sponsored transaction %s: %v", txHash, err)
+	}
+}
+
+// releaseSponsoredTransaction gives the reserved transaction back to the user's quota if it wasn't sent
+func (s *Service) releaseSponsoredTransaction(ctx context.Context, reservationID uuid.UUID) {
+	if reservationID == uuid.Nil {
+		return
+	}
+
+	if err := s.wr.DeleteSponsoredTransaction(ctx, reservationID); err != nil {
+		log.Printf("could not release sponsored transaction %s: %v", reservationID.String(), err)
+	}
+}
+
+// chargeSponsorshipFallbackFee charges a flat fee in SAO for transactions
+// which can't charge the solana fee from the sender themselves, e.g. lock and unlock of tokens.
+// Must be called after the transaction succeeded, so the fee is never charged for a failed one.
+func (s *Service) chargeSponsorshipFallbackFee(ctx context.Context, walletID uuid.UUID) error {
+	if s.feeSponsorshipFallbackFee <= 0 {
+		return nil
+	}
+
+	if _, err := s.execTransfer(
+		ctx,
+		walletID,
+		s.sc.FeeAccumulatorAddress(),
+		s.feeSponsorshipFallbackFee,
+		&lib_solana.SendAssetsConfig{},
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// matchFeeSponsorshipTier returns the most generous limits among tiers matching the role and locked amount.
+func matchFeeSponsorshipTier(tiers []FeeSponsorshipTier, role rbac.Role, stakeAmount float64) FeeSponsorshipTier {
+	var result FeeSponsorshipTier
+	for _, t := range tiers {
+		if t.Role != "" && t.Role != role {
+			continue
+		}
+		if stakeAmount < t.MinStakeAmount {
+			continue
+		}
+		if t.DailyLimit > result.DailyLimit {
+			result.DailyLimit = t.DailyLimit
+		}
+		if t.MonthlyLimit > result.MonthlyLimit {
+			result.MonthlyLimit = t.MonthlyLimit
+		}
+	}
+
+	return result
+}
+
+func remainingSponsoredTransactions(tier FeeSponsorshipTier, used repository.CountSponsoredTransactionsRow) SponsoredTransactions {
+	st := SponsoredTransactions{
+		DailyLimit:       tier.DailyLimit,
+		DailyRemaining:   tier.DailyLimit - used.Daily,
+		MonthlyLimit:     tier.MonthlyLimit,
+		MonthlyRemaining: tier.MonthlyLimit - used.Monthly,
+	}
+	if st.DailyRemaining < 0 {
+		st.DailyRemaining = 0
+	}
+	if st.MonthlyRemaining < 0 {
+		st.MonthlyRemaining = 0
+	}
+	if st.DailyRemaining > st.MonthlyRemaining {
+		st.DailyRemaining = st.MonthlyRemaining
+	}
+
+	return st
+}
+
+// sponsorshipPeriodStarts returns beginning of the current day and month in UTC
+func sponsorshipPeriodStarts(now time.Time) (dayStart, monthStart time.Time) {
+	now = now.UTC()
+	dayStart = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	monthStart = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return dayStart, monthStart
+}
@@ -0,0 +1,101 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SatorNetwork/sator-api/lib/rbac"
+	"github.com/SatorNetwork/sator-api/svc/wallet/repository"
+)
+
+func TestMatchFeeSponsorshipTier(t *testing.T) {
+	tiers := []FeeSponsorshipTier{
+		{DailyLimit: 3, MonthlyLimit: 30},
+		{MinStakeAmount: 1000, DailyLimit: 10, MonthlyLimit: 100},
+		{Role: rbac.RoleTestUser, DailyLimit: 5, MonthlyLimit: 1000},
+	}
+
+	tests := []struct {
+		name        string
+		role        rbac.Role
+		stakeAmount float64
+		wantDaily   int32
+		wantMonthly int32
+	}{
+		{"default tier", rbac.RoleUser, 0, 3, 30},
+		{"stake level tier", rbac.RoleUser, 1000, 10, 100},
+		{"role tier", rbac.RoleTestUser, 0, 5, 1000},
+		{"most generous limits of role and stake tiers", rbac.RoleTestUser, 5000, 10, 1000},
+		{"no role in context", "", 0, 3, 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matchFeeSponsorshipTier(tiers, tt.role, tt.stakeAmount)
+			if got.DailyLimit != tt.wantDaily || got.MonthlyLimit != tt.wantMonthly {
+				t.Errorf("matchFeeSponsorshipTier() = %d/%d, want %d/%d", got.DailyLimit, got.MonthlyLimit, tt.wantDaily, tt.wantMonthly)
+			}
+		})
+	}
+}
+
+func TestRemainingSponsoredTransactions(t *testing.T) {
+	tier := FeeSponsorshipTier{DailyLimit: 3, MonthlyLimit: 30}
+
+	tests := []struct {
+		name          string
+		used          repository.CountSponsoredTransactionsRow
+		wantDaily     int32
+		wantMonthly   int32
+		wantAvailable bool
+	}{
+		{"nothing used", repository.CountSponsoredTransactionsRow{}, 3, 30, true},
+		{"daily quota used", repository.CountSponsoredTransactionsRow{Daily: 3, Monthly: 10}, 0, 20, false},
+		{"monthly quota used", repository.CountSponsoredTransactionsRow{Daily: 0, Monthly: 30}, 0, 0, false},
+		{"monthly quota almost used", repository.CountSponsoredTransactionsRow{Daily: 0, Monthly: 29}, 1, 1, true},
+		{"over quota", repository.CountSponsoredTransactionsRow{Daily: 5, Monthly: 35}, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := remainingSponsoredTransactions(tier, tt.used)
+			if got.DailyRemaining != tt.wantDaily || got.MonthlyRemaining != tt.wantMonthly {
+				t.Errorf("remainingSponsoredTransactions() = %d/%d, want %d/%d", got.DailyRemaining, got.MonthlyRemaining, tt.wantDaily, tt.wantMonthly)
+			}
+			if got.Available() != tt.wantAvailable {
+				t.Errorf("Available() = %v, want %v", got.Available(), tt.wantAvailable)
+			}
+		})
+	}
+}
+
+func TestSponsorshipPeriodStarts(t *testing.T) {
+	now := time.Date(2022, time.October, 21, 15, 4, 5, 0, time.FixedZone("UTC+3", 3*60*60))
+	dayStart, monthStart := sponsorshipPeriodStarts(now)
+
+	if want := time.Date(2022, time.October, 21, 0, 0, 0, 0, time.UTC); !dayStart.Equal(want) {
+		t.Errorf("dayStart = %v, want %v", dayStart, want)
+	}
+	if want := time.Date(2022, time.October, 1, 0, 0, 0, 0, time.UTC); !monthStart.Equal(want) {
+		t.Errorf("monthStart = %v, want %v", monthStart, want)
+	}
+}
+
+func TestWithFeeSponsorshipFallbackFee(t *testing.T) {
+	tests := []struct {
+		name string
+		fee  float64
+		want float64
+	}{
+		{name: "custom fee", fee: 2.5, want: 2.5},
+		{name: "disabled", fee: 0, want: 0},
+		{name: "negative is ignored", fee: -1, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{feeSponsorshipFallbackFee: 1}
+			WithFeeSponsorshipFallbackFee(tt.fee)(s)
+			if s.feeSponsorshipFallbackFee != tt.want {
+				t.Errorf("feeSponsorshipFallbackFee = %v, want %v", s.feeSponsorshipFallbackFee, tt.want)
+			}
+		})
+	}
+}
@@ -28,6 +28,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addSolanaAccountStmt, err = db.PrepareContext(ctx, addSolanaAccount); err != nil {
 		return nil, fmt.Errorf("error preparing query AddSolanaAccount: %w", err)
 	}
+	if q.addSponsoredTransactionStmt, err = db.PrepareContext(ctx, addSponsoredTransaction); err != nil {
+		return nil, fmt.Errorf("error preparing query AddSponsoredTransaction: %w", err)
+	}
 	if q.addStakeStmt, err = db.PrepareContext(ctx, addStake); err != nil {
 		return nil, fmt.Errorf("error preparing query AddStake: %w", err)
 	}
@@ -37,18 +40,36 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addTokenTransferStmt, err = db.PrepareContext(ctx, addTokenTransfer); err != nil {
 		return nil, fmt.Errorf("error preparing query AddTokenTransfer: %w", err)
 	}
+	if q.addWatchedWalletStmt, err = db.PrepareContext(ctx, addWatchedWallet); err != nil {
+		return nil, fmt.Errorf("error preparing query AddWatchedWallet: %w", err)
+	}
 	if q.checkRecipientAddressStmt, err = db.PrepareContext(ctx, checkRecipientAddress); err != nil {
 		return nil, fmt.Errorf("error preparing query CheckRecipientAddress: %w", err)
 	}
+	if q.countSponsoredTransactionsStmt, err = db.PrepareContext(ctx, countSponsoredTransactions); err != nil {
+		return nil, fmt.Errorf("error preparing query CountSponsoredTransactions: %w", err)
+	}
+	if q.countWatchedWalletsByUserIDStmt, err = db.PrepareContext(ctx, countWatchedWalletsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query CountWatchedWalletsByUserID: %w", err)
+	}
 	if q.createWalletStmt, err = db.PrepareContext(ctx, createWallet); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateWallet: %w", err)
 	}
+	if q.deleteSponsoredTransactionStmt, err = db.PrepareContext(ctx, deleteSponsoredTransaction); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteSponsoredTransaction: %w", err)
+	}
 	if q.deleteStakeByUserIDStmt, err = db.PrepareContext(ctx, deleteStakeByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteStakeByUserID: %w", err)
 	}
 	if q.deleteWalletByIDStmt, err = db.PrepareContext(ctx, deleteWalletByID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteWalletByID: %w", err)
 	}
+	if q.deleteWatchedWalletStmt, err = db.PrepareContext(ctx, deleteWatchedWallet); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteWatchedWallet: %w", err)
+	}
+	if q.deleteWatchedWalletsByUserIDStmt, err = db.PrepareContext(ctx, deleteWatchedWalletsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteWatchedWalletsByUserID: %w", err)
+	}
 	if q.doesUserHaveFraudulentTransfersStmt, err = db.PrepareContext(ctx, doesUserHaveFraudulentTransfers); err != nil {
 		return nil, fmt.Errorf("error preparing query DoesUserHaveFraudulentTransfers: %w", err)
 	}
@@ -91,6 +112,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getStakeLevelByIDStmt, err = db.PrepareContext(ctx, getStakeLevelByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetStakeLevelByID: %w", err)
 	}
+	if q.getStaleWatchedWalletsStmt, err = db.PrepareContext(ctx, getStaleWatchedWallets); err != nil {
+		return nil, fmt.Errorf("error preparing query GetStaleWatchedWallets: %w", err)
+	}
 	if q.getTotalStakeStmt, err = db.PrepareContext(ctx, getTotalStake); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTotalStake: %w", err)
 	}
@@ -109,6 +133,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getWalletsByUserIDStmt, err = db.PrepareContext(ctx, getWalletsByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetWalletsByUserID: %w", err)
 	}
+	if q.getWatchedWalletByIDStmt, err = db.PrepareContext(ctx, getWatchedWalletByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWatchedWalletByID: %w", err)
+	}
+	if q.getWatchedWalletsByUserIDStmt, err = db.PrepareContext(ctx, getWatchedWalletsByUserID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWatchedWalletsByUserID: %w", err)
+	}
+	if q.lockSponsoredTransactionsStmt, err = db.PrepareContext(ctx, lockSponsoredTransactions); err != nil {
+		return nil, fmt.Errorf("error preparing query LockSponsoredTransactions: %w", err)
+	}
+	if q.updateSponsoredTransactionTxHashStmt, err = db.PrepareContext(ctx, updateSponsoredTransactionTxHash); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateSponsoredTransactionTxHash: %w", err)
+	}
 	if q.updateStakeStmt, err = db.PrepareContext(ctx, updateStake); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateStake: %w", err)
 	}
@@ -118,6 +154,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateTokenTransferStmt, err = db.PrepareContext(ctx, updateTokenTransfer); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateTokenTransfer: %w", err)
 	}
+	if q.updateWatchedWalletBalancesStmt, err = db.PrepareContext(ctx, updateWatchedWalletBalances); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateWatchedWalletBalances: %w", err)
+	}
 	return &q, nil
 }
 
@@ -133,6 +172,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addSolanaAccountStmt: %w", cerr)
 		}
 	}
+	if q.addSponsoredTransactionStmt != nil {
+		if cerr := q.addSponsoredTransactionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addSponsoredTransactionStmt: %w", cerr)
+		}
+	}
 	if q.addStakeStmt != nil {
 		if cerr := q.addStakeStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addStakeStmt: %w", cerr)
@@ -148,16 +192,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addTokenTransferStmt: %w", cerr)
 		}
 	}
+	if q.addWatchedWalletStmt != nil {
+		if cerr := q.addWatchedWalletStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addWatchedWalletStmt: %w", cerr)
+		}
+	}
 	if q.checkRecipientAddressStmt != nil {
 		if cerr := q.checkRecipientAddressStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing checkRecipientAddressStmt: %w", cerr)
 		}
 	}
+	if q.countSponsoredTransactionsStmt != nil {
+		if cerr := q.countSponsoredTransactionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countSponsoredTransactionsStmt: %w", cerr)
+		}
+	}
+	if q.countWatchedWalletsByUserIDStmt != nil {
+		if cerr := q.countWatchedWalletsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countWatchedWalletsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.createWalletStmt != nil {
 		if cerr := q.createWalletStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createWalletStmt: %w", cerr)
 		}
 	}
+	if q.deleteSponsoredTransactionStmt != nil {
+		if cerr := q.deleteSponsoredTransactionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteSponsoredTransactionStmt: %w", cerr)
+		}
+	}
 	if q.deleteStakeByUserIDStmt != nil {
 		if cerr := q.deleteStakeByUserIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteStakeByUserIDStmt: %w", cerr)
@@ -168,6 +232,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteWalletByIDStmt: %w", cerr)
 		}
 	}
+	if q.deleteWatchedWalletStmt != nil {
+		if cerr := q.deleteWatchedWalletStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteWatchedWalletStmt: %w", cerr)
+		}
+	}
+	if q.deleteWatchedWalletsByUserIDStmt != nil {
+		if cerr := q.deleteWatchedWalletsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteWatchedWalletsByUserIDStmt: %w", cerr)
+		}
+	}
 	if q.doesUserHaveFraudulentTransfersStmt != nil {
 		if cerr := q.doesUserHaveFraudulentTransfersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing doesUserHaveFraudulentTransfersStmt: %w", cerr)
@@ -238,6 +312,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getStakeLevelByIDStmt: %w", cerr)
 		}
 	}
+	if q.getStaleWatchedWalletsStmt != nil {
+		if cerr := q.getStaleWatchedWalletsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getStaleWatchedWalletsStmt: %w", cerr)
+		}
+	}
 	if q.getTotalStakeStmt != nil {
 		if cerr := q.getTotalStakeStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTotalStakeStmt: %w", cerr)
@@ -268,6 +347,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getWalletsByUserIDStmt: %w", cerr)
 		}
 	}
+	if q.getWatchedWalletByIDStmt != nil {
+		if cerr := q.getWatchedWalletByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWatchedWalletByIDStmt: %w", cerr)
+		}
+	}
+	if q.getWatchedWalletsByUserIDStmt != nil {
+		if cerr := q.getWatchedWalletsByUserIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWatchedWalletsByUserIDStmt: %w", cerr)
+		}
+	}
+	if q.lockSponsoredTransactionsStmt != nil {
+		if cerr := q.lockSponsoredTransactionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockSponsoredTransactionsStmt: %w", cerr)
+		}
+	}
+	if q.updateSponsoredTransactionTxHashStmt != nil {
+		if cerr := q.updateSponsoredTransactionTxHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateSponsoredTransactionTxHashStmt: %w", cerr)
+		}
+	}
 	if q.updateStakeStmt != nil {
 		if cerr := q.updateStakeStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateStakeStmt: %w", cerr)
@@ -283,6 +382,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateTokenTransferStmt: %w", cerr)
 		}
 	}
+	if q.updateWatchedWalletBalancesStmt != nil {
+		if cerr := q.updateWatchedWalletBalancesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateWatchedWalletBalancesStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -324,13 +428,20 @@ type Queries struct {
 	tx                                    *sql.Tx
 	addEthereumAccountStmt                *sql.Stmt
 	addSolanaAccountStmt                  *sql.Stmt
+	addSponsoredTransactionStmt           *sql.Stmt
 	addStakeStmt                          *sql.Stmt
 	addStakeLevelStmt                     *sql.Stmt
 	addTokenTransferStmt                  *sql.Stmt
+	addWatchedWalletStmt                  *sql.Stmt
 	checkRecipientAddressStmt             *sql.Stmt
+	countSponsoredTransactionsStmt        *sql.Stmt
+	countWatchedWalletsByUserIDStmt       *sql.Stmt
 	createWalletStmt                      *sql.Stmt
+	deleteSponsoredTransactionStmt        *sql.Stmt
 	deleteStakeByUserIDStmt               *sql.Stmt
 	deleteWalletByIDStmt                  *sql.Stmt
+	deleteWatchedWalletStmt               *sql.Stmt
+	deleteWatchedWalletsByUserIDStmt      *sql.Stmt
 	doesUserHaveFraudulentTransfersStmt   *sql.Stmt
 	doesUserMakeTransferForLastMinuteStmt *sql.Stmt
 	getAllEnabledStakeLevelsStmt          *sql.Stmt
@@ -345,15 +456,21 @@ type Queries struct {
 	getStakeByUserIDStmt                  *sql.Stmt
 	getStakeLevelByAmountStmt             *sql.Stmt
 	getStakeLevelByIDStmt                 *sql.Stmt
+	getStaleWatchedWalletsStmt            *sql.Stmt
 	getTotalStakeStmt                     *sql.Stmt
 	getWalletByEthereumAccountIDStmt      *sql.Stmt
 	getWalletByIDStmt                     *sql.Stmt
 	getWalletBySolanaAccountIDStmt        *sql.Stmt
 	getWalletByUserIDAndTypeStmt          *sql.Stmt
 	getWalletsByUserIDStmt                *sql.Stmt
+	getWatchedWalletByIDStmt              *sql.Stmt
+	getWatchedWalletsByUserIDStmt         *sql.Stmt
+	lockSponsoredTransactionsStmt         *sql.Stmt
+	updateSponsoredTransactionTxHashStmt  *sql.Stmt
 	updateStakeStmt                       *sql.Stmt
 	updateStakeLevelStmt                  *sql.Stmt
 	updateTokenTransferStmt               *sql.Stmt
+	updateWatchedWalletBalancesStmt       *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
@@ -362,13 +479,20 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		tx:                                    tx,
 		addEthereumAccountStmt:                q.addEthereumAccountStmt,
 		addSolanaAccountStmt:                  q.addSolanaAccountStmt,
+		addSponsoredTransactionStmt:           q.addSponsoredTransactionStmt,
 		addStakeStmt:                          q.addStakeStmt,
 		addStakeLevelStmt:                     q.addStakeLevelStmt,
 		addTokenTransferStmt:                  q.addTokenTransferStmt,
+		addWatchedWalletStmt:                  q.addWatchedWalletStmt,
 		checkRecipientAddressStmt:             q.checkRecipientAddressStmt,
+		countSponsoredTransactionsStmt:        q.countSponsoredTransactionsStmt,
+		countWatchedWalletsByUserIDStmt:       q.countWatchedWalletsByUserIDStmt,
 		createWalletStmt:                      q.createWalletStmt,
+		deleteSponsoredTransactionStmt:        q.deleteSponsoredTransactionStmt,
 		deleteStakeByUserIDStmt:               q.deleteStakeByUserIDStmt,
 		deleteWalletByIDStmt:                  q.deleteWalletByIDStmt,
+		deleteWatchedWalletStmt:               q.deleteWatchedWalletStmt,
+		deleteWatchedWalletsByUserIDStmt:      q.deleteWatchedWalletsByUserIDStmt,
 		doesUserHaveFraudulentTransfersStmt:   q.doesUserHaveFraudulentTransfersStmt,
 		doesUserMakeTransferForLastMinuteStmt: q.doesUserMakeTransferForLastMinuteStmt,
 		getAllEnabledStakeLevelsStmt:          q.getAllEnabledStakeLevelsStmt,
@@ -383,14 +507,20 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getStakeByUserIDStmt:                  q.getStakeByUserIDStmt,
 		getStakeLevelByAmountStmt:             q.getStakeLevelByAmountStmt,
 		getStakeLevelByIDStmt:                 q.getStakeLevelByIDStmt,
+		getStaleWatchedWalletsStmt:            q.getStaleWatchedWalletsStmt,
 		getTotalStakeStmt:                     q.getTotalStakeStmt,
 		getWalletByEthereumAccountIDStmt:      q.getWalletByEthereumAccountIDStmt,
 		getWalletByIDStmt:                     q.getWalletByIDStmt,
 		getWalletBySolanaAccountIDStmt:        q.getWalletBySolanaAccountIDStmt,
 		getWalletByUserIDAndTypeStmt:          q.getWalletByUserIDAndTypeStmt,
 		getWalletsByUserIDStmt:                q.getWalletsByUserIDStmt,
+		getWatchedWalletByIDStmt:              q.getWatchedWalletByIDStmt,
+		getWatchedWalletsByUserIDStmt:         q.getWatchedWalletsByUserIDStmt,
+		lockSponsoredTransactionsStmt:         q.lockSponsoredTransactionsStmt,
+		updateSponsoredTransactionTxHashStmt:  q.updateSponsoredTransactionTxHashStmt,
 		updateStakeStmt:                       q.updateStakeStmt,
 		updateStakeLevelStmt:                  q.updateStakeLevelStmt,
 		updateTokenTransferStmt:               q.updateTokenTransferStmt,
+		updateWatchedWalletBalancesStmt:       q.updateWatchedWalletBalancesStmt,
 	}
 }
@@ -28,6 +28,14 @@ type SolanaAccount struct {
 	CreatedAt   time.Time     `json:"created_at"`
 }
 
+type SponsoredTransaction struct {
+	ID         uuid.UUID `json:"id"`
+	UserID     uuid.UUID `json:"user_id"`
+	ActionType string    `json:"action_type"`
+	TxHash     string    `json:"tx_hash"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
 type Stake struct {
 	ID               uuid.UUID     `json:"id"`
 	UserID           uuid.UUID     `json:"user_id"`
@@ -73,3 +81,15 @@ type Wallet struct {
 	Sort              int32         `json:"sort"`
 	EthereumAccountID uuid.NullUUID `json:"ethereum_account_id"`
 }
+
+type WatchedWallet struct {
+	ID           uuid.UUID    `json:"id"`
+	UserID       uuid.UUID    `json:"user_id"`
+	Address      string       `json:"address"`
+	Title        string       `json:"title"`
+	SaoBalance   float64      `json:"sao_balance"`
+	SolBalance   float64      `json:"sol_balance"`
+	NftMintAddrs []string     `json:"nft_mint_addrs"`
+	RefreshedAt  sql.NullTime `json:"refreshed_at"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
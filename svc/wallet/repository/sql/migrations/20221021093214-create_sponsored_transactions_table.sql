@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sponsored_transactions (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    action_type VARCHAR NOT NULL,
+    tx_hash VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE INDEX sponsored_transactions_user_id_created_at ON sponsored_transactions USING BTREE (user_id, created_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS sponsored_transactions_user_id_created_at;
+DROP TABLE IF EXISTS sponsored_transactions;
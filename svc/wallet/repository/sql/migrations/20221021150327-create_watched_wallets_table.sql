@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS watched_wallets (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL,
+    address VARCHAR NOT NULL,
+    title VARCHAR NOT NULL DEFAULT '',
+    sao_balance DOUBLE PRECISION NOT NULL DEFAULT 0,
+    sol_balance DOUBLE PRECISION NOT NULL DEFAULT 0,
+    nft_mint_addrs TEXT[] NOT NULL DEFAULT '{}',
+    refreshed_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX watched_wallets_user_id_address ON watched_wallets USING BTREE (user_id, address);
+
+-- +migrate Down
+DROP INDEX IF EXISTS watched_wallets_user_id_address;
+DROP TABLE IF EXISTS watched_wallets;
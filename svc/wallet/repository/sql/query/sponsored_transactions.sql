@@ -0,0 +1,24 @@
+-- name: LockSponsoredTransactions :exec
+SELECT pg_advisory_xact_lock(hashtext(@user_id::TEXT));
+
+-- name: AddSponsoredTransaction :one
+INSERT INTO sponsored_transactions (user_id, action_type, tx_hash)
+VALUES (@user_id, @action_type, @tx_hash)
+RETURNING id;
+
+-- name: UpdateSponsoredTransactionTxHash :exec
+UPDATE sponsored_transactions
+SET tx_hash = @tx_hash
+WHERE id = @id;
+
+-- name: DeleteSponsoredTransaction :exec
+DELETE FROM sponsored_transactions
+WHERE id = @id;
+
+-- name: CountSponsoredTransactions :one
+SELECT 
+    COUNT(*) FILTER (WHERE created_at >= @day_start::TIMESTAMP)::INT AS daily,
+    COUNT(*)::INT AS monthly
+FROM sponsored_transactions
+WHERE user_id = @user_id
+    AND created_at >= @month_start::TIMESTAMP;
@@ -0,0 +1,46 @@
+-- name: AddWatchedWallet :one
+INSERT INTO watched_wallets (user_id, address, title)
+VALUES (@user_id, @address, @title) RETURNING *;
+
+-- name: GetWatchedWalletByID :one
+SELECT *
+FROM watched_wallets
+WHERE id = @id
+LIMIT 1;
+
+-- name: GetWatchedWalletsByUserID :many
+SELECT *
+FROM watched_wallets
+WHERE user_id = @user_id
+ORDER BY created_at ASC;
+
+-- name: GetStaleWatchedWallets :many
+SELECT *
+FROM watched_wallets
+WHERE refreshed_at IS NULL
+    OR refreshed_at < @refreshed_before::TIMESTAMP
+ORDER BY refreshed_at ASC NULLS FIRST
+LIMIT @limit_val;
+
+-- name: CountWatchedWalletsByUserID :one
+SELECT COUNT(*)
+FROM watched_wallets
+WHERE user_id = @user_id;
+
+-- name: UpdateWatchedWalletBalances :one
+UPDATE watched_wallets
+SET sao_balance = @sao_balance,
+    sol_balance = @sol_balance,
+    nft_mint_addrs = @nft_mint_addrs,
+    refreshed_at = now()
+WHERE id = @id RETURNING *;
+
+-- name: DeleteWatchedWallet :exec
+DELETE FROM watched_wallets
+WHERE id = @id
+    AND user_id = @user_id;
+
+
+-- name: DeleteWatchedWalletsByUserID :exec
+DELETE FROM watched_wallets
+WHERE user_id = @user_id;
\ No newline at end of file
@@ -5,12 +5,16 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/mr-tron/base58"
 	"github.com/pkg/errors"
 	"github.com/portto/solana-go-sdk/common"
+	"github.com/portto/solana-go-sdk/program/assotokenprog"
+	"github.com/portto/solana-go-sdk/program/sysprog"
+	"github.com/portto/solana-go-sdk/program/tokenprog"
 	"github.com/portto/solana-go-sdk/types"
 	log "github.com/sirupsen/logrus"
 
@@ -18,24 +22,28 @@ import (
 	lib_errors "github.com/SatorNetwork/sator-api/lib/errors"
 	"github.com/SatorNetwork/sator-api/lib/ethereum"
 	lib_solana "github.com/SatorNetwork/sator-api/lib/solana"
-	tx_watcher_alias "github.com/SatorNetwork/sator-api/svc/tx_watcher/alias"
+	"github.com/SatorNetwork/sator-api/lib/totp"
+	"github.com/SatorNetwork/sator-api/svc/campaigns"
 	"github.com/SatorNetwork/sator-api/svc/wallet/repository"
 )
 
 type (
 	// Service struct
 	Service struct {
-		wr walletRepository
-		sc solanaClient
-		ec ethereumClient
+		dbConn *sql.DB
+		wr     walletRepository
+		sc     solanaClient
+		ec     ethereumClient
 		// rw rewardsService
 
-		txWatcher txWatcher
+		exchangeRates exchangeRatesClient
 
 		satorAssetName  string
 		solanaAssetName string
+		usdcAssetName   string
 
 		satorAssetSolanaAddr        string
+		usdcAssetSolanaAddr         string
 		feePayerSolanaAddr          string
 		feePayerSolanaPrivateKey    []byte
 		stakePoolSolanaPublicKey    string
@@ -46,6 +54,7 @@ type (
 		walletTransactionsURL   string // url template to get SOL & SAO wallet types transactions list
 		rewardsWalletDetailsURL string // url template to get rewards wallet type details
 		rewardsTransactionsURL  string // url template to get rewards wallet type transactions list
+		watchedWalletDetailsURL string // url template to get watch-only wallet details
 
 		minAmountToTransfer float64 // minimum amount to transfer request
 
@@ -57,12 +66,26 @@ type (
 		enableResourceIntensiveQueries bool
 
 		enableRewardsWallet bool
+
+		feeSponsorshipTiers       []FeeSponsorshipTier
+		feeSponsorshipFallbackFee float64 // fee in SAO for lock/unlock when sponsorship quota is exceeded
+
+		maxWatchedWallets            int32         // max number of watch-only wallets per user
+		watchedWalletRefreshInterval time.Duration // how long watch-only wallet balances are cached
+
+		trackEvent      trackEventFunc     // reports user's activity to reward campaigns
+		verifyTwoFactor twoFactorCheckFunc // checks step-up code of sensitive actions, skipped if nil
 	}
 
 	// ServiceOption function
 	// interface to extend service via options
 	ServiceOption func(*Service)
 
+	trackEventFunc func(ctx context.Context, userID uuid.UUID, event string)
+
+	// twoFactorCheckFunc returns error if the action requires step-up code and the code is missed or invalid
+	twoFactorCheckFunc func(ctx context.Context, userID uuid.UUID, action string) error
+
 	walletRepository interface {
 		CreateWallet(ctx context.Context, arg repository.CreateWalletParams) (repository.Wallet, error)
 		GetWalletsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.Wallet, error)
@@ -97,11 +120,29 @@ type (
 		CheckRecipientAddress(ctx context.Context, arg repository.CheckRecipientAddressParams) (int64, error)
 		DoesUserHaveFraudulentTransfers(ctx context.Context, userID uuid.UUID) (bool, error)
 		DoesUserMakeTransferForLastMinute(ctx context.Context, userID uuid.UUID) (bool, error)
+
+		LockSponsoredTransactions(ctx context.Context, userID uuid.UUID) error
+		AddSponsoredTransaction(ctx context.Context, arg repository.AddSponsoredTransactionParams) (uuid.UUID, error)
+		UpdateSponsoredTransactionTxHash(ctx context.Context, arg repository.UpdateSponsoredTransactionTxHashParams) error
+		DeleteSponsoredTransaction(ctx context.Context, id uuid.UUID) error
+		CountSponsoredTransactions(ctx context.Context, arg repository.CountSponsoredTransactionsParams) (repository.CountSponsoredTransactionsRow, error)
+
+		AddWatchedWallet(ctx context.Context, arg repository.AddWatchedWalletParams) (repository.WatchedWallet, error)
+		GetWatchedWalletByID(ctx context.Context, id uuid.UUID) (repository.WatchedWallet, error)
+		GetWatchedWalletsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.WatchedWallet, error)
+		DeleteWatchedWalletsByUserID(ctx context.Context, userID uuid.UUID) error
+		CountWatchedWalletsByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+		GetStaleWatchedWallets(ctx context.Context, arg repository.GetStaleWatchedWalletsParams) ([]repository.WatchedWallet, error)
+		UpdateWatchedWalletBalances(ctx context.Context, arg repository.UpdateWatchedWalletBalancesParams) (repository.WatchedWallet, error)
+		DeleteWatchedWallet(ctx context.Context, arg repository.DeleteWatchedWalletParams) error
+
+		WithTx(tx *sql.Tx) *repository.Queries
 	}
 
 	solanaClient interface {
 		GetAccountBalanceSOL(ctx context.Context, accPubKey string) (float64, error)
 		GetTokenAccountBalanceWithAutoDerive(ctx context.Context, assetAddr, accountAddr string) (float64, error)
+		GetTokenAccountAmount(ctx context.Context, accPubKey string) (amount uint64, decimals uint8, err error)
 		NewAccount() types.Account
 		AccountFromPrivateKeyBytes(pk []byte) (types.Account, error)
 		FeeAccumulatorAddress() string
@@ -117,20 +158,22 @@ type (
 		) (*lib_solana.PrepareTxResponse, error)
 		SendAssetsWithAutoDerive(ctx context.Context, assetAddr string, feePayer, source types.Account, recipientAddr string, amount float64, cfg *lib_solana.SendAssetsConfig) (string, error)
 		GetTransactionsWithAutoDerive(ctx context.Context, assetAddr, accountAddr string) ([]lib_solana.ConfirmedTransactionResponse, error)
+		GetNFTMintAddrs(ctx context.Context, walletAddr string) ([]string, error)
 
 		InitializeStakePool(ctx context.Context, feePayer, issuer types.Account, asset common.PublicKey) (txHast string, stakePool types.Account, err error)
 		Stake(ctx context.Context, feePayer, userWallet types.Account, pool, asset common.PublicKey, duration int64, amount float64) (string, error)
 		Unstake(ctx context.Context, feePayer, userWallet types.Account, stakePool, asset common.PublicKey) (string, error)
 		IsTransactionSuccessful(ctx context.Context, txhash string) (bool, error)
+		SendTransaction(ctx context.Context, feePayer, signer types.Account, instructions ...types.Instruction) (string, error)
+		SendConstructedTransaction(ctx context.Context, tx types.Transaction) (string, error)
 	}
 
 	ethereumClient interface {
 		CreateAccount() (ethereum.Wallet, error)
 	}
 
-	txWatcher interface {
-		SendAndWatchTx(ctx context.Context, message types.Message, accountAliases []tx_watcher_alias.Alias) (string, error)
-	}
+	// SignedTxFunc receives signature and blockhash of the transaction before it's sent to blockchain
+	SignedTxFunc func(txHash, blockhash string) error
 
 	// PreparedTransaction ...
 	PreparedTransaction struct {
@@ -155,25 +198,33 @@ type (
 
 // NewService is a factory function,
 // returns a new instance of the Service interface implementation
-func NewService(wr walletRepository, sc solanaClient, ec ethereumClient, txWatcher txWatcher, opt ...ServiceOption) *Service {
+func NewService(dbConn *sql.DB, wr walletRepository, sc solanaClient, ec ethereumClient, opt ...ServiceOption) *Service {
 	s := &Service{
-		wr: wr,
-		sc: sc,
-		ec: ec,
+		dbConn: dbConn,
+		wr:     wr,
+		sc:     sc,
+		ec:     ec,
 		// rw: rw,
-		txWatcher: txWatcher,
 
 		solanaAssetName: "SOL",
 		satorAssetName:  "SAO",
+		usdcAssetName:   "USDC",
 
 		walletDetailsURL:        "wallets/%s",
 		walletTransactionsURL:   "wallets/%s/transactions",
 		rewardsWalletDetailsURL: "rewards/wallet/%s",
 		rewardsTransactionsURL:  "rewards/wallet/%s/transactions",
+		watchedWalletDetailsURL: "wallets/watched/%s",
 
 		minAmountToTransfer: 0,
 
 		enableRewardsWallet: true,
+
+		feeSponsorshipTiers:       defaultFeeSponsorshipTiers,
+		feeSponsorshipFallbackFee: 1,
+
+		maxWatchedWallets:            10,
+		watchedWalletRefreshInterval: 10 * time.Minute,
 	}
 
 	for _, o := range opt {
@@ -253,6 +304,26 @@ func (s *Service) GetWallets(ctx context.Context, uid uuid.UUID) (Wallets, error
 		result = append(result, wli)
 	}
 
+	watched, err := s.getWatchedWallets(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	order := int32(len(result))
+	for _, ww := range watched {
+		order++
+		result = append(result, WalletsListItem{
+			ID:                   ww.ID,
+			Type:                 ww.Type,
+			GetDetailsURL:        fmt.Sprintf(s.watchedWalletDetailsURL, ww.ID),
+			Order:                order,
+			Title:                ww.Title,
+			SolanaAccountAddress: ww.SolanaAccountAddress,
+			Balance:              ww.Balance,
+			TotalUSD:             ww.TotalUSD,
+		})
+	}
+
 	return result, nil
 }
 
@@ -316,6 +387,7 @@ func (s *Service) GetWalletByID(ctx context.Context, userID, walletID uuid.UUID)
 	}
 
 	var balance []Balance
+	var sponsored *SponsoredTransactions
 
 	switch sa.AccountType {
 	case GeneralAccount.String():
@@ -331,6 +403,11 @@ func (s *Service) GetWalletByID(ctx context.Context, userID, walletID uuid.UUID)
 				// },
 			}
 		}
+		if st, err := s.GetSponsoredTransactions(ctx, userID); err == nil {
+			sponsored = &st
+		} else {
+			log.Printf("could not get sponsored transactions of user %s: %v", userID.String(), err)
+		}
 		// case GeneralAccount.String():
 		// 	if bal, err := s.sc.GetAccountBalanceSOL(ctx, sa.PublicKey); err == nil {
 		// 		balance = []Balance{
@@ -368,7 +445,8 @@ func (s *Service) GetWalletByID(ctx context.Context, userID, walletID uuid.UUID)
 			// 	URL:  "",
 			// },
 		},
-		Balance: balance,
+		Balance:               balance,
+		SponsoredTransactions: sponsored,
 	}, nil
 }
 
@@ -439,8 +517,11 @@ func (s *Service) CreateWallet(ctx context.Context, userID uuid.UUID) error {
 	return nil
 }
 
-// WithdrawRewards convert rewards into sator tokens
-func (s *Service) WithdrawRewards(ctx context.Context, userID uuid.UUID, amount float64) (txhash string, err error) {
+// WithdrawRewards convert rewards into sator tokens.
+// The signed transaction is passed to onSigned before it's sent to blockchain and isn't sent if onSigned fails,
+// so the caller is able to store the signature and find the transaction on chain whatever happens next.
+// The same signed transaction is resent on failure, so it can't be executed twice.
+func (s *Service) WithdrawRewards(ctx context.Context, userID uuid.UUID, amount float64, onSigned SignedTxFunc) (txhash string, err error) {
 	user, err := s.wr.GetSolanaAccountByUserIDAndType(ctx, repository.GetSolanaAccountByUserIDAndTypeParams{
 		UserID:     userID,
 		WalletType: WalletTypeSator,
@@ -466,6 +547,12 @@ func (s *Service) WithdrawRewards(ctx context.Context, userID uuid.UUID, amount
 		return "", err
 	}
 
+	cfg := &lib_solana.SendAssetsConfig{
+		PercentToCharge:           s.claimRewardsPercent,
+		ChargeSolanaFeeFromSender: true,
+	}
+	sponsorshipID := s.sponsorFee(ctx, userID, ActionClaimRewards, cfg)
+
 	prepareTxResp, err := s.sc.PrepareSendAssetsTx(
 		ctx,
 		s.satorAssetSolanaAddr,
@@ -473,26 +560,33 @@ func (s *Service) WithdrawRewards(ctx context.Context, userID uuid.UUID, amount
 		tokenHolder,
 		user.PublicKey,
 		amount,
-		&lib_solana.SendAssetsConfig{
-			PercentToCharge:           s.claimRewardsPercent,
-			ChargeSolanaFeeFromSender: true,
-		},
+		cfg,
 	)
 	if err != nil {
+		s.releaseSponsoredTransaction(ctx, sponsorshipID)
 		return "", errors.Wrap(err, "can't prepare send assets tx")
 	}
 
+	if len(prepareTxResp.Tx.Signatures) == 0 {
+		s.releaseSponsoredTransaction(ctx, sponsorshipID)
+		return "", fmt.Errorf("could not claim rewards: transaction is not signed")
+	}
+	txhash = base58.Encode(prepareTxResp.Tx.Signatures[0])
+
+	if err := onSigned(txhash, prepareTxResp.Tx.Message.RecentBlockHash); err != nil {
+		s.releaseSponsoredTransaction(ctx, sponsorshipID)
+		return "", fmt.Errorf("could not store rewards withdraw transaction: %w", err)
+	}
+	// the transaction may reach blockchain from now on, so the sponsored transaction is counted anyway
+	s.confirmSponsoredTransaction(ctx, sponsorshipID, txhash)
+
 	// sends token
 	for i := 0; i < 5; i++ {
-		if txhash, err = s.txWatcher.SendAndWatchTx(
-			ctx,
-			prepareTxResp.Tx.Message,
-			[]tx_watcher_alias.Alias{tx_watcher_alias.FeePayerAlias, tx_watcher_alias.TokenHolderAlias},
-		); err != nil {
+		if _, err = s.sc.SendConstructedTransaction(ctx, prepareTxResp.Tx); err != nil {
 			if i < 4 {
 				log.Println(err)
 			} else {
-				return "", fmt.Errorf("could not claim rewards: %w", err)
+				return txhash, fmt.Errorf("could not send rewards withdraw transaction %s: %w", txhash, err)
 			}
 			time.Sleep(time.Second * 10)
 		} else {
@@ -637,6 +731,14 @@ func (s *Service) CreateTransfer(ctx context.Context, walletID uuid.UUID, recipi
 			return PreparedTransferTransaction{}, err
 		}
 
+		cfg := &lib_solana.SendAssetsConfig{
+			PercentToCharge:           s.tokenTransferPercent,
+			ChargeSolanaFeeFromSender: true,
+			AllowFallbackToDefaultFee: true,
+			DefaultFee:                1,
+		}
+		cfg.ChargeSolanaFeeFromSender = !s.isTransactionSponsored(ctx, w.UserID)
+
 		resp, err := s.sc.PrepareSendAssetsTx(
 			ctx,
 			s.satorAssetSolanaAddr,
@@ -644,12 +746,7 @@ func (s *Service) CreateTransfer(ctx context.Context, walletID uuid.UUID, recipi
 			source,
 			recipientPK,
 			amount,
-			&lib_solana.SendAssetsConfig{
-				PercentToCharge:           s.tokenTransferPercent,
-				ChargeSolanaFeeFromSender: true,
-				AllowFallbackToDefaultFee: true,
-				DefaultFee:                1,
-			},
+			cfg,
 		)
 		if err != nil {
 			return PreparedTransferTransaction{}, err
@@ -669,6 +766,12 @@ func (s *Service) CreateTransfer(ctx context.Context, walletID uuid.UUID, recipi
 }
 
 func (s *Service) ConfirmTransfer(ctx context.Context, uid, walletID uuid.UUID, encodedData string) error {
+	if s.verifyTwoFactor != nil {
+		if err := s.verifyTwoFactor(ctx, uid, totp.ActionConfirmTransfer); err != nil {
+			return err
+		}
+	}
+
 	decoded, err := base58.Decode(encodedData)
 	if err != nil {
 		return fmt.Errorf("could not decode from base58: %w", err)
@@ -723,13 +826,18 @@ func (s *Service) ConfirmTransfer(ctx context.Context, uid, walletID uuid.UUID,
 		}
 	}
 
-	tx, err := s.execTransfer(ctx, walletID, toDecode.RecipientAddr, toDecode.Amount, &lib_solana.SendAssetsConfig{
+	cfg := &lib_solana.SendAssetsConfig{
 		PercentToCharge:           s.tokenTransferPercent,
 		ChargeSolanaFeeFromSender: true,
 		AllowFallbackToDefaultFee: true,
 		DefaultFee:                1,
-	})
+	}
+	sponsorshipID := s.sponsorFee(ctx, uid, ActionSendTokens, cfg)
+
+	tx, err := s.execTransfer(ctx, walletID, toDecode.RecipientAddr, toDecode.Amount, cfg)
 	if err != nil {
+		s.releaseSponsoredTransaction(ctx, sponsorshipID)
+
 		if tr.ID != uuid.Nil {
 			if err := s.wr.UpdateTokenTransfer(ctx, repository.UpdateTokenTransferParams{
 				ID:     tr.ID,
@@ -742,6 +850,8 @@ func (s *Service) ConfirmTransfer(ctx context.Context, uid, walletID uuid.UUID,
 		return fmt.Errorf("could not confirm transfer: %w", err)
 	}
 
+	s.confirmSponsoredTransaction(ctx, sponsorshipID, tx)
+
 	if tr.ID != uuid.Nil {
 		if err := s.wr.UpdateTokenTransfer(ctx, repository.UpdateTokenTransferParams{
 			ID:     tr.ID,
@@ -906,6 +1016,11 @@ func (s *Service) SetStake(ctx context.Context, userID, walletID uuid.UUID, dura
 		return false, err
 	}
 
+	sponsorshipID := s.reserveSponsoredTransaction(ctx, userID, ActionStakeTokens)
+	if sponsorshipID == uuid.Nil && bal < amount+s.feeSponsorshipFallbackFee {
+		return false, fmt.Errorf("insufficient balance amount to lock tokens and pay fee %.5f SAO. You have %.5f SAO", s.feeSponsorshipFallbackFee, bal)
+	}
+
 	var tx string
 	for i := 0; i < 5; i++ {
 		newCtx, cancel := context.WithCancel(context.Background())
@@ -914,6 +1029,7 @@ func (s *Service) SetStake(ctx context.Context, userID, walletID uuid.UUID, dura
 				log.Println(err)
 			} else {
 				cancel()
+				s.releaseSponsoredTransaction(ctx, sponsorshipID)
 				return false, fmt.Errorf("%w: %v", ErrTransactionFailed, err)
 			}
 			cancel()
@@ -921,6 +1037,7 @@ func (s *Service) SetStake(ctx context.Context, userID, walletID uuid.UUID, dura
 		} else {
 			cancel()
 			log.Printf("successful transaction: %s", tx)
+			s.confirmSponsoredTransaction(ctx, sponsorshipID, tx)
 			break
 		}
 	}
@@ -936,6 +1053,16 @@ func (s *Service) SetStake(ctx context.Context, userID, walletID uuid.UUID, dura
 		time.Sleep(time.Second * 10)
 	}
 
+	if sponsorshipID == uuid.Nil {
+		if err := s.chargeSponsorshipFallbackFee(ctx, walletID); err != nil {
+			log.Printf("could not charge tokens lock fee from wallet %s: %v", walletID.String(), err)
+		}
+	}
+
+	if s.trackEvent != nil {
+		s.trackEvent(ctx, userID, campaigns.EventStake)
+	}
+
 	// Store stake data in our db.
 	staked, err := s.wr.GetStakeByUserID(ctx, userID)
 	if err != nil {
@@ -1019,6 +1146,8 @@ func (s *Service) Unstake(ctx context.Context, userID, walletID uuid.UUID) error
 		return fmt.Errorf("unlock time has not yet come, unlock will be availabe at: %s", unstakeDate.String())
 	}
 
+	sponsorshipID := s.reserveSponsoredTransaction(ctx, userID, ActionUnstakeTokens)
+
 	for i := 0; i < 5; i++ {
 		newCtx, cancel := context.WithCancel(context.Background())
 		if tx, err := s.sc.Unstake(newCtx, feePayer, userWallet, stakePool, asset); err != nil {
@@ -1026,6 +1155,7 @@ func (s *Service) Unstake(ctx context.Context, userID, walletID uuid.UUID) error
 				log.Println(err)
 			} else {
 				cancel()
+				s.releaseSponsoredTransaction(ctx, sponsorshipID)
 				return fmt.Errorf("transaction: %w", err)
 			}
 			cancel()
@@ -1033,10 +1163,17 @@ func (s *Service) Unstake(ctx context.Context, userID, walletID uuid.UUID) error
 		} else {
 			cancel()
 			log.Printf("successful transaction: %s", tx)
+			s.confirmSponsoredTransaction(ctx, sponsorshipID, tx)
 			break
 		}
 	}
 
+	if sponsorshipID == uuid.Nil {
+		if err := s.chargeSponsorshipFallbackFee(ctx, walletID); err != nil {
+			log.Printf("could not charge tokens unlock fee from wallet %s: %v", walletID.String(), err)
+		}
+	}
+
 	err = s.wr.DeleteStakeByUserID(ctx, userID)
 	if err != nil {
 		log.Printf("could not delete stake by user id: %v", err)
@@ -1090,6 +1227,127 @@ func (s *Service) PayForService(ctx context.Context, uid uuid.UUID, amount float
 	return nil
 }
 
+// PayForServiceWithAsset charges the user in one of the supported assets (SAO, SOL or USDC)
+// and returns the payment transaction hash.
+func (s *Service) PayForServiceWithAsset(ctx context.Context, uid uuid.UUID, asset string, amount float64, info string) (string, error) {
+	if amount <= 0 {
+		return "", fmt.Errorf("%w: amount must be greater than 0", ErrInvalidParameter)
+	}
+
+	w, err := s.wr.GetWalletByUserIDAndType(ctx, repository.GetWalletByUserIDAndTypeParams{
+		UserID:     uid,
+		WalletType: WalletTypeSator,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not make payment for %s: %w", info, err)
+	}
+
+	switch asset {
+	case s.satorAssetName:
+		txHash, err := s.execTransfer(ctx, w.ID, s.tokenHolderSolanaAddr, amount, &lib_solana.SendAssetsConfig{})
+		if err != nil {
+			return "", fmt.Errorf("could not make payment for %s: %w", info, err)
+		}
+		return txHash, nil
+	case s.solanaAssetName, s.usdcAssetName:
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedAsset, asset)
+	}
+
+	sa, err := s.wr.GetSolanaAccountByID(ctx, w.SolanaAccountID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return "", fmt.Errorf("%w solana account for this wallet", ErrNotFound)
+		}
+		return "", fmt.Errorf("could not get solana account for this wallet: %w", err)
+	}
+
+	feePayer, err := s.sc.AccountFromPrivateKeyBytes(s.feePayerSolanaPrivateKey)
+	if err != nil {
+		return "", err
+	}
+	source, err := s.sc.AccountFromPrivateKeyBytes(sa.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+	recipient := common.PublicKeyFromString(s.tokenHolderSolanaAddr)
+
+	var instructions []types.Instruction
+	if asset == s.solanaAssetName {
+		bal, err := s.sc.GetAccountBalanceSOL(ctx, sa.PublicKey)
+		if err != nil {
+			return "", fmt.Errorf("could not get wallet balance: %w", err)
+		}
+		if bal < amount {
+			return "", ErrNotEnoughBalance
+		}
+
+		instructions = append(instructions, sysprog.Transfer(sysprog.TransferParam{
+			From:   source.PublicKey,
+			To:     recipient,
+			Amount: uint64(math.Ceil(amount * 1e9)),
+		}))
+	} else {
+		if s.usdcAssetSolanaAddr == "" {
+			return "", fmt.Errorf("%w: %s", ErrUnsupportedAsset, asset)
+		}
+
+		mint := common.PublicKeyFromString(s.usdcAssetSolanaAddr)
+		sourceAta, _, err := common.FindAssociatedTokenAddress(source.PublicKey, mint)
+		if err != nil {
+			return "", fmt.Errorf("could not derive source token account: %w", err)
+		}
+		recipientAta, _, err := common.FindAssociatedTokenAddress(recipient, mint)
+		if err != nil {
+			return "", fmt.Errorf("could not derive recipient token account: %w", err)
+		}
+
+		// Raw amounts are compared, since SAO and USDC have different decimals
+		rawAmount := uint64(math.Ceil(amount * math.Pow10(usdcDecimals)))
+		bal, _, err := s.sc.GetTokenAccountAmount(ctx, sourceAta.ToBase58())
+		if err != nil {
+			if errors.Is(err, lib_solana.ErrTokenAccountNotFound) {
+				return "", ErrNotEnoughBalance
+			}
+			return "", fmt.Errorf("could not get wallet balance: %w", err)
+		}
+		if bal < rawAmount {
+			return "", ErrNotEnoughBalance
+		}
+
+		// Token holder may have no USDC account yet, it's created in the same transaction
+		if _, _, err := s.sc.GetTokenAccountAmount(ctx, recipientAta.ToBase58()); err != nil {
+			if !errors.Is(err, lib_solana.ErrTokenAccountNotFound) {
+				return "", fmt.Errorf("could not get recipient token account: %w", err)
+			}
+			instructions = append(instructions, assotokenprog.CreateAssociatedTokenAccount(assotokenprog.CreateAssociatedTokenAccountParam{
+				Funder:                 feePayer.PublicKey,
+				Owner:                  recipient,
+				Mint:                   mint,
+				AssociatedTokenAccount: recipientAta,
+			}))
+		}
+
+		instructions = append(instructions, tokenprog.TransferChecked(tokenprog.TransferCheckedParam{
+			From:     sourceAta,
+			To:       recipientAta,
+			Mint:     mint,
+			Auth:     source.PublicKey,
+			Signers:  []common.PublicKey{},
+			Amount:   rawAmount,
+			Decimals: usdcDecimals,
+		}))
+	}
+
+	txHash, err := s.sc.SendTransaction(ctx, feePayer, source, instructions...)
+	if err != nil {
+		log.Error(errors.Wrap(err, "can't send transaction"))
+		return "", fmt.Errorf("could not make payment for %s: %w", info, lib_errors.ErrCantSendSolanaTransaction)
+	}
+
+	return txHash, nil
+}
+
 // PayForNFT draft
 func (s *Service) PayForNFT(ctx context.Context, uid uuid.UUID, amount float64, info string, creatorAddr string, creatorShare int32) error {
 	w, err := s.wr.GetWalletByUserIDAndType(ctx, repository.GetWalletByUserIDAndTypeParams{
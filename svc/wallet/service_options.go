@@ -1,5 +1,7 @@
 package wallet
 
+import "time"
+
 // WithAssetSolanaAddress ...
 func WithAssetSolanaAddress(addr string) ServiceOption {
 	return func(s *Service) {
@@ -7,6 +9,13 @@ func WithAssetSolanaAddress(addr string) ServiceOption {
 	}
 }
 
+// WithUSDCAssetSolanaAddress sets USDC mint address used to accept payments in USDC
+func WithUSDCAssetSolanaAddress(addr string) ServiceOption {
+	return func(s *Service) {
+		s.usdcAssetSolanaAddr = addr
+	}
+}
+
 // WithStakePoolSolanaAddress ...
 func WithStakePoolSolanaAddress(addr string) ServiceOption {
 	return func(s *Service) {
@@ -67,3 +76,63 @@ func WithRewardsWalletEnabled(enable bool) ServiceOption {
 		s.enableRewardsWallet = enable
 	}
 }
+
+// WithFeeSponsorshipTiers sets tiers of transactions paid by the fee payer.
+// Default tiers are used if none passed.
+func WithFeeSponsorshipTiers(tiers ...FeeSponsorshipTier) ServiceOption {
+	return func(s *Service) {
+		if len(tiers) > 0 {
+			s.feeSponsorshipTiers = tiers
+		}
+	}
+}
+
+// WithFeeSponsorshipFallbackFee sets fee in SAO charged for lock and unlock of tokens
+// when the sponsorship quota is exceeded. Zero disables the fee, negative values are ignored.
+func WithFeeSponsorshipFallbackFee(fee float64) ServiceOption {
+	return func(s *Service) {
+		if fee >= 0 {
+			s.feeSponsorshipFallbackFee = fee
+		}
+	}
+}
+
+// WithExchangeRatesClient sets client to calculate USD value of watch-only wallets
+func WithExchangeRatesClient(er exchangeRatesClient) ServiceOption {
+	return func(s *Service) {
+		s.exchangeRates = er
+	}
+}
+
+// WithWatchedWalletsLimit sets max number of watch-only wallets per user
+func WithWatchedWalletsLimit(limit int32) ServiceOption {
+	return func(s *Service) {
+		if limit > 0 {
+			s.maxWatchedWallets = limit
+		}
+	}
+}
+
+// WithWatchedWalletRefreshInterval sets how long watch-only wallet balances are cached
+// before they are requested from blockchain again.
+func WithWatchedWalletRefreshInterval(d time.Duration) ServiceOption {
+	return func(s *Service) {
+		if d > 0 {
+			s.watchedWalletRefreshInterval = d
+		}
+	}
+}
+
+// WithCampaignEventFunc sets function to report locked tokens to reward campaigns
+func WithCampaignEventFunc(fn trackEventFunc) ServiceOption {
+	return func(s *Service) {
+		s.trackEvent = fn
+	}
+}
+
+// WithTwoFactorCheckFunc sets function to check step-up code of transfers
+func WithTwoFactorCheckFunc(fn twoFactorCheckFunc) ServiceOption {
+	return func(s *Service) {
+		s.verifyTwoFactor = fn
+	}
+}
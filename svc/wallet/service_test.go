@@ -144,6 +144,62 @@ func (r *walletRepoMock) DoesUserMakeTransferForLastMinute(ctx context.Context,
 	panic("not implemented") // TODO: Implement
 }
 
+func (r *walletRepoMock) LockSponsoredTransactions(ctx context.Context, userID uuid.UUID) error {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) AddSponsoredTransaction(ctx context.Context, arg repository.AddSponsoredTransactionParams) (uuid.UUID, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) UpdateSponsoredTransactionTxHash(ctx context.Context, arg repository.UpdateSponsoredTransactionTxHashParams) error {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) DeleteSponsoredTransaction(ctx context.Context, id uuid.UUID) error {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) CountSponsoredTransactions(ctx context.Context, arg repository.CountSponsoredTransactionsParams) (repository.CountSponsoredTransactionsRow, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) AddWatchedWallet(ctx context.Context, arg repository.AddWatchedWalletParams) (repository.WatchedWallet, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) GetWatchedWalletByID(ctx context.Context, id uuid.UUID) (repository.WatchedWallet, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) GetWatchedWalletsByUserID(ctx context.Context, userID uuid.UUID) ([]repository.WatchedWallet, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) CountWatchedWalletsByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) GetStaleWatchedWallets(ctx context.Context, arg repository.GetStaleWatchedWalletsParams) ([]repository.WatchedWallet, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) UpdateWatchedWalletBalances(ctx context.Context, arg repository.UpdateWatchedWalletBalancesParams) (repository.WatchedWallet, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) DeleteWatchedWallet(ctx context.Context, arg repository.DeleteWatchedWalletParams) error {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) DeleteWatchedWalletsByUserID(ctx context.Context, userID uuid.UUID) error {
+	panic("not implemented") // TODO: Implement
+}
+
+func (r *walletRepoMock) WithTx(tx *sql.Tx) *repository.Queries {
+	panic("not implemented") // TODO: Implement
+}
+
 func TestService_GetMultiplier(t *testing.T) {
 	type fields struct {
 		wr                          walletRepository
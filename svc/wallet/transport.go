@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/SatorNetwork/sator-api/lib/httpencoder"
+	"github.com/SatorNetwork/sator-api/lib/totp"
 	"github.com/SatorNetwork/sator-api/lib/utils"
 
 	"github.com/go-chi/chi/v5"
@@ -36,7 +37,7 @@ func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
 	options := []httptransport.ServerOption{
 		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(log)),
 		httptransport.ServerErrorEncoder(httpencoder.EncodeError(log, codeAndMessageFrom)),
-		httptransport.ServerBefore(jwtkit.HTTPToContext()),
+		httptransport.ServerBefore(jwtkit.HTTPToContext(), totp.ToContext()),
 	}
 
 	r.Get("/", httptransport.NewServer(
@@ -60,6 +61,27 @@ func MakeHTTPHandler(e Endpoints, log logger) http.Handler {
 		options...,
 	).ServeHTTP)
 
+	r.Post("/watched", httptransport.NewServer(
+		e.AddWatchedWallet,
+		decodeAddWatchedWalletRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Get("/watched/{watched_wallet_id}", httptransport.NewServer(
+		e.GetWatchedWalletByID,
+		decodeWatchedWalletIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
+	r.Delete("/watched/{watched_wallet_id}", httptransport.NewServer(
+		e.DeleteWatchedWallet,
+		decodeWatchedWalletIDRequest,
+		httpencoder.EncodeResponse,
+		options...,
+	).ServeHTTP)
+
 	r.Get("/{wallet_id}", httptransport.NewServer(
 		e.GetWalletByID,
 		decodeGetWalletByIDRequest,
@@ -180,10 +202,14 @@ func codeAndMessageFrom(err error) (int, interface{}) {
 		return http.StatusNotFound, err.Error()
 	}
 
-	if errors.Is(err, ErrInvalidParameter) {
+	if errors.Is(err, ErrInvalidParameter) || errors.Is(err, ErrWatchedWalletsLimit) {
 		return http.StatusBadRequest, err.Error()
 	}
 
+	if errors.Is(err, ErrAlreadyWatched) {
+		return http.StatusConflict, err.Error()
+	}
+
 	if errors.Is(err, ErrTransactionFailed) {
 		log.Printf("%v", err)
 		return http.StatusInternalServerError, ErrTransactionFailed
@@ -227,3 +253,20 @@ func decodePossibleMultiplierRequest(_ context.Context, r *http.Request) (interf
 
 	return req, nil
 }
+
+func decodeAddWatchedWalletRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req AddWatchedWalletRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("could not decode request body: %w", err)
+	}
+
+	return req, nil
+}
+
+func decodeWatchedWalletIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id := chi.URLParam(r, "watched_wallet_id")
+	if id == "" {
+		return nil, fmt.Errorf("%w: missed watched_wallet_id", ErrInvalidParameter)
+	}
+	return id, nil
+}
@@ -1,13 +1,21 @@
 package wallet
 
+import "math"
+
 // Predefined wallet types
 const (
 	WalletTypeSolana   string = "sol"
 	WalletTypeSator    string = "sao"
 	WalletTypeRewards  string = "rewards"
 	WalletTypeEthereum string = "eth"
+
+	// WalletTypeWatchOnly is an external solana address which balances are tracked but can't be used to sign
+	WalletTypeWatchOnly string = "watch_only"
 )
 
+// usdcDecimals is a number of decimals of USDC token on Solana
+const usdcDecimals = 6
+
 // Predefined  solana account types
 const (
 	TokenAccount       SolanaAccountType = "token_account"   // custom token account with sator tokens
@@ -35,6 +43,7 @@ const (
 	ActionSendTokens    ActionType = "send_tokens"
 	ActionReceiveTokens ActionType = "receive_tokens"
 	ActionStakeTokens   ActionType = "stake_tokens"
+	ActionUnstakeTokens ActionType = "unstake_tokens"
 )
 
 // Name of action type
@@ -48,6 +57,8 @@ func (at ActionType) Name() string {
 		return "Receive"
 	case ActionStakeTokens:
 		return "Lock"
+	case ActionUnstakeTokens:
+		return "Unlock"
 	}
 	return "Undefined"
 }
@@ -67,9 +78,26 @@ type (
 		GetDetailsURL      string `json:"get_details_url"`      // url to get wallet details
 		GetTransactionsURL string `json:"get_transactions_url"` // url to get transactions list
 		Order              int32  `json:"order"`
+
+		// watch-only wallets only
+		Title                string    `json:"title,omitempty"`
+		SolanaAccountAddress string    `json:"solana_account_address,omitempty"`
+		Balance              []Balance `json:"balance,omitempty"`
+		TotalUSD             float64   `json:"total_usd,omitempty"`
 	}
 )
 
+// WatchedTotalUSD returns aggregated USD value of the watch-only wallets in the list,
+// app wallets are not included since their balances are not cached.
+func (w Wallets) WatchedTotalUSD() float64 {
+	var total float64
+	for _, item := range w {
+		total += item.TotalUSD
+	}
+
+	return math.Round(total*100) / 100
+}
+
 type (
 	// Transactions list
 	Transactions []Transaction
@@ -105,6 +133,8 @@ type (
 		EthereumAccountAddress string    `json:"ethereum_account_address"`
 		Balance                []Balance `json:"balance"`
 		Actions                []Action  `json:"actions"`
+
+		SponsoredTransactions *SponsoredTransactions `json:"sponsored_transactions,omitempty"`
 	}
 
 	// Action ...
@@ -118,6 +148,7 @@ type (
 	Balance struct {
 		Currency string  `json:"currency"`
 		Amount   float64 `json:"amount"`
+		UnlockAt string  `json:"unlock_at,omitempty"` // date when the amount becomes available, if it's locked
 	}
 )
 
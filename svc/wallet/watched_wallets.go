@@ -0,0 +1,263 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/portto/solana-go-sdk/common"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/SatorNetwork/sator-api/lib/db"
+	lib_solana "github.com/SatorNetwork/sator-api/lib/solana"
+	"github.com/SatorNetwork/sator-api/svc/exchange_rates"
+	"github.com/SatorNetwork/sator-api/svc/wallet/repository"
+)
+
+// watchedWalletsRefreshBatchSize is the max number of watch-only wallets refreshed per run
+const watchedWalletsRefreshBatchSize = 100
+
+type (
+	// WatchedWallet is an external solana address added by user to track its balances.
+	// It can't be used to sign any transaction.
+	WatchedWallet struct {
+		ID                   string    `json:"id"`
+		Type                 string    `json:"type"`
+		Title                string    `json:"title"`
+		SolanaAccountAddress string    `json:"solana_account_address"`
+		Balance              []Balance `json:"balance"`
+		TotalUSD             float64   `json:"total_usd"`
+		NFTMintAddrs         []string  `json:"nft_mint_addrs"`
+		RefreshedAt          string    `json:"refreshed_at,omitempty"`
+	}
+
+	exchangeRatesClient interface {
+		GetAssetPrice(ctx context.Context, req *exchange_rates.Asset) (*exchange_rates.Price, error)
+	}
+)
+
+// AddWatchedWallet adds external solana address to the user's wallets list
+func (s *Service) AddWatchedWallet(ctx context.Context, userID uuid.UUID, address, title string) (WatchedWallet, error) {
+	if _, err := s.wr.GetSolanaAccountTypeByPublicKey(ctx, address); err == nil {
+		return WatchedWallet{}, fmt.Errorf("%w: address belongs to the app wallet", ErrInvalidParameter)
+	} else if !db.IsNotFoundError(err) {
+		return WatchedWallet{}, fmt.Errorf("could not check solana address: %w", err)
+	}
+
+	count, err := s.wr.CountWatchedWalletsByUserID(ctx, userID)
+	if err != nil {
+		return WatchedWallet{}, fmt.Errorf("could not count watch-only wallets: %w", err)
+	}
+	if count >= int64(s.maxWatchedWallets) {
+		return WatchedWallet{}, fmt.Errorf("%w: %d", ErrWatchedWalletsLimit, s.maxWatchedWallets)
+	}
+
+	ww, err := s.wr.AddWatchedWallet(ctx, repository.AddWatchedWalletParams{
+		UserID:  userID,
+		Address: address,
+		Title:   title,
+	})
+	if err != nil {
+		if db.IsDuplicateError(err) {
+			return WatchedWallet{}, ErrAlreadyWatched
+		}
+		return WatchedWallet{}, fmt.Errorf("could not add watch-only wallet: %w", err)
+	}
+
+	// balances of the new wallet are fetched right away, the rest are refreshed by RunWatchedWalletsRefresh
+	if updated, err := s.refreshWatchedWallet(ctx, ww); err != nil {
+		log.Printf("could not refresh watch-only wallet %s: %v", ww.ID.String(), err)
+	} else {
+		ww = updated
+	}
+
+	return s.castToWatchedWallet(ctx, ww), nil
+}
+
+// GetWatchedWalletByID returns watch-only wallet details
+func (s *Service) GetWatchedWalletByID(ctx context.Context, userID, id uuid.UUID) (WatchedWallet, error) {
+	ww, err := s.wr.GetWatchedWalletByID(ctx, id)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return WatchedWallet{}, fmt.Errorf("%w wallet", ErrNotFound)
+		}
+		return WatchedWallet{}, fmt.Errorf("could not get watch-only wallet: %w", err)
+	}
+
+	if ww.UserID != userID {
+		return WatchedWallet{}, fmt.Errorf("%w: you have no permissions to get this wallet", ErrForbidden)
+	}
+
+	return s.castToWatchedWallet(ctx, ww), nil
+}
+
+// DeleteWatchedWallet removes watch-only wallet from the user's wallets list
+func (s *Service) DeleteWatchedWallet(ctx context.Context, userID, id uuid.UUID) error {
+	if err := s.wr.DeleteWatchedWallet(ctx, repository.DeleteWatchedWalletParams{
+		ID:     id,
+		UserID: userID,
+	}); err != nil {
+		return fmt.Errorf("could not delete watch-only wallet: %w", err)
+	}
+
+	return nil
+}
+
+// getWatchedWallets returns the user's watch-only wallets with cached balances
+func (s *Service) getWatchedWallets(ctx context.Context, userID uuid.UUID) ([]WatchedWallet, error) {
+	list, err := s.wr.GetWatchedWalletsByUserID(ctx, userID)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not get watch-only wallets: %w", err)
+	}
+
+	result := make([]WatchedWallet, 0, len(list))
+	for _, ww := range list {
+		result = append(result, s.castToWatchedWallet(ctx, ww))
+	}
+
+	return result, nil
+}
+
+// RunWatchedWalletsRefresh refreshes cached balances of watch-only wallets in the background,
+// so the wallets list never waits for blockchain requests.
+func (s *Service) RunWatchedWalletsRefresh(ctx context.Context, interval time.Duration) error {
+	s.refreshStaleWatchedWallets(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.refreshStaleWatchedWallets(ctx)
+		}
+	}
+}
+
+// refreshStaleWatchedWallets refreshes one batch of wallets with outdated balances.
+// Wallets failed to refresh keep cached balances and are retried on the next run.
+func (s *Service) refreshStaleWatchedWallets(ctx context.Context) {
+	list, err := s.wr.GetStaleWatchedWallets(ctx, repository.GetStaleWatchedWalletsParams{
+		RefreshedBefore: time.Now().Add(-s.watchedWalletRefreshInterval),
+		LimitVal:        watchedWalletsRefreshBatchSize,
+	})
+	if err != nil {
+		if !db.IsNotFoundError(err) {
+			log.Printf("could not get stale watch-only wallets: %v", err)
+		}
+		return
+	}
+
+	for _, ww := range list {
+		if ctx.Err() != nil {
+			return
+		}
+		if _, err := s.refreshWatchedWallet(ctx, ww); err != nil {
+			log.Printf("could not refresh watch-only wallet %s: %v", ww.ID.String(), err)
+		}
+	}
+}
+
+// refreshWatchedWallet fetches balances from blockchain and stores them in the cache
+func (s *Service) refreshWatchedWallet(ctx context.Context, ww repository.WatchedWallet) (repository.WatchedWallet, error) {
+	saoBalance, err := s.getSAOBalance(ctx, ww.Address)
+	if err != nil {
+		return ww, fmt.Errorf("could not get SAO balance: %w", err)
+	}
+
+	solBalance, err := s.sc.GetAccountBalanceSOL(ctx, ww.Address)
+	if err != nil {
+		return ww, fmt.Errorf("could not get SOL balance: %w", err)
+	}
+
+	nfts, err := s.sc.GetNFTMintAddrs(ctx, ww.Address)
+	if err != nil {
+		return ww, fmt.Errorf("could not get NFTs: %w", err)
+	}
+
+	updated, err := s.wr.UpdateWatchedWalletBalances(ctx, repository.UpdateWatchedWalletBalancesParams{
+		SaoBalance:   saoBalance,
+		SolBalance:   solBalance,
+		NftMintAddrs: nfts,
+		ID:           ww.ID,
+	})
+	if err != nil {
+		return ww, fmt.Errorf("could not update balances: %w", err)
+	}
+
+	return updated, nil
+}
+
+// getSAOBalance returns SAO balance of the solana address.
+// Zero balance is returned only if the token account doesn't exist, any other error is passed through.
+func (s *Service) getSAOBalance(ctx context.Context, address string) (float64, error) {
+	ata, _, err := common.FindAssociatedTokenAddress(
+		common.PublicKeyFromString(address),
+		common.PublicKeyFromString(s.satorAssetSolanaAddr),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("could not derive token account: %w", err)
+	}
+
+	amount, decimals, err := s.sc.GetTokenAccountAmount(ctx, ata.ToBase58())
+	if err != nil {
+		if errors.Is(err, lib_solana.ErrTokenAccountNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return float64(amount) / math.Pow10(int(decimals)), nil
+}
+
+func (s *Service) castToWatchedWallet(ctx context.Context, ww repository.WatchedWallet) WatchedWallet {
+	result := WatchedWallet{
+		ID:                   ww.ID.String(),
+		Type:                 WalletTypeWatchOnly,
+		Title:                ww.Title,
+		SolanaAccountAddress: ww.Address,
+		Balance: []Balance{
+			{Currency: s.satorAssetName, Amount: ww.SaoBalance},
+			{Currency: s.solanaAssetName, Amount: ww.SolBalance},
+		},
+		TotalUSD:     s.totalUSD(ctx, ww.SaoBalance, ww.SolBalance),
+		NFTMintAddrs: ww.NftMintAddrs,
+	}
+	if ww.RefreshedAt.Valid {
+		result.RefreshedAt = ww.RefreshedAt.Time.Format(time.RFC3339)
+	}
+
+	return result
+}
+
+// totalUSD returns USD value of SAO and SOL balances, assets without exchange rate are skipped
+func (s *Service) totalUSD(ctx context.Context, saoBalance, solBalance float64) float64 {
+	if s.exchangeRates == nil {
+		return 0
+	}
+
+	var total float64
+	for asset, amount := range map[exchange_rates.AssetType]float64{
+		exchange_rates.AssetTypeSAO: saoBalance,
+		exchange_rates.AssetTypeSOL: solBalance,
+	} {
+		if amount <= 0 {
+			continue
+		}
+		price, err := s.exchangeRates.GetAssetPrice(ctx, &exchange_rates.Asset{AssetType: asset})
+		if err != nil || price == nil {
+			continue
+		}
+		total += amount * price.Usd
+	}
+
+	return math.Round(total*100) / 100
+}
@@ -0,0 +1,26 @@
+package wallet
+
+import "testing"
+
+func TestWallets_WatchedTotalUSD(t *testing.T) {
+	tests := []struct {
+		name    string
+		wallets Wallets
+		want    float64
+	}{
+		{"empty list", nil, 0},
+		{"app wallets only", Wallets{{Type: WalletTypeSator}, {Type: WalletTypeSolana}}, 0},
+		{"watch-only wallets", Wallets{
+			{Type: WalletTypeSator},
+			{Type: WalletTypeWatchOnly, TotalUSD: 10.555},
+			{Type: WalletTypeWatchOnly, TotalUSD: 0.01},
+		}, 10.57},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.wallets.WatchedTotalUSD(); got != tt.want {
+				t.Errorf("WatchedTotalUSD() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
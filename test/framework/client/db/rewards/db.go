@@ -38,3 +38,12 @@ func (db *DB) DepositRewards(ctx context.Context, userID uuid.UUID, amount float
 
 	return nil
 }
+
+func (db *DB) GetRewardClaimsByUserID(ctx context.Context, userID uuid.UUID) ([]rewardsRepo.RewardClaim, error) {
+	claims, err := db.authRepository.GetRewardClaimsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error to get reward claims of user: %v: %w", userID, err)
+	}
+
+	return claims, nil
+}
@@ -2,30 +2,33 @@ package rewards
 
 import (
 	"context"
-	"log"
 	"testing"
 
 	"github.com/golang/mock/gomock"
-	"github.com/portto/solana-go-sdk/rpc"
+	"github.com/google/uuid"
+	"github.com/mr-tron/base58"
+	"github.com/pkg/errors"
 	"github.com/portto/solana-go-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	db_internal "github.com/SatorNetwork/sator-api/lib/db"
 	lib_solana "github.com/SatorNetwork/sator-api/lib/solana"
 	solana_lib "github.com/SatorNetwork/sator-api/lib/solana"
 	"github.com/SatorNetwork/sator-api/lib/sumsub"
-	tx_watcher_svc "github.com/SatorNetwork/sator-api/svc/tx_watcher"
-	tx_watcher_repository "github.com/SatorNetwork/sator-api/svc/tx_watcher/repository"
+	"github.com/SatorNetwork/sator-api/svc/rewards"
+	rewardsRepo "github.com/SatorNetwork/sator-api/svc/rewards/repository"
 	"github.com/SatorNetwork/sator-api/test/app_config"
-	"github.com/SatorNetwork/sator-api/test/framework/accounts"
 	"github.com/SatorNetwork/sator-api/test/framework/client"
 	"github.com/SatorNetwork/sator-api/test/framework/client/auth"
 	"github.com/SatorNetwork/sator-api/test/framework/user"
 	"github.com/SatorNetwork/sator-api/test/mock"
 )
 
-func TestClaimRewards_ImmediateSuccess(t *testing.T) {
+const testBlockhash = "EkSnNWid2cvwEVnVx9aBqawnmiCNiDgp3gUdkDPTKN1N"
+
+func newSolanaMock(t *testing.T) *solana_lib.MockInterface {
 	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	t.Cleanup(ctrl.Finish)
 
 	solanaMock := solana_lib.NewMockInterface(ctrl)
 	mock.RegisterMockObject(mock.SolanaProvider, solanaMock)
@@ -36,49 +39,31 @@ func TestClaimRewards_ImmediateSuccess(t *testing.T) {
 		GetTokenAccountBalanceWithAutoDerive(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(float64(100), nil).
 		AnyTimes()
+	// claims reconciliation may run in background during the test
 	solanaMock.EXPECT().
-		SerializeTxMessage(gomock.Any()).
-		Return([]byte{}, nil).
-		Times(1)
-	solanaMock.EXPECT().
-		DeserializeTxMessage(gomock.Any()).
-		Return(types.Message{}, nil).
-		Times(1)
-	solanaMock.EXPECT().
-		NewTransaction(gomock.Any()).
-		Return(types.Transaction{}, nil).
-		Times(1)
-	solanaMock.EXPECT().
-		GetLatestBlockhash(gomock.Any()).
-		Return(rpc.GetLatestBlockhashValue{}, nil).
-		Times(1)
-	solanaMock.EXPECT().
-		PrepareSendAssetsTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(&lib_solana.PrepareTxResponse{}, nil).
-		Times(1)
-	solanaMock.EXPECT().
-		SendConstructedTransaction(gomock.Any(), gomock.Any()).
-		Return("", nil).
-		Times(1)
-	solanaMock.EXPECT().
-		IsTransactionSuccessful(gomock.Any(), gomock.Any()).
+		IsBlockhashValid(gomock.Any(), gomock.Any()).
 		Return(true, nil).
-		Times(1)
-
-	defer app_config.RunAndWait()()
+		AnyTimes()
+	solanaMock.EXPECT().
+		GetTransactionStatus(gomock.Any(), gomock.Any()).
+		Return(lib_solana.TransactionStatusPending, nil).
+		AnyTimes()
 
-	c := client.NewClient()
+	return solanaMock
+}
 
-	txWatcherRepository, err := tx_watcher_repository.Prepare(context.Background(), c.DB.Client())
-	if err != nil {
-		log.Fatalf("can't prepare tx watcher repository: %v", err)
-	}
-	err = txWatcherRepository.CleanTransactions(context.Background())
-	require.NoError(t, err)
+func signedTx() (types.Transaction, string) {
+	signature := []byte(uuid.New().String() + uuid.New().String())
+	return types.Transaction{
+		Signatures: []types.Signature{signature},
+		Message:    types.Message{RecentBlockHash: testBlockhash},
+	}, base58.Encode(signature)
+}
 
+func newUserWithRewards(t *testing.T, c *client.Client) (*user.User, uuid.UUID) {
 	user := user.NewInitializedUser(auth.RandomSignUpRequest(), t)
 
-	err = c.DB.AuthDB().UpdateKYCStatus(context.TODO(), user.Email(), sumsub.KYCStatusApproved)
+	err := c.DB.AuthDB().UpdateKYCStatus(context.TODO(), user.Email(), sumsub.KYCStatusApproved)
 	require.NoError(t, err)
 
 	id, err := c.DB.AuthDB().GetUserIDByEmail(context.Background(), user.Email())
@@ -87,271 +72,230 @@ func TestClaimRewards_ImmediateSuccess(t *testing.T) {
 	err = c.DB.RewardsDB().DepositRewards(context.Background(), id, 100)
 	require.NoError(t, err)
 
-	resp, err := c.RewardsClient.ClaimRewards(user.AccessToken())
-	require.NoError(t, err)
-	require.NotEqual(t, "", resp.TransactionURL)
+	return user, id
+}
 
-	var txWatcherSvc *tx_watcher_svc.Service
-	{
-		feePayer, tokenHolder, _ := accounts.GetAccounts()
-
-		txWatcherSvc = tx_watcher_svc.NewService(
-			txWatcherRepository,
-			solanaMock,
-			feePayer,
-			tokenHolder,
-		)
-	}
-	err = txWatcherSvc.ResendSolanaDBTXsIfNeeded(context.Background())
+// txStatusChecker reports the same status for any transaction,
+// so background reconciliation doesn't affect the test
+type txStatusChecker struct {
+	status lib_solana.TransactionStatus
+}
+
+func (c *txStatusChecker) GetTransactionStatus(ctx context.Context, txhash string) (lib_solana.TransactionStatus, error) {
+	return c.status, nil
+}
+
+func (c *txStatusChecker) IsBlockhashValid(ctx context.Context, blockhash string) (bool, error) {
+	return true, nil
+}
+
+func newRewardsService(t *testing.T, c *client.Client, checker *txStatusChecker) *rewards.Service {
+	repo, err := rewardsRepo.Prepare(context.Background(), c.DB.Client())
 	require.NoError(t, err)
 
-	allTransactions, err := txWatcherRepository.GetAllTransactions(context.Background())
+	return rewards.NewService(
+		c.DB.Client(),
+		repo,
+		nil,
+		db_internal.NewAdvisoryLocks(c.DB.Client()),
+		rewards.WithTransactionStatusChecker(checker),
+	)
+}
+
+func requireClaimStatus(t *testing.T, c *client.Client, userID uuid.UUID, status string) {
+	claims, err := c.DB.RewardsDB().GetRewardClaimsByUserID(context.Background(), userID)
 	require.NoError(t, err)
-	require.Len(t, allTransactions, 1)
-	require.Equal(t, allTransactions[0].Status, "successful")
+	require.Len(t, claims, 1)
+	require.Equal(t, status, claims[0].Status)
 }
 
-func TestClaimRewards_SuccessAfterSomeTime(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+func TestClaimRewards_ImmediateSuccess(t *testing.T) {
+	solanaMock := newSolanaMock(t)
 
-	solanaMock := solana_lib.NewMockInterface(ctrl)
-	mock.RegisterMockObject(mock.SolanaProvider, solanaMock)
-	solanaMock.ExpectCheckPrivateKeyAny()
-	solanaMock.ExpectNewAccountAny()
-	solanaMock.ExpectAccountFromPrivateKeyBytesAny()
-	solanaMock.EXPECT().
-		GetTokenAccountBalanceWithAutoDerive(gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(float64(100), nil).
-		AnyTimes()
-	solanaMock.EXPECT().
-		SerializeTxMessage(gomock.Any()).
-		Return([]byte{}, nil).
-		Times(1)
-	solanaMock.EXPECT().
-		DeserializeTxMessage(gomock.Any()).
-		Return(types.Message{}, nil).
-		Times(1)
-	solanaMock.EXPECT().
-		NewTransaction(gomock.Any()).
-		Return(types.Transaction{}, nil).
-		Times(1)
-	solanaMock.EXPECT().
-		GetLatestBlockhash(gomock.Any()).
-		Return(rpc.GetLatestBlockhashValue{}, nil).
-		Times(1)
+	tx, txHash := signedTx()
 	solanaMock.EXPECT().
 		PrepareSendAssetsTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(&lib_solana.PrepareTxResponse{}, nil).
+		Return(&lib_solana.PrepareTxResponse{Tx: tx}, nil).
 		Times(1)
 	solanaMock.EXPECT().
-		SendConstructedTransaction(gomock.Any(), gomock.Any()).
-		Return("", nil).
+		SendConstructedTransaction(gomock.Any(), tx).
+		Return(txHash, nil).
 		Times(1)
-	{
-		var cnt int
-		callback := func(ctx context.Context, txhash string) (bool, error) {
-			cnt++
-			if cnt <= 2 {
-				return false, nil
-			}
-
-			return true, nil
-		}
-		solanaMock.EXPECT().
-			IsTransactionSuccessful(gomock.Any(), gomock.Any()).
-			DoAndReturn(callback).
-			Times(3)
-	}
-	solanaMock.EXPECT().
-		NeedToRetry(gomock.Any(), gomock.Any()).
-		Return(false, nil).
-		Times(2)
 
 	defer app_config.RunAndWait()()
 
 	c := client.NewClient()
+	user, userID := newUserWithRewards(t, c)
 
-	txWatcherRepository, err := tx_watcher_repository.Prepare(context.Background(), c.DB.Client())
-	if err != nil {
-		log.Fatalf("can't prepare tx watcher repository: %v", err)
-	}
-	err = txWatcherRepository.CleanTransactions(context.Background())
+	resp, err := c.RewardsClient.ClaimRewards(user.AccessToken())
 	require.NoError(t, err)
+	require.Contains(t, resp.TransactionURL, txHash)
 
-	user := user.NewInitializedUser(auth.RandomSignUpRequest(), t)
-
-	err = c.DB.AuthDB().UpdateKYCStatus(context.TODO(), user.Email(), sumsub.KYCStatusApproved)
+	checker := &txStatusChecker{status: lib_solana.TransactionStatusSucceeded}
+	err = newRewardsService(t, c, checker).ReconcileClaims(context.Background())
 	require.NoError(t, err)
+	requireClaimStatus(t, c, userID, rewards.ClaimStatusConfirmed)
+}
 
-	id, err := c.DB.AuthDB().GetUserIDByEmail(context.Background(), user.Email())
-	require.NoError(t, err)
+func TestClaimRewards_SuccessAfterSomeTime(t *testing.T) {
+	solanaMock := newSolanaMock(t)
 
-	err = c.DB.RewardsDB().DepositRewards(context.Background(), id, 100)
-	require.NoError(t, err)
+	tx, txHash := signedTx()
+	solanaMock.EXPECT().
+		PrepareSendAssetsTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&lib_solana.PrepareTxResponse{Tx: tx}, nil).
+		Times(1)
+	solanaMock.EXPECT().
+		SendConstructedTransaction(gomock.Any(), tx).
+		Return(txHash, nil).
+		Times(1)
+
+	defer app_config.RunAndWait()()
+
+	c := client.NewClient()
+	user, userID := newUserWithRewards(t, c)
 
 	resp, err := c.RewardsClient.ClaimRewards(user.AccessToken())
 	require.NoError(t, err)
-	require.NotEqual(t, "", resp.TransactionURL)
+	require.Contains(t, resp.TransactionURL, txHash)
 
-	var txWatcherSvc *tx_watcher_svc.Service
-	{
-		feePayer, tokenHolder, _ := accounts.GetAccounts()
-
-		txWatcherSvc = tx_watcher_svc.NewService(
-			txWatcherRepository,
-			solanaMock,
-			feePayer,
-			tokenHolder,
-		)
-	}
+	checker := &txStatusChecker{status: lib_solana.TransactionStatusPending}
+	rewardsSvc := newRewardsService(t, c, checker)
 
 	{
-		err = txWatcherSvc.ResendSolanaDBTXsIfNeeded(context.Background())
+		err = rewardsSvc.ReconcileClaims(context.Background())
 		require.NoError(t, err)
-
-		allTransactions, err := txWatcherRepository.GetAllTransactions(context.Background())
-		require.NoError(t, err)
-		require.Len(t, allTransactions, 1)
-		require.Equal(t, "registered", allTransactions[0].Status)
+		requireClaimStatus(t, c, userID, rewards.ClaimStatusSubmitted)
 	}
 
-	err = txWatcherSvc.ResendSolanaDBTXsIfNeeded(context.Background())
-	require.NoError(t, err)
-
 	{
-		err = txWatcherSvc.ResendSolanaDBTXsIfNeeded(context.Background())
-		require.NoError(t, err)
-
-		allTransactions, err := txWatcherRepository.GetAllTransactions(context.Background())
+		checker.status = lib_solana.TransactionStatusSucceeded
+		err = rewardsSvc.ReconcileClaims(context.Background())
 		require.NoError(t, err)
-		require.Len(t, allTransactions, 1)
-		require.Equal(t, "successful", allTransactions[0].Status)
+		requireClaimStatus(t, c, userID, rewards.ClaimStatusConfirmed)
 	}
 }
 
 func TestClaimRewards_SuccessAfterRetry(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	solanaMock := newSolanaMock(t)
 
-	solanaMock := solana_lib.NewMockInterface(ctrl)
-	mock.RegisterMockObject(mock.SolanaProvider, solanaMock)
-	solanaMock.ExpectCheckPrivateKeyAny()
-	solanaMock.ExpectNewAccountAny()
-	solanaMock.ExpectAccountFromPrivateKeyBytesAny()
-	solanaMock.EXPECT().
-		GetTokenAccountBalanceWithAutoDerive(gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(float64(100), nil).
-		AnyTimes()
-	solanaMock.EXPECT().
-		SerializeTxMessage(gomock.Any()).
-		Return([]byte{}, nil).
-		Times(1)
-	solanaMock.EXPECT().
-		DeserializeTxMessage(gomock.Any()).
-		Return(types.Message{}, nil).
-		Times(2)
-	solanaMock.EXPECT().
-		NewTransaction(gomock.Any()).
-		Return(types.Transaction{}, nil).
-		Times(2)
-	solanaMock.EXPECT().
-		GetLatestBlockhash(gomock.Any()).
-		Return(rpc.GetLatestBlockhashValue{}, nil).
-		Times(2)
+	tx, txHash := signedTx()
 	solanaMock.EXPECT().
 		PrepareSendAssetsTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(&lib_solana.PrepareTxResponse{}, nil).
+		Return(&lib_solana.PrepareTxResponse{Tx: tx}, nil).
 		Times(1)
-	solanaMock.EXPECT().
-		SendConstructedTransaction(gomock.Any(), gomock.Any()).
-		Return("", nil).
-		Times(2)
 	{
 		var cnt int
-		callback := func(ctx context.Context, txhash string) (bool, error) {
+		callback := func(ctx context.Context, _ types.Transaction) (string, error) {
 			cnt++
 			if cnt <= 1 {
-				return false, nil
+				return "", errors.New("request timeout")
 			}
 
-			return true, nil
+			return txHash, nil
 		}
 		solanaMock.EXPECT().
-			IsTransactionSuccessful(gomock.Any(), gomock.Any()).
+			SendConstructedTransaction(gomock.Any(), tx).
 			DoAndReturn(callback).
 			Times(2)
 	}
+
+	defer app_config.RunAndWait()()
+
+	c := client.NewClient()
+	user, userID := newUserWithRewards(t, c)
+
+	resp, err := c.RewardsClient.ClaimRewards(user.AccessToken())
+	require.NoError(t, err)
+	require.Contains(t, resp.TransactionURL, txHash)
+	requireClaimStatus(t, c, userID, rewards.ClaimStatusSubmitted)
+
+	checker := &txStatusChecker{status: lib_solana.TransactionStatusSucceeded}
+	err = newRewardsService(t, c, checker).ReconcileClaims(context.Background())
+	require.NoError(t, err)
+	requireClaimStatus(t, c, userID, rewards.ClaimStatusConfirmed)
+}
+
+func TestClaimRewards_SignatureStoredBeforeSending(t *testing.T) {
+	solanaMock := newSolanaMock(t)
+
+	tx, txHash := signedTx()
 	solanaMock.EXPECT().
-		NeedToRetry(gomock.Any(), gomock.Any()).
-		Return(true, nil).
+		PrepareSendAssetsTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&lib_solana.PrepareTxResponse{Tx: tx}, nil).
 		Times(1)
 
 	defer app_config.RunAndWait()()
 
 	c := client.NewClient()
+	user, userID := newUserWithRewards(t, c)
 
-	txWatcherRepository, err := tx_watcher_repository.Prepare(context.Background(), c.DB.Client())
-	if err != nil {
-		log.Fatalf("can't prepare tx watcher repository: %v", err)
-	}
-	err = txWatcherRepository.CleanTransactions(context.Background())
+	solanaMock.EXPECT().
+		SendConstructedTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ types.Transaction) (string, error) {
+			claims, err := c.DB.RewardsDB().GetRewardClaimsByUserID(ctx, userID)
+			require.NoError(t, err)
+			require.Len(t, claims, 1)
+			require.Equal(t, rewards.ClaimStatusSubmitted, claims[0].Status)
+			require.Equal(t, txHash, claims[0].TxHash.String)
+			require.Equal(t, testBlockhash, claims[0].TxBlockhash.String)
+			return txHash, nil
+		}).
+		Times(1)
+
+	resp, err := c.RewardsClient.ClaimRewards(user.AccessToken())
 	require.NoError(t, err)
+	require.Contains(t, resp.TransactionURL, txHash)
+}
 
-	user := user.NewInitializedUser(auth.RandomSignUpRequest(), t)
+func TestClaimRewards_RevertedIfNotSigned(t *testing.T) {
+	solanaMock := newSolanaMock(t)
+	solanaMock.EXPECT().
+		PrepareSendAssetsTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("can't get recent blockhash")).
+		Times(1)
 
-	err = c.DB.AuthDB().UpdateKYCStatus(context.TODO(), user.Email(), sumsub.KYCStatusApproved)
-	require.NoError(t, err)
+	defer app_config.RunAndWait()()
 
-	id, err := c.DB.AuthDB().GetUserIDByEmail(context.Background(), user.Email())
-	require.NoError(t, err)
+	c := client.NewClient()
+	user, userID := newUserWithRewards(t, c)
 
-	err = c.DB.RewardsDB().DepositRewards(context.Background(), id, 100)
-	require.NoError(t, err)
+	_, err := c.RewardsClient.ClaimRewards(user.AccessToken())
+	require.Error(t, err)
 
-	resp, err := c.RewardsClient.ClaimRewards(user.AccessToken())
+	claims, err := c.DB.RewardsDB().GetRewardClaimsByUserID(context.Background(), userID)
 	require.NoError(t, err)
-	require.NotEqual(t, "", resp.TransactionURL)
+	require.Len(t, claims, 1)
+	require.Equal(t, rewards.ClaimStatusReverted, claims[0].Status)
+}
 
-	var txWatcherSvc *tx_watcher_svc.Service
-	{
-		feePayer, tokenHolder, _ := accounts.GetAccounts()
-
-		txWatcherSvc = tx_watcher_svc.NewService(
-			txWatcherRepository,
-			solanaMock,
-			feePayer,
-			tokenHolder,
-		)
-	}
+func TestClaimRewards_PendingIfSendingFailed(t *testing.T) {
+	solanaMock := newSolanaMock(t)
 
-	{
-		allTransactions, err := txWatcherRepository.GetAllTransactions(context.Background())
-		require.NoError(t, err)
-		require.Len(t, allTransactions, 1)
-		require.Equal(t, "registered", allTransactions[0].Status)
-		require.Equal(t, int32(0), allTransactions[0].Retries)
-	}
+	tx, txHash := signedTx()
+	solanaMock.EXPECT().
+		PrepareSendAssetsTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&lib_solana.PrepareTxResponse{Tx: tx}, nil).
+		Times(1)
+	// the same signed transaction is resent, it may have reached blockchain anyway
+	solanaMock.EXPECT().
+		SendConstructedTransaction(gomock.Any(), tx).
+		Return("", errors.New("request timeout")).
+		Times(5)
 
-	{
-		err = txWatcherSvc.ResendSolanaDBTXsIfNeeded(context.Background())
-		require.NoError(t, err)
+	defer app_config.RunAndWait()()
 
-		allTransactions, err := txWatcherRepository.GetAllTransactions(context.Background())
-		require.NoError(t, err)
-		require.Len(t, allTransactions, 1)
-		require.Equal(t, "registered", allTransactions[0].Status)
-		require.Equal(t, int32(1), allTransactions[0].Retries)
-	}
+	c := client.NewClient()
+	user, userID := newUserWithRewards(t, c)
 
-	{
-		err = txWatcherSvc.ResendSolanaDBTXsIfNeeded(context.Background())
-		require.NoError(t, err)
+	// pending claim is reported with 202 status and without transaction
+	resp, err := c.RewardsClient.ClaimRewards(user.AccessToken())
+	require.NoError(t, err)
+	require.Empty(t, resp.TransactionURL)
 
-		allTransactions, err := txWatcherRepository.GetAllTransactions(context.Background())
-		require.NoError(t, err)
-		require.Len(t, allTransactions, 1)
-		require.Equal(t, "successful", allTransactions[0].Status)
-		require.Equal(t, int32(1), allTransactions[0].Retries)
-	}
+	claims, err := c.DB.RewardsDB().GetRewardClaimsByUserID(context.Background(), userID)
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	require.Equal(t, rewards.ClaimStatusSubmitted, claims[0].Status)
+	require.Equal(t, txHash, claims[0].TxHash.String)
 }